@@ -246,6 +246,152 @@ const docTemplate = `{
                 }
             }
         },
+        "/account/password": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to change password using the old password (logout from all devices)",
+                "tags": [
+                    "User Profile"
+                ],
+                "summary": "Change password (User)",
+                "operationId": "UserChangePassword",
+                "parameters": [
+                    {
+                        "description": "Change password details",
+                        "name": "inputs",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ChangePassword"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully password changed",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Old password doesn't match",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many wrong passwords",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to change password",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/account/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to get all devices the user logged in",
+                "tags": [
+                    "User Profile"
+                ],
+                "summary": "Get all sessions (User)",
+                "operationId": "UserGetAllSessions",
+                "responses": {
+                    "200": {
+                        "description": "Successfully found all sessions",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.Session"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to find all sessions",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/account/sessions/{session_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to logout a device using session id",
+                "tags": [
+                    "User Profile"
+                ],
+                "summary": "Revoke a session (User)",
+                "operationId": "UserRevokeSession",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Session ID",
+                        "name": "session_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully session revoked",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No active session found with given session id",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to revoke session",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
         "/account/wallet": {
             "get": {
                 "security": [
@@ -416,69 +562,87 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/auth/renew-access-token": {
-            "post": {
+        "/admin/account/sessions": {
+            "get": {
                 "security": [
                     {
-                        "ApiKeyAuth": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "API for admin to renew access token using refresh token",
+                "description": "API for admin to get all devices the admin logged in",
                 "tags": [
                     "Admin Authentication"
                 ],
-                "summary": "Renew Access Token (Admin)",
-                "operationId": "AdminRenewAccessToken",
-                "parameters": [
-                    {
-                        "description": "Refresh token",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.RefreshToken"
-                        }
-                    }
-                ],
+                "summary": "Get all sessions (Admin)",
+                "operationId": "AdminGetAllSessions",
                 "responses": {
                     "200": {
-                        "description": "Successfully generated access token using refresh token",
-                        "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid input",
+                        "description": "Successfully found all sessions",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.Session"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
-                    "401": {
-                        "description": "Invalid refresh token",
+                    "500": {
+                        "description": "Failed to find all sessions",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
-                    },
-                    "403": {
-                        "description": "Refresh token blocked",
+                    }
+                }
+            }
+        },
+        "/admin/account/sessions/{session_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for admin to logout a device using session id",
+                "tags": [
+                    "Admin Authentication"
+                ],
+                "summary": "Revoke a session (Admin)",
+                "operationId": "AdminRevokeSession",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Session ID",
+                        "name": "session_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully session revoked",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "404": {
-                        "description": "No session found for the given refresh token",
-                        "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
-                    },
-                    "410": {
-                        "description": "Refresh token expired",
+                        "description": "No active session found with given session id",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed generate access token",
+                        "description": "Failed to revoke session",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -486,28 +650,82 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/auth/sign-in": {
-            "post": {
-                "description": "API for admin to login with password",
+        "/admin/audit-logs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for super admin to get all create, update and delete actions done by admins with filters",
                 "tags": [
-                    "Admin Authentication"
+                    "Admin Staff"
                 ],
-                "summary": "Login with password (Admin)",
-                "operationId": "AdminLogin",
+                "summary": "Get all audit logs (Super Admin)",
+                "operationId": "GetAllAuditLogs",
                 "parameters": [
                     {
-                        "description": "Login credentials",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.Login"
-                        }
+                        "type": "integer",
+                        "description": "Admin ID",
+                        "name": "admin_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "POST",
+                            "PUT",
+                            "PATCH",
+                            "DELETE"
+                        ],
+                        "type": "string",
+                        "description": "Request Method",
+                        "name": "method",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Part of route (eg: /orders)",
+                        "name": "route",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "success",
+                            "failure"
+                        ],
+                        "type": "string",
+                        "description": "Result of action",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Starting date",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ending date",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Count",
+                        "name": "count",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully logged in",
+                        "description": "Successfully found all audit logs",
                         "schema": {
                             "allOf": [
                                 {
@@ -517,33 +735,318 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.TokenResponse"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.AuditLog"
+                                            }
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Invalid input",
+                    "204": {
+                        "description": "No audit logs found",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
-                    "401": {
-                        "description": "Wrong password",
+                    "400": {
+                        "description": "Invalid inputs",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
-                    "404": {
-                        "description": "Admin not exist with this details",
+                    "403": {
+                        "description": "Permission denied",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to login",
+                        "description": "Failed to find all audit logs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/auth/logout": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for admin to logout from the current device (refresh token and access token of the session are revoked)",
+                "tags": [
+                    "Admin Authentication"
+                ],
+                "summary": "Logout (Admin)",
+                "operationId": "AdminLogout",
+                "responses": {
+                    "200": {
+                        "description": "Successfully logged out",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized user",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No session found for the access token",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to logout",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/auth/logout-all": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for admin to logout from all devices (all sessions of admin are revoked)",
+                "tags": [
+                    "Admin Authentication"
+                ],
+                "summary": "Logout from all devices (Admin)",
+                "operationId": "AdminLogoutAll",
+                "responses": {
+                    "200": {
+                        "description": "Successfully logged out from all devices",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized user",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to logout from all devices",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/auth/renew-access-token": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for admin to renew access token using refresh token (the refresh token is rotated on each renewal)",
+                "tags": [
+                    "Admin Authentication"
+                ],
+                "summary": "Renew Access Token (Admin)",
+                "operationId": "AdminRenewAccessToken",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.RefreshToken"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully generated access token and rotated refresh token",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.TokenResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid refresh token or refresh token reused",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Refresh token blocked",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No session found for the given refresh token",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "410": {
+                        "description": "Refresh token expired",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed generate access token",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/auth/set-password": {
+            "post": {
+                "description": "API for invited staff to set the password with the token received on invitation mail",
+                "tags": [
+                    "Admin Authentication"
+                ],
+                "summary": "Set password of invited staff (Admin)",
+                "operationId": "SetStaffPassword",
+                "parameters": [
+                    {
+                        "description": "Password details",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.StaffSetPassword"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully password set",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid or expired password token",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to set password",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/auth/sign-in": {
+            "post": {
+                "description": "API for admin to login with password",
+                "tags": [
+                    "Admin Authentication"
+                ],
+                "summary": "Login with password (Admin)",
+                "operationId": "AdminLogin",
+                "parameters": [
+                    {
+                        "description": "Login credentials",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.Login"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully logged in",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.TokenResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Wrong password",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Admin blocked by super admin",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Admin not exist with this details",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "Login locked due to too many failed attempts",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to login",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -944,14 +1447,67 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/categories/{category_id}/variations": {
-            "get": {
+        "/admin/categories/{category_id}/tax-class": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API for admin to get all variation and its values of a category",
+                "description": "API for admin to assign a tax class to a category (zero tax class id to remove)",
+                "tags": [
+                    "Admin Tax"
+                ],
+                "summary": "Assign tax class to category (Admin)",
+                "operationId": "UpdateCategoryTaxClass",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Category ID",
+                        "name": "category_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tax class details",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.AssignTaxClass"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully tax class of category updated",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update tax class of category",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/categories/{category_id}/variations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for admin to get all variation and its values of a category",
                 "consumes": [
                     "application/json"
                 ],
@@ -1236,6 +1792,73 @@ const docTemplate = `{
                 }
             }
         },
+        "/admin/dashboard": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for admin to get revenue, order count, average order value, new users, pending returns,\nlow stock count and payment conversion of a period with time series for charts",
+                "tags": [
+                    "Admin Dashboard"
+                ],
+                "summary": "Get dashboard (Admin)",
+                "operationId": "GetDashboard",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Starting date (default 30 days before end date)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ending date (default now)",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time series bucket day, week or month (default day)",
+                        "name": "bucket",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully found dashboard",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.Dashboard"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to find dashboard",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
         "/admin/offers": {
             "get": {
                 "security": [
@@ -1764,6 +2387,58 @@ const docTemplate = `{
                 }
             }
         },
+        "/admin/orders/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for admin to cancel an order with restock and refund of paid amount",
+                "tags": [
+                    "Admin Orders"
+                ],
+                "summary": "Cancel order (Admin)",
+                "operationId": "CancelOrderAdmin",
+                "parameters": [
+                    {
+                        "description": "input field",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CancelOrder"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully order cancelled",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Order can't cancel on current status",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to cancel order",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
         "/admin/orders/returns": {
             "get": {
                 "security": [
@@ -1923,6 +2598,65 @@ const docTemplate = `{
                 }
             }
         },
+        "/admin/orders/{shop_order_id}/invoice": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for admin to download the pdf invoice of a placed order",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "Admin Orders"
+                ],
+                "summary": "Download order invoice (Admin)",
+                "operationId": "GetOrderInvoiceAdmin",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Shop Order ID",
+                        "name": "shop_order_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "invoice pdf",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "302": {
+                        "description": "redirect to stored invoice pdf",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Order is not placed yet",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to get order invoice",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
         "/admin/orders/{shop_order_id}/items": {
             "get": {
                 "security": [
@@ -1979,22 +2713,31 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/payment-method": {
-            "put": {
+        "/admin/orders/{shop_order_id}/timeline": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API for admin to change maximum price or block or unblock the payment method",
+                "description": "API for admin to get all status changes of an order",
                 "tags": [
-                    "Admin Payment"
+                    "Admin Orders"
+                ],
+                "summary": "Get order timeline (Admin)",
+                "operationId": "GetOrderTimelineAdmin",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Shop Order ID",
+                        "name": "shop_order_id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Update payment method (Admin)",
-                "operationId": "UpdatePaymentMethod",
                 "responses": {
                     "200": {
-                        "description": "Successfully payment method updated",
+                        "description": "Successfully found order timeline",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -2006,7 +2749,7 @@ const docTemplate = `{
                         }
                     },
                     "500": {
-                        "description": "Failed to update payment method",
+                        "description": "Failed to find order timeline",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -2014,28 +2757,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/payment-methods": {
+        "/admin/payment-events": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API for admin to get all payment methods",
+                "description": "API for admin to get all received payment gateway webhook events",
                 "tags": [
                     "Admin Payment"
                 ],
-                "summary": "Get payment methods (Admin)",
-                "operationId": "GetAllPaymentMethodsAdmin",
+                "summary": "Get all payment events (Admin)",
+                "operationId": "GetAllPaymentEvents",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Count",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
-                        "description": "Failed to retrieve payment methods",
+                        "description": "Successfully found all payment events",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "204": {
+                        "description": "No payment events found",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Successfully retrieved all payment methods",
+                        "description": "Failed to find all payment events",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -2043,31 +2806,145 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/products": {
-            "get": {
+        "/admin/payment-events/{payment_event_id}/replay": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API for admin to get all products",
+                "description": "API for admin to process a saved payment gateway webhook event again",
                 "tags": [
-                    "Admin Products"
+                    "Admin Payment"
                 ],
-                "summary": "Get all products (Admin)",
-                "operationId": "GetAllProductsAdmin",
+                "summary": "Replay payment event (Admin)",
+                "operationId": "ReplayPaymentEvent",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Page Number",
-                        "name": "page_number",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Count",
-                        "name": "count",
-                        "in": "query"
+                        "description": "Payment Event ID",
+                        "name": "payment_event_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully payment event replayed",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Payment event not exist",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to replay payment event",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/payment-method": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for admin to change maximum price or block or unblock the payment method",
+                "tags": [
+                    "Admin Payment"
+                ],
+                "summary": "Update payment method (Admin)",
+                "operationId": "UpdatePaymentMethod",
+                "responses": {
+                    "200": {
+                        "description": "Successfully payment method updated",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update payment method",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/payment-methods": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for admin to get all payment methods",
+                "tags": [
+                    "Admin Payment"
+                ],
+                "summary": "Get payment methods (Admin)",
+                "operationId": "GetAllPaymentMethodsAdmin",
+                "responses": {
+                    "200": {
+                        "description": "Failed to retrieve payment methods",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Successfully retrieved all payment methods",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for admin to get all products",
+                "tags": [
+                    "Admin Products"
+                ],
+                "summary": "Get all products (Admin)",
+                "operationId": "GetAllProductsAdmin",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Count",
+                        "name": "count",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2222,58 +3099,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/products/{product_id}/items": {
+        "/admin/products/export": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API for admin to get all product items for a specific product",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "API for admin to download all product items as csv (same format of import)",
                 "produces": [
-                    "application/json"
+                    "text/csv"
                 ],
                 "tags": [
                     "Admin Products"
                 ],
-                "summary": "Get all product items (Admin)",
-                "operationId": "GetAllProductItemsAdmin",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Product ID",
-                        "name": "product_id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
+                "summary": "Export products as csv (Admin)",
+                "operationId": "ExportProducts",
                 "responses": {
                     "200": {
-                        "description": "Successfully get all product items",
+                        "description": "ecommerce_products.csv",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
-                    "400": {
-                        "description": "Failed to get all product items",
+                    "500": {
+                        "description": "Failed to export products",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/admin/products/import": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API for admin to add a product item for a specific product(should select at least one variation option from each variations)",
+                "description": "API for admin to add products and product items from a csv file\ncolumns: product_name, description, category_name, brand_name, product_price, sku, price, qty_in_stock, weight, variations\nvariations are given as \"Color:Red|Size:M\", sku is generated when it's empty and weight (in grams) is zero when it's empty",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
@@ -2281,64 +3148,68 @@ const docTemplate = `{
                 "tags": [
                     "Admin Products"
                 ],
-                "summary": "Add a product item (Admin)",
-                "operationId": "SaveProductItem",
+                "summary": "Import products from csv (Admin)",
+                "operationId": "ImportProducts",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Product ID",
-                        "name": "product_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Price",
-                        "name": "price",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Quantity In Stock",
-                        "name": "qty_in_stock",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "integer"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Variation Option IDs",
-                        "name": "variation_option_ids",
+                        "type": "file",
+                        "description": "Products csv file",
+                        "name": "file",
                         "in": "formData",
                         "required": true
                     },
                     {
-                        "type": "file",
-                        "description": "Images",
-                        "name": "images",
-                        "in": "formData",
-                        "required": true
+                        "type": "boolean",
+                        "description": "Only validate the rows without saving",
+                        "name": "dry_run",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully product item added",
+                        "description": "Successfully validated products",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.ProductImport"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "201": {
+                        "description": "Successfully imported products",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.ProductImport"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "invalid input",
+                        "description": "Invalid csv file",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
-                    "409": {
-                        "description": "Product have already this configured product items exist",
+                    "500": {
+                        "description": "Failed to import products",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -2346,30 +3217,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/sales": {
+        "/admin/products/questions": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API for admin to get all sales report for a specific period in csv form",
+                "description": "API for admin to get all product questions including hidden questions with filters",
                 "tags": [
-                    "Admin Sales"
+                    "Admin Products"
                 ],
-                "summary": "Get full sales report (Admin)",
-                "operationId": "GetFullSalesReport",
+                "summary": "Get all product questions (Admin)",
+                "operationId": "GetAllProductQuestionsAdmin",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Sales report starting date",
-                        "name": "start_date",
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "product_id",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Sales report ending date",
-                        "name": "end_date",
+                        "type": "boolean",
+                        "description": "Only questions without answer",
+                        "name": "unanswered",
                         "in": "query"
                     },
                     {
@@ -2387,19 +3258,40 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "ecommerce_sales_report.csv",
+                        "description": "Successfully found all product questions",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.ProductQuestion"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "204": {
-                        "description": "No sales report found",
+                        "description": "No product questions found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "failed to get sales report",
+                        "description": "Failed to find all product questions",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -2407,92 +3299,105 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/stocks": {
-            "get": {
+        "/admin/products/questions/answers/{answer_id}": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API for admin to get all stocks",
+                "description": "API for admin to hide or show an answer of a product question to users",
                 "tags": [
-                    "Admin Stock"
+                    "Admin Products"
                 ],
-                "summary": "Get all stocks (Admin)",
-                "operationId": "GetAllStocks",
+                "summary": "Hide or show an answer (Admin)",
+                "operationId": "UpdateProductAnswerVisibility",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Page Number",
-                        "name": "page_number",
-                        "in": "query"
+                        "description": "Answer ID",
+                        "name": "answer_id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Count",
-                        "name": "count",
-                        "in": "query"
+                        "description": "Visibility details",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateVisibility"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully found all stocks",
+                        "description": "Successfully updated visibility of answer",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
-                    "204": {
-                        "description": "No stocks found",
+                    "400": {
+                        "description": "Invalid inputs",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to Get all stocks",
+                        "description": "Failed to update visibility of answer",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/admin/products/questions/{question_id}": {
             "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API for admin to update stock details",
+                "description": "API for admin to hide or show a product question to users",
                 "tags": [
-                    "Admin Stock"
+                    "Admin Products"
                 ],
-                "summary": "Update stocks (Admin)",
-                "operationId": "UpdateStock",
+                "summary": "Hide or show a product question (Admin)",
+                "operationId": "UpdateProductQuestionVisibility",
                 "parameters": [
                     {
-                        "description": "Update stock details",
+                        "type": "integer",
+                        "description": "Question ID",
+                        "name": "question_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Visibility details",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UpdateStock"
+                            "$ref": "#/definitions/request.UpdateVisibility"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully updated sock",
+                        "description": "Successfully updated visibility of question",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "400": {
-                        "description": "Failed to bind input",
+                        "description": "Invalid inputs",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to update stock",
+                        "description": "Failed to update visibility of question",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -2500,20 +3405,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/users": {
+        "/admin/products/questions/{question_id}/answers": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API for admin to get all user details",
+                "description": "API for admin to get all answers of a question including hidden answers",
                 "tags": [
-                    "Admin User"
+                    "Admin Products"
                 ],
-                "summary": "Get all users",
-                "operationId": "GetAllUsers",
+                "summary": "Get all answers of question (Admin)",
+                "operationId": "GetAllProductAnswersAdmin",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Question ID",
+                        "name": "question_id",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "integer",
                         "description": "Page Number",
@@ -2529,58 +3441,91 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully got all users",
+                        "description": "Successfully found all answers of question",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.ProductAnswer"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "204": {
-                        "description": "No users found",
+                        "description": "No answers found for question",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to find all users",
+                        "description": "Failed to find all answers of question",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
-            }
-        },
-        "/admin/users/block": {
-            "patch": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "API for admin to answer a product question",
                 "tags": [
-                    "Admin User"
+                    "Admin Products"
                 ],
-                "summary": "api for admin to block or unblock user",
-                "operationId": "BlockUser",
+                "summary": "Answer a product question (Admin)",
+                "operationId": "SaveProductAnswerAdmin",
                 "parameters": [
                     {
-                        "description": "inputs",
+                        "type": "integer",
+                        "description": "Question ID",
+                        "name": "question_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Answer details",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.BlockUser"
+                            "$ref": "#/definitions/request.ProductAnswer"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Successfully changed block status of user",
+                    "201": {
+                        "description": "Successfully answer added for question",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "400": {
-                        "description": "invalid input",
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to add answer for question",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -2588,41 +3533,86 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/google-auth": {
+        "/admin/products/reviews": {
             "get": {
-                "description": "API for user to load google login page",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for admin to get all product reviews for moderation with filters",
                 "tags": [
-                    "User Authentication"
+                    "Admin Products"
+                ],
+                "summary": "Get all product reviews (Admin)",
+                "operationId": "GetAllProductReviewsAdmin",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "approved",
+                            "hidden"
+                        ],
+                        "type": "string",
+                        "description": "Review Status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Count",
+                        "name": "count",
+                        "in": "query"
+                    }
                 ],
-                "summary": "To load google login page (User)",
-                "operationId": "UserGoogleAuthLoginPage",
                 "responses": {
                     "200": {
-                        "description": "Successfully google login page loaded",
+                        "description": "Successfully found all product reviews",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.ProductReview"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "204": {
+                        "description": "No product reviews found",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
-                    }
-                }
-            }
-        },
-        "/auth/google-auth/callback": {
-            "post": {
-                "description": "API for google to callback after authentication",
-                "tags": [
-                    "User Authentication"
-                ],
-                "summary": "Google auth callback (User)",
-                "operationId": "UserGoogleAuthCallBack",
-                "responses": {
-                    "200": {
-                        "description": "Successfully logged in with google",
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed Login with google",
+                        "description": "Failed to find all product reviews",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -2630,80 +3620,87 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/google-auth/initialize": {
-            "get": {
-                "description": "API for user to initialize google auth",
-                "tags": [
-                    "User Authentication"
-                ],
-                "summary": "Initialize google auth (User)",
-                "operationId": "UserGoogleAuthInitialize",
-                "responses": {}
-            }
-        },
-        "/auth/renew-access-token": {
-            "post": {
+        "/admin/products/reviews/{review_id}/approve": {
+            "patch": {
                 "security": [
                     {
-                        "ApiKeyAuth": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "API for user to renew access token using refresh token",
+                "description": "API for admin to approve a review, so it's shown on the product and counted on the rating",
                 "tags": [
-                    "User Authentication"
+                    "Admin Products"
                 ],
-                "summary": "Renew Access Token (User)",
-                "operationId": "UserRenewAccessToken",
+                "summary": "Approve a product review (Admin)",
+                "operationId": "ApproveProductReview",
                 "parameters": [
                     {
-                        "description": "Refresh token",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.RefreshToken"
-                        }
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "review_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully generated access token using refresh token",
+                        "description": "Successfully review approved",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "400": {
-                        "description": "Invalid input",
-                        "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
-                    },
-                    "401": {
-                        "description": "Invalid refresh token",
+                        "description": "Invalid inputs",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
-                    "403": {
-                        "description": "Refresh token blocked",
+                    "500": {
+                        "description": "Failed to approve review",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
-                    },
-                    "404": {
-                        "description": "No session found for the given refresh token",
+                    }
+                }
+            }
+        },
+        "/admin/products/reviews/{review_id}/hide": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for admin to hide a review from the product and its rating",
+                "tags": [
+                    "Admin Products"
+                ],
+                "summary": "Hide a product review (Admin)",
+                "operationId": "HideProductReview",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "review_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully review hidden",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
-                    "410": {
-                        "description": "Refresh token expired",
+                    "400": {
+                        "description": "Invalid inputs",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed generate access token",
+                        "description": "Failed to hide review",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -2711,64 +3708,129 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/sign-in": {
-            "post": {
-                "description": "API for user to login with email | phone | user_name with password",
-                "tags": [
-                    "User Authentication"
-                ],
-                "summary": "Login with password (User)",
-                "operationId": "UserLogin",
-                "parameters": [
+        "/admin/products/{product_id}/items": {
+            "get": {
+                "security": [
                     {
-                        "description": "Login Details",
-                        "name": "inputs",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.Login"
-                        }
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for admin to get all product items for a specific product",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Products"
+                ],
+                "summary": "Get all product items (Admin)",
+                "operationId": "GetAllProductItemsAdmin",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully logged in",
+                        "description": "Successfully get all product items",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.TokenResponse"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     },
                     "400": {
-                        "description": "Invalid inputs",
+                        "description": "Failed to get all product items",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for admin to add a product item for a specific product(should select at least one variation option from each variations)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Products"
+                ],
+                "summary": "Add a product item (Admin)",
+                "operationId": "SaveProductItem",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
                     },
-                    "401": {
-                        "description": "User not exist with given login credentials",
+                    {
+                        "type": "integer",
+                        "description": "Price",
+                        "name": "price",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quantity In Stock",
+                        "name": "qty_in_stock",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Variation Option IDs",
+                        "name": "variation_option_ids",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Weight In Grams",
+                        "name": "weight",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Images",
+                        "name": "images",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully product item added",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
-                    "403": {
-                        "description": "User blocked by admin",
+                    "400": {
+                        "description": "invalid input",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
-                    "500": {
-                        "description": "Failed to login",
+                    "409": {
+                        "description": "Product have already this configured product items exist",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -2776,52 +3838,71 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/sign-in/otp/send": {
-            "post": {
-                "description": "API for user to send otp for login enter email | phone | user_name : otp will send to user registered number",
+        "/admin/products/{product_id}/items/{product_item_id}": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for admin to update the weight of a product item (used for weight based delivery charge)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "User Authentication"
+                    "Admin Products"
                 ],
-                "summary": "Login with Otp send (User)",
-                "operationId": "UserLoginOtpSend",
+                "summary": "Update a product item (Admin)",
+                "operationId": "UpdateProductItem",
                 "parameters": [
                     {
-                        "description": "Login credentials",
-                        "name": "inputs",
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Product Item ID",
+                        "name": "product_item_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Product item update input",
+                        "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.OTPLogin"
+                            "$ref": "#/definitions/request.UpdateProductItem"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully otp send to user's registered number",
+                        "description": "Successfully product item updated",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "400": {
-                        "description": "Invalid Otp",
-                        "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
-                    },
-                    "401": {
-                        "description": "User not exist with given login credentials",
+                        "description": "invalid input",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
-                    "403": {
-                        "description": "User blocked by admin",
+                    "404": {
+                        "description": "Product item not exist",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to send otp",
+                        "description": "Failed to update product item",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -2829,42 +3910,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/sign-in/otp/verify": {
-            "post": {
-                "description": "API for user to verify otp",
+        "/admin/products/{product_id}/tax-class": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for admin to assign a tax class to a product, it's used instead of category tax class (zero tax class id to remove)",
                 "tags": [
-                    "User Authentication"
+                    "Admin Tax"
                 ],
-                "summary": "Login with Otp verify (User)",
-                "operationId": "UserLoginOtpVerify",
+                "summary": "Assign tax class to product (Admin)",
+                "operationId": "UpdateProductTaxClass",
                 "parameters": [
                     {
-                        "description": "Otp Verify Details",
-                        "name": "inputs",
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tax class details",
+                        "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.OTPVerify"
+                            "$ref": "#/definitions/request.AssignTaxClass"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully user logged in",
+                        "description": "Successfully tax class of product updated",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/response.TokenResponse"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.Response"
                         }
                     },
                     "400": {
@@ -2873,20 +3954,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.Response"
                         }
                     },
-                    "401": {
-                        "description": "Otp not matched",
-                        "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
-                    },
-                    "410": {
-                        "description": "Otp Expired",
-                        "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
-                    },
                     "500": {
-                        "description": "Failed to verify otp",
+                        "description": "Failed to update tax class of product",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -2894,28 +3963,22 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/sign-up": {
-            "post": {
-                "description": "API for user to register a new account",
-                "tags": [
-                    "User Authentication"
-                ],
-                "summary": "Signup (User)",
-                "operationId": "UserSignUp",
-                "parameters": [
+        "/admin/roles": {
+            "get": {
+                "security": [
                     {
-                        "description": "Input Fields",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.UserSignUp"
-                        }
+                        "BearerAuth": []
                     }
                 ],
+                "description": "API for super admin to get all staff roles with its permissions",
+                "tags": [
+                    "Admin Staff"
+                ],
+                "summary": "Get all roles (Super Admin)",
+                "operationId": "GetAllRoles",
                 "responses": {
                     "200": {
-                        "description": "Successfully account created and otp send to registered number",
+                        "description": "Successfully found all roles",
                         "schema": {
                             "allOf": [
                                 {
@@ -2925,27 +3988,24 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.OTPResponse"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.Role"
+                                            }
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Invalid input",
-                        "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
-                    },
-                    "409": {
-                        "description": "A verified user already exist with given user credentials",
+                    "403": {
+                        "description": "Permission denied",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to signup",
+                        "description": "Failed to find all roles",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -2953,28 +4013,111 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/sign-up/verify": {
-            "post": {
-                "description": "API for user to verify otp on sign up",
+        "/admin/sales": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for admin to download the full sales report with its summary for a specific period as csv or pdf",
+                "produces": [
+                    "text/csv",
+                    "application/pdf"
+                ],
                 "tags": [
-                    "User Authentication"
+                    "Admin Sales"
                 ],
-                "summary": "UserSingUp verify OTP  (User)",
-                "operationId": "UserSignUpVerify",
+                "summary": "Get full sales report (Admin)",
+                "operationId": "GetFullSalesReport",
                 "parameters": [
                     {
-                        "description": "Otp Verify Details",
-                        "name": "inputs",
-                        "in": "body",
-                        "required": true,
+                        "type": "string",
+                        "description": "Sales report starting date",
+                        "name": "start_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sales report ending date",
+                        "name": "end_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Report format csv or pdf (default csv)",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sales bucket day, week or month (default day)",
+                        "name": "bucket",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ecommerce_sales_report.csv",
                         "schema": {
-                            "$ref": "#/definitions/request.OTPVerify"
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "failed to get sales report",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
                         }
                     }
+                }
+            }
+        },
+        "/admin/sales/summary": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for admin to get revenue, discounts, refunds, order counts and top products and categories of a period\nwith the sales of each day, week or month",
+                "tags": [
+                    "Admin Sales"
+                ],
+                "summary": "Get sales summary (Admin)",
+                "operationId": "GetSalesSummary",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sales report starting date",
+                        "name": "start_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sales report ending date",
+                        "name": "end_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sales bucket day, week or month (default day)",
+                        "name": "bucket",
+                        "in": "query"
+                    }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully otp verified for user sign up",
+                        "description": "Successfully found sales summary",
                         "schema": {
                             "allOf": [
                                 {
@@ -2984,7 +4127,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.TokenResponse"
+                                            "$ref": "#/definitions/response.SalesSummary"
                                         }
                                     }
                                 }
@@ -2992,25 +4135,13 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid inputs",
-                        "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
-                    },
-                    "401": {
-                        "description": "Otp not matched",
-                        "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
-                    },
-                    "410": {
-                        "description": "Otp Expired",
+                        "description": "invalid input",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to verify otp",
+                        "description": "Failed to find sales summary",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -3018,78 +4149,113 @@ const docTemplate = `{
                 }
             }
         },
-        "/carts": {
+        "/admin/shipping-zones": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API for user to get all cart items",
+                "description": "API for admin to get all shipping zones",
                 "tags": [
-                    "User Cart"
+                    "Admin Shipping"
+                ],
+                "summary": "Get all shipping zones (Admin)",
+                "operationId": "GetAllShippingZones",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Count",
+                        "name": "count",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get cart Items (User)",
-                "operationId": "GetCart",
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved all cart items",
+                        "description": "Successfully found all shipping zones",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.ShippingZone"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "204": {
-                        "description": "Cart is empty",
+                        "description": "No shipping zones found",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to get user cart",
+                        "description": "Failed to find all shipping zones",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API for user to update cart item quantity (minimum qty is 1)",
+                "description": "API for admin to add a shipping zone of a pincode range with flat, weight or free over rate\npincodes should be 6 digit indian pincodes and delivery is free for all addresses until a zone is added",
                 "tags": [
-                    "User Cart"
+                    "Admin Shipping"
                 ],
-                "summary": "Change Cart Qty (User)",
-                "operationId": "UpdateCart",
+                "summary": "Add shipping zone (Admin)",
+                "operationId": "SaveShippingZone",
                 "parameters": [
                     {
-                        "description": "Input Field",
+                        "description": "Shipping zone details",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UpdateCartItem"
+                            "$ref": "#/definitions/request.ShippingZone"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Successfully to update cart item quantity changed in cart",
+                    "201": {
+                        "description": "Successfully shipping zone added",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "400": {
-                        "description": "Invalid input",
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Shipping zone already exist with this name",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to update product item in cart",
+                        "description": "Failed to add shipping zone",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -3097,124 +4263,100 @@ const docTemplate = `{
                 }
             }
         },
-        "/carts/apply-coupon": {
-            "patch": {
+        "/admin/shipping-zones/{shipping_zone_id}": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API for user to apply a coupon on cart",
+                "description": "API for admin to update pincode range (6 digit indian pincodes) or rate of a shipping zone",
                 "tags": [
-                    "User Cart"
+                    "Admin Shipping"
                 ],
-                "summary": "Apply coupon",
-                "operationId": "ApplyCouponToCart",
+                "summary": "Update shipping zone (Admin)",
+                "operationId": "UpdateShippingZone",
                 "parameters": [
                     {
-                        "description": "Input Field",
-                        "name": "inputs",
+                        "type": "integer",
+                        "description": "Shipping Zone ID",
+                        "name": "shipping_zone_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Shipping zone details",
+                        "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.ApplyCoupon"
+                            "$ref": "#/definitions/request.ShippingZone"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully coupon applied to user cart",
+                        "description": "Successfully shipping zone updated",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "400": {
-                        "description": "invalid input",
+                        "description": "Invalid inputs",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
-                    }
-                }
-            }
-        },
-        "/carts/checkout/payment-select-page": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "API for user to render payment select page",
-                "tags": [
-                    "User Payment"
-                ],
-                "summary": "Render Payment Page (User)",
-                "operationId": "CartOrderPaymentSelectPage",
-                "responses": {
-                    "200": {
-                        "description": "Successfully rendered payment page",
+                    },
+                    "409": {
+                        "description": "Shipping zone already exist with this name",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to render payment page",
+                        "description": "Failed to update shipping zone",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
-            }
-        },
-        "/carts/place-order": {
-            "post": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API for user save an order",
+                "description": "API for admin to delete a shipping zone (pincodes of the zone will not be serviceable, delivery is free for all addresses when no zone is left)",
                 "tags": [
-                    "User Orders"
+                    "Admin Shipping"
                 ],
-                "summary": "Save Order (User)",
-                "operationId": "SaveOrder",
+                "summary": "Delete shipping zone (Admin)",
+                "operationId": "DeleteShippingZone",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Address ID",
-                        "name": "address_id",
-                        "in": "formData",
+                        "type": "integer",
+                        "description": "Shipping Zone ID",
+                        "name": "shipping_zone_id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "successfully order placed",
-                        "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
-                    },
-                    "204": {
-                        "description": "Cart is empty",
+                        "description": "Successfully shipping zone deleted",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "400": {
-                        "description": "invalid input",
-                        "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
-                    },
-                    "409": {
-                        "description": "Can't place order out of stock product on cart",
+                        "description": "Invalid inputs",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to save order",
+                        "description": "Failed to delete shipping zone",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -3222,78 +4364,119 @@ const docTemplate = `{
                 }
             }
         },
-        "/carts/place-order/cod": {
-            "post": {
+        "/admin/staff": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API for user to place order for cash on delivery",
+                "description": "API for super admin to get all staff accounts with its role",
                 "tags": [
-                    "User Payment"
+                    "Admin Staff"
                 ],
-                "summary": "Place order  for COD (User)",
-                "operationId": "PaymentCOD",
+                "summary": "Get all staff (Super Admin)",
+                "operationId": "GetAllStaff",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Shop Order ID",
-                        "name": "shop_order_id",
-                        "in": "formData",
-                        "required": true
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Count",
+                        "name": "count",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "successfully order placed for COD",
+                        "description": "Successfully found all staff",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
-                    },
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.Staff"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
                     "500": {
-                        "description": "Failed place order for COD",
+                        "description": "Failed to find all staff",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
-            }
-        },
-        "/carts/place-order/razorpay-checkout": {
+            },
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for user to create stripe payment",
+                "description": "API for super admin to create a staff account with a role (a token to set the password is mailed on invitation)",
                 "tags": [
-                    "User Payment"
+                    "Admin Staff"
                 ],
-                "summary": "Razorpay checkout (User)",
-                "operationId": "RazorpayCheckout",
+                "summary": "Invite a staff (Super Admin)",
+                "operationId": "InviteStaff",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Shop Order ID",
-                        "name": "shop_order_id",
-                        "in": "formData",
-                        "required": true
+                        "description": "Staff details",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.StaffInvite"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "successfully razorpay payment order created",
+                    "201": {
+                        "description": "Successfully staff invited",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or invalid role id",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "An admin already exist with this email or user_name",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to make razorpay order",
+                        "description": "Failed to invite staff",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -3301,152 +4484,118 @@ const docTemplate = `{
                 }
             }
         },
-        "/carts/place-order/razorpay-verify": {
-            "post": {
+        "/admin/staff/{admin_id}": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API for razorpay to callback backend for payment verification",
+                "description": "API for super admin to change role or block status of a staff (all sessions of the staff are revoked)",
                 "tags": [
-                    "User Payment"
+                    "Admin Staff"
                 ],
-                "summary": "Razorpay verify (User)",
-                "operationId": "RazorpayVerify",
+                "summary": "Update a staff (Super Admin)",
+                "operationId": "UpdateStaff",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Razorpay payment id",
-                        "name": "razorpay_order_id",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Razorpay payment id",
-                        "name": "razorpay_payment_id",
-                        "in": "formData",
+                        "type": "integer",
+                        "description": "Admin ID",
+                        "name": "admin_id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Razorpay signature",
-                        "name": "razorpay_signature",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Shop Order ID",
-                        "name": "shop_order_id",
-                        "in": "formData",
-                        "required": true
+                        "description": "Staff details",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.StaffUpdate"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully razorpay payment verified",
+                        "description": "Successfully staff updated",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
-                    "402": {
-                        "description": "Payment not approved",
+                    "400": {
+                        "description": "Invalid input or own account",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Staff not exist",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to Approve order",
+                        "description": "Failed to update staff",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
-            }
-        },
-        "/carts/place-order/stripe-checkout": {
-            "post": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API for user to create stripe payment",
+                "description": "API for super admin to delete a staff account (all sessions of the staff are revoked)",
                 "tags": [
-                    "User Payment"
+                    "Admin Staff"
                 ],
-                "summary": "Stripe checkout (User)",
-                "operationId": "StripPaymentCheckout",
+                "summary": "Delete a staff (Super Admin)",
+                "operationId": "DeleteStaff",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Shop Order ID",
-                        "name": "shop_order_id",
-                        "in": "formData",
+                        "type": "integer",
+                        "description": "Admin ID",
+                        "name": "admin_id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "successfully stripe payment order created",
+                        "description": "Successfully staff deleted",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
-                    "500": {
-                        "description": "Failed to create stripe order",
+                    "400": {
+                        "description": "Invalid input or own account",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
-                    }
-                }
-            }
-        },
-        "/carts/place-order/stripe-verify": {
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "API for user to callback backend after stripe payment for verification",
-                "tags": [
-                    "User Payment"
-                ],
-                "summary": "Stripe verify (User)",
-                "operationId": "StripePaymentVeify",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Stripe payment ID",
-                        "name": "stripe_payment_id",
-                        "in": "formData",
-                        "required": true
                     },
-                    {
-                        "type": "string",
-                        "description": "Shop Order ID",
-                        "name": "shop_order_id",
-                        "in": "formData",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Successfully stripe payment verified",
+                    "403": {
+                        "description": "Permission denied",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
-                    "402": {
-                        "description": "Payment not approved",
+                    "404": {
+                        "description": "Staff not exist",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to Approve order",
+                        "description": "Failed to delete staff",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -3454,97 +4603,98 @@ const docTemplate = `{
                 }
             }
         },
-        "/carts/{product_item_id}": {
-            "post": {
+        "/admin/stocks": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API for user to add a product item to cart",
+                "description": "API for admin to get all stocks",
                 "tags": [
-                    "User Cart"
+                    "Admin Stock"
                 ],
-                "summary": "Add product item to cart (User)",
-                "operationId": "AddToCart",
+                "summary": "Get all stocks (Admin)",
+                "operationId": "GetAllStocks",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Product Item ID",
-                        "name": "product_item_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Page Number",
+                        "name": "page_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Count",
+                        "name": "count",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully product item added to cart",
-                        "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
-                    },
-                    "404": {
-                        "description": "Product item in out of stock",
+                        "description": "Successfully found all stocks",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
-                    "409": {
-                        "description": "Product item already exist in cart",
+                    "204": {
+                        "description": "No stocks found",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to add product item into cart",
+                        "description": "Failed to Get all stocks",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
             },
-            "delete": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API for user to remove a product item from cart",
+                "description": "API for admin to update stock details (positive qty_to_add is restock and negative is manual adjustment)",
                 "tags": [
-                    "User Cart"
+                    "Admin Stock"
                 ],
-                "summary": "Remove product item from cart (User)",
-                "operationId": "RemoveFromCart",
+                "summary": "Update stocks (Admin)",
+                "operationId": "UpdateStock",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Product Item ID",
-                        "name": "product_item_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Update stock details",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateStock"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully product item removed form cart",
+                        "description": "Successfully updated sock",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "400": {
-                        "description": "invalid input",
+                        "description": "Failed to bind input",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
-                    "404": {
-                        "description": "Product item not exist in cart",
+                    "409": {
+                        "description": "Not enough stock to remove",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to remove product item from cart",
+                        "description": "Failed to update stock",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -3552,19 +4702,19 @@ const docTemplate = `{
                 }
             }
         },
-        "/orders": {
+        "/admin/stocks/low": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API to get order for user user orders",
+                "description": "API for admin to get all stocks which are reached their reorder threshold",
                 "tags": [
-                    "User Orders"
+                    "Admin Stock"
                 ],
-                "summary": "Get user orders (User)",
-                "operationId": "GetUserOrder",
+                "summary": "Get all low stocks (Admin)",
+                "operationId": "GetAllLowStocks",
                 "parameters": [
                     {
                         "type": "integer",
@@ -3574,26 +4724,26 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "Count Of Order",
+                        "description": "Count",
                         "name": "count",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved all user orders",
+                        "description": "Successfully found all low stocks",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "204": {
-                        "description": "No shop orders for user",
+                        "description": "No low stocks found",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to retrieve all user orders",
+                        "description": "Failed to find all low stocks",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -3601,39 +4751,61 @@ const docTemplate = `{
                 }
             }
         },
-        "/orders/return": {
-            "post": {
+        "/admin/stocks/{sku}/movements": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API for user to request a return for delivered order",
+                "description": "API for admin to get the history of stock changes of a product item",
                 "tags": [
-                    "User Orders"
+                    "Admin Stock"
                 ],
-                "summary": "Return request (User)",
-                "operationId": "SubmitReturnRequest",
+                "summary": "Get stock movements (Admin)",
+                "operationId": "GetAllStockMovements",
                 "parameters": [
                     {
-                        "description": "Input Fields",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.Return"
-                        }
+                        "type": "string",
+                        "description": "Product Item SKU",
+                        "name": "sku",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Count",
+                        "name": "count",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully return request submitted for order",
+                        "description": "Successfully found all stock movements",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "204": {
+                        "description": "No stock movements found",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "400": {
-                        "description": "invalid input",
+                        "description": "Invalid sku",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to find all stock movements",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -3641,43 +4813,52 @@ const docTemplate = `{
                 }
             }
         },
-        "/orders/{shop_order_id}/cancel": {
-            "post": {
+        "/admin/stocks/{sku}/reorder-threshold": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for user to cancel a order",
+                "description": "API for admin to update the reorder threshold of a product item (zero disable the low stock alert)",
                 "tags": [
-                    "User Orders"
+                    "Admin Stock"
                 ],
-                "summary": "Cancel order (User)",
-                "operationId": "CancelOrder",
+                "summary": "Update reorder threshold (Admin)",
+                "operationId": "UpdateReorderThreshold",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Shop Order ID",
-                        "name": "shop_order_id",
+                        "type": "string",
+                        "description": "Product Item SKU",
+                        "name": "sku",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Reorder threshold details",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateReorderThreshold"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully order cancelled",
+                        "description": "Successfully updated reorder threshold",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "400": {
-                        "description": "Invalid inputs",
+                        "description": "Failed to bind input",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to cancel order",
+                        "description": "Failed to update reorder threshold",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -3685,27 +4866,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/orders/{shop_order_id}/items": {
+        "/admin/tax-classes": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API for user to get all order items of a specific order",
+                "description": "API for admin to get all GST tax classes",
                 "tags": [
-                    "User Orders"
+                    "Admin Tax"
                 ],
-                "summary": "Get all order items (User)",
-                "operationId": "GetAllOrderItemsUser",
+                "summary": "Get all tax classes (Admin)",
+                "operationId": "GetAllTaxClasses",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Shop Order ID",
-                        "name": "shop_order_id",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "integer",
                         "description": "Page Number",
@@ -3714,20 +4888,91 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "Count Of Order",
+                        "description": "Count",
                         "name": "count",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully found order items",
+                        "description": "Successfully found all tax classes",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.TaxClass"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "204": {
+                        "description": "No tax classes found",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to find order items",
+                        "description": "Failed to find all tax classes",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for admin to add a GST tax class with its rate in percentage",
+                "tags": [
+                    "Admin Tax"
+                ],
+                "summary": "Add tax class (Admin)",
+                "operationId": "SaveTaxClass",
+                "parameters": [
+                    {
+                        "description": "Tax class details",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.TaxClass"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successfully tax class added",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Tax class already exist with this name",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to add tax class",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -3735,28 +4980,58 @@ const docTemplate = `{
                 }
             }
         },
-        "/payment-methods": {
-            "get": {
+        "/admin/tax-classes/{tax_class_id}": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API for user to get all payment methods",
+                "description": "API for admin to update name or rate of a tax class (new rate is only applied on new orders)",
                 "tags": [
-                    "User Payment"
+                    "Admin Tax"
+                ],
+                "summary": "Update tax class (Admin)",
+                "operationId": "UpdateTaxClass",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Tax Class ID",
+                        "name": "tax_class_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tax class details",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.TaxClass"
+                        }
+                    }
                 ],
-                "summary": "Get payment methods (User)",
-                "operationId": "GetAllPaymentMethodsUser",
                 "responses": {
                     "200": {
-                        "description": "Failed to retrieve payment methods",
+                        "description": "Successfully tax class updated",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Tax class already exist with this name",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Successfully retrieved all payment methods",
+                        "description": "Failed to update tax class",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -3764,19 +5039,19 @@ const docTemplate = `{
                 }
             }
         },
-        "/products": {
+        "/admin/users": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API for user to get all products",
+                "description": "API for admin to get all user details",
                 "tags": [
-                    "User Products"
+                    "Admin User"
                 ],
-                "summary": "Get all products (User)",
-                "operationId": "GetAllProductsUser",
+                "summary": "Get all users",
+                "operationId": "GetAllUsers",
                 "parameters": [
                     {
                         "type": "integer",
@@ -3793,13 +5068,19 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully found all products",
+                        "description": "Successfully got all users",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "204": {
+                        "description": "No users found",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "Failed to get all products",
+                        "description": "Failed to find all users",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -3807,277 +5088,3457 @@ const docTemplate = `{
                 }
             }
         },
-        "/products/{product_id}/items": {
-            "get": {
+        "/admin/users/block": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "API for user to get all product items for a specific product",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "User Products"
+                    "Admin User"
                 ],
-                "summary": "Get all product items (User)",
-                "operationId": "GetAllProductItemsUser",
+                "summary": "api for admin to block or unblock user",
+                "operationId": "BlockUser",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Product ID",
-                        "name": "product_id",
-                        "in": "path",
-                        "required": true
+                        "description": "inputs",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.BlockUser"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully get all product items",
+                        "description": "Successfully changed block status of user",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "400": {
-                        "description": "Failed to get all product items",
+                        "description": "invalid input",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "request.Address": {
-            "type": "object",
-            "required": [
-                "house",
-                "land_mark",
-                "name",
-                "phone_number",
-                "pincode"
-            ],
-            "properties": {
-                "area": {
-                    "type": "string"
-                },
-                "city": {
-                    "type": "string"
-                },
-                "house": {
-                    "type": "string"
-                },
-                "is_default": {
-                    "type": "boolean"
-                },
-                "land_mark": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string",
-                    "maxLength": 50,
-                    "minLength": 2
-                },
-                "phone_number": {
-                    "type": "string",
-                    "maxLength": 10,
-                    "minLength": 10
-                },
+        },
+        "/auth/forgot-password": {
+            "post": {
+                "description": "API for user to send otp for reset password enter email | phone | user_name : otp will send to user registered number or email (by otp_channel sms | email)",
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "Forgot password otp send (User)",
+                "operationId": "UserForgotPassword",
+                "parameters": [
+                    {
+                        "description": "User credentials",
+                        "name": "inputs",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.OTPLogin"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully otp send to user's registered number",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.OTPResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "User blocked by admin",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "User not exist with given credentials",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "Otp resend cooldown or daily limit reached",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to send otp",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/google-auth": {
+            "get": {
+                "description": "API for user to load google login page",
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "To load google login page (User)",
+                "operationId": "UserGoogleAuthLoginPage",
+                "responses": {
+                    "200": {
+                        "description": "Successfully google login page loaded",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/google-auth/callback": {
+            "post": {
+                "description": "API for google to callback after authentication",
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "Google auth callback (User)",
+                "operationId": "UserGoogleAuthCallBack",
+                "responses": {
+                    "200": {
+                        "description": "Successfully logged in with google",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed Login with google",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/google-auth/initialize": {
+            "get": {
+                "description": "API for user to initialize google auth",
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "Initialize google auth (User)",
+                "operationId": "UserGoogleAuthInitialize",
+                "responses": {}
+            }
+        },
+        "/auth/logout": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to logout from the current device (refresh token and access token of the session are revoked)",
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "Logout (User)",
+                "operationId": "UserLogout",
+                "responses": {
+                    "200": {
+                        "description": "Successfully logged out",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized user",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No session found for the access token",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to logout",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/logout-all": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to logout from all devices (all sessions of user are revoked)",
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "Logout from all devices (User)",
+                "operationId": "UserLogoutAll",
+                "responses": {
+                    "200": {
+                        "description": "Successfully logged out from all devices",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized user",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to logout from all devices",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/renew-access-token": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for user to renew access token using refresh token (the refresh token is rotated on each renewal)",
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "Renew Access Token (User)",
+                "operationId": "UserRenewAccessToken",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.RefreshToken"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully generated access token and rotated refresh token",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.TokenResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid refresh token or refresh token reused",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Refresh token blocked",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "No session found for the given refresh token",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "410": {
+                        "description": "Refresh token expired",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed generate access token",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/reset-password": {
+            "post": {
+                "description": "API for user to set a new password by verifying the otp of forgot password (logout from all devices)",
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "Reset password (User)",
+                "operationId": "UserResetPassword",
+                "parameters": [
+                    {
+                        "description": "Reset password details",
+                        "name": "inputs",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ResetPassword"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully password reset",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Otp not matched",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "410": {
+                        "description": "Otp Expired",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many wrong otp attempts",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to reset password",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/sign-in": {
+            "post": {
+                "description": "API for user to login with email | phone | user_name with password",
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "Login with password (User)",
+                "operationId": "UserLogin",
+                "parameters": [
+                    {
+                        "description": "Login Details",
+                        "name": "inputs",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.Login"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully logged in",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.TokenResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "User not exist with given login credentials",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "User blocked by admin",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "Login locked due to too many failed attempts",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to login",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/sign-in/otp/send": {
+            "post": {
+                "description": "API for user to send otp for login enter email | phone | user_name : otp will send to user registered number or email (by otp_channel sms | email)",
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "Login with Otp send (User)",
+                "operationId": "UserLoginOtpSend",
+                "parameters": [
+                    {
+                        "description": "Login credentials",
+                        "name": "inputs",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.OTPLogin"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully otp send to user's registered number",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid Otp",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "User not exist with given login credentials",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "User blocked by admin",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "Otp resend cooldown or daily limit reached",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to send otp",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/sign-in/otp/verify": {
+            "post": {
+                "description": "API for user to verify otp",
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "Login with Otp verify (User)",
+                "operationId": "UserLoginOtpVerify",
+                "parameters": [
+                    {
+                        "description": "Otp Verify Details",
+                        "name": "inputs",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.OTPVerify"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully user logged in",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.TokenResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Otp not matched",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "410": {
+                        "description": "Otp Expired",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many wrong otp attempts",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to verify otp",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/sign-up": {
+            "post": {
+                "description": "API for user to register a new account",
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "Signup (User)",
+                "operationId": "UserSignUp",
+                "parameters": [
+                    {
+                        "description": "Input Fields",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UserSignUp"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully account created and otp send to registered number",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.OTPResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "A verified user already exist with given user credentials",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "Otp resend cooldown or daily limit reached",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to signup",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/sign-up/verify": {
+            "post": {
+                "description": "API for user to verify otp on sign up",
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "UserSingUp verify OTP  (User)",
+                "operationId": "UserSignUpVerify",
+                "parameters": [
+                    {
+                        "description": "Otp Verify Details",
+                        "name": "inputs",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.OTPVerify"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully otp verified for user sign up",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.TokenResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Otp not matched",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "410": {
+                        "description": "Otp Expired",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many wrong otp attempts",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to verify otp",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/carts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to get all cart items",
+                "tags": [
+                    "User Cart"
+                ],
+                "summary": "Get cart Items (User)",
+                "operationId": "GetCart",
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved all cart items",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "204": {
+                        "description": "Cart is empty",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to get user cart",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to update cart item quantity (minimum qty is 1)",
+                "tags": [
+                    "User Cart"
+                ],
+                "summary": "Change Cart Qty (User)",
+                "operationId": "UpdateCart",
+                "parameters": [
+                    {
+                        "description": "Input Field",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateCartItem"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully to update cart item quantity changed in cart",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update product item in cart",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/carts/apply-coupon": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to apply a coupon on cart",
+                "tags": [
+                    "User Cart"
+                ],
+                "summary": "Apply coupon",
+                "operationId": "ApplyCouponToCart",
+                "parameters": [
+                    {
+                        "description": "Input Field",
+                        "name": "inputs",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ApplyCoupon"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully coupon applied to user cart",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/carts/checkout/payment-select-page": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to render payment select page",
+                "tags": [
+                    "User Payment"
+                ],
+                "summary": "Render Payment Page (User)",
+                "operationId": "CartOrderPaymentSelectPage",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Shop Order ID (to show order price with delivery charge)",
+                        "name": "shop_order_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully rendered payment page",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to render payment page",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/carts/place-order": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user save an order",
+                "tags": [
+                    "User Orders"
+                ],
+                "summary": "Save Order (User)",
+                "operationId": "SaveOrder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Address ID",
+                        "name": "address_id",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "successfully order placed",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "204": {
+                        "description": "Cart is empty",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Can't place order out of stock product on cart",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Delivery is not available to the address pincode",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to save order",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/carts/place-order/cod": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to place order for cash on delivery",
+                "tags": [
+                    "User Payment"
+                ],
+                "summary": "Place order  for COD (User)",
+                "operationId": "PaymentCOD",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Shop Order ID",
+                        "name": "shop_order_id",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "successfully order placed for COD",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid shop order id",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Order already cancelled",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed place order for COD",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/carts/place-order/razorpay-checkout": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for user to create stripe payment",
+                "tags": [
+                    "User Payment"
+                ],
+                "summary": "Razorpay checkout (User)",
+                "operationId": "RazorpayCheckout",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Shop Order ID",
+                        "name": "shop_order_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Pay part of the amount from wallet",
+                        "name": "use_wallet",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "successfully razorpay payment order created",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to make razorpay order",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/carts/place-order/razorpay-verify": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for razorpay to callback backend for payment verification",
+                "tags": [
+                    "User Payment"
+                ],
+                "summary": "Razorpay verify (User)",
+                "operationId": "RazorpayVerify",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Razorpay payment id",
+                        "name": "razorpay_order_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Razorpay payment id",
+                        "name": "razorpay_payment_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Razorpay signature",
+                        "name": "razorpay_signature",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Shop Order ID",
+                        "name": "shop_order_id",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully razorpay payment verified",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid shop order or payment",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "402": {
+                        "description": "Payment not approved",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Order already cancelled",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to verify payment",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/carts/place-order/stripe-checkout": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to create stripe payment",
+                "tags": [
+                    "User Payment"
+                ],
+                "summary": "Stripe checkout (User)",
+                "operationId": "StripPaymentCheckout",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Shop Order ID",
+                        "name": "shop_order_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Pay part of the amount from wallet",
+                        "name": "use_wallet",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "successfully stripe payment order created",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create stripe order",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/carts/place-order/stripe-verify": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to callback backend after stripe payment for verification",
+                "tags": [
+                    "User Payment"
+                ],
+                "summary": "Stripe verify (User)",
+                "operationId": "StripePaymentVeify",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Stripe payment ID",
+                        "name": "stripe_payment_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Shop Order ID",
+                        "name": "shop_order_id",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully stripe payment verified",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid shop order or payment",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "402": {
+                        "description": "Payment not approved",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Order already cancelled",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to verify payment",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/carts/place-order/wallet": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to place order and pay full amount from wallet",
+                "tags": [
+                    "User Payment"
+                ],
+                "summary": "Place order with wallet (User)",
+                "operationId": "PaymentWallet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Shop Order ID",
+                        "name": "shop_order_id",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully order placed with wallet",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid shop order id",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "402": {
+                        "description": "Not enough balance on wallet",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Wallet payment is blocked or amount reached maximum",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Order is not waiting for payment",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to place order with wallet",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/carts/{product_item_id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to add a product item to cart",
+                "tags": [
+                    "User Cart"
+                ],
+                "summary": "Add product item to cart (User)",
+                "operationId": "AddToCart",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product Item ID",
+                        "name": "product_item_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully product item added to cart",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Product item in out of stock",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Product item already exist in cart",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to add product item into cart",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to remove a product item from cart",
+                "tags": [
+                    "User Cart"
+                ],
+                "summary": "Remove product item from cart (User)",
+                "operationId": "RemoveFromCart",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product Item ID",
+                        "name": "product_item_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully product item removed form cart",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Product item not exist in cart",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to remove product item from cart",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API to get order for user user orders",
+                "tags": [
+                    "User Orders"
+                ],
+                "summary": "Get user orders (User)",
+                "operationId": "GetUserOrder",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Count Of Order",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved all user orders",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "204": {
+                        "description": "No shop orders for user",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve all user orders",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/return": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to request a return for delivered order",
+                "tags": [
+                    "User Orders"
+                ],
+                "summary": "Return request (User)",
+                "operationId": "SubmitReturnRequest",
+                "parameters": [
+                    {
+                        "description": "Input Fields",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.Return"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully return request submitted for order",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{shop_order_id}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for user to cancel a order",
+                "tags": [
+                    "User Orders"
+                ],
+                "summary": "Cancel order (User)",
+                "operationId": "CancelOrder",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Shop Order ID",
+                        "name": "shop_order_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully order cancelled",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Order can't cancel on current status",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to cancel order",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{shop_order_id}/invoice": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to download the pdf invoice of a placed order",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "User Orders"
+                ],
+                "summary": "Download order invoice (User)",
+                "operationId": "GetOrderInvoiceUser",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Shop Order ID",
+                        "name": "shop_order_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "invoice pdf",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "302": {
+                        "description": "redirect to stored invoice pdf",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "422": {
+                        "description": "Order is not placed yet",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to get order invoice",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{shop_order_id}/items": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to get all order items of a specific order",
+                "tags": [
+                    "User Orders"
+                ],
+                "summary": "Get all order items (User)",
+                "operationId": "GetAllOrderItemsUser",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Shop Order ID",
+                        "name": "shop_order_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Count Of Order",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully found order items",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to find order items",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{shop_order_id}/timeline": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to get all status changes of an order",
+                "tags": [
+                    "User Orders"
+                ],
+                "summary": "Get order timeline (User)",
+                "operationId": "GetOrderTimelineUser",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Shop Order ID",
+                        "name": "shop_order_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully found order timeline",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to find order timeline",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/payment-methods": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to get all payment methods",
+                "tags": [
+                    "User Payment"
+                ],
+                "summary": "Get payment methods (User)",
+                "operationId": "GetAllPaymentMethodsUser",
+                "responses": {
+                    "200": {
+                        "description": "Failed to retrieve payment methods",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Successfully retrieved all payment methods",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/products": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to get all products",
+                "tags": [
+                    "User Products"
+                ],
+                "summary": "Get all products (User)",
+                "operationId": "GetAllProductsUser",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Count",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully found all products",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to get all products",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/search": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to search products with keyword, filters and sort",
+                "tags": [
+                    "User Products"
+                ],
+                "summary": "Search products (User)",
+                "operationId": "SearchProducts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Keyword to search on product name and description",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Category ID",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Brand ID",
+                        "name": "brand_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum Price (discount price when offer applied)",
+                        "name": "min_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum Price (discount price when offer applied)",
+                        "name": "max_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only products which have stock",
+                        "name": "in_stock_only",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Variation Option IDs",
+                        "name": "variation_option_ids",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "price_asc",
+                            "price_desc",
+                            "newest",
+                            "popularity",
+                            "rating"
+                        ],
+                        "type": "string",
+                        "description": "Sort By",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Count",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully found products",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Failed to bind query",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to search products",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{product_id}/items": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to get all product items for a specific product",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Products"
+                ],
+                "summary": "Get all product items (User)",
+                "operationId": "GetAllProductItemsUser",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully get all product items",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Failed to get all product items",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{product_id}/questions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to get all questions of a product",
+                "tags": [
+                    "User Products"
+                ],
+                "summary": "Get all questions of product (User)",
+                "operationId": "GetAllProductQuestionsUser",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Count",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully found all questions of product",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.ProductQuestion"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "204": {
+                        "description": "No questions found for product",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to find all questions of product",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to ask a question about a product (optionally notified by mail when answered)",
+                "tags": [
+                    "User Products"
+                ],
+                "summary": "Ask question about a product (User)",
+                "operationId": "SaveProductQuestion",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Question details",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ProductQuestion"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successfully question added for product",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to add question for product",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{product_id}/questions/{question_id}/answers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to get all answers of a product question (most upvoted first)",
+                "tags": [
+                    "User Products"
+                ],
+                "summary": "Get all answers of question (User)",
+                "operationId": "GetAllProductAnswersUser",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Question ID",
+                        "name": "question_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Count",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully found all answers of question",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.ProductAnswer"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "204": {
+                        "description": "No answers found for question",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to find all answers of question",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to answer a question of a product they received",
+                "tags": [
+                    "User Products"
+                ],
+                "summary": "Answer a product question (User)",
+                "operationId": "SaveProductAnswerUser",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Question ID",
+                        "name": "question_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Answer details",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ProductAnswer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successfully answer added for question",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "User have no delivered order of the product",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to add answer for question",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{product_id}/questions/{question_id}/answers/{answer_id}/upvote": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to upvote an answer of a product question (upvoting again will not change anything)",
+                "tags": [
+                    "User Products"
+                ],
+                "summary": "Upvote an answer (User)",
+                "operationId": "UpvoteProductAnswer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Question ID",
+                        "name": "question_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Answer ID",
+                        "name": "answer_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully answer upvoted",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to upvote answer",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to remove their upvote of an answer",
+                "tags": [
+                    "User Products"
+                ],
+                "summary": "Remove upvote of an answer (User)",
+                "operationId": "RemoveProductAnswerUpvote",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Question ID",
+                        "name": "question_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Answer ID",
+                        "name": "answer_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully upvote removed",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to remove upvote",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{product_id}/reviews": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to get all approved reviews of a product",
+                "tags": [
+                    "User Products"
+                ],
+                "summary": "Get all reviews of product (User)",
+                "operationId": "GetAllProductReviewsUser",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Count",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully found all reviews of product",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.ProductReview"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "204": {
+                        "description": "No reviews found for product",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to find all reviews of product",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "API for user to review a product they received (review is shown after admin approve it)",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "tags": [
+                    "User Products"
+                ],
+                "summary": "Add review for a product (User)",
+                "operationId": "SaveProductReview",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Rating (1 to 5)",
+                        "name": "rating",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Review Title",
+                        "name": "title",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Review Body",
+                        "name": "body",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Images (maximum 5)",
+                        "name": "images",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successfully review added for product",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid inputs",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "User have no delivered order of the product",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "User already reviewed the product",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to add review for product",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/razorpay": {
+            "post": {
+                "description": "API for razorpay to notify payment events (verified with X-Razorpay-Signature header)",
+                "tags": [
+                    "Payment Webhook"
+                ],
+                "summary": "Razorpay webhook",
+                "operationId": "RazorpayWebhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Razorpay Signature",
+                        "name": "X-Razorpay-Signature",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Razorpay Event ID",
+                        "name": "X-Razorpay-Event-Id",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully webhook processed",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid webhook signature",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to process webhook",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/stripe": {
+            "post": {
+                "description": "API for stripe to notify payment events (verified with Stripe-Signature header)",
+                "tags": [
+                    "Payment Webhook"
+                ],
+                "summary": "Stripe webhook",
+                "operationId": "StripeWebhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Stripe Signature",
+                        "name": "Stripe-Signature",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully webhook processed",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid webhook signature",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to process webhook",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "domain.ShippingRateType": {
+            "type": "string",
+            "enum": [
+                "flat",
+                "weight",
+                "free_over"
+            ],
+            "x-enum-comments": {
+                "ShippingRateFlat": "base charge for every order",
+                "ShippingRateFreeOver": "base charge only for orders below the free over amount",
+                "ShippingRateWeight": "base charge plus charge for each started kg"
+            },
+            "x-enum-varnames": [
+                "ShippingRateFlat",
+                "ShippingRateWeight",
+                "ShippingRateFreeOver"
+            ]
+        },
+        "domain.ShippingZone": {
+            "type": "object",
+            "properties": {
+                "base_charge": {
+                    "type": "integer"
+                },
+                "charge_per_kg": {
+                    "type": "integer"
+                },
+                "country_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "free_over_amount": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "pincode_from": {
+                    "type": "integer"
+                },
+                "pincode_to": {
+                    "type": "integer"
+                },
+                "rate_type": {
+                    "$ref": "#/definitions/domain.ShippingRateType"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.TaxClass": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "rate": {
+                    "description": "percentage of GST",
+                    "type": "number"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.Address": {
+            "type": "object",
+            "required": [
+                "house",
+                "land_mark",
+                "name",
+                "phone_number",
+                "pincode"
+            ],
+            "properties": {
+                "area": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "house": {
+                    "type": "string"
+                },
+                "is_default": {
+                    "type": "boolean"
+                },
+                "land_mark": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 2
+                },
+                "phone_number": {
+                    "type": "string",
+                    "maxLength": 10,
+                    "minLength": 10
+                },
+                "pincode": {
+                    "type": "integer"
+                },
+                "state": {
+                    "type": "string",
+                    "maxLength": 50
+                }
+            }
+        },
+        "request.ApplyCoupon": {
+            "type": "object",
+            "required": [
+                "coupon_code"
+            ],
+            "properties": {
+                "coupon_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.AssignTaxClass": {
+            "type": "object",
+            "properties": {
+                "tax_class_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "request.BlockUser": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "block": {
+                    "type": "boolean"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "request.Brand": {
+            "type": "object",
+            "required": [
+                "category_name"
+            ],
+            "properties": {
+                "category_name": {
+                    "type": "string",
+                    "maxLength": 25,
+                    "minLength": 3
+                }
+            }
+        },
+        "request.CancelOrder": {
+            "type": "object",
+            "required": [
+                "shop_order_id"
+            ],
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "maxLength": 150
+                },
+                "shop_order_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "request.Category": {
+            "type": "object",
+            "required": [
+                "category_name"
+            ],
+            "properties": {
+                "category_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.ChangePassword": {
+            "type": "object",
+            "required": [
+                "confirm_password",
+                "new_password",
+                "old_password"
+            ],
+            "properties": {
+                "confirm_password": {
+                    "type": "string"
+                },
+                "new_password": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 5
+                },
+                "old_password": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.Coupon": {
+            "type": "object",
+            "required": [
+                "coupon_name",
+                "description",
+                "discount_rate",
+                "expire_date",
+                "image",
+                "minimum_cart_price"
+            ],
+            "properties": {
+                "block_status": {
+                    "type": "boolean"
+                },
+                "coupon_name": {
+                    "type": "string",
+                    "maxLength": 25,
+                    "minLength": 3
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 150,
+                    "minLength": 6
+                },
+                "discount_rate": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
+                },
+                "expire_date": {
+                    "type": "string"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "minimum_cart_price": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "request.EditAddress": {
+            "type": "object",
+            "required": [
+                "address_id",
+                "house",
+                "land_mark",
+                "name",
+                "phone_number",
+                "pincode"
+            ],
+            "properties": {
+                "address_id": {
+                    "type": "integer"
+                },
+                "area": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "house": {
+                    "type": "string"
+                },
+                "is_default": {
+                    "type": "boolean"
+                },
+                "land_mark": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 2
+                },
+                "phone_number": {
+                    "type": "string",
+                    "maxLength": 10,
+                    "minLength": 10
+                },
                 "pincode": {
                     "type": "integer"
+                },
+                "state": {
+                    "type": "string",
+                    "maxLength": 50
+                }
+            }
+        },
+        "request.EditCoupon": {
+            "type": "object",
+            "required": [
+                "coupon_name",
+                "description",
+                "discount_rate",
+                "expire_date",
+                "image",
+                "minimum_cart_price"
+            ],
+            "properties": {
+                "block_status": {
+                    "type": "boolean"
+                },
+                "coupon_id": {
+                    "type": "integer"
+                },
+                "coupon_name": {
+                    "type": "string",
+                    "maxLength": 25,
+                    "minLength": 3
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 150,
+                    "minLength": 6
+                },
+                "discount_rate": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
+                },
+                "expire_date": {
+                    "type": "string"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "minimum_cart_price": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "request.EditUser": {
+            "type": "object",
+            "required": [
+                "age",
+                "email",
+                "first_name",
+                "last_name",
+                "phone",
+                "user_name"
+            ],
+            "properties": {
+                "age": {
+                    "type": "integer"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 2
+                },
+                "last_name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 1
+                },
+                "phone": {
+                    "type": "string",
+                    "maxLength": 10,
+                    "minLength": 10
+                },
+                "user_name": {
+                    "type": "string",
+                    "maxLength": 15,
+                    "minLength": 3
+                }
+            }
+        },
+        "request.Login": {
+            "type": "object",
+            "required": [
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 5
+                },
+                "phone": {
+                    "type": "string",
+                    "maxLength": 10,
+                    "minLength": 10
+                },
+                "user_name": {
+                    "type": "string",
+                    "maxLength": 15,
+                    "minLength": 3
+                }
+            }
+        },
+        "request.OTPLogin": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "otp_channel": {
+                    "description": "default sms",
+                    "type": "string",
+                    "enum": [
+                        "sms",
+                        "email"
+                    ]
+                },
+                "phone": {
+                    "type": "string",
+                    "maxLength": 10,
+                    "minLength": 10
+                },
+                "user_name": {
+                    "type": "string",
+                    "maxLength": 16,
+                    "minLength": 3
+                }
+            }
+        },
+        "request.OTPVerify": {
+            "type": "object",
+            "required": [
+                "otp"
+            ],
+            "properties": {
+                "otp": {
+                    "type": "string",
+                    "maxLength": 8,
+                    "minLength": 4
+                },
+                "otp_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.Offer": {
+            "type": "object",
+            "required": [
+                "description",
+                "discount_rate",
+                "end_date",
+                "offer_name",
+                "start_date"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 6
+                },
+                "discount_rate": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "offer_name": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.OfferCategory": {
+            "type": "object",
+            "required": [
+                "category_id",
+                "offer_id"
+            ],
+            "properties": {
+                "category_id": {
+                    "type": "integer"
+                },
+                "offer_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "request.OfferProduct": {
+            "type": "object",
+            "required": [
+                "offer_id",
+                "product_id"
+            ],
+            "properties": {
+                "offer_id": {
+                    "type": "integer"
+                },
+                "product_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "request.ProductAnswer": {
+            "type": "object",
+            "required": [
+                "answer"
+            ],
+            "properties": {
+                "answer": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 2
+                }
+            }
+        },
+        "request.ProductQuestion": {
+            "type": "object",
+            "required": [
+                "question"
+            ],
+            "properties": {
+                "notify_on_answer": {
+                    "type": "boolean"
+                },
+                "question": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "minLength": 5
+                }
+            }
+        },
+        "request.RefreshToken": {
+            "type": "object",
+            "properties": {
+                "refresh_token": {
+                    "type": "string",
+                    "minLength": 10
+                }
+            }
+        },
+        "request.ResetPassword": {
+            "type": "object",
+            "required": [
+                "confirm_password",
+                "otp",
+                "otp_id",
+                "password"
+            ],
+            "properties": {
+                "confirm_password": {
+                    "type": "string"
+                },
+                "otp": {
+                    "type": "string",
+                    "maxLength": 8,
+                    "minLength": 4
+                },
+                "otp_id": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 5
+                }
+            }
+        },
+        "request.Return": {
+            "type": "object",
+            "required": [
+                "return_reason",
+                "shop_order_id"
+            ],
+            "properties": {
+                "return_reason": {
+                    "type": "string",
+                    "maxLength": 150,
+                    "minLength": 6
+                },
+                "shop_order_id": {
+                    "type": "integer"
                 }
             }
         },
-        "request.ApplyCoupon": {
+        "request.ShippingZone": {
             "type": "object",
             "required": [
-                "coupon_code"
+                "country_id",
+                "name",
+                "pincode_from",
+                "pincode_to",
+                "rate_type"
             ],
             "properties": {
-                "coupon_code": {
+                "base_charge": {
+                    "type": "integer"
+                },
+                "charge_per_kg": {
+                    "type": "integer"
+                },
+                "country_id": {
+                    "type": "integer"
+                },
+                "free_over_amount": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 3
+                },
+                "pincode_from": {
+                    "type": "integer",
+                    "maximum": 999999,
+                    "minimum": 100000
+                },
+                "pincode_to": {
+                    "type": "integer",
+                    "maximum": 999999,
+                    "minimum": 100000
+                },
+                "rate_type": {
+                    "type": "string",
+                    "enum": [
+                        "flat",
+                        "weight",
+                        "free_over"
+                    ]
+                }
+            }
+        },
+        "request.StaffInvite": {
+            "type": "object",
+            "required": [
+                "email",
+                "role_id",
+                "user_name"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "role_id": {
+                    "type": "integer"
+                },
+                "user_name": {
+                    "type": "string",
+                    "maxLength": 15,
+                    "minLength": 3
+                }
+            }
+        },
+        "request.StaffSetPassword": {
+            "type": "object",
+            "required": [
+                "confirm_password",
+                "password",
+                "token"
+            ],
+            "properties": {
+                "confirm_password": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 5
+                },
+                "token": {
                     "type": "string"
                 }
             }
         },
-        "request.BlockUser": {
+        "request.StaffUpdate": {
             "type": "object",
             "required": [
-                "user_id"
+                "role_id"
             ],
             "properties": {
-                "block": {
+                "block_status": {
                     "type": "boolean"
                 },
-                "user_id": {
+                "role_id": {
                     "type": "integer"
                 }
             }
         },
-        "request.Brand": {
+        "request.SubCategory": {
             "type": "object",
             "required": [
+                "category_id",
                 "category_name"
             ],
             "properties": {
+                "category_id": {
+                    "type": "integer"
+                },
                 "category_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.TaxClass": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
                     "type": "string",
-                    "maxLength": 25,
-                    "minLength": 3
+                    "maxLength": 30,
+                    "minLength": 2
+                },
+                "rate": {
+                    "description": "percentage of GST",
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
                 }
             }
         },
-        "request.Category": {
+        "request.UpdateCartItem": {
             "type": "object",
             "required": [
-                "category_name"
+                "product_item_id"
             ],
             "properties": {
-                "category_name": {
-                    "type": "string"
+                "count": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "product_item_id": {
+                    "type": "integer"
                 }
             }
         },
-        "request.Coupon": {
+        "request.UpdateCategoryOffer": {
             "type": "object",
             "required": [
-                "coupon_name",
-                "description",
-                "discount_rate",
-                "expire_date",
-                "image",
-                "minimum_cart_price"
+                "category_offer_id",
+                "offer_id"
             ],
             "properties": {
-                "block_status": {
-                    "type": "boolean"
+                "category_offer_id": {
+                    "type": "integer"
                 },
-                "coupon_name": {
+                "offer_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "request.UpdateOrder": {
+            "type": "object",
+            "required": [
+                "shop_order_id"
+            ],
+            "properties": {
+                "comment": {
                     "type": "string",
-                    "maxLength": 25,
-                    "minLength": 3
+                    "maxLength": 150
                 },
-                "description": {
+                "order_status_id": {
+                    "type": "integer"
+                },
+                "shop_order_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "request.UpdateOrderReturn": {
+            "type": "object",
+            "required": [
+                "admin_comment",
+                "order_return_id",
+                "order_status_id"
+            ],
+            "properties": {
+                "admin_comment": {
                     "type": "string",
                     "maxLength": 150,
                     "minLength": 6
                 },
-                "discount_rate": {
-                    "type": "integer",
-                    "maximum": 100,
-                    "minimum": 1
+                "order_return_id": {
+                    "type": "integer"
                 },
-                "expire_date": {
-                    "type": "string"
+                "order_status_id": {
+                    "type": "integer"
                 },
-                "image": {
+                "return_date": {
                     "type": "string"
-                },
-                "minimum_cart_price": {
-                    "type": "integer",
-                    "minimum": 1
                 }
             }
         },
-        "request.EditAddress": {
+        "request.UpdateProduct": {
             "type": "object",
             "required": [
-                "address_id",
-                "house",
-                "land_mark",
-                "name",
-                "phone_number",
-                "pincode"
+                "brand_id",
+                "category_id",
+                "description",
+                "price",
+                "product_id",
+                "product_name"
             ],
             "properties": {
-                "address_id": {
+                "brand_id": {
                     "type": "integer"
                 },
-                "area": {
-                    "type": "string"
+                "category_id": {
+                    "type": "integer"
                 },
-                "city": {
-                    "type": "string"
+                "description": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 10
                 },
-                "house": {
+                "image": {
                     "type": "string"
                 },
-                "is_default": {
-                    "type": "boolean"
+                "price": {
+                    "type": "integer"
                 },
-                "land_mark": {
-                    "type": "string"
+                "product_id": {
+                    "type": "integer"
                 },
-                "name": {
+                "product_name": {
                     "type": "string",
                     "maxLength": 50,
-                    "minLength": 2
-                },
-                "phone_number": {
-                    "type": "string",
-                    "maxLength": 10,
-                    "minLength": 10
+                    "minLength": 3
+                }
+            }
+        },
+        "request.UpdateProductItem": {
+            "type": "object",
+            "required": [
+                "weight"
+            ],
+            "properties": {
+                "weight": {
+                    "description": "in grams",
+                    "type": "integer"
+                }
+            }
+        },
+        "request.UpdateProductOffer": {
+            "type": "object",
+            "required": [
+                "offer_id",
+                "product_offer_id"
+            ],
+            "properties": {
+                "offer_id": {
+                    "type": "integer"
                 },
-                "pincode": {
+                "product_offer_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "request.UpdateReorderThreshold": {
+            "type": "object",
+            "required": [
+                "reorder_threshold"
+            ],
+            "properties": {
+                "reorder_threshold": {
                     "type": "integer"
                 }
             }
         },
-        "request.EditCoupon": {
+        "request.UpdateStock": {
             "type": "object",
             "required": [
-                "coupon_name",
-                "description",
-                "discount_rate",
-                "expire_date",
-                "image",
-                "minimum_cart_price"
+                "sku"
             ],
             "properties": {
-                "block_status": {
-                    "type": "boolean"
-                },
-                "coupon_id": {
-                    "type": "integer"
-                },
-                "coupon_name": {
-                    "type": "string",
-                    "maxLength": 25,
-                    "minLength": 3
-                },
-                "description": {
+                "note": {
                     "type": "string",
-                    "maxLength": 150,
-                    "minLength": 6
-                },
-                "discount_rate": {
-                    "type": "integer",
-                    "maximum": 100,
-                    "minimum": 1
+                    "maxLength": 150
                 },
-                "expire_date": {
-                    "type": "string"
+                "qty_to_add": {
+                    "type": "integer"
                 },
-                "image": {
+                "sku": {
                     "type": "string"
-                },
-                "minimum_cart_price": {
-                    "type": "integer",
-                    "minimum": 1
                 }
             }
         },
-        "request.EditUser": {
+        "request.UpdateVisibility": {
+            "type": "object",
+            "properties": {
+                "is_hidden": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "request.UserSignUp": {
             "type": "object",
             "required": [
                 "age",
+                "confirm_password",
                 "email",
                 "first_name",
                 "last_name",
+                "password",
                 "phone",
                 "user_name"
             ],
@@ -4101,6 +8562,14 @@ const docTemplate = `{
                     "maxLength": 50,
                     "minLength": 1
                 },
+                "otp_channel": {
+                    "description": "default sms",
+                    "type": "string",
+                    "enum": [
+                        "sms",
+                        "email"
+                    ]
+                },
                 "password": {
                     "type": "string"
                 },
@@ -4116,390 +8585,483 @@ const docTemplate = `{
                 }
             }
         },
-        "request.Login": {
+        "request.Variation": {
             "type": "object",
             "required": [
-                "password"
+                "variation_names"
             ],
             "properties": {
-                "email": {
-                    "type": "string"
-                },
-                "password": {
-                    "type": "string",
-                    "maxLength": 30,
-                    "minLength": 5
-                },
-                "phone": {
-                    "type": "string",
-                    "maxLength": 10,
-                    "minLength": 10
-                },
-                "user_name": {
-                    "type": "string",
-                    "maxLength": 15,
-                    "minLength": 3
-                }
-            }
-        },
-        "request.OTPLogin": {
-            "type": "object",
-            "properties": {
-                "email": {
-                    "type": "string"
-                },
-                "phone": {
-                    "type": "string",
-                    "maxLength": 10,
-                    "minLength": 10
-                },
-                "user_name": {
-                    "type": "string",
-                    "maxLength": 16,
-                    "minLength": 3
+                "variation_names": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "request.OTPVerify": {
+        "request.VariationOption": {
             "type": "object",
             "required": [
-                "otp"
+                "variation_value"
             ],
             "properties": {
-                "otp": {
-                    "type": "string",
-                    "maxLength": 8,
-                    "minLength": 4
-                },
-                "otp_id": {
-                    "type": "string"
+                "variation_value": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "request.Offer": {
+        "response.AuditLog": {
             "type": "object",
-            "required": [
-                "description",
-                "discount_rate",
-                "end_date",
-                "offer_name",
-                "start_date"
-            ],
             "properties": {
-                "description": {
-                    "type": "string",
-                    "maxLength": 50,
-                    "minLength": 6
+                "admin_id": {
+                    "type": "integer"
                 },
-                "discount_rate": {
-                    "type": "integer",
-                    "maximum": 100,
-                    "minimum": 1
+                "client_ip": {
+                    "type": "string"
                 },
-                "end_date": {
+                "created_at": {
                     "type": "string"
                 },
-                "offer_name": {
+                "id": {
+                    "type": "integer"
+                },
+                "message": {
                     "type": "string"
                 },
-                "start_date": {
+                "method": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "route": {
+                    "type": "string"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "user_name": {
                     "type": "string"
                 }
             }
         },
-        "request.OfferCategory": {
+        "response.Dashboard": {
             "type": "object",
-            "required": [
-                "category_id",
-                "offer_id"
-            ],
             "properties": {
-                "category_id": {
+                "average_order_value": {
+                    "type": "number"
+                },
+                "bucket": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "low_stock_count": {
                     "type": "integer"
                 },
-                "offer_id": {
+                "new_users": {
                     "type": "integer"
-                }
-            }
-        },
-        "request.OfferProduct": {
-            "type": "object",
-            "required": [
-                "offer_id",
-                "product_id"
-            ],
-            "properties": {
-                "offer_id": {
+                },
+                "order_count": {
                     "type": "integer"
                 },
-                "product_id": {
+                "payment_conversion_rate": {
+                    "description": "percentage of orders moved from payment pending to order placed",
+                    "type": "number"
+                },
+                "pending_returns": {
                     "type": "integer"
+                },
+                "revenue": {
+                    "type": "integer"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "time_series": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.DashboardPoint"
+                    }
                 }
             }
         },
-        "request.RefreshToken": {
-            "type": "object",
-            "properties": {
-                "refresh_token": {
-                    "type": "string",
-                    "minLength": 10
-                }
-            }
-        },
-        "request.Return": {
+        "response.DashboardPoint": {
             "type": "object",
-            "required": [
-                "return_reason",
-                "shop_order_id"
-            ],
             "properties": {
-                "return_reason": {
-                    "type": "string",
-                    "maxLength": 150,
-                    "minLength": 6
+                "new_users": {
+                    "type": "integer"
                 },
-                "shop_order_id": {
+                "order_count": {
+                    "type": "integer"
+                },
+                "period": {
+                    "type": "string"
+                },
+                "revenue": {
                     "type": "integer"
                 }
             }
         },
-        "request.SubCategory": {
+        "response.OTPResponse": {
             "type": "object",
-            "required": [
-                "category_id",
-                "category_name"
-            ],
             "properties": {
-                "category_id": {
-                    "type": "integer"
-                },
-                "category_name": {
+                "otp_id": {
                     "type": "string"
                 }
             }
         },
-        "request.UpdateCartItem": {
+        "response.ProductAnswer": {
             "type": "object",
-            "required": [
-                "product_item_id"
-            ],
             "properties": {
-                "count": {
-                    "type": "integer",
-                    "minimum": 1
+                "actor_id": {
+                    "type": "integer"
                 },
-                "product_item_id": {
+                "actor_type": {
+                    "description": "admin or user (verified buyer)",
+                    "type": "string"
+                },
+                "answer": {
+                    "type": "string"
+                },
+                "answerer_name": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_hidden": {
+                    "type": "boolean"
+                },
+                "product_question_id": {
+                    "type": "integer"
+                },
+                "upvote_count": {
                     "type": "integer"
                 }
             }
         },
-        "request.UpdateCategoryOffer": {
+        "response.ProductImport": {
             "type": "object",
-            "required": [
-                "category_offer_id",
-                "offer_id"
-            ],
             "properties": {
-                "category_offer_id": {
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ProductImportRowError"
+                    }
+                },
+                "invalid_rows": {
                     "type": "integer"
                 },
-                "offer_id": {
+                "items_created": {
+                    "type": "integer"
+                },
+                "products_created": {
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "type": "integer"
+                },
+                "valid_rows": {
                     "type": "integer"
                 }
             }
         },
-        "request.UpdateOrder": {
+        "response.ProductImportRowError": {
             "type": "object",
-            "required": [
-                "shop_order_id"
-            ],
             "properties": {
-                "order_status_id": {
-                    "type": "integer"
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "shop_order_id": {
+                "row": {
                     "type": "integer"
                 }
             }
         },
-        "request.UpdateOrderReturn": {
+        "response.ProductQuestion": {
             "type": "object",
-            "required": [
-                "admin_comment",
-                "order_return_id",
-                "order_status_id"
-            ],
             "properties": {
-                "admin_comment": {
-                    "type": "string",
-                    "maxLength": 150,
-                    "minLength": 6
+                "answer_count": {
+                    "type": "integer"
                 },
-                "order_return_id": {
+                "created_at": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "id": {
                     "type": "integer"
                 },
-                "order_status_id": {
+                "is_hidden": {
+                    "type": "boolean"
+                },
+                "product_id": {
                     "type": "integer"
                 },
-                "return_date": {
+                "question": {
                     "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
                 }
             }
         },
-        "request.UpdateProduct": {
+        "response.ProductReview": {
             "type": "object",
-            "required": [
-                "category_id",
-                "description",
-                "image",
-                "price",
-                "product_id",
-                "product_name"
-            ],
             "properties": {
-                "category_id": {
-                    "type": "integer"
+                "body": {
+                    "type": "string"
                 },
-                "description": {
-                    "type": "string",
-                    "maxLength": 100,
-                    "minLength": 10
+                "created_at": {
+                    "type": "string"
                 },
-                "image": {
+                "first_name": {
                     "type": "string"
                 },
-                "price": {
+                "id": {
                     "type": "integer"
                 },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "product_id": {
                     "type": "integer"
                 },
                 "product_name": {
-                    "type": "string",
-                    "maxLength": 50,
-                    "minLength": 3
+                    "type": "string"
+                },
+                "rating": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
                 }
             }
         },
-        "request.UpdateProductOffer": {
+        "response.Response": {
             "type": "object",
-            "required": [
-                "offer_id",
-                "product_offer_id"
-            ],
             "properties": {
-                "offer_id": {
-                    "type": "integer"
+                "data": {},
+                "error": {},
+                "message": {
+                    "type": "string"
                 },
-                "product_offer_id": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean"
                 }
             }
         },
-        "request.UpdateStock": {
+        "response.Role": {
             "type": "object",
             "properties": {
-                "qty_to_add": {
+                "id": {
                     "type": "integer"
                 },
-                "sku": {
+                "name": {
                     "type": "string"
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "request.UserSignUp": {
+        "response.SalesBucket": {
             "type": "object",
-            "required": [
-                "age",
-                "confirm_password",
-                "email",
-                "first_name",
-                "last_name",
-                "password",
-                "phone",
-                "user_name"
-            ],
             "properties": {
-                "age": {
+                "cgst": {
                     "type": "integer"
                 },
-                "confirm_password": {
-                    "type": "string"
+                "discounts": {
+                    "type": "integer"
                 },
-                "email": {
-                    "type": "string"
+                "gross_revenue": {
+                    "type": "integer"
                 },
-                "first_name": {
-                    "type": "string",
-                    "maxLength": 50,
-                    "minLength": 2
+                "igst": {
+                    "type": "integer"
                 },
-                "last_name": {
-                    "type": "string",
-                    "maxLength": 50,
-                    "minLength": 1
+                "net_revenue": {
+                    "type": "integer"
                 },
-                "password": {
+                "orders": {
+                    "type": "integer"
+                },
+                "period": {
                     "type": "string"
                 },
-                "phone": {
-                    "type": "string",
-                    "maxLength": 10,
-                    "minLength": 10
+                "refunds": {
+                    "type": "integer"
                 },
-                "user_name": {
-                    "type": "string",
-                    "maxLength": 15,
-                    "minLength": 3
+                "sgst": {
+                    "type": "integer"
                 }
             }
         },
-        "request.Variation": {
+        "response.SalesCount": {
             "type": "object",
-            "required": [
-                "variation_names"
-            ],
             "properties": {
-                "variation_names": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "amount": {
+                    "type": "integer"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
                 }
             }
         },
-        "request.VariationOption": {
+        "response.SalesSummary": {
             "type": "object",
-            "required": [
-                "variation_value"
-            ],
             "properties": {
-                "variation_value": {
+                "bucket": {
+                    "type": "string"
+                },
+                "buckets": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/response.SalesBucket"
+                    }
+                },
+                "cgst": {
+                    "description": "GST included on the revenue",
+                    "type": "integer"
+                },
+                "discounts": {
+                    "type": "integer"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "gross_revenue": {
+                    "description": "order total before discount",
+                    "type": "integer"
+                },
+                "igst": {
+                    "type": "integer"
+                },
+                "net_revenue": {
+                    "type": "integer"
+                },
+                "orders_by_payment_method": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.SalesCount"
+                    }
+                },
+                "orders_by_status": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.SalesCount"
+                    }
+                },
+                "refunds": {
+                    "type": "integer"
+                },
+                "sgst": {
+                    "type": "integer"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "top_categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.TopSellingItem"
+                    }
+                },
+                "top_products": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.TopSellingItem"
                     }
+                },
+                "total_orders": {
+                    "type": "integer"
                 }
             }
         },
-        "response.OTPResponse": {
+        "response.Session": {
             "type": "object",
             "properties": {
-                "otp_id": {
+                "client_ip": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "current": {
+                    "type": "boolean"
+                },
+                "expire_at": {
+                    "type": "string"
+                },
+                "session_id": {
+                    "type": "string"
+                },
+                "user_agent": {
                     "type": "string"
                 }
             }
         },
-        "response.Response": {
+        "response.Staff": {
             "type": "object",
             "properties": {
-                "data": {},
-                "error": {},
-                "message": {
+                "block_status": {
+                    "type": "boolean"
+                },
+                "created_at": {
                     "type": "string"
                 },
-                "success": {
-                    "type": "boolean"
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "role_id": {
+                    "type": "integer"
+                },
+                "role_name": {
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
                 }
             }
         },
@@ -4513,6 +9075,23 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "response.TopSellingItem": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "revenue": {
+                    "type": "integer"
+                }
+            }
         }
     },
     "securityDefinitions": {
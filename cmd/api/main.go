@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/config"
@@ -15,12 +16,17 @@ func main() {
 		log.Fatal("Error to load the config: ", err)
 	}
 
-	server, err := di.InitializeApi(cfg)
+	app, err := di.InitializeApi(cfg)
 	if err != nil {
 		log.Fatal("Failed to initialize the api: ", err)
 	}
 
-	if server.Start(); err != nil {
+	// release the stock of abandoned payment pending orders on background
+	app.OrderReaper.Start(context.Background())
+	// send the digest of low stocks on background
+	app.LowStockDigest.Start(context.Background())
+
+	if app.Server.Start(); err != nil {
 		log.Fatal("failed to start server: ", err)
 	}
 }
@@ -1,20 +1,23 @@
 package handler
 
 import (
-	"encoding/csv"
 	"errors"
-	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/interfaces"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase"
 	usecaseInterface "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
 )
 
+// dashboard period when start date is not given
+const defaultDashboardDays = 30
+
 type adminHandler struct {
 	adminUseCase usecaseInterface.AdminUseCase
 }
@@ -115,83 +118,152 @@ func (a *adminHandler) BlockUser(ctx *gin.Context) {
 //
 //	@Summary		Get full sales report (Admin)
 //	@Security		BearerAuth
-//	@Description	API for admin to get all sales report for a specific period in csv form
+//	@Description	API for admin to download the full sales report with its summary for a specific period as csv or pdf
 //	@id				GetFullSalesReport
 //	@tags			Admin Sales
-//	@Param			start_date	query	string	false	"Sales report starting date"
-//	@Param			end_date	query	string	false	"Sales report ending date"
-//	@Param			page_number	query	int		false	"Page Number"
-//	@Param			count		query	int		false	"Count"
+//	@Produce		text/csv,application/pdf
+//	@Param			start_date	query	string	true	"Sales report starting date"
+//	@Param			end_date	query	string	true	"Sales report ending date"
+//	@Param			format		query	string	false	"Report format csv or pdf (default csv)"
+//	@Param			bucket		query	string	false	"Sales bucket day, week or month (default day)"
 //	@Router			/admin/sales [get]
 //	@Success		200	{object}	response.Response{}	"ecommerce_sales_report.csv"
-//	@Success		204	{object}	response.Response{}	"No sales report found"
+//	@Failure		400	{object}	response.Response{}	"invalid input"
 //	@Failure		500	{object}	response.Response{}	"failed to get sales report"
 func (c *adminHandler) GetFullSalesReport(ctx *gin.Context) {
 
-	// time
-	startDate, err1 := utils.StringToTime(ctx.Query("start_date"))
-	endDate, err2 := utils.StringToTime(ctx.Query("end_date"))
-
-	// join all error and send it if its not nil
-	err := errors.Join(err1, err2)
+	reqData, err := getSalesReportRequest(ctx)
 	if err != nil {
-		response.ErrorResponse(ctx, http.StatusBadRequest, BindQueryFailMessage, err1, nil)
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindQueryFailMessage, err, nil)
 		return
 	}
 
-	pagination := request.GetPagination(ctx)
+	contentType := "text/csv"
+	if reqData.Format == request.SalesReportPDF {
+		contentType = "application/pdf"
+	}
+	ctx.Header("Content-Type", contentType)
+	ctx.Header("Content-Disposition", "attachment;filename=ecommerce_sales_report."+reqData.Format)
 
-	reqData := request.SalesReport{
-		StartDate:  startDate,
-		EndDate:    endDate,
-		Pagination: pagination,
+	err = c.adminUseCase.ExportSalesReport(ctx, reqData, ctx.Writer)
+	if err != nil {
+		// headers are already sent when any part of report is written
+		if ctx.Writer.Written() {
+			ctx.Error(err)
+			return
+		}
+		ctx.Writer.Header().Del("Content-Type")
+		ctx.Writer.Header().Del("Content-Disposition")
+
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrInvalidSalesReportFormat) || errors.Is(err, usecase.ErrInvalidSalesReportBucket) {
+			statusCode = http.StatusBadRequest
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to get full sales report", err, nil)
 	}
+}
 
-	salesReport, err := c.adminUseCase.GetFullSalesReport(ctx, reqData)
+// GetSalesSummary godoc
+//
+//	@Summary		Get sales summary (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to get revenue, discounts, refunds, order counts and top products and categories of a period
+//	@Description	with the sales of each day, week or month
+//	@id				GetSalesSummary
+//	@tags			Admin Sales
+//	@Param			start_date	query	string	true	"Sales report starting date"
+//	@Param			end_date	query	string	true	"Sales report ending date"
+//	@Param			bucket		query	string	false	"Sales bucket day, week or month (default day)"
+//	@Router			/admin/sales/summary [get]
+//	@Success		200	{object}	response.Response{data=response.SalesSummary}	"Successfully found sales summary"
+//	@Failure		400	{object}	response.Response{}								"invalid input"
+//	@Failure		500	{object}	response.Response{}								"Failed to find sales summary"
+func (c *adminHandler) GetSalesSummary(ctx *gin.Context) {
+
+	reqData, err := getSalesReportRequest(ctx)
 	if err != nil {
-		response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to get full sales report", err, nil)
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindQueryFailMessage, err, nil)
 		return
 	}
 
-	if len(salesReport) == 0 {
-		response.SuccessResponse(ctx, http.StatusNoContent, "No sales report found", nil)
+	summary, err := c.adminUseCase.FindSalesSummary(ctx, reqData)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrInvalidSalesReportBucket) {
+			statusCode = http.StatusBadRequest
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to find sales summary", err, nil)
 		return
 	}
 
-	ctx.Header("Content-Type", "text/csv")
-	ctx.Header("Content-Disposition", "attachment;filename=ecommerce_sales_report.csv")
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully found sales summary", summary)
+}
+
+func getSalesReportRequest(ctx *gin.Context) (request.SalesReport, error) {
 
-	csvWriter := csv.NewWriter(ctx.Writer)
-	headers := []string{
-		"UserID", "FirstName", "Email",
-		"ShopOrderID", "OrderDate", "OrderTotalPrice",
-		"Discount", "OrderStatus", "PaymentType",
+	startDate, err1 := utils.StringToTime(ctx.Query("start_date"))
+	endDate, err2 := utils.StringToTime(ctx.Query("end_date"))
+
+	// join all error and send it if its not nil
+	err := errors.Join(err1, err2)
+	if err != nil {
+		return request.SalesReport{}, err
 	}
 
-	if err := csvWriter.Write(headers); err != nil {
-		response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to write sales report on csv", err, nil)
-		return
+	return request.SalesReport{
+		StartDate: startDate,
+		EndDate:   endDate,
+		Format:    ctx.DefaultQuery("format", request.SalesReportCSV),
+		Bucket:    ctx.DefaultQuery("bucket", request.SalesBucketDay),
+	}, nil
+}
+
+// GetDashboard godoc
+//
+//	@Summary		Get dashboard (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to get revenue, order count, average order value, new users, pending returns,
+//	@Description	low stock count and payment conversion of a period with time series for charts
+//	@id				GetDashboard
+//	@tags			Admin Dashboard
+//	@Param			start_date	query	string	false	"Starting date (default 30 days before end date)"
+//	@Param			end_date	query	string	false	"Ending date (default now)"
+//	@Param			bucket		query	string	false	"Time series bucket day, week or month (default day)"
+//	@Router			/admin/dashboard [get]
+//	@Success		200	{object}	response.Response{data=response.Dashboard}	"Successfully found dashboard"
+//	@Failure		400	{object}	response.Response{}							"invalid input"
+//	@Failure		500	{object}	response.Response{}							"Failed to find dashboard"
+func (c *adminHandler) GetDashboard(ctx *gin.Context) {
+
+	reqData := request.SalesReport{
+		EndDate: time.Now(),
+		Bucket:  ctx.DefaultQuery("bucket", request.SalesBucketDay),
 	}
 
-	for _, sales := range salesReport {
-		row := []string{
-			fmt.Sprintf("%v", sales.UserID),
-			sales.FirstName,
-			sales.Email,
-			fmt.Sprintf("%v", sales.ShopOrderID),
-			sales.OrderDate.Format("2006-01-02 15:04:05"),
-			fmt.Sprintf("%v", sales.OrderTotalPrice),
-			fmt.Sprintf("%v", sales.Discount),
-			sales.OrderStatus,
-			sales.PaymentType,
-		}
+	var err1, err2 error
+	if endDate := ctx.Query("end_date"); endDate != "" {
+		reqData.EndDate, err2 = utils.StringToTime(endDate)
+	}
+	reqData.StartDate = reqData.EndDate.AddDate(0, 0, -defaultDashboardDays)
+	if startDate := ctx.Query("start_date"); startDate != "" {
+		reqData.StartDate, err1 = utils.StringToTime(startDate)
+	}
 
-		if err := csvWriter.Write(row); err != nil {
-			response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to create write sales report to csv", err, nil)
-			return
-		}
+	err := errors.Join(err1, err2)
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindQueryFailMessage, err, nil)
+		return
 	}
 
-	csvWriter.Flush()
+	dashboard, err := c.adminUseCase.FindDashboard(ctx, reqData)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrInvalidSalesReportBucket) || errors.Is(err, usecase.ErrInvalidDashboardPeriod) {
+			statusCode = http.StatusBadRequest
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to find dashboard", err, nil)
+		return
+	}
 
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully found dashboard", dashboard)
 }
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase"
+)
+
+// GetAllAuditLogs godoc
+//
+//	@Summary		Get all audit logs (Super Admin)
+//	@Security		BearerAuth
+//	@Description	API for super admin to get all create, update and delete actions done by admins with filters
+//	@Id				GetAllAuditLogs
+//	@Tags			Admin Staff
+//	@Param			admin_id	query	int		false	"Admin ID"
+//	@Param			method		query	string	false	"Request Method"	Enums(POST, PUT, PATCH, DELETE)
+//	@Param			route		query	string	false	"Part of route (eg: /orders)"
+//	@Param			status		query	string	false	"Result of action"	Enums(success, failure)
+//	@Param			start_date	query	string	false	"Starting date"
+//	@Param			end_date	query	string	false	"Ending date"
+//	@Param			page_number	query	int		false	"Page Number"
+//	@Param			count		query	int		false	"Count"
+//	@Router			/admin/audit-logs [get]
+//	@Success		200	{object}	response.Response{data=[]response.AuditLog}	"Successfully found all audit logs"
+//	@Success		204	{object}	response.Response{}							"No audit logs found"
+//	@Failure		400	{object}	response.Response{}							"Invalid inputs"
+//	@Failure		403	{object}	response.Response{}							"Permission denied"
+//	@Failure		500	{object}	response.Response{}							"Failed to find all audit logs"
+func (a *adminHandler) GetAllAuditLogs(ctx *gin.Context) {
+
+	var filter request.AuditLogFilter
+
+	if err := ctx.ShouldBindQuery(&filter); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindQueryFailMessage, err, nil)
+		return
+	}
+
+	filter.Pagination = request.GetPagination(ctx)
+
+	auditLogs, err := a.adminUseCase.FindAllAuditLogs(ctx, filter)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrInvalidAuditLogPeriod) {
+			statusCode = http.StatusBadRequest
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to find all audit logs", err, nil)
+		return
+	}
+
+	if len(auditLogs) == 0 {
+		response.SuccessResponse(ctx, http.StatusNoContent, "No audit logs found", nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully found all audit logs", auditLogs)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/config"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/otp"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/token"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase"
 	usecaseInterface "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
@@ -45,6 +46,7 @@ func NewAuthHandler(authUsecase usecaseInterface.AuthUseCase, config config.Conf
 //	@Failure		400	{object}	response.Response{}								"Invalid inputs"
 //	@Failure		403	{object}	response.Response{}								"User blocked by admin"
 //	@Failure		401	{object}	response.Response{}								"User not exist with given login credentials"
+//	@Failure		429	{object}	response.Response{}								"Login locked due to too many failed attempts"
 //	@Failure		500	{object}	response.Response{}								"Failed to login"
 func (c *AuthHandler) UserLogin(ctx *gin.Context) {
 
@@ -55,6 +57,8 @@ func (c *AuthHandler) UserLogin(ctx *gin.Context) {
 		return
 	}
 
+	body.ClientIP = ctx.ClientIP()
+
 	userID, err := c.authUseCase.UserLogin(ctx, body)
 
 	if err != nil {
@@ -72,6 +76,8 @@ func (c *AuthHandler) UserLogin(ctx *gin.Context) {
 			statusCode = http.StatusUnauthorized
 		case errors.Is(err, usecase.ErrWrongPassword):
 			statusCode = http.StatusUnauthorized
+		case errors.Is(err, usecase.ErrLoginLocked):
+			statusCode = http.StatusTooManyRequests
 		default:
 			statusCode = http.StatusInternalServerError
 		}
@@ -87,7 +93,7 @@ func (c *AuthHandler) UserLogin(ctx *gin.Context) {
 // UserLoginOtpSend godoc
 //
 //	@Summary		Login with Otp send (User)
-//	@Description	API for user to send otp for login enter email | phone | user_name : otp will send to user registered number
+//	@Description	API for user to send otp for login enter email | phone | user_name : otp will send to user registered number or email (by otp_channel sms | email)
 //	@Id				UserLoginOtpSend
 //	@Tags			User Authentication
 //	@Param			inputs	body	request.OTPLogin{}	true	"Login credentials"
@@ -96,6 +102,7 @@ func (c *AuthHandler) UserLogin(ctx *gin.Context) {
 //	@Failure		400	{object}	response.Response{}							"Invalid Otp"
 //	@Failure		403	{object}	response.Response{}							"User blocked by admin"
 //	@Failure		401	{object}	response.Response{}							"User not exist with given login credentials"
+//	@Failure		429	{object}	response.Response{}							"Otp resend cooldown or daily limit reached"
 //	@Failure		500	{object}	response.Response{}							"Failed to send otp"
 func (u *AuthHandler) UserLoginOtpSend(ctx *gin.Context) {
 
@@ -124,6 +131,8 @@ func (u *AuthHandler) UserLoginOtpSend(ctx *gin.Context) {
 			statusCode = http.StatusForbidden
 		case errors.Is(err, usecase.ErrUserBlocked):
 			statusCode = http.StatusUnauthorized
+		case errors.Is(err, usecase.ErrOtpResendCooldown), errors.Is(err, usecase.ErrOtpDailyLimitReached):
+			statusCode = http.StatusTooManyRequests
 		default:
 			statusCode = http.StatusInternalServerError
 		}
@@ -149,6 +158,7 @@ func (u *AuthHandler) UserLoginOtpSend(ctx *gin.Context) {
 //	@Failure		400	{object}	response.Response{}								"Invalid inputs"
 //	@Failure		401	{object}	response.Response{}								"Otp not matched"
 //	@Failure		410	{object}	response.Response{}								"Otp Expired"
+//	@Failure		429	{object}	response.Response{}								"Too many wrong otp attempts"
 //	@Failure		500	{object}	response.Response{}								"Failed to verify otp
 func (c *AuthHandler) UserLoginOtpVerify(ctx *gin.Context) {
 
@@ -157,6 +167,7 @@ func (c *AuthHandler) UserLoginOtpVerify(ctx *gin.Context) {
 		response.ErrorResponse(ctx, http.StatusBadRequest, BindJsonFailMessage, err, body)
 		return
 	}
+	body.ClientIP = ctx.ClientIP()
 
 	// get the user using loginOtp useCase
 	userID, err := c.authUseCase.LoginOtpVerify(ctx, body)
@@ -167,6 +178,8 @@ func (c *AuthHandler) UserLoginOtpVerify(ctx *gin.Context) {
 			statusCode = http.StatusGone
 		case errors.Is(err, usecase.ErrInvalidOtp):
 			statusCode = http.StatusUnauthorized
+		case errors.Is(err, usecase.ErrLoginLocked):
+			statusCode = http.StatusTooManyRequests
 		default:
 			statusCode = http.StatusInternalServerError
 		}
@@ -188,6 +201,7 @@ func (c *AuthHandler) UserLoginOtpVerify(ctx *gin.Context) {
 //	@Success		200	{object}	response.Response{data=response.OTPResponse}	"Successfully account created and otp send to registered number"
 //	@Failure		400	{object}	response.Response{}								"Invalid input"
 //	@Failure		409	{object}	response.Response{}								"A verified user already exist with given user credentials"
+//	@Failure		429	{object}	response.Response{}								"Otp resend cooldown or daily limit reached"
 //	@Failure		500	{object}	response.Response{}								"Failed to signup"
 func (c *AuthHandler) UserSignUp(ctx *gin.Context) {
 
@@ -204,12 +218,17 @@ func (c *AuthHandler) UserSignUp(ctx *gin.Context) {
 		return
 	}
 
-	otpID, err := c.authUseCase.UserSignUp(ctx, user)
+	otpID, err := c.authUseCase.UserSignUp(ctx, user, otp.Channel(body.OtpChannel))
 
 	if err != nil {
-		statusCode := http.StatusInternalServerError
-		if errors.Is(err, usecase.ErrUserAlreadyExit) {
+		var statusCode int
+		switch {
+		case errors.Is(err, usecase.ErrUserAlreadyExit):
 			statusCode = http.StatusConflict
+		case errors.Is(err, usecase.ErrOtpResendCooldown), errors.Is(err, usecase.ErrOtpDailyLimitReached):
+			statusCode = http.StatusTooManyRequests
+		default:
+			statusCode = http.StatusInternalServerError
 		}
 
 		response.ErrorResponse(ctx, statusCode, "Failed to signup", err, nil)
@@ -236,6 +255,7 @@ func (c *AuthHandler) UserSignUp(ctx *gin.Context) {
 //	@Failure		400	{object}	response.Response{}								"Invalid inputs"
 //	@Failure		401	{object}	response.Response{}								"Otp not matched"
 //	@Failure		410	{object}	response.Response{}								"Otp Expired"
+//	@Failure		429	{object}	response.Response{}								"Too many wrong otp attempts"
 //	@Failure		500	{object}	response.Response{}								"Failed to verify otp"
 func (c *AuthHandler) UserSignUpVerify(ctx *gin.Context) {
 
@@ -244,6 +264,7 @@ func (c *AuthHandler) UserSignUpVerify(ctx *gin.Context) {
 		response.ErrorResponse(ctx, http.StatusBadRequest, BindJsonFailMessage, err, body)
 		return
 	}
+	body.ClientIP = ctx.ClientIP()
 
 	// get the user using loginOtp useCase
 	userID, err := c.authUseCase.SingUpOtpVerify(ctx, body)
@@ -254,6 +275,8 @@ func (c *AuthHandler) UserSignUpVerify(ctx *gin.Context) {
 			statusCode = http.StatusGone
 		case errors.Is(err, usecase.ErrInvalidOtp):
 			statusCode = http.StatusUnauthorized
+		case errors.Is(err, usecase.ErrLoginLocked):
+			statusCode = http.StatusTooManyRequests
 		default:
 			statusCode = http.StatusInternalServerError
 		}
@@ -275,7 +298,9 @@ func (c *AuthHandler) UserSignUpVerify(ctx *gin.Context) {
 //	@Success		200	{object}	response.Response{data=response.TokenResponse}	"Successfully logged in"
 //	@Failure		400	{object}	response.Response{}								"Invalid input"
 //	@Failure		401	{object}	response.Response{}								"Wrong password"
+//	@Failure		403	{object}	response.Response{}								"Admin blocked by super admin"
 //	@Failure		404	{object}	response.Response{}								"Admin not exist with this details"
+//	@Failure		429	{object}	response.Response{}								"Login locked due to too many failed attempts"
 //	@Failure		500	{object}	response.Response{}								"Failed to login"
 func (c *AuthHandler) AdminLogin(ctx *gin.Context) {
 
@@ -286,6 +311,8 @@ func (c *AuthHandler) AdminLogin(ctx *gin.Context) {
 		return
 	}
 
+	body.ClientIP = ctx.ClientIP()
+
 	adminID, err := c.authUseCase.AdminLogin(ctx, body)
 	if err != nil {
 
@@ -296,8 +323,12 @@ func (c *AuthHandler) AdminLogin(ctx *gin.Context) {
 			statusCode = http.StatusBadRequest
 		case errors.Is(err, usecase.ErrUserNotExist):
 			statusCode = http.StatusNotFound
+		case errors.Is(err, usecase.ErrUserBlocked):
+			statusCode = http.StatusForbidden
 		case errors.Is(err, usecase.ErrWrongPassword):
 			statusCode = http.StatusUnauthorized
+		case errors.Is(err, usecase.ErrLoginLocked):
+			statusCode = http.StatusTooManyRequests
 		default:
 			statusCode = http.StatusInternalServerError
 		}
@@ -314,9 +345,22 @@ func (c *AuthHandler) AdminLogin(ctx *gin.Context) {
 // a common function for it.(differentiate user by user type )
 func (c *AuthHandler) setupTokenAndResponse(ctx *gin.Context, tokenUser token.UserType, userID uint) {
 
+	// refresh session created first so the access token can refer it's session
+	refreshSession, err := c.authUseCase.GenerateRefreshToken(ctx, usecaseInterface.GenerateTokenParams{
+		UserID:    userID,
+		UserType:  tokenUser,
+		UserAgent: ctx.Request.UserAgent(),
+		ClientIP:  ctx.ClientIP(),
+	})
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to generate refresh token", err, nil)
+		return
+	}
+
 	tokenParams := usecaseInterface.GenerateTokenParams{
-		UserID:   userID,
-		UserType: tokenUser,
+		UserID:    userID,
+		UserType:  tokenUser,
+		SessionID: refreshSession.TokenID,
 	}
 
 	accessToken, err := c.authUseCase.GenerateAccessToken(ctx, tokenParams)
@@ -326,24 +370,15 @@ func (c *AuthHandler) setupTokenAndResponse(ctx *gin.Context, tokenUser token.Us
 		return
 	}
 
-	refreshToken, err := c.authUseCase.GenerateRefreshToken(ctx, usecaseInterface.GenerateTokenParams{
-		UserID:   userID,
-		UserType: tokenUser,
-	})
-	if err != nil {
-		response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to generate refresh token", err, nil)
-		return
-	}
-
 	authorizationValue := authorizationType + " " + accessToken
 	ctx.Header(authorizationHeaderKey, authorizationValue)
 
 	ctx.Header("access_token", accessToken)
-	ctx.Header("refresh_token", refreshToken)
+	ctx.Header("refresh_token", refreshSession.RefreshToken)
 
 	tokenRes := response.TokenResponse{
 		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+		RefreshToken: refreshSession.RefreshToken,
 	}
 
 	response.SuccessResponse(ctx, http.StatusOK, "Successfully logged in", tokenRes)
@@ -352,15 +387,15 @@ func (c *AuthHandler) setupTokenAndResponse(ctx *gin.Context, tokenUser token.Us
 // UserRenewAccessToken godoc
 //
 //	@Summary		Renew Access Token (User)
-//	@Description	API for user to renew access token using refresh token
+//	@Description	API for user to renew access token using refresh token (the refresh token is rotated on each renewal)
 //	@Security		ApiKeyAuth
 //	@Id				UserRenewAccessToken
 //	@Tags			User Authentication
 //	@Param			input	body	request.RefreshToken{}	true	"Refresh token"
 //	@Router			/auth/renew-access-token [post]
-//	@Success		200	{object}	response.Response{}	"Successfully generated access token using refresh token"
+//	@Success		200	{object}	response.Response{data=response.TokenResponse}	"Successfully generated access token and rotated refresh token"
 //	@Failure		400	{object}	response.Response{}	"Invalid input"
-//	@Failure		401	{object}	response.Response{}	"Invalid refresh token"
+//	@Failure		401	{object}	response.Response{}	"Invalid refresh token or refresh token reused"
 //	@Failure		404	{object}	response.Response{}	"No session found for the given refresh token"
 //	@Failure		410	{object}	response.Response{}	"Refresh token expired"
 //	@Failure		403	{object}	response.Response{}	"Refresh token blocked"
@@ -372,15 +407,15 @@ func (c *AuthHandler) UserRenewAccessToken() gin.HandlerFunc {
 // AdminRenewAccessToken godoc
 //
 //	@Summary		Renew Access Token (Admin)
-//	@Description	API for admin to renew access token using refresh token
+//	@Description	API for admin to renew access token using refresh token (the refresh token is rotated on each renewal)
 //	@Security		ApiKeyAuth
 //	@Id				AdminRenewAccessToken
 //	@Tags			Admin Authentication
 //	@Param			input	body	request.RefreshToken{}	true	"Refresh token"
 //	@Router			/admin/auth/renew-access-token [post]
-//	@Success		200	{object}	response.Response{}	"Successfully generated access token using refresh token"
+//	@Success		200	{object}	response.Response{data=response.TokenResponse}	"Successfully generated access token and rotated refresh token"
 //	@Failure		400	{object}	response.Response{}	"Invalid input"
-//	@Failure		401	{object}	response.Response{}	"Invalid refresh token"
+//	@Failure		401	{object}	response.Response{}	"Invalid refresh token or refresh token reused"
 //	@Failure		404	{object}	response.Response{}	"No session found for the given refresh token"
 //	@Failure		410	{object}	response.Response{}	"Refresh token expired"
 //	@Failure		403	{object}	response.Response{}	"Refresh token blocked"
@@ -414,6 +449,8 @@ func (c *AuthHandler) renewAccessToken(tokenUser token.UserType) gin.HandlerFunc
 				statusCode = http.StatusGone
 			case errors.Is(err, usecase.ErrRefreshSessionBlocked):
 				statusCode = http.StatusForbidden
+			case errors.Is(err, usecase.ErrRefreshTokenReused):
+				statusCode = http.StatusUnauthorized
 			default:
 				statusCode = http.StatusInternalServerError
 			}
@@ -421,9 +458,26 @@ func (c *AuthHandler) renewAccessToken(tokenUser token.UserType) gin.HandlerFunc
 			return
 		}
 
+		// each renewal rotate the refresh token (the used refresh token can't use again)
+		newRefreshSession, err := c.authUseCase.RotateRefreshToken(ctx, refreshSession, usecaseInterface.GenerateTokenParams{
+			UserID:    refreshSession.UserID,
+			UserType:  tokenUser,
+			UserAgent: ctx.Request.UserAgent(),
+			ClientIP:  ctx.ClientIP(),
+		})
+		if err != nil {
+			statusCode := http.StatusInternalServerError
+			if errors.Is(err, usecase.ErrRefreshTokenReused) {
+				statusCode = http.StatusUnauthorized
+			}
+			response.ErrorResponse(ctx, statusCode, "Failed to rotate refresh token", err, nil)
+			return
+		}
+
 		accessTokenParams := usecaseInterface.GenerateTokenParams{
-			UserID:   refreshSession.UserID,
-			UserType: tokenUser,
+			UserID:    refreshSession.UserID,
+			UserType:  tokenUser,
+			SessionID: newRefreshSession.TokenID,
 		}
 
 		accessToken, err := c.authUseCase.GenerateAccessToken(ctx, accessTokenParams)
@@ -435,9 +489,10 @@ func (c *AuthHandler) renewAccessToken(tokenUser token.UserType) gin.HandlerFunc
 		cookieName := "auth-" + string(tokenUser)
 		ctx.SetCookie(cookieName, accessToken, 15*60, "", "", false, true)
 
-		accessTokenRes := response.TokenResponse{
-			AccessToken: accessToken,
+		tokenRes := response.TokenResponse{
+			AccessToken:  accessToken,
+			RefreshToken: newRefreshSession.RefreshToken,
 		}
-		response.SuccessResponse(ctx, http.StatusOK, "Successfully generated access token using refresh token", accessTokenRes)
+		response.SuccessResponse(ctx, http.StatusOK, "Successfully generated access token using refresh token", tokenRes)
 	}
 }
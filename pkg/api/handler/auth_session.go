@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/token"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+)
+
+// UserLogout godoc
+//
+//	@Summary		Logout (User)
+//	@Description	API for user to logout from the current device (refresh token and access token of the session are revoked)
+//	@Security		BearerAuth
+//	@Id				UserLogout
+//	@Tags			User Authentication
+//	@Router			/auth/logout [post]
+//	@Success		200	{object}	response.Response{}	"Successfully logged out"
+//	@Failure		401	{object}	response.Response{}	"Unauthorized user"
+//	@Failure		404	{object}	response.Response{}	"No session found for the access token"
+//	@Failure		500	{object}	response.Response{}	"Failed to logout"
+func (c *AuthHandler) UserLogout() gin.HandlerFunc {
+	return c.logout(token.User)
+}
+
+// AdminLogout godoc
+//
+//	@Summary		Logout (Admin)
+//	@Description	API for admin to logout from the current device (refresh token and access token of the session are revoked)
+//	@Security		BearerAuth
+//	@Id				AdminLogout
+//	@Tags			Admin Authentication
+//	@Router			/admin/auth/logout [post]
+//	@Success		200	{object}	response.Response{}	"Successfully logged out"
+//	@Failure		401	{object}	response.Response{}	"Unauthorized user"
+//	@Failure		404	{object}	response.Response{}	"No session found for the access token"
+//	@Failure		500	{object}	response.Response{}	"Failed to logout"
+func (c *AuthHandler) AdminLogout() gin.HandlerFunc {
+	return c.logout(token.Admin)
+}
+
+// common functionality of logout for user and admin
+func (c *AuthHandler) logout(tokenUser token.UserType) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+
+		userID := utils.GetUserIdFromContext(ctx)
+		sessionID := utils.GetSessionIdFromContext(ctx)
+
+		err := c.authUseCase.RevokeSession(ctx, userID, tokenUser, sessionID)
+		if err != nil {
+			statusCode := http.StatusInternalServerError
+			if errors.Is(err, usecase.ErrRefreshSessionNotExist) {
+				statusCode = http.StatusNotFound
+			}
+			response.ErrorResponse(ctx, statusCode, "Failed to logout", err, nil)
+			return
+		}
+
+		response.SuccessResponse(ctx, http.StatusOK, "Successfully logged out")
+	}
+}
+
+// UserLogoutAll godoc
+//
+//	@Summary		Logout from all devices (User)
+//	@Description	API for user to logout from all devices (all sessions of user are revoked)
+//	@Security		BearerAuth
+//	@Id				UserLogoutAll
+//	@Tags			User Authentication
+//	@Router			/auth/logout-all [post]
+//	@Success		200	{object}	response.Response{}	"Successfully logged out from all devices"
+//	@Failure		401	{object}	response.Response{}	"Unauthorized user"
+//	@Failure		500	{object}	response.Response{}	"Failed to logout from all devices"
+func (c *AuthHandler) UserLogoutAll() gin.HandlerFunc {
+	return c.logoutAll(token.User)
+}
+
+// AdminLogoutAll godoc
+//
+//	@Summary		Logout from all devices (Admin)
+//	@Description	API for admin to logout from all devices (all sessions of admin are revoked)
+//	@Security		BearerAuth
+//	@Id				AdminLogoutAll
+//	@Tags			Admin Authentication
+//	@Router			/admin/auth/logout-all [post]
+//	@Success		200	{object}	response.Response{}	"Successfully logged out from all devices"
+//	@Failure		401	{object}	response.Response{}	"Unauthorized user"
+//	@Failure		500	{object}	response.Response{}	"Failed to logout from all devices"
+func (c *AuthHandler) AdminLogoutAll() gin.HandlerFunc {
+	return c.logoutAll(token.Admin)
+}
+
+// common functionality of logout from all devices for user and admin
+func (c *AuthHandler) logoutAll(tokenUser token.UserType) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+
+		userID := utils.GetUserIdFromContext(ctx)
+
+		err := c.authUseCase.RevokeAllSessions(ctx, userID, tokenUser)
+		if err != nil {
+			response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to logout from all devices", err, nil)
+			return
+		}
+
+		response.SuccessResponse(ctx, http.StatusOK, "Successfully logged out from all devices")
+	}
+}
+
+// UserGetAllSessions godoc
+//
+//	@Summary		Get all sessions (User)
+//	@Description	API for user to get all devices the user logged in
+//	@Security		BearerAuth
+//	@Id				UserGetAllSessions
+//	@Tags			User Profile
+//	@Router			/account/sessions [get]
+//	@Success		200	{object}	response.Response{data=[]response.Session}	"Successfully found all sessions"
+//	@Failure		500	{object}	response.Response{}							"Failed to find all sessions"
+func (c *AuthHandler) UserGetAllSessions() gin.HandlerFunc {
+	return c.getAllSessions(token.User)
+}
+
+// AdminGetAllSessions godoc
+//
+//	@Summary		Get all sessions (Admin)
+//	@Description	API for admin to get all devices the admin logged in
+//	@Security		BearerAuth
+//	@Id				AdminGetAllSessions
+//	@Tags			Admin Authentication
+//	@Router			/admin/account/sessions [get]
+//	@Success		200	{object}	response.Response{data=[]response.Session}	"Successfully found all sessions"
+//	@Failure		500	{object}	response.Response{}							"Failed to find all sessions"
+func (c *AuthHandler) AdminGetAllSessions() gin.HandlerFunc {
+	return c.getAllSessions(token.Admin)
+}
+
+// common functionality of listing sessions for user and admin
+func (c *AuthHandler) getAllSessions(tokenUser token.UserType) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+
+		userID := utils.GetUserIdFromContext(ctx)
+		sessionID := utils.GetSessionIdFromContext(ctx)
+
+		sessions, err := c.authUseCase.FindAllSessions(ctx, userID, tokenUser)
+		if err != nil {
+			response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to find all sessions", err, nil)
+			return
+		}
+
+		// mark the session of current request
+		for i := range sessions {
+			sessions[i].Current = sessions[i].SessionID == sessionID
+		}
+
+		response.SuccessResponse(ctx, http.StatusOK, "Successfully found all sessions", sessions)
+	}
+}
+
+// UserRevokeSession godoc
+//
+//	@Summary		Revoke a session (User)
+//	@Description	API for user to logout a device using session id
+//	@Security		BearerAuth
+//	@Id				UserRevokeSession
+//	@Tags			User Profile
+//	@Param			session_id	path	string	true	"Session ID"
+//	@Router			/account/sessions/{session_id} [delete]
+//	@Success		200	{object}	response.Response{}	"Successfully session revoked"
+//	@Failure		404	{object}	response.Response{}	"No active session found with given session id"
+//	@Failure		500	{object}	response.Response{}	"Failed to revoke session"
+func (c *AuthHandler) UserRevokeSession() gin.HandlerFunc {
+	return c.revokeSession(token.User)
+}
+
+// AdminRevokeSession godoc
+//
+//	@Summary		Revoke a session (Admin)
+//	@Description	API for admin to logout a device using session id
+//	@Security		BearerAuth
+//	@Id				AdminRevokeSession
+//	@Tags			Admin Authentication
+//	@Param			session_id	path	string	true	"Session ID"
+//	@Router			/admin/account/sessions/{session_id} [delete]
+//	@Success		200	{object}	response.Response{}	"Successfully session revoked"
+//	@Failure		404	{object}	response.Response{}	"No active session found with given session id"
+//	@Failure		500	{object}	response.Response{}	"Failed to revoke session"
+func (c *AuthHandler) AdminRevokeSession() gin.HandlerFunc {
+	return c.revokeSession(token.Admin)
+}
+
+// common functionality of revoking a session for user and admin
+func (c *AuthHandler) revokeSession(tokenUser token.UserType) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+
+		userID := utils.GetUserIdFromContext(ctx)
+		sessionID := ctx.Param("session_id")
+
+		err := c.authUseCase.RevokeSession(ctx, userID, tokenUser, sessionID)
+		if err != nil {
+			statusCode := http.StatusInternalServerError
+			if errors.Is(err, usecase.ErrRefreshSessionNotExist) {
+				statusCode = http.StatusNotFound
+			}
+			response.ErrorResponse(ctx, statusCode, "Failed to revoke session", err, nil)
+			return
+		}
+
+		response.SuccessResponse(ctx, http.StatusOK, "Successfully session revoked")
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/mock/mockusecase"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/token"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase"
+	usecaseInterface "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -63,6 +64,8 @@ func TestUserLogin(t *testing.T) {
 			buildStub: func(useCaseMock *mockusecase.MockAuthUseCase, loginDetails request.Login) {
 				useCaseMock.EXPECT().UserLogin(gomock.Any(), loginDetails).
 					Times(1).Return(uint(1), nil)
+				useCaseMock.EXPECT().GenerateRefreshToken(gomock.Any(), gomock.Any()).
+					Times(1).Return(domain.RefreshSession{TokenID: "token_id", RefreshToken: "refreshToken"}, nil)
 				useCaseMock.EXPECT().GenerateAccessToken(gomock.Any(), gomock.Any()).
 					Times(1).Return("", errors.New("failed to generate access token"))
 			},
@@ -76,10 +79,8 @@ func TestUserLogin(t *testing.T) {
 
 				useCaseMock.EXPECT().UserLogin(gomock.Any(), loginDetails).
 					Times(1).Return(uint(1), nil)
-				useCaseMock.EXPECT().GenerateAccessToken(gomock.Any(), gomock.Any()).
-					Times(1).Return("accessToken", nil)
 				useCaseMock.EXPECT().GenerateRefreshToken(gomock.Any(), gomock.Any()).
-					Times(1).Return("", errors.New("faild to generate access_token"))
+					Times(1).Return(domain.RefreshSession{}, errors.New("faild to generate access_token"))
 			},
 			checkResponse: func(t *testing.T, responseRecorder *httptest.ResponseRecorder) {
 				assert.Equal(t, http.StatusInternalServerError, responseRecorder.Code)
@@ -90,10 +91,12 @@ func TestUserLogin(t *testing.T) {
 			buildStub: func(useCaseMock *mockusecase.MockAuthUseCase, loginDetails request.Login) {
 				useCaseMock.EXPECT().UserLogin(gomock.Any(), loginDetails).
 					Times(1).Return(uint(1), nil)
-				useCaseMock.EXPECT().GenerateAccessToken(gomock.Any(), gomock.Any()).
-					Times(1).Return("accessTokenFromGenerateAccessToken", nil)
 				useCaseMock.EXPECT().GenerateRefreshToken(gomock.Any(), gomock.Any()).
-					Times(1).Return("refreshTokenFromGenerateRefreshToken", nil)
+					Times(1).Return(domain.RefreshSession{TokenID: "token_id",
+					RefreshToken: "refreshTokenFromGenerateRefreshToken"}, nil)
+				useCaseMock.EXPECT().GenerateAccessToken(gomock.Any(), usecaseInterface.GenerateTokenParams{
+					UserID: 1, UserType: token.User, SessionID: "token_id"}).
+					Times(1).Return("accessTokenFromGenerateAccessToken", nil)
 			},
 
 			checkResponse: func(t *testing.T, responseRecorder *httptest.ResponseRecorder) {
@@ -178,6 +181,8 @@ func TestUserRenewRefreshToken(t *testing.T) {
 			buildStub: func(mockAuthUseCase *mockusecase.MockAuthUseCase) {
 				mockAuthUseCase.EXPECT().VerifyAndGetRefreshTokenSession(gomock.Any(), "validRefreshToken", tokenUsedFor).
 					Times(1).Return(domain.RefreshSession{}, nil)
+				mockAuthUseCase.EXPECT().RotateRefreshToken(gomock.Any(), domain.RefreshSession{}, gomock.Any()).
+					Times(1).Return(domain.RefreshSession{TokenID: "new_token_id"}, nil)
 
 				mockAuthUseCase.EXPECT().GenerateAccessToken(gomock.Any(), gomock.Any()).
 					Times(1).Return("", errors.New("failed to generate access token for refresh token"))
@@ -191,8 +196,11 @@ func TestUserRenewRefreshToken(t *testing.T) {
 			refreshToken: "validRefreshToken",
 			buildStub: func(mockAuthUseCase *mockusecase.MockAuthUseCase) {
 				mockAuthUseCase.EXPECT().VerifyAndGetRefreshTokenSession(gomock.Any(), "validRefreshToken", tokenUsedFor).
-					Times(1).Return(domain.RefreshSession{}, nil)
-				mockAuthUseCase.EXPECT().GenerateAccessToken(gomock.Any(), gomock.Any()).
+					Times(1).Return(domain.RefreshSession{TokenID: "token_id", UserID: 1}, nil)
+				mockAuthUseCase.EXPECT().RotateRefreshToken(gomock.Any(), domain.RefreshSession{TokenID: "token_id", UserID: 1}, gomock.Any()).
+					Times(1).Return(domain.RefreshSession{TokenID: "new_token_id", RefreshToken: "rotated_refresh_token"}, nil)
+				mockAuthUseCase.EXPECT().GenerateAccessToken(gomock.Any(), usecaseInterface.GenerateTokenParams{
+					UserID: 1, UserType: tokenUsedFor, SessionID: "new_token_id"}).
 					Times(1).Return("generated_access_token", nil)
 			},
 			checkResponse: func(t *testing.T, responseRecorder *httptest.ResponseRecorder) {
@@ -211,6 +219,18 @@ func TestUserRenewRefreshToken(t *testing.T) {
 				assert.NoError(t, err)
 
 				assert.Equal(t, "generated_access_token", tokenResponse.AccessToken)
+				assert.Equal(t, "rotated_refresh_token", tokenResponse.RefreshToken)
+			},
+		},
+		{
+			testName:     "ReusedRefreshTokenShouldReturnUnauthorized",
+			refreshToken: "usedRefreshToken",
+			buildStub: func(mockAuthUseCase *mockusecase.MockAuthUseCase) {
+				mockAuthUseCase.EXPECT().VerifyAndGetRefreshTokenSession(gomock.Any(), "usedRefreshToken", tokenUsedFor).
+					Times(1).Return(domain.RefreshSession{}, usecase.ErrRefreshTokenReused)
+			},
+			checkResponse: func(t *testing.T, responseRecorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusUnauthorized, responseRecorder.Code)
 			},
 		},
 	}
@@ -8,4 +8,16 @@ type AdminHandler interface {
 
 	AdminSignUp(ctx *gin.Context)
 	GetFullSalesReport(ctx *gin.Context)
+	GetSalesSummary(ctx *gin.Context)
+
+	GetDashboard(ctx *gin.Context)
+
+	// staff
+	GetAllRoles(ctx *gin.Context)
+	GetAllStaff(ctx *gin.Context)
+	InviteStaff(ctx *gin.Context)
+	SetStaffPassword(ctx *gin.Context)
+	UpdateStaff(ctx *gin.Context)
+	DeleteStaff(ctx *gin.Context)
+	GetAllAuditLogs(ctx *gin.Context)
 }
@@ -15,9 +15,23 @@ type AuthHandler interface {
 	UserLoginOtpVerify(ctx *gin.Context)
 	UserLoginOtpSend(ctx *gin.Context)
 
+	UserForgotPassword(ctx *gin.Context)
+	UserResetPassword(ctx *gin.Context)
+	UserChangePassword(ctx *gin.Context)
+
 	UserRenewAccessToken() gin.HandlerFunc
 
+	UserLogout() gin.HandlerFunc
+	UserLogoutAll() gin.HandlerFunc
+	UserGetAllSessions() gin.HandlerFunc
+	UserRevokeSession() gin.HandlerFunc
+
 	//admin side
 	AdminLogin(ctx *gin.Context)
 	AdminRenewAccessToken() gin.HandlerFunc
+
+	AdminLogout() gin.HandlerFunc
+	AdminLogoutAll() gin.HandlerFunc
+	AdminGetAllSessions() gin.HandlerFunc
+	AdminRevokeSession() gin.HandlerFunc
 }
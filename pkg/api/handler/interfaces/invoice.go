@@ -0,0 +1,8 @@
+package interfaces
+
+import "github.com/gin-gonic/gin"
+
+type InvoiceHandler interface {
+	GetOrderInvoiceUser(ctx *gin.Context)
+	GetOrderInvoiceAdmin(ctx *gin.Context)
+}
@@ -9,14 +9,17 @@ type OrderHandler interface {
 
 	// ApproveOrderCOD(ctx *gin.Context)
 	CancelOrder(ctx *gin.Context)
+	CancelOrderAdmin(ctx *gin.Context)
 	SubmitReturnRequest(ctx *gin.Context)
 	GetAllOrderItemsUser() func(ctx *gin.Context)
 	GetUserOrder(ctx *gin.Context)
+	GetOrderTimelineUser() func(ctx *gin.Context)
 
 	//admin side
 	GetAllShopOrders(ctx *gin.Context)
 	GetAllOrderItemsAdmin() func(ctx *gin.Context)
 	UpdateOrderStatus(ctx *gin.Context)
+	GetOrderTimelineAdmin() func(ctx *gin.Context)
 	GetAllOrderReturns(ctx *gin.Context)
 	GetAllPendingReturns(ctx *gin.Context)
 	UpdateReturnRequest(ctx *gin.Context)
@@ -18,4 +18,12 @@ type PaymentHandler interface {
 
 	StripePaymentVeify(ctx *gin.Context)
 	StripPaymentCheckout(ctx *gin.Context)
+
+	PaymentWallet(ctx *gin.Context)
+
+	// webhooks
+	StripeWebhook(ctx *gin.Context)
+	RazorpayWebhook(ctx *gin.Context)
+	GetAllPaymentEvents(ctx *gin.Context)
+	ReplayPaymentEvent(ctx *gin.Context)
 }
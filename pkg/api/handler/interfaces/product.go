@@ -12,11 +12,16 @@ type ProductHandler interface {
 
 	GetAllProductsAdmin() func(ctx *gin.Context)
 	GetAllProductsUser() func(ctx *gin.Context)
+	SearchProducts(ctx *gin.Context)
 
 	SaveProduct(ctx *gin.Context)
 	UpdateProduct(ctx *gin.Context)
 
 	SaveProductItem(ctx *gin.Context)
+	UpdateProductItem(ctx *gin.Context)
 	GetAllProductItemsAdmin() func(ctx *gin.Context)
 	GetAllProductItemsUser() func(ctx *gin.Context)
+
+	ImportProducts(ctx *gin.Context)
+	ExportProducts(ctx *gin.Context)
 }
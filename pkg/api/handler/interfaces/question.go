@@ -0,0 +1,18 @@
+package interfaces
+
+import "github.com/gin-gonic/gin"
+
+type QuestionHandler interface {
+	SaveProductQuestion(ctx *gin.Context)
+	GetAllProductQuestionsUser(ctx *gin.Context)
+	GetAllProductAnswersUser(ctx *gin.Context)
+	SaveProductAnswerUser(ctx *gin.Context)
+	UpvoteProductAnswer(ctx *gin.Context)
+	RemoveProductAnswerUpvote(ctx *gin.Context)
+
+	GetAllProductQuestionsAdmin(ctx *gin.Context)
+	GetAllProductAnswersAdmin(ctx *gin.Context)
+	SaveProductAnswerAdmin(ctx *gin.Context)
+	UpdateProductQuestionVisibility(ctx *gin.Context)
+	UpdateProductAnswerVisibility(ctx *gin.Context)
+}
@@ -0,0 +1,12 @@
+package interfaces
+
+import "github.com/gin-gonic/gin"
+
+type ReviewHandler interface {
+	SaveProductReview(ctx *gin.Context)
+	GetAllProductReviewsUser(ctx *gin.Context)
+
+	GetAllProductReviewsAdmin(ctx *gin.Context)
+	ApproveProductReview(ctx *gin.Context)
+	HideProductReview(ctx *gin.Context)
+}
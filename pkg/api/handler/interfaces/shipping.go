@@ -0,0 +1,10 @@
+package interfaces
+
+import "github.com/gin-gonic/gin"
+
+type ShippingHandler interface {
+	SaveShippingZone(ctx *gin.Context)
+	GetAllShippingZones(ctx *gin.Context)
+	UpdateShippingZone(ctx *gin.Context)
+	DeleteShippingZone(ctx *gin.Context)
+}
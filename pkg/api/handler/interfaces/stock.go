@@ -5,4 +5,7 @@ import "github.com/gin-gonic/gin"
 type StockHandler interface {
 	UpdateStock(ctx *gin.Context)
 	GetAllStocks(ctx *gin.Context)
+	GetAllStockMovements(ctx *gin.Context)
+	GetAllLowStocks(ctx *gin.Context)
+	UpdateReorderThreshold(ctx *gin.Context)
 }
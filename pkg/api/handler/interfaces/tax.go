@@ -0,0 +1,11 @@
+package interfaces
+
+import "github.com/gin-gonic/gin"
+
+type TaxHandler interface {
+	SaveTaxClass(ctx *gin.Context)
+	GetAllTaxClasses(ctx *gin.Context)
+	UpdateTaxClass(ctx *gin.Context)
+	UpdateCategoryTaxClass(ctx *gin.Context)
+	UpdateProductTaxClass(ctx *gin.Context)
+}
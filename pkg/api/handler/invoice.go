@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase"
+	usecaseInterface "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+)
+
+type invoiceHandler struct {
+	invoiceUseCase usecaseInterface.InvoiceUseCase
+}
+
+func NewInvoiceHandler(invoiceUseCase usecaseInterface.InvoiceUseCase) interfaces.InvoiceHandler {
+	return &invoiceHandler{
+		invoiceUseCase: invoiceUseCase,
+	}
+}
+
+// GetOrderInvoiceUser godoc
+//
+//	@Summary		Download order invoice (User)
+//	@Security		BearerAuth
+//	@Description	API for user to download the pdf invoice of a placed order
+//	@Id				GetOrderInvoiceUser
+//	@Tags			User Orders
+//	@Produce		application/pdf
+//	@Param			shop_order_id	path	int	true	"Shop Order ID"
+//	@Router			/orders/{shop_order_id}/invoice [get]
+//	@Success		200	{file}		file				"invoice pdf"
+//	@Success		302	{string}	string				"redirect to stored invoice pdf"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		422	{object}	response.Response{}	"Order is not placed yet"
+//	@Failure		500	{object}	response.Response{}	"Failed to get order invoice"
+func (c *invoiceHandler) GetOrderInvoiceUser(ctx *gin.Context) {
+
+	shopOrderID, err := request.GetParamAsUint(ctx, "shop_order_id")
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	userID := utils.GetUserIdFromContext(ctx)
+
+	invoice, err := c.invoiceUseCase.FindUserOrderInvoice(ctx, userID, shopOrderID)
+
+	writeInvoice(ctx, invoice, err)
+}
+
+// GetOrderInvoiceAdmin godoc
+//
+//	@Summary		Download order invoice (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to download the pdf invoice of a placed order
+//	@Id				GetOrderInvoiceAdmin
+//	@Tags			Admin Orders
+//	@Produce		application/pdf
+//	@Param			shop_order_id	path	int	true	"Shop Order ID"
+//	@Router			/admin/orders/{shop_order_id}/invoice [get]
+//	@Success		200	{file}		file				"invoice pdf"
+//	@Success		302	{string}	string				"redirect to stored invoice pdf"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		422	{object}	response.Response{}	"Order is not placed yet"
+//	@Failure		500	{object}	response.Response{}	"Failed to get order invoice"
+func (c *invoiceHandler) GetOrderInvoiceAdmin(ctx *gin.Context) {
+
+	shopOrderID, err := request.GetParamAsUint(ctx, "shop_order_id")
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	invoice, err := c.invoiceUseCase.FindOrderInvoice(ctx, shopOrderID)
+
+	writeInvoice(ctx, invoice, err)
+}
+
+// To send the invoice pdf or redirect to the stored pdf url
+func writeInvoice(ctx *gin.Context, invoice response.Invoice, err error) {
+
+	if err != nil {
+		var statusCode int
+
+		switch {
+		case errors.Is(err, usecase.ErrInvalidShopOrderID):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, usecase.ErrInvoiceNotAvailable):
+			statusCode = http.StatusUnprocessableEntity
+		default:
+			statusCode = http.StatusInternalServerError
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to get order invoice", err, nil)
+		return
+	}
+
+	if invoice.FileUrl != "" {
+		ctx.Redirect(http.StatusFound, invoice.FileUrl)
+		return
+	}
+
+	ctx.Header("Content-Disposition", "attachment;filename="+invoice.FileName)
+	ctx.Data(http.StatusOK, "application/pdf", invoice.File)
+}
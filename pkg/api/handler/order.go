@@ -62,6 +62,7 @@ func (c *OrderHandler) GetAllOrderStatuses(ctx *gin.Context) {
 //	@Success		204	{object}	response.Response{}	"Cart is empty"
 //	@Failure		400	{object}	response.Response{}	"invalid input"
 //	@Failure		409	{object}	response.Response{}	"Can't place order out of stock product on cart"
+//	@Failure		422	{object}	response.Response{}	"Delivery is not available to the address pincode"
 //	@Failure		500	{object}	response.Response{}	"Failed to save order"
 func (c *OrderHandler) SaveOrder(ctx *gin.Context) {
 
@@ -83,6 +84,10 @@ func (c *OrderHandler) SaveOrder(ctx *gin.Context) {
 			statusCode = http.StatusNoContent
 		case errors.Is(err, usecase.ErrOutOfStockOnCart):
 			statusCode = http.StatusConflict
+		case errors.Is(err, usecase.ErrInvalidAddressID):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, usecase.ErrUnserviceablePincode):
+			statusCode = http.StatusUnprocessableEntity
 		default:
 			statusCode = http.StatusInternalServerError
 		}
@@ -226,23 +231,103 @@ func (c *OrderHandler) findAllOrderItems() func(ctx *gin.Context) {
 //	@Router			/orders/{shop_order_id}/cancel [post]
 //	@Success		200	{object}	response.Response{}	"Successfully order cancelled"
 //	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		409	{object}	response.Response{}	"Order can't cancel on current status"
 //	@Failure		500	{object}	response.Response{}	"Failed to cancel order"
 func (c *OrderHandler) CancelOrder(ctx *gin.Context) {
 
 	shopOrderID, err := request.GetParamAsUint(ctx, "shop_order_id")
 	if err != nil {
 		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
 	}
 
-	err = c.orderUseCase.CancelOrder(ctx, shopOrderID)
+	userID := utils.GetUserIdFromContext(ctx)
+
+	err = c.orderUseCase.CancelOrder(ctx, userID, shopOrderID)
 	if err != nil {
-		response.ErrorResponse(ctx, http.StatusBadRequest, "Failed to cancel order", err, nil)
+		var statusCode int
+		switch {
+		case errors.Is(err, usecase.ErrInvalidShopOrderID):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, usecase.ErrOrderNotCancellable):
+			statusCode = http.StatusConflict
+		default:
+			statusCode = http.StatusInternalServerError
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to cancel order", err, nil)
 		return
 	}
 
 	response.SuccessResponse(ctx, http.StatusOK, "successfully order cancelled", nil)
 }
 
+// GetOrderTimelineUser godoc
+//
+//	@Summary		Get order timeline (User)
+//	@Security		BearerAuth
+//	@Description	API for user to get all status changes of an order
+//	@Id				GetOrderTimelineUser
+//	@Tags			User Orders
+//	@Param			shop_order_id	path	int	true	"Shop Order ID"
+//	@Router			/orders/{shop_order_id}/timeline [get]
+//	@Success		200	{object}	response.Response{}	"Successfully found order timeline"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		500	{object}	response.Response{}	"Failed to find order timeline"
+func (c *OrderHandler) GetOrderTimelineUser() func(ctx *gin.Context) {
+	return c.getOrderTimeline(func(ctx *gin.Context, shopOrderID uint) ([]response.OrderStatusHistory, error) {
+		userID := utils.GetUserIdFromContext(ctx)
+		return c.orderUseCase.FindUserOrderTimeline(ctx, userID, shopOrderID)
+	})
+}
+
+// GetOrderTimelineAdmin godoc
+//
+//	@Summary		Get order timeline (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to get all status changes of an order
+//	@Id				GetOrderTimelineAdmin
+//	@Tags			Admin Orders
+//	@Param			shop_order_id	path	int	true	"Shop Order ID"
+//	@Router			/admin/orders/{shop_order_id}/timeline [get]
+//	@Success		200	{object}	response.Response{}	"Successfully found order timeline"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		500	{object}	response.Response{}	"Failed to find order timeline"
+func (c *OrderHandler) GetOrderTimelineAdmin() func(ctx *gin.Context) {
+	return c.getOrderTimeline(func(ctx *gin.Context, shopOrderID uint) ([]response.OrderStatusHistory, error) {
+		return c.orderUseCase.FindOrderTimeline(ctx, shopOrderID)
+	})
+}
+
+func (c *OrderHandler) getOrderTimeline(
+	findTimeline func(ctx *gin.Context, shopOrderID uint) ([]response.OrderStatusHistory, error)) func(ctx *gin.Context) {
+
+	return func(ctx *gin.Context) {
+
+		shopOrderID, err := request.GetParamAsUint(ctx, "shop_order_id")
+		if err != nil {
+			response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+			return
+		}
+
+		timeline, err := findTimeline(ctx, shopOrderID)
+		if err != nil {
+			statusCode := http.StatusInternalServerError
+			if errors.Is(err, usecase.ErrInvalidShopOrderID) {
+				statusCode = http.StatusBadRequest
+			}
+			response.ErrorResponse(ctx, statusCode, "Failed to find order timeline", err, nil)
+			return
+		}
+
+		if len(timeline) == 0 {
+			response.SuccessResponse(ctx, http.StatusOK, "No order timeline found", nil)
+			return
+		}
+
+		response.SuccessResponse(ctx, http.StatusOK, "Successfully found order timeline", timeline)
+	}
+}
+
 // UpdateOrderStatus godoc
 //	@Summary		Change order status (Admin)
 //	@Security		BearerAuth
@@ -262,7 +347,9 @@ func (c *OrderHandler) UpdateOrderStatus(ctx *gin.Context) {
 		return
 	}
 
-	err := c.orderUseCase.UpdateOrderStatus(ctx, body.ShopOrderID, body.OrderStatusID)
+	adminID := utils.GetUserIdFromContext(ctx)
+
+	err := c.orderUseCase.UpdateOrderStatus(ctx, adminID, body)
 	if err != nil {
 		response.ErrorResponse(ctx, http.StatusBadRequest, "Failed to update order status", err, nil)
 		return
@@ -271,6 +358,47 @@ func (c *OrderHandler) UpdateOrderStatus(ctx *gin.Context) {
 	response.SuccessResponse(ctx, http.StatusOK, "Successfully order status updated", nil)
 }
 
+// CancelOrderAdmin godoc
+//	@Summary		Cancel order (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to cancel an order with restock and refund of paid amount
+//	@Id				CancelOrderAdmin
+//	@Tags			Admin Orders
+//	@Param			input	body	request.CancelOrder{}	true	"input field"
+//	@Router			/admin/orders/cancel [post]
+//	@Success		200	{object}	response.Response{}	"Successfully order cancelled"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		409	{object}	response.Response{}	"Order can't cancel on current status"
+//	@Failure		500	{object}	response.Response{}	"Failed to cancel order"
+func (c *OrderHandler) CancelOrderAdmin(ctx *gin.Context) {
+
+	var body request.CancelOrder
+
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindJsonFailMessage, err, nil)
+		return
+	}
+
+	adminID := utils.GetUserIdFromContext(ctx)
+
+	err := c.orderUseCase.CancelOrderAdmin(ctx, adminID, body)
+	if err != nil {
+		var statusCode int
+		switch {
+		case errors.Is(err, usecase.ErrInvalidShopOrderID):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, usecase.ErrOrderNotCancellable):
+			statusCode = http.StatusConflict
+		default:
+			statusCode = http.StatusInternalServerError
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to cancel order", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully order cancelled", nil)
+}
+
 // SubmitReturnRequest godoc
 //	@Summary		Return request (User)
 //	@Security		BearerAuth
@@ -289,7 +417,9 @@ func (c OrderHandler) SubmitReturnRequest(ctx *gin.Context) {
 		return
 	}
 
-	err := c.orderUseCase.SubmitReturnRequest(ctx, body)
+	userID := utils.GetUserIdFromContext(ctx)
+
+	err := c.orderUseCase.SubmitReturnRequest(ctx, userID, body)
 	if err != nil {
 		response.ErrorResponse(ctx, http.StatusBadRequest, "Failed to submit return request", err, nil)
 		return
@@ -375,7 +505,9 @@ func (c *OrderHandler) UpdateReturnRequest(ctx *gin.Context) {
 		return
 	}
 
-	err := c.orderUseCase.UpdateReturnDetails(ctx, body)
+	adminID := utils.GetUserIdFromContext(ctx)
+
+	err := c.orderUseCase.UpdateReturnDetails(ctx, adminID, body)
 	if err != nil {
 		response.ErrorResponse(ctx, http.StatusBadRequest, "Failed to update order return", err, nil)
 		return
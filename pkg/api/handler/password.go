@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+)
+
+// UserForgotPassword godoc
+//
+//	@Summary		Forgot password otp send (User)
+//	@Description	API for user to send otp for reset password enter email | phone | user_name : otp will send to user registered number or email (by otp_channel sms | email)
+//	@Id				UserForgotPassword
+//	@Tags			User Authentication
+//	@Param			inputs	body	request.OTPLogin{}	true	"User credentials"
+//	@Router			/auth/forgot-password [post]
+//	@Success		200	{object}	response.Response{data=response.OTPResponse}	"Successfully otp send to user's registered number"
+//	@Failure		400	{object}	response.Response{}								"Invalid inputs"
+//	@Failure		403	{object}	response.Response{}								"User blocked by admin"
+//	@Failure		404	{object}	response.Response{}								"User not exist with given credentials"
+//	@Failure		429	{object}	response.Response{}								"Otp resend cooldown or daily limit reached"
+//	@Failure		500	{object}	response.Response{}								"Failed to send otp"
+func (c *AuthHandler) UserForgotPassword(ctx *gin.Context) {
+
+	var body request.OTPLogin
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindJsonFailMessage, err, nil)
+		return
+	}
+
+	otpID, err := c.authUseCase.ForgotPasswordOtpSend(ctx, body)
+	if err != nil {
+		var statusCode int
+		switch {
+		case errors.Is(err, usecase.ErrEmptyLoginCredentials):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, usecase.ErrUserNotExist):
+			statusCode = http.StatusNotFound
+		case errors.Is(err, usecase.ErrUserBlocked):
+			statusCode = http.StatusForbidden
+		case errors.Is(err, usecase.ErrOtpResendCooldown), errors.Is(err, usecase.ErrOtpDailyLimitReached):
+			statusCode = http.StatusTooManyRequests
+		default:
+			statusCode = http.StatusInternalServerError
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to send otp", err, nil)
+		return
+	}
+
+	otpRes := response.OTPResponse{
+		OtpID: otpID,
+	}
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully otp send to user's registered number", otpRes)
+}
+
+// UserResetPassword godoc
+//
+//	@Summary		Reset password (User)
+//	@Description	API for user to set a new password by verifying the otp of forgot password (logout from all devices)
+//	@Id				UserResetPassword
+//	@Tags			User Authentication
+//	@Param			inputs	body	request.ResetPassword{}	true	"Reset password details"
+//	@Router			/auth/reset-password [post]
+//	@Success		200	{object}	response.Response{}	"Successfully password reset"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		401	{object}	response.Response{}	"Otp not matched"
+//	@Failure		410	{object}	response.Response{}	"Otp Expired"
+//	@Failure		429	{object}	response.Response{}	"Too many wrong otp attempts"
+//	@Failure		500	{object}	response.Response{}	"Failed to reset password"
+func (c *AuthHandler) UserResetPassword(ctx *gin.Context) {
+
+	var body request.ResetPassword
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindJsonFailMessage, err, nil)
+		return
+	}
+	body.ClientIP = ctx.ClientIP()
+
+	err := c.authUseCase.ResetPassword(ctx, body)
+	if err != nil {
+		var statusCode int
+		switch {
+		case errors.Is(err, usecase.ErrOtpExpired):
+			statusCode = http.StatusGone
+		case errors.Is(err, usecase.ErrInvalidOtp):
+			statusCode = http.StatusUnauthorized
+		case errors.Is(err, usecase.ErrLoginLocked):
+			statusCode = http.StatusTooManyRequests
+		default:
+			statusCode = http.StatusInternalServerError
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to reset password", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully password reset")
+}
+
+// UserChangePassword godoc
+//
+//	@Summary		Change password (User)
+//	@Security		BearerAuth
+//	@Description	API for user to change password using the old password (logout from all devices)
+//	@Id				UserChangePassword
+//	@Tags			User Profile
+//	@Param			inputs	body	request.ChangePassword{}	true	"Change password details"
+//	@Router			/account/password [patch]
+//	@Success		200	{object}	response.Response{}	"Successfully password changed"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		401	{object}	response.Response{}	"Old password doesn't match"
+//	@Failure		429	{object}	response.Response{}	"Too many wrong passwords"
+//	@Failure		500	{object}	response.Response{}	"Failed to change password"
+func (c *AuthHandler) UserChangePassword(ctx *gin.Context) {
+
+	userID := utils.GetUserIdFromContext(ctx)
+
+	var body request.ChangePassword
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindJsonFailMessage, err, nil)
+		return
+	}
+
+	body.ClientIP = ctx.ClientIP()
+
+	err := c.authUseCase.ChangePassword(ctx, userID, body)
+	if err != nil {
+		var statusCode int
+		switch {
+		case errors.Is(err, usecase.ErrWrongPassword):
+			statusCode = http.StatusUnauthorized
+		case errors.Is(err, usecase.ErrLoginLocked):
+			statusCode = http.StatusTooManyRequests
+		default:
+			statusCode = http.StatusInternalServerError
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to change password", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully password changed")
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -8,6 +9,7 @@ import (
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
 )
@@ -28,8 +30,10 @@ func NewPaymentHandler(paymentUseCase interfaces.PaymentUseCase) handlerInterfac
 //	@Description	API for user to render payment select page
 //	@Id				CartOrderPaymentSelectPage
 //	@Tags			User Payment
+//	@Param			shop_order_id	query	int	false	"Shop Order ID (to show order price with delivery charge)"
 //	@Router			/carts/checkout/payment-select-page [get]
 //	@Success		200	{object}	response.Response{}	"Successfully rendered payment page"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
 //	@Failure		500	{object}	response.Response{}	"Failed to render payment page"
 func (c *paymentHandler) CartOrderPaymentSelectPage(ctx *gin.Context) {
 
@@ -39,7 +43,33 @@ func (c *paymentHandler) CartOrderPaymentSelectPage(ctx *gin.Context) {
 		return
 	}
 
-	ctx.HTML(200, "paymentForm.html", Payments)
+	data := gin.H{
+		"PaymentMethods": Payments,
+	}
+
+	// order summary is shown only when the page opened for a saved order
+	if ctx.Query("shop_order_id") != "" {
+		shopOrderID, err := request.GetQueryValueAsUint(ctx, "shop_order_id")
+		if err != nil {
+			response.ErrorResponse(ctx, http.StatusBadRequest, BindQueryFailMessage, err, nil)
+			return
+		}
+
+		userID := utils.GetUserIdFromContext(ctx)
+
+		orderSummary, err := c.paymentUseCase.FindPaymentOrderSummary(ctx, userID, shopOrderID)
+		if err != nil {
+			statusCode := http.StatusInternalServerError
+			if errors.Is(err, usecase.ErrInvalidShopOrderID) {
+				statusCode = http.StatusBadRequest
+			}
+			response.ErrorResponse(ctx, statusCode, "Failed to render payment page", err, nil)
+			return
+		}
+		data["Order"] = orderSummary
+	}
+
+	ctx.HTML(200, "paymentForm.html", data)
 }
 
 // UpdatePaymentMethod godoc
@@ -125,6 +155,8 @@ func (c *paymentHandler) findAllPaymentMethods() func(ctx *gin.Context) {
 //	@Param			shop_order_id	formData	string	true	"Shop Order ID"
 //	@Router			/carts/place-order/cod [post]
 //	@Success		200	{object}	response.Response{}	"successfully order placed for COD"
+//	@Failure		400	{object}	response.Response{}	"Invalid shop order id"
+//	@Failure		409	{object}	response.Response{}	"Order already cancelled"
 //	@Failure		500	{object}	response.Response{}	"Failed place order for COD"
 func (c *paymentHandler) PaymentCOD(ctx *gin.Context) {
 
@@ -145,7 +177,13 @@ func (c *paymentHandler) PaymentCOD(ctx *gin.Context) {
 	err = c.paymentUseCase.ApproveShopOrderAndClearCart(ctx, UserID, approveReq)
 
 	if err != nil {
-		response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to approve order and clear cart", err, nil)
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrInvalidShopOrderID) {
+			statusCode = http.StatusBadRequest
+		} else if errors.Is(err, usecase.ErrOrderAlreadyCancelled) {
+			statusCode = http.StatusConflict
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to approve order and clear cart", err, nil)
 		return
 	}
 
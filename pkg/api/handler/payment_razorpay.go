@@ -20,6 +20,7 @@ import (
 //	@Tags			User Payment
 //	@Id				RazorpayCheckout
 //	@Param			shop_order_id	formData	string	true	"Shop Order ID"
+//	@Param			use_wallet		formData	bool	false	"Pay part of the amount from wallet"
 //	@Router			/carts/place-order/razorpay-checkout [post]
 //	@Success		200	{object}	response.Response{}	"successfully razorpay payment order created"
 //	@Failure		500	{object}	response.Response{}	"Failed to make razorpay order"
@@ -31,12 +32,23 @@ func (c *paymentHandler) RazorpayCheckout(ctx *gin.Context) {
 		return
 	}
 
+	useWallet := request.GetFormValueAsBool(ctx, "use_wallet")
+
 	UserID := utils.GetUserIdFromContext(ctx)
 
-	razorpayOrder, err := c.paymentUseCase.MakeRazorpayOrder(ctx, UserID, shopOrderID)
+	razorpayOrder, err := c.paymentUseCase.MakeRazorpayOrder(ctx, UserID, shopOrderID, useWallet)
 
 	if err != nil {
-		response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to make razorpay order ", err, nil)
+		var statusCode int
+		switch {
+		case errors.Is(err, usecase.ErrWalletCoversOrderAmount), errors.Is(err, usecase.ErrInvalidShopOrderID):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, usecase.ErrOrderNotPaymentPending), errors.Is(err, usecase.ErrOrderAlreadyCancelled):
+			statusCode = http.StatusConflict
+		default:
+			statusCode = http.StatusInternalServerError
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to make razorpay order ", err, nil)
 		return
 	}
 
@@ -60,8 +72,10 @@ func (c *paymentHandler) RazorpayCheckout(ctx *gin.Context) {
 //	@Param			shop_order_id		formData	string	true	"Shop Order ID"
 //	@Router			/carts/place-order/razorpay-verify [post]
 //	@Success		200	{object}	response.Response{}	"Successfully razorpay payment verified"
+//	@Failure		400	{object}	response.Response{}	"Invalid shop order or payment"
 //	@Failure		402	{object}	response.Response{}	"Payment not approved"
-//	@Failure		500	{object}	response.Response{}	"Failed to Approve order"
+//	@Failure		409	{object}	response.Response{}	"Order already cancelled"
+//	@Failure		500	{object}	response.Response{}	"Failed to verify payment"
 func (c *paymentHandler) RazorpayVerify(ctx *gin.Context) {
 
 	userID := utils.GetUserIdFromContext(ctx)
@@ -85,26 +99,23 @@ func (c *paymentHandler) RazorpayVerify(ctx *gin.Context) {
 		Signature: razorpaySignature,
 	}
 
-	err = c.paymentUseCase.VerifyRazorPay(ctx, verifyReq)
+	err = c.paymentUseCase.VerifyRazorPay(ctx, userID, shopOrderID, verifyReq)
 	if err != nil {
-		statusCode := http.StatusInternalServerError
-		if errors.Is(err, usecase.ErrPaymentNotApproved) {
+		var statusCode int
+		switch {
+		case errors.Is(err, usecase.ErrInvalidShopOrderID), errors.Is(err, usecase.ErrPaymentNotForOrder):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, usecase.ErrPaymentNotApproved), errors.Is(err, usecase.ErrPaymentAmountMismatch),
+			errors.Is(err, usecase.ErrInsufficientWalletBalance):
 			statusCode = http.StatusPaymentRequired
+		case errors.Is(err, usecase.ErrOrderAlreadyCancelled):
+			statusCode = http.StatusConflict
+		default:
+			statusCode = http.StatusInternalServerError
 		}
 		response.ErrorResponse(ctx, statusCode, "Failed to verify razorpay payment", err, nil)
 		return
 	}
 
-	approveReq := request.ApproveOrder{
-		ShopOrderID: shopOrderID,
-		PaymentType: domain.RazopayPayment,
-	}
-
-	err = c.paymentUseCase.ApproveShopOrderAndClearCart(ctx, userID, approveReq)
-	if err != nil {
-		response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to Approve order", err, nil)
-		return
-	}
-
 	response.SuccessResponse(ctx, http.StatusOK, "Successfully razorpay payment verified", nil)
 }
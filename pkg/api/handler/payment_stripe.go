@@ -19,6 +19,7 @@ import (
 //	@Tags			User Payment
 //	@Id				StripPaymentCheckout
 //	@Param			shop_order_id	formData	string	true	"Shop Order ID"
+//	@Param			use_wallet		formData	bool	false	"Pay part of the amount from wallet"
 //	@Router			/carts/place-order/stripe-checkout [post]
 //	@Success		200	{object}	response.Response{}	"successfully stripe payment order created"
 //	@Failure		500	{object}	response.Response{}	"Failed to create stripe order"
@@ -30,11 +31,22 @@ func (c *paymentHandler) StripPaymentCheckout(ctx *gin.Context) {
 		return
 	}
 
+	useWallet := request.GetFormValueAsBool(ctx, "use_wallet")
+
 	UserID := utils.GetUserIdFromContext(ctx)
 
-	stripeOrder, err := c.paymentUseCase.MakeStripeOrder(ctx, UserID, shopOrderID)
+	stripeOrder, err := c.paymentUseCase.MakeStripeOrder(ctx, UserID, shopOrderID, useWallet)
 	if err != nil {
-		response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to create stripe order", err, nil)
+		var statusCode int
+		switch {
+		case errors.Is(err, usecase.ErrWalletCoversOrderAmount), errors.Is(err, usecase.ErrInvalidShopOrderID):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, usecase.ErrOrderNotPaymentPending), errors.Is(err, usecase.ErrOrderAlreadyCancelled):
+			statusCode = http.StatusConflict
+		default:
+			statusCode = http.StatusInternalServerError
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to create stripe order", err, nil)
 		return
 	}
 
@@ -56,8 +68,10 @@ func (c *paymentHandler) StripPaymentCheckout(ctx *gin.Context) {
 //	@Param			shop_order_id		formData	string	true	"Shop Order ID"
 //	@Router			/carts/place-order/stripe-verify [post]
 //	@Success		200	{object}	response.Response{}	"Successfully stripe payment verified"
+//	@Failure		400	{object}	response.Response{}	"Invalid shop order or payment"
 //	@Failure		402	{object}	response.Response{}	"Payment not approved"
-//	@Failure		500	{object}	response.Response{}	"Failed to Approve order"
+//	@Failure		409	{object}	response.Response{}	"Order already cancelled"
+//	@Failure		500	{object}	response.Response{}	"Failed to verify payment"
 func (c *paymentHandler) StripePaymentVeify(ctx *gin.Context) {
 
 	shopOrderID, err1 := request.GetFormValuesAsUint(ctx, "shop_order_id")
@@ -72,26 +86,23 @@ func (c *paymentHandler) StripePaymentVeify(ctx *gin.Context) {
 
 	userID := utils.GetUserIdFromContext(ctx)
 
-	err = c.paymentUseCase.VerifyStripOrder(ctx, stripePaymentID)
+	err = c.paymentUseCase.VerifyStripOrder(ctx, userID, shopOrderID, stripePaymentID)
 	if err != nil {
-		statusCode := http.StatusInternalServerError
-		if errors.Is(err, usecase.ErrPaymentNotApproved) {
+		var statusCode int
+		switch {
+		case errors.Is(err, usecase.ErrInvalidShopOrderID), errors.Is(err, usecase.ErrPaymentNotForOrder):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, usecase.ErrPaymentNotApproved), errors.Is(err, usecase.ErrPaymentAmountMismatch),
+			errors.Is(err, usecase.ErrInsufficientWalletBalance):
 			statusCode = http.StatusPaymentRequired
+		case errors.Is(err, usecase.ErrOrderAlreadyCancelled):
+			statusCode = http.StatusConflict
+		default:
+			statusCode = http.StatusInternalServerError
 		}
 		response.ErrorResponse(ctx, statusCode, "Failed to verify stripe payment", err, nil)
 		return
 	}
 
-	approveReq := request.ApproveOrder{
-		ShopOrderID: shopOrderID,
-		PaymentType: domain.StripePayment,
-	}
-
-	err = c.paymentUseCase.ApproveShopOrderAndClearCart(ctx, userID, approveReq)
-	if err != nil {
-		response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to Approve order", err, nil)
-		return
-	}
-
 	response.SuccessResponse(ctx, http.StatusOK, "Successfully stripe payment verified", nil)
 }
@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+)
+
+// PaymentWallet godoc
+//
+//	@Summary		Place order with wallet (User)
+//	@Security		BearerAuth
+//	@Description	API for user to place order and pay full amount from wallet
+//	@Tags			User Payment
+//	@Id				PaymentWallet
+//	@Param			shop_order_id	formData	string	true	"Shop Order ID"
+//	@Router			/carts/place-order/wallet [post]
+//	@Success		200	{object}	response.Response{}	"Successfully order placed with wallet"
+//	@Failure		400	{object}	response.Response{}	"Failed to bind input"
+//	@Failure		400	{object}	response.Response{}	"Invalid shop order id"
+//	@Failure		402	{object}	response.Response{}	"Not enough balance on wallet"
+//	@Failure		403	{object}	response.Response{}	"Wallet payment is blocked or amount reached maximum"
+//	@Failure		409	{object}	response.Response{}	"Order is not waiting for payment"
+//	@Failure		500	{object}	response.Response{}	"Failed to place order with wallet"
+func (c *paymentHandler) PaymentWallet(ctx *gin.Context) {
+
+	shopOrderID, err := request.GetFormValuesAsUint(ctx, "shop_order_id")
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindFormValueMessage, err, nil)
+		return
+	}
+
+	userID := utils.GetUserIdFromContext(ctx)
+
+	err = c.paymentUseCase.MakeWalletPayment(ctx, userID, shopOrderID)
+	if err != nil {
+		var statusCode int
+		switch {
+		case errors.Is(err, usecase.ErrInvalidShopOrderID):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, usecase.ErrInsufficientWalletBalance):
+			statusCode = http.StatusPaymentRequired
+		case errors.Is(err, usecase.ErrOrderNotPaymentPending), errors.Is(err, usecase.ErrOrderAlreadyCancelled):
+			statusCode = http.StatusConflict
+		case errors.Is(err, usecase.ErrBlockedPayment), errors.Is(err, usecase.ErrPaymentAmountReachedMax):
+			statusCode = http.StatusForbidden
+		default:
+			statusCode = http.StatusInternalServerError
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to place order with wallet", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully order placed with wallet")
+}
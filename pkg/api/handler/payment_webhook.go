@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase"
+)
+
+// maximum allowed size of webhook body
+const maxWebhookBodyBytes = 65536
+
+// StripeWebhook godoc
+//	@Summary		Stripe webhook
+//	@Description	API for stripe to notify payment events (verified with Stripe-Signature header)
+//	@Id				StripeWebhook
+//	@Tags			Payment Webhook
+//	@Param			Stripe-Signature	header	string	true	"Stripe Signature"
+//	@Router			/webhooks/stripe [post]
+//	@Success		200	{object}	response.Response{}	"Successfully webhook processed"
+//	@Failure		400	{object}	response.Response{}	"Invalid webhook signature"
+//	@Failure		500	{object}	response.Response{}	"Failed to process webhook"
+func (c *paymentHandler) StripeWebhook(ctx *gin.Context) {
+
+	payload, err := io.ReadAll(http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxWebhookBodyBytes))
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, "Failed to read webhook body", err, nil)
+		return
+	}
+
+	signature := ctx.GetHeader("Stripe-Signature")
+
+	err = c.paymentUseCase.HandleStripeWebhook(ctx, payload, signature)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrInvalidWebhookSignature) {
+			statusCode = http.StatusBadRequest
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to process webhook", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully webhook processed", nil)
+}
+
+// RazorpayWebhook godoc
+//	@Summary		Razorpay webhook
+//	@Description	API for razorpay to notify payment events (verified with X-Razorpay-Signature header)
+//	@Id				RazorpayWebhook
+//	@Tags			Payment Webhook
+//	@Param			X-Razorpay-Signature	header	string	true	"Razorpay Signature"
+//	@Param			X-Razorpay-Event-Id		header	string	false	"Razorpay Event ID"
+//	@Router			/webhooks/razorpay [post]
+//	@Success		200	{object}	response.Response{}	"Successfully webhook processed"
+//	@Failure		400	{object}	response.Response{}	"Invalid webhook signature"
+//	@Failure		500	{object}	response.Response{}	"Failed to process webhook"
+func (c *paymentHandler) RazorpayWebhook(ctx *gin.Context) {
+
+	payload, err := io.ReadAll(http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxWebhookBodyBytes))
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, "Failed to read webhook body", err, nil)
+		return
+	}
+
+	signature := ctx.GetHeader("X-Razorpay-Signature")
+	eventID := ctx.GetHeader("X-Razorpay-Event-Id")
+
+	err = c.paymentUseCase.HandleRazorpayWebhook(ctx, payload, signature, eventID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrInvalidWebhookSignature) {
+			statusCode = http.StatusBadRequest
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to process webhook", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully webhook processed", nil)
+}
+
+// GetAllPaymentEvents godoc
+//	@Summary		Get all payment events (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to get all received payment gateway webhook events
+//	@Id				GetAllPaymentEvents
+//	@Tags			Admin Payment
+//	@Param			page_number	query	int	false	"Page Number"
+//	@Param			count		query	int	false	"Count"
+//	@Router			/admin/payment-events [get]
+//	@Success		200	{object}	response.Response{}	"Successfully found all payment events"
+//	@Success		204	{object}	response.Response{}	"No payment events found"
+//	@Failure		500	{object}	response.Response{}	"Failed to find all payment events"
+func (c *paymentHandler) GetAllPaymentEvents(ctx *gin.Context) {
+
+	pagination := request.GetPagination(ctx)
+
+	events, err := c.paymentUseCase.FindAllPaymentEvents(ctx, pagination)
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to find all payment events", err, nil)
+		return
+	}
+
+	if len(events) == 0 {
+		response.SuccessResponse(ctx, http.StatusNoContent, "No payment events found", nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully found all payment events", events)
+}
+
+// ReplayPaymentEvent godoc
+//	@Summary		Replay payment event (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to process a saved payment gateway webhook event again
+//	@Id				ReplayPaymentEvent
+//	@Tags			Admin Payment
+//	@Param			payment_event_id	path	int	true	"Payment Event ID"
+//	@Router			/admin/payment-events/{payment_event_id}/replay [post]
+//	@Success		200	{object}	response.Response{}	"Successfully payment event replayed"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		404	{object}	response.Response{}	"Payment event not exist"
+//	@Failure		500	{object}	response.Response{}	"Failed to replay payment event"
+func (c *paymentHandler) ReplayPaymentEvent(ctx *gin.Context) {
+
+	paymentEventID, err := request.GetParamAsUint(ctx, "payment_event_id")
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	err = c.paymentUseCase.ReplayPaymentEvent(ctx, paymentEventID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrPaymentEventNotExist) {
+			statusCode = http.StatusNotFound
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to replay payment event", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully payment event replayed", nil)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/jinzhu/copier"
@@ -13,8 +14,11 @@ import (
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase"
 	usecaseInterface "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
 )
 
+const maxProductImportFileBytes = 5 << 20
+
 type ProductHandler struct {
 	productUseCase usecaseInterface.ProductUseCase
 }
@@ -374,6 +378,52 @@ func (p *ProductHandler) getAllProducts() func(ctx *gin.Context) {
 
 }
 
+// SearchProducts godoc
+//
+//	@Summary		Search products (User)
+//	@Security		BearerAuth
+//	@Description	API for user to search products with keyword, filters and sort
+//	@ID				SearchProducts
+//	@Tags			User Products
+//	@Param			keyword					query	string	false	"Keyword to search on product name and description"
+//	@Param			category_id				query	int		false	"Category ID"
+//	@Param			brand_id				query	int		false	"Brand ID"
+//	@Param			min_price				query	int		false	"Minimum Price (discount price when offer applied)"
+//	@Param			max_price				query	int		false	"Maximum Price (discount price when offer applied)"
+//	@Param			in_stock_only			query	bool	false	"Only products which have stock"
+//	@Param			variation_option_ids	query	[]int	false	"Variation Option IDs"	collectionFormat(multi)
+//	@Param			sort_by					query	string	false	"Sort By"	Enums(price_asc, price_desc, newest, popularity, rating)
+//	@Param			page_number				query	int		false	"Page Number"
+//	@Param			count					query	int		false	"Count"
+//	@Router			/products/search [get]
+//	@Success		200	{object}	response.Response{}	"Successfully found products"
+//	@Failure		400	{object}	response.Response{}	"Failed to bind query"
+//	@Failure		500	{object}	response.Response{}	"Failed to search products"
+func (p *ProductHandler) SearchProducts(ctx *gin.Context) {
+
+	var search request.ProductSearch
+
+	if err := ctx.ShouldBindQuery(&search); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindQueryFailMessage, err, nil)
+		return
+	}
+
+	search.Pagination = request.GetPagination(ctx)
+
+	productSearch, err := p.productUseCase.SearchProducts(ctx, search)
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to search products", err, nil)
+		return
+	}
+
+	if len(productSearch.Products) == 0 {
+		response.SuccessResponse(ctx, http.StatusOK, "No products found", productSearch)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully found products", productSearch)
+}
+
 // UpdateProduct godoc
 //
 //	@Summary		Update a product (Admin)
@@ -427,6 +477,7 @@ func (c *ProductHandler) UpdateProduct(ctx *gin.Context) {
 //	@Param			price					formData	int		true	"Price"
 //	@Param			qty_in_stock			formData	int		true	"Quantity In Stock"
 //	@Param			variation_option_ids	formData	[]int	true	"Variation Option IDs"
+//	@Param			weight					formData	int		false	"Weight In Grams"
 //	@Param			images					formData	file	true	"Images"
 //	@Router			/admin/products/{product_id}/items [post]
 //	@Success		200	{object}	response.Response{}	"Successfully product item added"
@@ -446,6 +497,14 @@ func (p *ProductHandler) SaveProductItem(ctx *gin.Context) {
 
 	err = errors.Join(err1, err2, err3, err4)
 
+	// weight is optional (only needed for weight based delivery charge)
+	var weight uint
+	if ctx.PostForm("weight") != "" {
+		var err5 error
+		weight, err5 = request.GetFormValuesAsUint(ctx, "weight")
+		err = errors.Join(err, err5)
+	}
+
 	if err != nil {
 		response.ErrorResponse(ctx, http.StatusBadRequest, BindFormValueMessage, err, nil)
 		return
@@ -455,12 +514,15 @@ func (p *ProductHandler) SaveProductItem(ctx *gin.Context) {
 		Price:              price,
 		VariationOptionIDs: variationOptionIDS,
 		QtyInStock:         qtyInStock,
+		Weight:             weight,
 		ImageFileHeaders:   imageFileHeaders,
 	}
 
 	fmt.Println(productItem, productID)
 
-	err = p.productUseCase.SaveProductItem(ctx, productID, productItem)
+	adminID := utils.GetUserIdFromContext(ctx)
+
+	err = p.productUseCase.SaveProductItem(ctx, adminID, productID, productItem)
 
 	if err != nil {
 
@@ -482,6 +544,51 @@ func (p *ProductHandler) SaveProductItem(ctx *gin.Context) {
 	response.SuccessResponse(ctx, http.StatusCreated, "Successfully product item added", nil)
 }
 
+// UpdateProductItem godoc
+//
+//	@Summary		Update a product item (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to update the weight of a product item (used for weight based delivery charge)
+//	@ID				UpdateProductItem
+//	@Tags			Admin Products
+//	@Accept			json
+//	@Produce		json
+//	@Param			product_id		path	int							true	"Product ID"
+//	@Param			product_item_id	path	int							true	"Product Item ID"
+//	@Param			input			body	request.UpdateProductItem{}	true	"Product item update input"
+//	@Router			/admin/products/{product_id}/items/{product_item_id} [patch]
+//	@Success		200	{object}	response.Response{}	"Successfully product item updated"
+//	@Failure		400	{object}	response.Response{}	"invalid input"
+//	@Failure		404	{object}	response.Response{}	"Product item not exist"
+//	@Failure		500	{object}	response.Response{}	"Failed to update product item"
+func (p *ProductHandler) UpdateProductItem(ctx *gin.Context) {
+
+	productID, err1 := request.GetParamAsUint(ctx, "product_id")
+	productItemID, err2 := request.GetParamAsUint(ctx, "product_item_id")
+	if err := errors.Join(err1, err2); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	var body request.UpdateProductItem
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindJsonFailMessage, err, nil)
+		return
+	}
+
+	err := p.productUseCase.UpdateProductItem(ctx, productID, productItemID, body)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrProductItemNotExist) {
+			statusCode = http.StatusNotFound
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to update product item", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully product item updated", nil)
+}
+
 // GetAllProductItemsAdmin godoc
 //
 //	@Summary		Get all product items (Admin)
@@ -544,3 +651,92 @@ func (p *ProductHandler) getAllProductItems() func(ctx *gin.Context) {
 		response.SuccessResponse(ctx, http.StatusOK, "Successfully get all product items ", productItems)
 	}
 }
+
+// ImportProducts godoc
+//
+//	@Summary		Import products from csv (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to add products and product items from a csv file
+//	@Description	columns: product_name, description, category_name, brand_name, product_price, sku, price, qty_in_stock, weight, variations
+//	@Description	variations are given as "Color:Red|Size:M", sku is generated when it's empty and weight (in grams) is zero when it's empty
+//	@ID				ImportProducts
+//	@Tags			Admin Products
+//	@Accept			mpfd
+//	@Produce		json
+//	@Param			file	formData	file	true	"Products csv file"
+//	@Param			dry_run	query		bool	false	"Only validate the rows without saving"
+//	@Router			/admin/products/import [post]
+//	@Success		200	{object}	response.Response{data=response.ProductImport}	"Successfully validated products"
+//	@Success		201	{object}	response.Response{data=response.ProductImport}	"Successfully imported products"
+//	@Failure		400	{object}	response.Response{}								"Invalid csv file"
+//	@Failure		500	{object}	response.Response{}								"Failed to import products"
+func (p *ProductHandler) ImportProducts(ctx *gin.Context) {
+
+	dryRun, _ := strconv.ParseBool(ctx.Query("dry_run"))
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindFormValueMessage, err, nil)
+		return
+	}
+	if fileHeader.Size > maxProductImportFileBytes {
+		response.ErrorResponse(ctx, http.StatusBadRequest, "Failed to import products",
+			fmt.Errorf("file size should be less than %d bytes", maxProductImportFileBytes), nil)
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindFormValueMessage, err, nil)
+		return
+	}
+	defer file.Close()
+
+	adminID := utils.GetUserIdFromContext(ctx)
+
+	result, err := p.productUseCase.ImportProducts(ctx, adminID, file, dryRun)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrInvalidProductImportFile) {
+			statusCode = http.StatusBadRequest
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to import products", err, nil)
+		return
+	}
+
+	if dryRun {
+		response.SuccessResponse(ctx, http.StatusOK, "Successfully validated products", result)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusCreated, "Successfully imported products", result)
+}
+
+// ExportProducts godoc
+//
+//	@Summary		Export products as csv (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to download all product items as csv (same format of import)
+//	@ID				ExportProducts
+//	@Tags			Admin Products
+//	@Produce		text/csv
+//	@Router			/admin/products/export [get]
+//	@Success		200	{object}	response.Response{}	"ecommerce_products.csv"
+//	@Failure		500	{object}	response.Response{}	"Failed to export products"
+func (p *ProductHandler) ExportProducts(ctx *gin.Context) {
+
+	ctx.Header("Content-Type", "text/csv")
+	ctx.Header("Content-Disposition", "attachment;filename=ecommerce_products.csv")
+
+	err := p.productUseCase.ExportProducts(ctx, ctx.Writer)
+	if err != nil {
+		// headers are already sent when any rows are written
+		if !ctx.Writer.Written() {
+			ctx.Writer.Header().Del("Content-Type")
+			ctx.Writer.Header().Del("Content-Disposition")
+			response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to export products", err, nil)
+			return
+		}
+		ctx.Error(err)
+	}
+}
@@ -0,0 +1,486 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase"
+	usecaseInterface "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+)
+
+type questionHandler struct {
+	questionUseCase usecaseInterface.QuestionUseCase
+}
+
+func NewQuestionHandler(questionUseCase usecaseInterface.QuestionUseCase) interfaces.QuestionHandler {
+	return &questionHandler{
+		questionUseCase: questionUseCase,
+	}
+}
+
+// SaveProductQuestion godoc
+//
+//	@Summary		Ask question about a product (User)
+//	@Security		BearerAuth
+//	@Description	API for user to ask a question about a product (optionally notified by mail when answered)
+//	@Id				SaveProductQuestion
+//	@Tags			User Products
+//	@Param			product_id	path	int						true	"Product ID"
+//	@Param			input		body	request.ProductQuestion{}	true	"Question details"
+//	@Router			/products/{product_id}/questions [post]
+//	@Success		201	{object}	response.Response{}	"Successfully question added for product"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		500	{object}	response.Response{}	"Failed to add question for product"
+func (c *questionHandler) SaveProductQuestion(ctx *gin.Context) {
+
+	productID, err := request.GetParamAsUint(ctx, "product_id")
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	var body request.ProductQuestion
+
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindJsonFailMessage, err, body)
+		return
+	}
+
+	userID := utils.GetUserIdFromContext(ctx)
+
+	err = c.questionUseCase.SaveProductQuestion(ctx, userID, productID, body)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrInvalidProductID) {
+			statusCode = http.StatusBadRequest
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to add question for product", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusCreated, "Successfully question added for product")
+}
+
+// GetAllProductQuestionsUser godoc
+//
+//	@Summary		Get all questions of product (User)
+//	@Security		BearerAuth
+//	@Description	API for user to get all questions of a product
+//	@Id				GetAllProductQuestionsUser
+//	@Tags			User Products
+//	@Param			product_id	path	int	true	"Product ID"
+//	@Param			page_number	query	int	false	"Page Number"
+//	@Param			count		query	int	false	"Count"
+//	@Router			/products/{product_id}/questions [get]
+//	@Success		200	{object}	response.Response{data=[]response.ProductQuestion}	"Successfully found all questions of product"
+//	@Success		204	{object}	response.Response{}									"No questions found for product"
+//	@Failure		400	{object}	response.Response{}									"Invalid inputs"
+//	@Failure		500	{object}	response.Response{}									"Failed to find all questions of product"
+func (c *questionHandler) GetAllProductQuestionsUser(ctx *gin.Context) {
+
+	productID, err := request.GetParamAsUint(ctx, "product_id")
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	pagination := request.GetPagination(ctx)
+
+	questions, err := c.questionUseCase.FindAllProductQuestionsUser(ctx, productID, pagination)
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to find all questions of product", err, nil)
+		return
+	}
+
+	if len(questions) == 0 {
+		response.SuccessResponse(ctx, http.StatusNoContent, "No questions found for product", nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully found all questions of product", questions)
+}
+
+// GetAllProductAnswersUser godoc
+//
+//	@Summary		Get all answers of question (User)
+//	@Security		BearerAuth
+//	@Description	API for user to get all answers of a product question (most upvoted first)
+//	@Id				GetAllProductAnswersUser
+//	@Tags			User Products
+//	@Param			product_id	path	int	true	"Product ID"
+//	@Param			question_id	path	int	true	"Question ID"
+//	@Param			page_number	query	int	false	"Page Number"
+//	@Param			count		query	int	false	"Count"
+//	@Router			/products/{product_id}/questions/{question_id}/answers [get]
+//	@Success		200	{object}	response.Response{data=[]response.ProductAnswer}	"Successfully found all answers of question"
+//	@Success		204	{object}	response.Response{}									"No answers found for question"
+//	@Failure		400	{object}	response.Response{}									"Invalid inputs"
+//	@Failure		500	{object}	response.Response{}									"Failed to find all answers of question"
+func (c *questionHandler) GetAllProductAnswersUser(ctx *gin.Context) {
+
+	productID, err1 := request.GetParamAsUint(ctx, "product_id")
+	questionID, err2 := request.GetParamAsUint(ctx, "question_id")
+	if err := errors.Join(err1, err2); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	pagination := request.GetPagination(ctx)
+
+	answers, err := c.questionUseCase.FindAllProductAnswersUser(ctx, productID, questionID, pagination)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrInvalidQuestionID) {
+			statusCode = http.StatusBadRequest
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to find all answers of question", err, nil)
+		return
+	}
+
+	if len(answers) == 0 {
+		response.SuccessResponse(ctx, http.StatusNoContent, "No answers found for question", nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully found all answers of question", answers)
+}
+
+// SaveProductAnswerUser godoc
+//
+//	@Summary		Answer a product question (User)
+//	@Security		BearerAuth
+//	@Description	API for user to answer a question of a product they received
+//	@Id				SaveProductAnswerUser
+//	@Tags			User Products
+//	@Param			product_id	path	int						true	"Product ID"
+//	@Param			question_id	path	int						true	"Question ID"
+//	@Param			input		body	request.ProductAnswer{}	true	"Answer details"
+//	@Router			/products/{product_id}/questions/{question_id}/answers [post]
+//	@Success		201	{object}	response.Response{}	"Successfully answer added for question"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		403	{object}	response.Response{}	"User have no delivered order of the product"
+//	@Failure		500	{object}	response.Response{}	"Failed to add answer for question"
+func (c *questionHandler) SaveProductAnswerUser(ctx *gin.Context) {
+
+	productID, err1 := request.GetParamAsUint(ctx, "product_id")
+	questionID, err2 := request.GetParamAsUint(ctx, "question_id")
+	if err := errors.Join(err1, err2); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	var body request.ProductAnswer
+
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindJsonFailMessage, err, body)
+		return
+	}
+
+	userID := utils.GetUserIdFromContext(ctx)
+
+	err := c.questionUseCase.SaveProductAnswerUser(ctx, userID, productID, questionID, body)
+	if err != nil {
+		var statusCode int
+
+		switch {
+		case errors.Is(err, usecase.ErrInvalidQuestionID):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, usecase.ErrAnswerNotAllowed):
+			statusCode = http.StatusForbidden
+		default:
+			statusCode = http.StatusInternalServerError
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to add answer for question", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusCreated, "Successfully answer added for question")
+}
+
+// UpvoteProductAnswer godoc
+//
+//	@Summary		Upvote an answer (User)
+//	@Security		BearerAuth
+//	@Description	API for user to upvote an answer of a product question (upvoting again will not change anything)
+//	@Id				UpvoteProductAnswer
+//	@Tags			User Products
+//	@Param			product_id	path	int	true	"Product ID"
+//	@Param			question_id	path	int	true	"Question ID"
+//	@Param			answer_id	path	int	true	"Answer ID"
+//	@Router			/products/{product_id}/questions/{question_id}/answers/{answer_id}/upvote [post]
+//	@Success		200	{object}	response.Response{}	"Successfully answer upvoted"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		500	{object}	response.Response{}	"Failed to upvote answer"
+func (c *questionHandler) UpvoteProductAnswer(ctx *gin.Context) {
+
+	productID, err1 := request.GetParamAsUint(ctx, "product_id")
+	questionID, err2 := request.GetParamAsUint(ctx, "question_id")
+	answerID, err3 := request.GetParamAsUint(ctx, "answer_id")
+	if err := errors.Join(err1, err2, err3); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	userID := utils.GetUserIdFromContext(ctx)
+
+	err := c.questionUseCase.UpvoteProductAnswer(ctx, userID, productID, questionID, answerID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrInvalidQuestionID) || errors.Is(err, usecase.ErrInvalidAnswerID) {
+			statusCode = http.StatusBadRequest
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to upvote answer", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully answer upvoted")
+}
+
+// RemoveProductAnswerUpvote godoc
+//
+//	@Summary		Remove upvote of an answer (User)
+//	@Security		BearerAuth
+//	@Description	API for user to remove their upvote of an answer
+//	@Id				RemoveProductAnswerUpvote
+//	@Tags			User Products
+//	@Param			product_id	path	int	true	"Product ID"
+//	@Param			question_id	path	int	true	"Question ID"
+//	@Param			answer_id	path	int	true	"Answer ID"
+//	@Router			/products/{product_id}/questions/{question_id}/answers/{answer_id}/upvote [delete]
+//	@Success		200	{object}	response.Response{}	"Successfully upvote removed"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		500	{object}	response.Response{}	"Failed to remove upvote"
+func (c *questionHandler) RemoveProductAnswerUpvote(ctx *gin.Context) {
+
+	productID, err1 := request.GetParamAsUint(ctx, "product_id")
+	questionID, err2 := request.GetParamAsUint(ctx, "question_id")
+	answerID, err3 := request.GetParamAsUint(ctx, "answer_id")
+	if err := errors.Join(err1, err2, err3); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	userID := utils.GetUserIdFromContext(ctx)
+
+	err := c.questionUseCase.RemoveProductAnswerUpvote(ctx, userID, productID, questionID, answerID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrInvalidQuestionID) || errors.Is(err, usecase.ErrInvalidAnswerID) {
+			statusCode = http.StatusBadRequest
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to remove upvote", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully upvote removed")
+}
+
+// GetAllProductQuestionsAdmin godoc
+//
+//	@Summary		Get all product questions (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to get all product questions including hidden questions with filters
+//	@Id				GetAllProductQuestionsAdmin
+//	@Tags			Admin Products
+//	@Param			product_id	query	int		false	"Product ID"
+//	@Param			unanswered	query	bool	false	"Only questions without answer"
+//	@Param			page_number	query	int		false	"Page Number"
+//	@Param			count		query	int		false	"Count"
+//	@Router			/admin/products/questions [get]
+//	@Success		200	{object}	response.Response{data=[]response.ProductQuestion}	"Successfully found all product questions"
+//	@Success		204	{object}	response.Response{}									"No product questions found"
+//	@Failure		400	{object}	response.Response{}									"Invalid inputs"
+//	@Failure		500	{object}	response.Response{}									"Failed to find all product questions"
+func (c *questionHandler) GetAllProductQuestionsAdmin(ctx *gin.Context) {
+
+	var filter request.QuestionFilter
+
+	if err := ctx.ShouldBindQuery(&filter); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindQueryFailMessage, err, nil)
+		return
+	}
+
+	filter.Pagination = request.GetPagination(ctx)
+
+	questions, err := c.questionUseCase.FindAllProductQuestionsAdmin(ctx, filter)
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to find all product questions", err, nil)
+		return
+	}
+
+	if len(questions) == 0 {
+		response.SuccessResponse(ctx, http.StatusNoContent, "No product questions found", nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully found all product questions", questions)
+}
+
+// GetAllProductAnswersAdmin godoc
+//
+//	@Summary		Get all answers of question (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to get all answers of a question including hidden answers
+//	@Id				GetAllProductAnswersAdmin
+//	@Tags			Admin Products
+//	@Param			question_id	path	int	true	"Question ID"
+//	@Param			page_number	query	int	false	"Page Number"
+//	@Param			count		query	int	false	"Count"
+//	@Router			/admin/products/questions/{question_id}/answers [get]
+//	@Success		200	{object}	response.Response{data=[]response.ProductAnswer}	"Successfully found all answers of question"
+//	@Success		204	{object}	response.Response{}									"No answers found for question"
+//	@Failure		400	{object}	response.Response{}									"Invalid inputs"
+//	@Failure		500	{object}	response.Response{}									"Failed to find all answers of question"
+func (c *questionHandler) GetAllProductAnswersAdmin(ctx *gin.Context) {
+
+	questionID, err := request.GetParamAsUint(ctx, "question_id")
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	pagination := request.GetPagination(ctx)
+
+	answers, err := c.questionUseCase.FindAllProductAnswersAdmin(ctx, questionID, pagination)
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to find all answers of question", err, nil)
+		return
+	}
+
+	if len(answers) == 0 {
+		response.SuccessResponse(ctx, http.StatusNoContent, "No answers found for question", nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully found all answers of question", answers)
+}
+
+// SaveProductAnswerAdmin godoc
+//
+//	@Summary		Answer a product question (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to answer a product question
+//	@Id				SaveProductAnswerAdmin
+//	@Tags			Admin Products
+//	@Param			question_id	path	int						true	"Question ID"
+//	@Param			input		body	request.ProductAnswer{}	true	"Answer details"
+//	@Router			/admin/products/questions/{question_id}/answers [post]
+//	@Success		201	{object}	response.Response{}	"Successfully answer added for question"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		500	{object}	response.Response{}	"Failed to add answer for question"
+func (c *questionHandler) SaveProductAnswerAdmin(ctx *gin.Context) {
+
+	questionID, err := request.GetParamAsUint(ctx, "question_id")
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	var body request.ProductAnswer
+
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindJsonFailMessage, err, body)
+		return
+	}
+
+	adminID := utils.GetUserIdFromContext(ctx)
+
+	err = c.questionUseCase.SaveProductAnswerAdmin(ctx, adminID, questionID, body)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrInvalidQuestionID) {
+			statusCode = http.StatusBadRequest
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to add answer for question", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusCreated, "Successfully answer added for question")
+}
+
+// UpdateProductQuestionVisibility godoc
+//
+//	@Summary		Hide or show a product question (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to hide or show a product question to users
+//	@Id				UpdateProductQuestionVisibility
+//	@Tags			Admin Products
+//	@Param			question_id	path	int							true	"Question ID"
+//	@Param			input		body	request.UpdateVisibility{}	true	"Visibility details"
+//	@Router			/admin/products/questions/{question_id} [patch]
+//	@Success		200	{object}	response.Response{}	"Successfully updated visibility of question"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		500	{object}	response.Response{}	"Failed to update visibility of question"
+func (c *questionHandler) UpdateProductQuestionVisibility(ctx *gin.Context) {
+
+	questionID, err := request.GetParamAsUint(ctx, "question_id")
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	var body request.UpdateVisibility
+
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindJsonFailMessage, err, body)
+		return
+	}
+
+	err = c.questionUseCase.UpdateProductQuestionVisibility(ctx, questionID, body.IsHidden)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrInvalidQuestionID) {
+			statusCode = http.StatusBadRequest
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to update visibility of question", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully updated visibility of question")
+}
+
+// UpdateProductAnswerVisibility godoc
+//
+//	@Summary		Hide or show an answer (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to hide or show an answer of a product question to users
+//	@Id				UpdateProductAnswerVisibility
+//	@Tags			Admin Products
+//	@Param			answer_id	path	int							true	"Answer ID"
+//	@Param			input		body	request.UpdateVisibility{}	true	"Visibility details"
+//	@Router			/admin/products/questions/answers/{answer_id} [patch]
+//	@Success		200	{object}	response.Response{}	"Successfully updated visibility of answer"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		500	{object}	response.Response{}	"Failed to update visibility of answer"
+func (c *questionHandler) UpdateProductAnswerVisibility(ctx *gin.Context) {
+
+	answerID, err := request.GetParamAsUint(ctx, "answer_id")
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	var body request.UpdateVisibility
+
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindJsonFailMessage, err, body)
+		return
+	}
+
+	err = c.questionUseCase.UpdateProductAnswerVisibility(ctx, answerID, body.IsHidden)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrInvalidAnswerID) {
+			statusCode = http.StatusBadRequest
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to update visibility of answer", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully updated visibility of answer")
+}
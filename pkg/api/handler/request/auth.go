@@ -5,8 +5,25 @@ type Login struct {
 	Phone    string `json:"phone" binding:"omitempty,min=10,max=10"`
 	Email    string `json:"email" binding:"omitempty,email"`
 	Password string `json:"password" binding:"required,min=5,max=30"`
+	ClientIP string `json:"-"` // to track failed login attempts of the ip
 }
 
 type RefreshToken struct {
 	RefreshToken string `json:"refresh_token" binding:"min=10"`
 }
+
+// password
+type ResetPassword struct {
+	OtpID           string `json:"otp_id" binding:"required"`
+	Otp             string `json:"otp" binding:"required,min=4,max=8"`
+	Password        string `json:"password" binding:"required,min=5,max=30,eqfield=ConfirmPassword"`
+	ConfirmPassword string `json:"confirm_password" binding:"required"`
+	ClientIP        string `json:"-"` // to track failed otp attempts of the ip
+}
+
+type ChangePassword struct {
+	OldPassword     string `json:"old_password" binding:"required"`
+	NewPassword     string `json:"new_password" binding:"required,min=5,max=30,eqfield=ConfirmPassword,nefield=OldPassword"`
+	ConfirmPassword string `json:"confirm_password" binding:"required"`
+	ClientIP        string `json:"-"` // to track failed attempts of the old password
+}
@@ -5,14 +5,16 @@ import (
 )
 
 type OTPLogin struct {
-	Email    string `json:"email" binding:"omitempty,email"`
-	UserName string `json:"user_name" binding:"omitempty,min=3,max=16"`
-	Phone    string `json:"phone" binding:"omitempty,min=10,max=10"`
+	Email      string `json:"email" binding:"omitempty,email"`
+	UserName   string `json:"user_name" binding:"omitempty,min=3,max=16"`
+	Phone      string `json:"phone" binding:"omitempty,min=10,max=10"`
+	OtpChannel string `json:"otp_channel" binding:"omitempty,oneof=sms email"` // default sms
 }
 
 type OTPVerify struct {
-	Otp   string `json:"otp" binding:"required,min=4,max=8"`
-	OtpID string `json:"otp_id" `
+	Otp      string `json:"otp" binding:"required,min=4,max=8"`
+	OtpID    string `json:"otp_id" `
+	ClientIP string `json:"-"` // to track failed otp attempts of the ip
 }
 
 type BlockUser struct {
@@ -20,14 +22,67 @@ type BlockUser struct {
 	Block  bool `json:"block"`
 }
 
+// admin staff
+type StaffInvite struct {
+	UserName string `json:"user_name" binding:"required,min=3,max=15"`
+	Email    string `json:"email" binding:"required,email"`
+	RoleID   uint   `json:"role_id" binding:"required"`
+}
+
+type StaffSetPassword struct {
+	Token           string `json:"token" binding:"required"`
+	Password        string `json:"password" binding:"required,min=5,max=30,eqfield=ConfirmPassword"`
+	ConfirmPassword string `json:"confirm_password" binding:"required"`
+}
+
+type StaffUpdate struct {
+	RoleID      uint `json:"role_id" binding:"required"`
+	BlockStatus bool `json:"block_status"`
+}
+
+// admin audit log
+type AuditLogFilter struct {
+	AdminID    uint       `form:"admin_id"`
+	Method     string     `form:"method" binding:"omitempty,oneof=POST PUT PATCH DELETE"`
+	Route      string     `form:"route"` // part of route pattern
+	Status     string     `form:"status" binding:"omitempty,oneof=success failure"`
+	StartDate  time.Time  `form:"start_date"`
+	EndDate    time.Time  `form:"end_date"`
+	Pagination Pagination `form:"-"`
+}
+
+// audit log statuses
+const (
+	AuditLogStatusSuccess = "success"
+	AuditLogStatusFailure = "failure"
+)
+
 type SalesReport struct {
 	StartDate  time.Time  `json:"start_date"`
 	EndDate    time.Time  `json:"end_date"`
+	Format     string     `json:"format"` // csv or pdf
+	Bucket     string     `json:"bucket"` // day, week or month
 	Pagination Pagination `json:"pagination"`
 }
 
+// sales report formats and buckets
+const (
+	SalesReportCSV = "csv"
+	SalesReportPDF = "pdf"
+
+	SalesBucketDay   = "day"
+	SalesBucketWeek  = "week"
+	SalesBucketMonth = "month"
+)
+
 // stock
+// positive qty_to_add is saved as restock and negative as manual adjustment
 type UpdateStock struct {
-	SKU      string `json:"sku"`
-	QtyToAdd uint   `json:"qty_to_add"`
+	SKU      string `json:"sku" binding:"required"`
+	QtyToAdd int    `json:"qty_to_add"`
+	Note     string `json:"note" binding:"omitempty,max=150"`
+}
+
+type UpdateReorderThreshold struct {
+	ReorderThreshold *uint `json:"reorder_threshold" binding:"required"`
 }
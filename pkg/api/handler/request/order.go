@@ -5,8 +5,15 @@ import (
 )
 
 type UpdateOrder struct {
-	ShopOrderID   uint `json:"shop_order_id" binding:"required"`
-	OrderStatusID uint `json:"order_status_id"`
+	ShopOrderID   uint   `json:"shop_order_id" binding:"required"`
+	OrderStatusID uint   `json:"order_status_id"`
+	Comment       string `json:"comment" binding:"omitempty,max=150"`
+}
+
+// cancel order by admin
+type CancelOrder struct {
+	ShopOrderID uint   `json:"shop_order_id" binding:"required"`
+	Comment     string `json:"comment" binding:"omitempty,max=150"`
 }
 
 // return request
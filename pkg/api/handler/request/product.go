@@ -26,10 +26,30 @@ type ProductItem struct {
 	Price              uint                    `json:"price" binding:"required,min=1"`
 	VariationOptionIDs []uint                  `json:"variation_option_ids" binding:"required,gte=1"`
 	QtyInStock         uint                    `json:"qty_in_stock" binding:"required,min=1"`
+	Weight             uint                    `json:"weight"` // in grams
 	SKU                string                  `json:"-"`
 	ImageFileHeaders   []*multipart.FileHeader `json:"images" binding:"required,gte=1"`
 }
 
+type UpdateProductItem struct {
+	Weight *uint `json:"weight" binding:"required"` // in grams
+}
+
+// a row of product import csv (each row is a product item and the product is created on its first row)
+type ProductImportRow struct {
+	Row          int               `json:"row"` // line number on csv file
+	ProductName  string            `json:"product_name" binding:"required,min=3,max=50"`
+	Description  string            `json:"description" binding:"required,min=10,max=100"`
+	CategoryName string            `json:"category_name" binding:"required"`
+	BrandName    string            `json:"brand_name" binding:"required"`
+	ProductPrice uint              `json:"product_price" binding:"required,numeric"`
+	SKU          string            `json:"sku"` // generated when it's empty
+	Price        uint              `json:"price" binding:"required,min=1"`
+	QtyInStock   uint              `json:"qty_in_stock" binding:"required,min=1"`
+	Weight       uint              `json:"weight"`                              // in grams (zero when it's empty)
+	Variations   map[string]string `json:"variations" binding:"required,gte=1"` // variation name to its value
+}
+
 type Variation struct {
 	Names []string `json:"variation_names" binding:"required,dive,min=1"`
 }
@@ -50,3 +70,25 @@ type SubCategory struct {
 type Brand struct {
 	Name string `json:"category_name" binding:"required,min=3,max=25"`
 }
+
+// sort options for product search
+const (
+	SortByPriceAsc   = "price_asc"
+	SortByPriceDesc  = "price_desc"
+	SortByNewest     = "newest"
+	SortByPopularity = "popularity"
+	SortByRating     = "rating"
+)
+
+// for search products with filters
+type ProductSearch struct {
+	Keyword            string     `form:"keyword"`
+	CategoryID         uint       `form:"category_id"`
+	BrandID            uint       `form:"brand_id"`
+	MinPrice           uint       `form:"min_price"`
+	MaxPrice           uint       `form:"max_price" binding:"omitempty,gtefield=MinPrice"`
+	InStockOnly        bool       `form:"in_stock_only"`
+	VariationOptionIDs []uint     `form:"variation_option_ids"`
+	SortBy             string     `form:"sort_by" binding:"omitempty,oneof=price_asc price_desc newest popularity rating"`
+	Pagination         Pagination `form:"-"`
+}
@@ -0,0 +1,23 @@
+package request
+
+type ProductQuestion struct {
+	Question       string `json:"question" binding:"required,min=5,max=500"`
+	NotifyOnAnswer bool   `json:"notify_on_answer"`
+}
+
+type ProductAnswer struct {
+	Answer string `json:"answer" binding:"required,min=2,max=1000"`
+}
+
+// filter of product questions
+type QuestionFilter struct {
+	ProductID   uint       `form:"product_id"`
+	Unanswered  bool       `form:"unanswered"`
+	VisibleOnly bool       `form:"-"` // hidden questions are only for admin
+	Pagination  Pagination `form:"-"`
+}
+
+// to hide or show a question or answer
+type UpdateVisibility struct {
+	IsHidden bool `json:"is_hidden"`
+}
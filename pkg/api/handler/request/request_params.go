@@ -32,6 +32,17 @@ func GetFormValuesAsUint(ctx *gin.Context, name string) (uint, error) {
 	return uint(uintVal), nil
 }
 
+// Get value from request form as bool (false if not exist or invalid)
+func GetFormValueAsBool(ctx *gin.Context, name string) bool {
+
+	value, err := strconv.ParseBool(ctx.Request.PostFormValue(name))
+	if err != nil {
+		return false
+	}
+
+	return value
+}
+
 // Get query values as uint from request
 func GetQueryValueAsUint(ctx *gin.Context, key string) (uint, error) {
 
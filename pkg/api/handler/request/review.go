@@ -0,0 +1,18 @@
+package request
+
+import "mime/multipart"
+
+// review of a product (multipart form with optional images)
+type ProductReview struct {
+	Rating           uint                    `form:"rating" binding:"required,min=1,max=5"`
+	Title            string                  `form:"title" binding:"required,min=3,max=100"`
+	Body             string                  `form:"body" binding:"required,min=10,max=1000"`
+	ImageFileHeaders []*multipart.FileHeader `form:"images" binding:"omitempty,max=5"`
+}
+
+// filter of product reviews for admin moderation
+type ReviewFilter struct {
+	ProductID  uint       `form:"product_id"`
+	Status     string     `form:"status" binding:"omitempty,oneof=pending approved hidden"`
+	Pagination Pagination `form:"-"`
+}
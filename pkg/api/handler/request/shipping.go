@@ -0,0 +1,14 @@
+package request
+
+// shipping zone of a pincode range with its delivery charge rate
+// pincodes are validated as 6 digit indian pincodes (shipping is only supported in india now)
+type ShippingZone struct {
+	Name           string `json:"name" binding:"required,min=3,max=50"`
+	CountryID      uint   `json:"country_id" binding:"required"`
+	PincodeFrom    uint   `json:"pincode_from" binding:"required,min=100000,max=999999"`
+	PincodeTo      uint   `json:"pincode_to" binding:"required,min=100000,max=999999,gtefield=PincodeFrom"`
+	RateType       string `json:"rate_type" binding:"required,oneof=flat weight free_over"`
+	BaseCharge     uint   `json:"base_charge"`
+	ChargePerKg    uint   `json:"charge_per_kg" binding:"required_if=RateType weight"`
+	FreeOverAmount uint   `json:"free_over_amount" binding:"required_if=RateType free_over"`
+}
@@ -0,0 +1,11 @@
+package request
+
+type TaxClass struct {
+	Name string  `json:"name" binding:"required,min=2,max=30"`
+	Rate float64 `json:"rate" binding:"min=0,max=100"` // percentage of GST
+}
+
+// tax class of a category or product (zero to remove the tax class)
+type AssignTaxClass struct {
+	TaxClassID uint `json:"tax_class_id"`
+}
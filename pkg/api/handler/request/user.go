@@ -9,6 +9,7 @@ type UserSignUp struct {
 	Phone           string `json:"phone" binding:"required,min=10,max=10"`
 	Password        string `json:"password"  binding:"required,eqfield=ConfirmPassword"`
 	ConfirmPassword string `json:"confirm_password" binding:"required"`
+	OtpChannel      string `json:"otp_channel" binding:"omitempty,oneof=sms email"` // default sms
 }
 
 // for address add address
@@ -20,6 +21,7 @@ type Address struct {
 	LandMark    string `json:"land_mark" binding:"required"`
 	City        string `json:"city"`
 	Pincode     uint   `json:"pincode" binding:"required"`
+	State       string `json:"state" binding:"omitempty,max=50"`
 	// CountryID   uint   `json:"country_id" binding:"required"`
 
 	IsDefault *bool `json:"is_default"`
@@ -33,6 +35,7 @@ type EditAddress struct {
 	LandMark    string `json:"land_mark" binding:"required"`
 	City        string `json:"city"`
 	Pincode     uint   `json:"pincode" binding:"required"`
+	State       string `json:"state" binding:"omitempty,max=50"`
 	// CountryID   uint   `json:"country_id" binding:"required"`
 
 	IsDefault *bool `json:"is_default"`
@@ -57,6 +60,4 @@ type EditUser struct {
 	Age             uint   `json:"age"  binding:"required,numeric"`
 	Email           string `json:"email" binding:"required,email"`
 	Phone           string `json:"phone" binding:"required,min=10,max=10"`
-	Password        string `json:"password"  binding:"omitempty,eqfield=ConfirmPassword"`
-	ConfirmPassword string `json:"confirm_password" binding:"omitempty"`
 }
@@ -11,6 +11,40 @@ type AdminLogin struct {
 	Email    string `json:"email"`
 }
 
+// admin staff
+type Staff struct {
+	ID          uint      `json:"id"`
+	UserName    string    `json:"user_name"`
+	Email       string    `json:"email"`
+	RoleID      uint      `json:"role_id"`
+	RoleName    string    `json:"role_name"`
+	BlockStatus bool      `json:"block_status"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type Role struct {
+	ID              uint     `json:"id"`
+	Name            string   `json:"name"`
+	PermissionNames string   `json:"-"`
+	Permissions     []string `json:"permissions" gorm:"-"`
+}
+
+// admin audit log
+type AuditLog struct {
+	ID         uint      `json:"id"`
+	AdminID    uint      `json:"admin_id"`
+	UserName   string    `json:"user_name"`
+	Method     string    `json:"method"`
+	Route      string    `json:"route"`
+	Path       string    `json:"path"`
+	Payload    string    `json:"payload"`
+	ClientIP   string    `json:"client_ip"`
+	StatusCode int       `json:"status_code"`
+	Success    bool      `json:"success"`
+	Message    string    `json:"message"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
 // reponse for get all variations with its respective category
 
 type SalesReport struct {
@@ -23,6 +57,88 @@ type SalesReport struct {
 	Discount        uint      `json:"discount_price"`
 	OrderStatus     string    `json:"order_status"`
 	PaymentType     string    `json:"payment_type"`
+	CGST            uint      `json:"cgst"`
+	SGST            uint      `json:"sgst"`
+	IGST            uint      `json:"igst"`
+}
+
+// aggregates of sales report (amounts are only of paid orders)
+type SalesSummary struct {
+	StartDate             time.Time        `json:"start_date"`
+	EndDate               time.Time        `json:"end_date"`
+	Bucket                string           `json:"bucket"`
+	TotalOrders           uint             `json:"total_orders"`
+	GrossRevenue          uint             `json:"gross_revenue"` // order total before discount
+	Discounts             uint             `json:"discounts"`
+	Refunds               uint             `json:"refunds"`
+	NetRevenue            int              `json:"net_revenue"`
+	CGST                  uint             `json:"cgst"` // GST included on the revenue
+	SGST                  uint             `json:"sgst"`
+	IGST                  uint             `json:"igst"`
+	OrdersByStatus        []SalesCount     `json:"orders_by_status"`
+	OrdersByPaymentMethod []SalesCount     `json:"orders_by_payment_method"`
+	TopProducts           []TopSellingItem `json:"top_products"`
+	TopCategories         []TopSellingItem `json:"top_categories"`
+	Buckets               []SalesBucket    `json:"buckets"`
+}
+
+type SalesCount struct {
+	Name   string `json:"name"`
+	Count  uint   `json:"count"`
+	Amount uint   `json:"amount"`
+}
+
+type TopSellingItem struct {
+	ID       uint   `json:"id"`
+	Name     string `json:"name"`
+	Quantity uint   `json:"quantity"`
+	Revenue  uint   `json:"revenue"`
+}
+
+// sales of a day, week or month (period is the start of bucket)
+type SalesBucket struct {
+	Period       time.Time `json:"period"`
+	Orders       uint      `json:"orders"`
+	GrossRevenue uint      `json:"gross_revenue"`
+	Discounts    uint      `json:"discounts"`
+	Refunds      uint      `json:"refunds"`
+	NetRevenue   int       `json:"net_revenue" gorm:"-"`
+	CGST         uint      `json:"cgst"`
+	SGST         uint      `json:"sgst"`
+	IGST         uint      `json:"igst"`
+}
+
+// kpi tiles and time series for admin dashboard
+type Dashboard struct {
+	StartDate             time.Time        `json:"start_date"`
+	EndDate               time.Time        `json:"end_date"`
+	Bucket                string           `json:"bucket"`
+	Revenue               uint             `json:"revenue"`
+	OrderCount            uint             `json:"order_count"`
+	AverageOrderValue     float64          `json:"average_order_value"`
+	NewUsers              uint             `json:"new_users"`
+	PendingReturns        uint             `json:"pending_returns"`
+	LowStockCount         uint             `json:"low_stock_count"`
+	PaymentConversionRate float64          `json:"payment_conversion_rate"` // percentage of orders moved from payment pending to order placed
+	TimeSeries            []DashboardPoint `json:"time_series"`
+}
+
+// counts of the dashboard found from database
+type DashboardStats struct {
+	Revenue        uint
+	OrderCount     uint
+	NewUsers       uint
+	PendingReturns uint
+	LowStockCount  uint
+	CreatedOrders  uint // all orders created on the period
+	PlacedOrders   uint // orders of the period which reached order placed
+}
+
+type DashboardPoint struct {
+	Period     time.Time `json:"period"`
+	Revenue    uint      `json:"revenue"`
+	OrderCount uint      `json:"order_count"`
+	NewUsers   uint      `json:"new_users"`
 }
 
 type Stock struct {
@@ -31,5 +147,6 @@ type Stock struct {
 	Price            uint              `json:"price"`
 	SKU              string            `json:"sku"`
 	QtyInStock       uint              `json:"qty_in_stock"`
+	ReorderThreshold uint              `json:"reorder_threshold"`
 	VariationOptions []VariationOption `gorm:"-"`
 }
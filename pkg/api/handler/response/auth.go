@@ -1,5 +1,7 @@
 package response
 
+import "time"
+
 type TokenResponse struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
@@ -7,3 +9,13 @@ type TokenResponse struct {
 type OTPResponse struct {
 	OtpID string `json:"otp_id"`
 }
+
+// a logged in device of the user (refresh session)
+type Session struct {
+	SessionID string    `json:"session_id"`
+	UserAgent string    `json:"user_agent"`
+	ClientIP  string    `json:"client_ip"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpireAt  time.Time `json:"expire_at"`
+	Current   bool      `json:"current"`
+}
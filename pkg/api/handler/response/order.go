@@ -33,6 +33,13 @@ type OrderItem struct {
 	SubTotal      uint   `json:"sub_total"`
 	OrderDate     string `json:"order_date" `
 	Status        string `json:"status"`
+
+	// GST included on the sub total
+	TaxRate       float64 `json:"tax_rate"`
+	TaxableAmount uint    `json:"taxable_amount"`
+	CGST          uint    `json:"cgst"`
+	SGST          uint    `json:"sgst"`
+	IGST          uint    `json:"igst"`
 }
 
 type ShopOrder struct {
@@ -43,12 +50,25 @@ type ShopOrder struct {
 	Address           Address   `json:"address"`
 	OrderTotalPrice   uint      `json:"order_total_price" `
 	Discount          uint      `json:"discount"`
+	DeliveryCharge    uint      `json:"delivery_charge"`
+	TotalTax          uint      `json:"total_tax"` // GST included on the order total
+	WalletAmount      uint      `json:"wallet_amount"`
 	OrderStatusID     uint      `json:"order_status_id"`
 	OrderStatus       string    `json:"order_status"`
 	PaymentMethodID   uint      `json:"payment_method_id" gorm:"primaryKey;not null"`
 	PaymentMethodName string    `json:"payment_method_name" gorm:"unique;not null"`
 }
 
+// order timeline
+type OrderStatusHistory struct {
+	OrderStatusID uint      `json:"order_status_id"`
+	OrderStatus   string    `json:"order_status"`
+	ActorID       uint      `json:"actor_id"`
+	ActorType     string    `json:"actor_type"`
+	Comment       string    `json:"comment"`
+	ChangedAt     time.Time `json:"changed_at"`
+}
+
 // checkout
 type CheckOut struct {
 	Addresses    []Address  `json:"addresses"`
@@ -72,11 +92,56 @@ type OrderReturn struct {
 	AdminComment  string    `json:"admin_comment"`
 }
 
+// price details of order shown on payment select page
+type PaymentOrderSummary struct {
+	ShopOrderID    uint `json:"shop_order_id"`
+	SubTotal       uint `json:"sub_total"`
+	Discount       uint `json:"discount"`
+	DeliveryCharge uint `json:"delivery_charge"`
+	OrderTotal     uint `json:"order_total"`
+}
+
+// details of shop order printed on its invoice
+type InvoiceOrder struct {
+	ShopOrderID       uint      `json:"shop_order_id"`
+	UserID            uint      `json:"user_id"`
+	OrderDate         time.Time `json:"order_date"`
+	FirstName         string    `json:"first_name"`
+	LastName          string    `json:"last_name"`
+	Email             string    `json:"email"`
+	OrderTotalPrice   uint      `json:"order_total_price"`
+	Discount          uint      `json:"discount"`
+	DeliveryCharge    uint      `json:"delivery_charge"`
+	WalletAmount      uint      `json:"wallet_amount"`
+	PaymentMethodName string    `json:"payment_method_name"`
+	CouponCode        string    `json:"coupon_code"`
+
+	// delivery address
+	AddressName string `json:"address_name"`
+	PhoneNumber string `json:"phone_number"`
+	House       string `json:"house"`
+	Area        string `json:"area"`
+	LandMark    string `json:"land_mark"`
+	City        string `json:"city"`
+	Pincode     uint   `json:"pincode"`
+	State       string `json:"state"`
+	CountryName string `json:"country_name"`
+}
+
+// invoice pdf of order (file url is set when the pdf is already stored on cloud)
+type Invoice struct {
+	InvoiceNumber string `json:"invoice_number"`
+	FileName      string `json:"file_name"`
+	FileUrl       string `json:"file_url"`
+	File          []byte `json:"-"`
+}
+
 // razorpay
 type RazorpayOrder struct {
 	RazorpayKey     string      `json:"razorpay_key"`
 	UserID          uint        `json:"user_id"`
 	AmountToPay     uint        `json:"amount_to_pay"`
+	WalletAmount    uint        `json:"wallet_amount"`
 	RazorpayAmount  uint        `json:"razorpay_amount"`
 	RazorpayOrderID interface{} `json:"razorpay_order_id"`
 	Email           string      `json:"email"`
@@ -89,5 +154,6 @@ type StripeOrder struct {
 	ClientSecret   string `json:"client_secret"`
 	PublishableKey string `json:"publishable_key"`
 	AmountToPay    uint   `json:"amount_to_pay"`
+	WalletAmount   uint   `json:"wallet_amount"`
 	ShopOrderID    uint   `json:"shop_order_id"`
 }
@@ -17,10 +17,38 @@ type Product struct {
 	BrandID          uint      `json:"brand_id"`
 	BrandName        string    `json:"brand_name"`
 	Image            string    `json:"image"`
+	AverageRating    float64   `json:"average_rating"` // of approved reviews
+	ReviewCount      uint      `json:"review_count"`
 	CreatedAt        time.Time `json:"created_at"`
 	UpdatedAt        time.Time `json:"updated_at"`
 }
 
+// response for product search with facet counts
+type ProductSearch struct {
+	Products []Product    `json:"products"`
+	Facets   ProductFacet `json:"facets"`
+}
+
+type ProductFacet struct {
+	Brands          []FacetCount          `json:"brands"`
+	Categories      []FacetCount          `json:"categories"`
+	VariationValues []VariationFacetCount `json:"variation_values"`
+}
+
+type FacetCount struct {
+	ID    uint   `json:"id"`
+	Name  string `json:"name"`
+	Count uint   `json:"count"`
+}
+
+type VariationFacetCount struct {
+	VariationID       uint   `json:"variation_id"`
+	VariationName     string `json:"variation_name"`
+	VariationOptionID uint   `json:"variation_option_id"`
+	Value             string `json:"variation_value"`
+	Count             uint   `json:"count"`
+}
+
 // for a specific category representation
 type Category struct {
 	ID          uint          `json:"category_id"`
@@ -63,6 +91,36 @@ type ProductItems struct {
 	Images           []string                `json:"images" gorm:"-"`
 }
 
+// result of product import (on dry run nothing is saved)
+type ProductImport struct {
+	DryRun          bool                    `json:"dry_run"`
+	TotalRows       int                     `json:"total_rows"`
+	ValidRows       int                     `json:"valid_rows"`
+	InvalidRows     int                     `json:"invalid_rows"`
+	ProductsCreated int                     `json:"products_created"`
+	ItemsCreated    int                     `json:"items_created"`
+	Errors          []ProductImportRowError `json:"errors"`
+}
+
+type ProductImportRowError struct {
+	Row    int      `json:"row"`
+	Errors []string `json:"errors"`
+}
+
+// a product item row of product export csv
+type ProductItemExport struct {
+	ProductName  string
+	Description  string
+	CategoryName string
+	BrandName    string
+	ProductPrice uint
+	SKU          string
+	Price        uint
+	QtyInStock   uint
+	Weight       uint
+	Variations   string // variation name and values as "Color:Red|Size:M"
+}
+
 type ProductVariationValue struct {
 	VariationID       uint   `json:"variation_id"`
 	Name              string `json:"variation_name"`
@@ -0,0 +1,26 @@
+package response
+
+import "time"
+
+type ProductQuestion struct {
+	ID          uint      `json:"id"`
+	ProductID   uint      `json:"product_id"`
+	UserID      uint      `json:"user_id"`
+	FirstName   string    `json:"first_name"`
+	Question    string    `json:"question"`
+	AnswerCount uint      `json:"answer_count"`
+	IsHidden    bool      `json:"is_hidden"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type ProductAnswer struct {
+	ID                uint      `json:"id"`
+	ProductQuestionID uint      `json:"product_question_id"`
+	ActorID           uint      `json:"actor_id"`
+	ActorType         string    `json:"actor_type"` // admin or user (verified buyer)
+	AnswererName      string    `json:"answerer_name"`
+	Answer            string    `json:"answer"`
+	UpvoteCount       uint      `json:"upvote_count"`
+	IsHidden          bool      `json:"is_hidden"`
+	CreatedAt         time.Time `json:"created_at"`
+}
@@ -0,0 +1,18 @@
+package response
+
+import "time"
+
+type ProductReview struct {
+	ID          uint      `json:"id"`
+	ProductID   uint      `json:"product_id"`
+	ProductName string    `json:"product_name"`
+	UserID      uint      `json:"user_id"`
+	FirstName   string    `json:"first_name"`
+	Rating      uint      `json:"rating"`
+	Title       string    `json:"title"`
+	Body        string    `json:"body"`
+	Status      string    `json:"status"`
+	Images      []string  `json:"images" gorm:"-"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
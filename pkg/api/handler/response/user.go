@@ -45,6 +45,7 @@ type Address struct {
 	LandMark    string `json:"land_mark"`
 	City        string `json:"city"`
 	Pincode     uint   `json:"pincode"`
+	State       string `json:"state"`
 	CountryID   uint   `json:"country_id"`
 	CountryName string `json:"country_name"`
 
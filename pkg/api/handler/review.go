@@ -0,0 +1,225 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase"
+	usecaseInterface "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+)
+
+type reviewHandler struct {
+	reviewUseCase usecaseInterface.ReviewUseCase
+}
+
+func NewReviewHandler(reviewUseCase usecaseInterface.ReviewUseCase) interfaces.ReviewHandler {
+	return &reviewHandler{
+		reviewUseCase: reviewUseCase,
+	}
+}
+
+// SaveProductReview godoc
+//
+//	@Summary		Add review for a product (User)
+//	@Security		BearerAuth
+//	@Description	API for user to review a product they received (review is shown after admin approve it)
+//	@Id				SaveProductReview
+//	@Tags			User Products
+//	@Accept			multipart/form-data
+//	@Param			product_id	path		int		true	"Product ID"
+//	@Param			rating		formData	int		true	"Rating (1 to 5)"
+//	@Param			title		formData	string	true	"Review Title"
+//	@Param			body		formData	string	true	"Review Body"
+//	@Param			images		formData	file	false	"Images (maximum 5)"
+//	@Router			/products/{product_id}/reviews [post]
+//	@Success		201	{object}	response.Response{}	"Successfully review added for product"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		403	{object}	response.Response{}	"User have no delivered order of the product"
+//	@Failure		409	{object}	response.Response{}	"User already reviewed the product"
+//	@Failure		500	{object}	response.Response{}	"Failed to add review for product"
+func (c *reviewHandler) SaveProductReview(ctx *gin.Context) {
+
+	productID, err := request.GetParamAsUint(ctx, "product_id")
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	var body request.ProductReview
+
+	if err := ctx.ShouldBind(&body); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindFormValueMessage, err, nil)
+		return
+	}
+
+	userID := utils.GetUserIdFromContext(ctx)
+
+	err = c.reviewUseCase.SaveProductReview(ctx, userID, productID, body)
+	if err != nil {
+		var statusCode int
+
+		switch {
+		case errors.Is(err, usecase.ErrReviewNotAllowed):
+			statusCode = http.StatusForbidden
+		case errors.Is(err, usecase.ErrReviewAlreadyExist):
+			statusCode = http.StatusConflict
+		default:
+			statusCode = http.StatusInternalServerError
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to add review for product", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusCreated, "Successfully review added for product")
+}
+
+// GetAllProductReviewsUser godoc
+//
+//	@Summary		Get all reviews of product (User)
+//	@Security		BearerAuth
+//	@Description	API for user to get all approved reviews of a product
+//	@Id				GetAllProductReviewsUser
+//	@Tags			User Products
+//	@Param			product_id	path	int	true	"Product ID"
+//	@Param			page_number	query	int	false	"Page Number"
+//	@Param			count		query	int	false	"Count"
+//	@Router			/products/{product_id}/reviews [get]
+//	@Success		200	{object}	response.Response{data=[]response.ProductReview}	"Successfully found all reviews of product"
+//	@Success		204	{object}	response.Response{}									"No reviews found for product"
+//	@Failure		400	{object}	response.Response{}									"Invalid inputs"
+//	@Failure		500	{object}	response.Response{}									"Failed to find all reviews of product"
+func (c *reviewHandler) GetAllProductReviewsUser(ctx *gin.Context) {
+
+	productID, err := request.GetParamAsUint(ctx, "product_id")
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	pagination := request.GetPagination(ctx)
+
+	reviews, err := c.reviewUseCase.FindAllApprovedProductReviews(ctx, productID, pagination)
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to find all reviews of product", err, nil)
+		return
+	}
+
+	if len(reviews) == 0 {
+		response.SuccessResponse(ctx, http.StatusNoContent, "No reviews found for product", nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully found all reviews of product", reviews)
+}
+
+// GetAllProductReviewsAdmin godoc
+//
+//	@Summary		Get all product reviews (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to get all product reviews for moderation with filters
+//	@Id				GetAllProductReviewsAdmin
+//	@Tags			Admin Products
+//	@Param			product_id	query	int		false	"Product ID"
+//	@Param			status		query	string	false	"Review Status"	Enums(pending, approved, hidden)
+//	@Param			page_number	query	int		false	"Page Number"
+//	@Param			count		query	int		false	"Count"
+//	@Router			/admin/products/reviews [get]
+//	@Success		200	{object}	response.Response{data=[]response.ProductReview}	"Successfully found all product reviews"
+//	@Success		204	{object}	response.Response{}									"No product reviews found"
+//	@Failure		400	{object}	response.Response{}									"Invalid inputs"
+//	@Failure		500	{object}	response.Response{}									"Failed to find all product reviews"
+func (c *reviewHandler) GetAllProductReviewsAdmin(ctx *gin.Context) {
+
+	var filter request.ReviewFilter
+
+	if err := ctx.ShouldBindQuery(&filter); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindQueryFailMessage, err, nil)
+		return
+	}
+
+	filter.Pagination = request.GetPagination(ctx)
+
+	reviews, err := c.reviewUseCase.FindAllProductReviews(ctx, filter)
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to find all product reviews", err, nil)
+		return
+	}
+
+	if len(reviews) == 0 {
+		response.SuccessResponse(ctx, http.StatusNoContent, "No product reviews found", nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully found all product reviews", reviews)
+}
+
+// ApproveProductReview godoc
+//
+//	@Summary		Approve a product review (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to approve a review, so it's shown on the product and counted on the rating
+//	@Id				ApproveProductReview
+//	@Tags			Admin Products
+//	@Param			review_id	path	int	true	"Review ID"
+//	@Router			/admin/products/reviews/{review_id}/approve [patch]
+//	@Success		200	{object}	response.Response{}	"Successfully review approved"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		500	{object}	response.Response{}	"Failed to approve review"
+func (c *reviewHandler) ApproveProductReview(ctx *gin.Context) {
+
+	reviewID, err := request.GetParamAsUint(ctx, "review_id")
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	err = c.reviewUseCase.ApproveProductReview(ctx, reviewID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrInvalidReviewID) {
+			statusCode = http.StatusBadRequest
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to approve review", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully review approved")
+}
+
+// HideProductReview godoc
+//
+//	@Summary		Hide a product review (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to hide a review from the product and its rating
+//	@Id				HideProductReview
+//	@Tags			Admin Products
+//	@Param			review_id	path	int	true	"Review ID"
+//	@Router			/admin/products/reviews/{review_id}/hide [patch]
+//	@Success		200	{object}	response.Response{}	"Successfully review hidden"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		500	{object}	response.Response{}	"Failed to hide review"
+func (c *reviewHandler) HideProductReview(ctx *gin.Context) {
+
+	reviewID, err := request.GetParamAsUint(ctx, "review_id")
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	err = c.reviewUseCase.HideProductReview(ctx, reviewID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrInvalidReviewID) {
+			statusCode = http.StatusBadRequest
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to hide review", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully review hidden")
+}
@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase"
+	usecaseInterface "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
+)
+
+type shippingHandler struct {
+	shippingUseCase usecaseInterface.ShippingUseCase
+}
+
+func NewShippingHandler(shippingUseCase usecaseInterface.ShippingUseCase) interfaces.ShippingHandler {
+	return &shippingHandler{
+		shippingUseCase: shippingUseCase,
+	}
+}
+
+// SaveShippingZone godoc
+//
+//	@Summary		Add shipping zone (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to add a shipping zone of a pincode range with flat, weight or free over rate
+//	@Description	pincodes should be 6 digit indian pincodes and delivery is free for all addresses until a zone is added
+//	@Id				SaveShippingZone
+//	@Tags			Admin Shipping
+//	@Param			input	body	request.ShippingZone{}	true	"Shipping zone details"
+//	@Router			/admin/shipping-zones [post]
+//	@Success		201	{object}	response.Response{}	"Successfully shipping zone added"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		409	{object}	response.Response{}	"Shipping zone already exist with this name"
+//	@Failure		500	{object}	response.Response{}	"Failed to add shipping zone"
+func (c *shippingHandler) SaveShippingZone(ctx *gin.Context) {
+
+	var body request.ShippingZone
+
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindJsonFailMessage, err, body)
+		return
+	}
+
+	zoneID, err := c.shippingUseCase.SaveShippingZone(ctx, body)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrShippingZoneAlreadyExist) {
+			statusCode = http.StatusConflict
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to add shipping zone", err, nil)
+		return
+	}
+
+	data := gin.H{
+		"shipping_zone_id": zoneID,
+	}
+	response.SuccessResponse(ctx, http.StatusCreated, "Successfully shipping zone added", data)
+}
+
+// GetAllShippingZones godoc
+//
+//	@Summary		Get all shipping zones (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to get all shipping zones
+//	@Id				GetAllShippingZones
+//	@Tags			Admin Shipping
+//	@Param			page_number	query	int	false	"Page Number"
+//	@Param			count		query	int	false	"Count"
+//	@Router			/admin/shipping-zones [get]
+//	@Success		200	{object}	response.Response{data=[]domain.ShippingZone}	"Successfully found all shipping zones"
+//	@Success		204	{object}	response.Response{}								"No shipping zones found"
+//	@Failure		500	{object}	response.Response{}								"Failed to find all shipping zones"
+func (c *shippingHandler) GetAllShippingZones(ctx *gin.Context) {
+
+	pagination := request.GetPagination(ctx)
+
+	zones, err := c.shippingUseCase.FindAllShippingZones(ctx, pagination)
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to find all shipping zones", err, nil)
+		return
+	}
+
+	if len(zones) == 0 {
+		response.SuccessResponse(ctx, http.StatusNoContent, "No shipping zones found", nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully found all shipping zones", zones)
+}
+
+// UpdateShippingZone godoc
+//
+//	@Summary		Update shipping zone (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to update pincode range (6 digit indian pincodes) or rate of a shipping zone
+//	@Id				UpdateShippingZone
+//	@Tags			Admin Shipping
+//	@Param			shipping_zone_id	path	int						true	"Shipping Zone ID"
+//	@Param			input				body	request.ShippingZone{}	true	"Shipping zone details"
+//	@Router			/admin/shipping-zones/{shipping_zone_id} [put]
+//	@Success		200	{object}	response.Response{}	"Successfully shipping zone updated"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		409	{object}	response.Response{}	"Shipping zone already exist with this name"
+//	@Failure		500	{object}	response.Response{}	"Failed to update shipping zone"
+func (c *shippingHandler) UpdateShippingZone(ctx *gin.Context) {
+
+	zoneID, err := request.GetParamAsUint(ctx, "shipping_zone_id")
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	var body request.ShippingZone
+
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindJsonFailMessage, err, body)
+		return
+	}
+
+	err = c.shippingUseCase.UpdateShippingZone(ctx, zoneID, body)
+	if err != nil {
+		var statusCode int
+
+		switch {
+		case errors.Is(err, usecase.ErrInvalidShippingZoneID):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, usecase.ErrShippingZoneAlreadyExist):
+			statusCode = http.StatusConflict
+		default:
+			statusCode = http.StatusInternalServerError
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to update shipping zone", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully shipping zone updated")
+}
+
+// DeleteShippingZone godoc
+//
+//	@Summary		Delete shipping zone (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to delete a shipping zone (pincodes of the zone will not be serviceable, delivery is free for all addresses when no zone is left)
+//	@Id				DeleteShippingZone
+//	@Tags			Admin Shipping
+//	@Param			shipping_zone_id	path	int	true	"Shipping Zone ID"
+//	@Router			/admin/shipping-zones/{shipping_zone_id} [delete]
+//	@Success		200	{object}	response.Response{}	"Successfully shipping zone deleted"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		500	{object}	response.Response{}	"Failed to delete shipping zone"
+func (c *shippingHandler) DeleteShippingZone(ctx *gin.Context) {
+
+	zoneID, err := request.GetParamAsUint(ctx, "shipping_zone_id")
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	err = c.shippingUseCase.DeleteShippingZone(ctx, zoneID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrInvalidShippingZoneID) {
+			statusCode = http.StatusBadRequest
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to delete shipping zone", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully shipping zone deleted")
+}
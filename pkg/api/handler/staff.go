@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+)
+
+// GetAllRoles godoc
+//
+//	@Summary		Get all roles (Super Admin)
+//	@Security		BearerAuth
+//	@Description	API for super admin to get all staff roles with its permissions
+//	@Id				GetAllRoles
+//	@Tags			Admin Staff
+//	@Router			/admin/roles [get]
+//	@Success		200	{object}	response.Response{data=[]response.Role}	"Successfully found all roles"
+//	@Failure		403	{object}	response.Response{}						"Permission denied"
+//	@Failure		500	{object}	response.Response{}						"Failed to find all roles"
+func (a *adminHandler) GetAllRoles(ctx *gin.Context) {
+
+	roles, err := a.adminUseCase.FindAllRoles(ctx)
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to find all roles", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully found all roles", roles)
+}
+
+// GetAllStaff godoc
+//
+//	@Summary		Get all staff (Super Admin)
+//	@Security		BearerAuth
+//	@Description	API for super admin to get all staff accounts with its role
+//	@Id				GetAllStaff
+//	@Tags			Admin Staff
+//	@Param			page_number	query	int	false	"Page Number"
+//	@Param			count		query	int	false	"Count"
+//	@Router			/admin/staff [get]
+//	@Success		200	{object}	response.Response{data=[]response.Staff}	"Successfully found all staff"
+//	@Failure		403	{object}	response.Response{}							"Permission denied"
+//	@Failure		500	{object}	response.Response{}							"Failed to find all staff"
+func (a *adminHandler) GetAllStaff(ctx *gin.Context) {
+
+	pagination := request.GetPagination(ctx)
+
+	staff, err := a.adminUseCase.FindAllStaff(ctx, pagination)
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to find all staff", err, nil)
+		return
+	}
+
+	if len(staff) == 0 {
+		response.SuccessResponse(ctx, http.StatusNoContent, "No staff found", nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully found all staff", staff)
+}
+
+// InviteStaff godoc
+//
+//	@Summary		Invite a staff (Super Admin)
+//	@Security		BearerAuth
+//	@Description	API for super admin to create a staff account with a role (a token to set the password is mailed on invitation)
+//	@Id				InviteStaff
+//	@Tags			Admin Staff
+//	@Param			input	body	request.StaffInvite{}	true	"Staff details"
+//	@Router			/admin/staff [post]
+//	@Success		201	{object}	response.Response{}	"Successfully staff invited"
+//	@Failure		400	{object}	response.Response{}	"Invalid input or invalid role id"
+//	@Failure		403	{object}	response.Response{}	"Permission denied"
+//	@Failure		409	{object}	response.Response{}	"An admin already exist with this email or user_name"
+//	@Failure		500	{object}	response.Response{}	"Failed to invite staff"
+func (a *adminHandler) InviteStaff(ctx *gin.Context) {
+
+	var body request.StaffInvite
+
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindJsonFailMessage, err, body)
+		return
+	}
+
+	adminID, err := a.adminUseCase.InviteStaff(ctx, body)
+	if err != nil {
+		var statusCode int
+
+		switch {
+		case errors.Is(err, usecase.ErrAdminAlreadyExist):
+			statusCode = http.StatusConflict
+		case errors.Is(err, usecase.ErrInvalidRoleID):
+			statusCode = http.StatusBadRequest
+		default:
+			statusCode = http.StatusInternalServerError
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to invite staff", err, nil)
+		return
+	}
+
+	data := gin.H{"admin_id": adminID}
+	response.SuccessResponse(ctx, http.StatusCreated, "Successfully staff invited", data)
+}
+
+// SetStaffPassword godoc
+//
+//	@Summary		Set password of invited staff (Admin)
+//	@Description	API for invited staff to set the password with the token received on invitation mail
+//	@Id				SetStaffPassword
+//	@Tags			Admin Authentication
+//	@Param			input	body	request.StaffSetPassword{}	true	"Password details"
+//	@Router			/admin/auth/set-password [post]
+//	@Success		200	{object}	response.Response{}	"Successfully password set"
+//	@Failure		400	{object}	response.Response{}	"Invalid input"
+//	@Failure		401	{object}	response.Response{}	"Invalid or expired password token"
+//	@Failure		500	{object}	response.Response{}	"Failed to set password"
+func (a *adminHandler) SetStaffPassword(ctx *gin.Context) {
+
+	var body request.StaffSetPassword
+
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindJsonFailMessage, err, nil)
+		return
+	}
+
+	err := a.adminUseCase.SetStaffPassword(ctx, body)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrInvalidStaffPasswordToken) {
+			statusCode = http.StatusUnauthorized
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to set password", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully password set", nil)
+}
+
+// UpdateStaff godoc
+//
+//	@Summary		Update a staff (Super Admin)
+//	@Security		BearerAuth
+//	@Description	API for super admin to change role or block status of a staff (all sessions of the staff are revoked)
+//	@Id				UpdateStaff
+//	@Tags			Admin Staff
+//	@Param			admin_id	path	int						true	"Admin ID"
+//	@Param			input		body	request.StaffUpdate{}	true	"Staff details"
+//	@Router			/admin/staff/{admin_id} [put]
+//	@Success		200	{object}	response.Response{}	"Successfully staff updated"
+//	@Failure		400	{object}	response.Response{}	"Invalid input or own account"
+//	@Failure		403	{object}	response.Response{}	"Permission denied"
+//	@Failure		404	{object}	response.Response{}	"Staff not exist"
+//	@Failure		500	{object}	response.Response{}	"Failed to update staff"
+func (a *adminHandler) UpdateStaff(ctx *gin.Context) {
+
+	adminID, err := request.GetParamAsUint(ctx, "admin_id")
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	var body request.StaffUpdate
+
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindJsonFailMessage, err, body)
+		return
+	}
+
+	currentAdminID := utils.GetUserIdFromContext(ctx)
+
+	err = a.adminUseCase.UpdateStaff(ctx, currentAdminID, adminID, body)
+	if err != nil {
+		response.ErrorResponse(ctx, getStaffErrorStatusCode(err), "Failed to update staff", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully staff updated")
+}
+
+// DeleteStaff godoc
+//
+//	@Summary		Delete a staff (Super Admin)
+//	@Security		BearerAuth
+//	@Description	API for super admin to delete a staff account (all sessions of the staff are revoked)
+//	@Id				DeleteStaff
+//	@Tags			Admin Staff
+//	@Param			admin_id	path	int	true	"Admin ID"
+//	@Router			/admin/staff/{admin_id} [delete]
+//	@Success		200	{object}	response.Response{}	"Successfully staff deleted"
+//	@Failure		400	{object}	response.Response{}	"Invalid input or own account"
+//	@Failure		403	{object}	response.Response{}	"Permission denied"
+//	@Failure		404	{object}	response.Response{}	"Staff not exist"
+//	@Failure		500	{object}	response.Response{}	"Failed to delete staff"
+func (a *adminHandler) DeleteStaff(ctx *gin.Context) {
+
+	adminID, err := request.GetParamAsUint(ctx, "admin_id")
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	currentAdminID := utils.GetUserIdFromContext(ctx)
+
+	err = a.adminUseCase.DeleteStaff(ctx, currentAdminID, adminID)
+	if err != nil {
+		response.ErrorResponse(ctx, getStaffErrorStatusCode(err), "Failed to delete staff", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully staff deleted")
+}
+
+// status code of staff update and delete errors
+func getStaffErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, usecase.ErrStaffSelfModify):
+		return http.StatusBadRequest
+	case errors.Is(err, usecase.ErrInvalidRoleID):
+		return http.StatusBadRequest
+	case errors.Is(err, usecase.ErrStaffNotExist):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
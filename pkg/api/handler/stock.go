@@ -1,13 +1,16 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/interfaces"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase"
 	usecaseInterface "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
 )
 
 type stockHandler struct {
@@ -54,13 +57,14 @@ func (c *stockHandler) GetAllStocks(ctx *gin.Context) {
 // UpdateStock godoc
 //	@Summary		Update stocks (Admin)
 //	@Security		BearerAuth
-//	@Description	API for admin to update stock details
+//	@Description	API for admin to update stock details (positive qty_to_add is restock and negative is manual adjustment)
 //	@Id				UpdateStock
 //	@Tags			Admin Stock
 //	@Param			input	body	request.UpdateStock{}	true	"Update stock details"
 //	@Router			/admin/stocks [patch]
 //	@Success		200	{object}	response.Response{}	"Successfully updated sock"
 //	@Failure		400	{object}	response.Response{}	"Failed to bind input"
+//	@Failure		409	{object}	response.Response{}	"Not enough stock to remove"
 //	@Failure		500	{object}	response.Response{}	"Failed to update stock"
 func (c *stockHandler) UpdateStock(ctx *gin.Context) {
 
@@ -72,12 +76,128 @@ func (c *stockHandler) UpdateStock(ctx *gin.Context) {
 		return
 	}
 
-	err = c.stockUseCase.UpdateStockBySKU(ctx, body)
+	adminID := utils.GetUserIdFromContext(ctx)
+
+	err = c.stockUseCase.UpdateStockBySKU(ctx, adminID, body)
 
 	if err != nil {
-		response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to update stock", err, nil)
+		var statusCode int
+
+		switch {
+		case errors.Is(err, usecase.ErrInvalidSKU), errors.Is(err, usecase.ErrZeroStockChange):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, usecase.ErrNotEnoughStock):
+			statusCode = http.StatusConflict
+		default:
+			statusCode = http.StatusInternalServerError
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to update stock", err, nil)
 		return
 	}
 
 	response.SuccessResponse(ctx, http.StatusOK, "Successfully updated sock", nil)
 }
+
+// GetAllStockMovements godoc
+//	@Summary		Get stock movements (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to get the history of stock changes of a product item
+//	@Id				GetAllStockMovements
+//	@Tags			Admin Stock
+//	@Param			sku			path	string	true	"Product Item SKU"
+//	@Param			page_number	query	int		false	"Page Number"
+//	@Param			count		query	int		false	"Count"
+//	@Router			/admin/stocks/{sku}/movements [get]
+//	@Success		200	{object}	response.Response{}	"Successfully found all stock movements"
+//	@Success		204	{object}	response.Response{}	"No stock movements found"
+//	@Failure		400	{object}	response.Response{}	"Invalid sku"
+//	@Failure		500	{object}	response.Response{}	"Failed to find all stock movements"
+func (c *stockHandler) GetAllStockMovements(ctx *gin.Context) {
+
+	sku := ctx.Param("sku")
+	pagination := request.GetPagination(ctx)
+
+	movements, err := c.stockUseCase.FindAllStockMovementsBySKU(ctx, sku, pagination)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrInvalidSKU) {
+			statusCode = http.StatusBadRequest
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to find all stock movements", err, nil)
+		return
+	}
+
+	if len(movements) == 0 {
+		response.SuccessResponse(ctx, http.StatusNoContent, "No stock movements found", nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully found all stock movements", movements)
+}
+
+// GetAllLowStocks godoc
+//	@Summary		Get all low stocks (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to get all stocks which are reached their reorder threshold
+//	@Id				GetAllLowStocks
+//	@Tags			Admin Stock
+//	@Param			page_number	query	int	false	"Page Number"
+//	@Param			count		query	int	false	"Count"
+//	@Router			/admin/stocks/low [get]
+//	@Success		200	{object}	response.Response{}	"Successfully found all low stocks"
+//	@Success		204	{object}	response.Response{}	"No low stocks found"
+//	@Failure		500	{object}	response.Response{}	"Failed to find all low stocks"
+func (c *stockHandler) GetAllLowStocks(ctx *gin.Context) {
+
+	pagination := request.GetPagination(ctx)
+
+	stocks, err := c.stockUseCase.FindAllLowStocks(ctx, pagination)
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to find all low stocks", err, nil)
+		return
+	}
+
+	if len(stocks) == 0 {
+		response.SuccessResponse(ctx, http.StatusNoContent, "No low stocks found", nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully found all low stocks", stocks)
+}
+
+// UpdateReorderThreshold godoc
+//	@Summary		Update reorder threshold (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to update the reorder threshold of a product item (zero disable the low stock alert)
+//	@Id				UpdateReorderThreshold
+//	@Tags			Admin Stock
+//	@Param			sku		path	string							true	"Product Item SKU"
+//	@Param			input	body	request.UpdateReorderThreshold{}	true	"Reorder threshold details"
+//	@Router			/admin/stocks/{sku}/reorder-threshold [patch]
+//	@Success		200	{object}	response.Response{}	"Successfully updated reorder threshold"
+//	@Failure		400	{object}	response.Response{}	"Failed to bind input"
+//	@Failure		500	{object}	response.Response{}	"Failed to update reorder threshold"
+func (c *stockHandler) UpdateReorderThreshold(ctx *gin.Context) {
+
+	sku := ctx.Param("sku")
+
+	var body request.UpdateReorderThreshold
+
+	err := ctx.ShouldBindJSON(&body)
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindJsonFailMessage, err, body)
+		return
+	}
+
+	err = c.stockUseCase.UpdateReorderThresholdBySKU(ctx, sku, *body.ReorderThreshold)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrInvalidSKU) {
+			statusCode = http.StatusBadRequest
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to update reorder threshold", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully updated reorder threshold", nil)
+}
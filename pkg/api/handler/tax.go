@@ -0,0 +1,222 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase"
+	usecaseInterface "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
+)
+
+type taxHandler struct {
+	taxUseCase usecaseInterface.TaxUseCase
+}
+
+func NewTaxHandler(taxUseCase usecaseInterface.TaxUseCase) interfaces.TaxHandler {
+	return &taxHandler{
+		taxUseCase: taxUseCase,
+	}
+}
+
+// SaveTaxClass godoc
+//
+//	@Summary		Add tax class (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to add a GST tax class with its rate in percentage
+//	@Id				SaveTaxClass
+//	@Tags			Admin Tax
+//	@Param			input	body	request.TaxClass{}	true	"Tax class details"
+//	@Router			/admin/tax-classes [post]
+//	@Success		201	{object}	response.Response{}	"Successfully tax class added"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		409	{object}	response.Response{}	"Tax class already exist with this name"
+//	@Failure		500	{object}	response.Response{}	"Failed to add tax class"
+func (c *taxHandler) SaveTaxClass(ctx *gin.Context) {
+
+	var body request.TaxClass
+
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindJsonFailMessage, err, body)
+		return
+	}
+
+	taxClassID, err := c.taxUseCase.SaveTaxClass(ctx, body)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrTaxClassAlreadyExist) {
+			statusCode = http.StatusConflict
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to add tax class", err, nil)
+		return
+	}
+
+	data := gin.H{
+		"tax_class_id": taxClassID,
+	}
+	response.SuccessResponse(ctx, http.StatusCreated, "Successfully tax class added", data)
+}
+
+// GetAllTaxClasses godoc
+//
+//	@Summary		Get all tax classes (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to get all GST tax classes
+//	@Id				GetAllTaxClasses
+//	@Tags			Admin Tax
+//	@Param			page_number	query	int	false	"Page Number"
+//	@Param			count		query	int	false	"Count"
+//	@Router			/admin/tax-classes [get]
+//	@Success		200	{object}	response.Response{data=[]domain.TaxClass}	"Successfully found all tax classes"
+//	@Success		204	{object}	response.Response{}							"No tax classes found"
+//	@Failure		500	{object}	response.Response{}							"Failed to find all tax classes"
+func (c *taxHandler) GetAllTaxClasses(ctx *gin.Context) {
+
+	pagination := request.GetPagination(ctx)
+
+	taxClasses, err := c.taxUseCase.FindAllTaxClasses(ctx, pagination)
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to find all tax classes", err, nil)
+		return
+	}
+
+	if len(taxClasses) == 0 {
+		response.SuccessResponse(ctx, http.StatusNoContent, "No tax classes found", nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully found all tax classes", taxClasses)
+}
+
+// UpdateTaxClass godoc
+//
+//	@Summary		Update tax class (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to update name or rate of a tax class (new rate is only applied on new orders)
+//	@Id				UpdateTaxClass
+//	@Tags			Admin Tax
+//	@Param			tax_class_id	path	int					true	"Tax Class ID"
+//	@Param			input			body	request.TaxClass{}	true	"Tax class details"
+//	@Router			/admin/tax-classes/{tax_class_id} [put]
+//	@Success		200	{object}	response.Response{}	"Successfully tax class updated"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		409	{object}	response.Response{}	"Tax class already exist with this name"
+//	@Failure		500	{object}	response.Response{}	"Failed to update tax class"
+func (c *taxHandler) UpdateTaxClass(ctx *gin.Context) {
+
+	taxClassID, err := request.GetParamAsUint(ctx, "tax_class_id")
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	var body request.TaxClass
+
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindJsonFailMessage, err, body)
+		return
+	}
+
+	err = c.taxUseCase.UpdateTaxClass(ctx, taxClassID, body)
+	if err != nil {
+		var statusCode int
+
+		switch {
+		case errors.Is(err, usecase.ErrInvalidTaxClassID):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, usecase.ErrTaxClassAlreadyExist):
+			statusCode = http.StatusConflict
+		default:
+			statusCode = http.StatusInternalServerError
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to update tax class", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully tax class updated")
+}
+
+// UpdateCategoryTaxClass godoc
+//
+//	@Summary		Assign tax class to category (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to assign a tax class to a category (zero tax class id to remove)
+//	@Id				UpdateCategoryTaxClass
+//	@Tags			Admin Tax
+//	@Param			category_id	path	int						true	"Category ID"
+//	@Param			input		body	request.AssignTaxClass{}	true	"Tax class details"
+//	@Router			/admin/categories/{category_id}/tax-class [patch]
+//	@Success		200	{object}	response.Response{}	"Successfully tax class of category updated"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		500	{object}	response.Response{}	"Failed to update tax class of category"
+func (c *taxHandler) UpdateCategoryTaxClass(ctx *gin.Context) {
+
+	categoryID, err := request.GetParamAsUint(ctx, "category_id")
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	var body request.AssignTaxClass
+
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindJsonFailMessage, err, body)
+		return
+	}
+
+	err = c.taxUseCase.UpdateCategoryTaxClass(ctx, categoryID, body.TaxClassID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrInvalidCategoryID) || errors.Is(err, usecase.ErrInvalidTaxClassID) {
+			statusCode = http.StatusBadRequest
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to update tax class of category", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully tax class of category updated")
+}
+
+// UpdateProductTaxClass godoc
+//
+//	@Summary		Assign tax class to product (Admin)
+//	@Security		BearerAuth
+//	@Description	API for admin to assign a tax class to a product, it's used instead of category tax class (zero tax class id to remove)
+//	@Id				UpdateProductTaxClass
+//	@Tags			Admin Tax
+//	@Param			product_id	path	int						true	"Product ID"
+//	@Param			input		body	request.AssignTaxClass{}	true	"Tax class details"
+//	@Router			/admin/products/{product_id}/tax-class [patch]
+//	@Success		200	{object}	response.Response{}	"Successfully tax class of product updated"
+//	@Failure		400	{object}	response.Response{}	"Invalid inputs"
+//	@Failure		500	{object}	response.Response{}	"Failed to update tax class of product"
+func (c *taxHandler) UpdateProductTaxClass(ctx *gin.Context) {
+
+	productID, err := request.GetParamAsUint(ctx, "product_id")
+	if err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindParamFailMessage, err, nil)
+		return
+	}
+
+	var body request.AssignTaxClass
+
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		response.ErrorResponse(ctx, http.StatusBadRequest, BindJsonFailMessage, err, body)
+		return
+	}
+
+	err = c.taxUseCase.UpdateProductTaxClass(ctx, productID, body.TaxClassID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrInvalidProductID) || errors.Is(err, usecase.ErrInvalidTaxClassID) {
+			statusCode = http.StatusBadRequest
+		}
+		response.ErrorResponse(ctx, statusCode, "Failed to update tax class of product", err, nil)
+		return
+	}
+
+	response.SuccessResponse(ctx, http.StatusOK, "Successfully tax class of product updated")
+}
@@ -25,21 +25,6 @@ func NewUserHandler(userUsecase usecaseInterface.UserUseCase) interfaces.UserHan
 	}
 }
 
-// // Logout godoc
-// // @summary api for user to logout
-// // @description user can logout
-// // @security ApiKeyAuth
-// // @id UserLogout
-// // @tags User Logout
-// // @Router /logout [post]
-// // @Success 200 "successfully logged out"
-// func (u *UserHandler) UserLogout(ctx *gin.Context) {
-
-// 	ctx.SetCookie("user-auth", "", -1, "", "", false, true)
-
-// 	response.SuccessResponse(ctx, http.StatusOK, "Successfully logged out", nil)
-// }
-
 // // CheckOutCart godoc
 // // @summary api for cart checkout
 // // @description user can checkout user cart items
@@ -0,0 +1,216 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+)
+
+const (
+	// max bytes of response body kept to find the result message of the action
+	auditResponseLimit = 4 << 10
+	// max bytes of multipart form kept on memory while parsing it (remaining saved on temporary files)
+	auditMultipartMemory = 32 << 20
+	maskedValue          = "*****"
+	// saved instead of the payload when the request body can't parse
+	redactedPayload = "[redacted]"
+)
+
+// keys of request payload which values are not saved on audit log
+var auditSecretKeys = []string{"password", "secret", "token", "otp"}
+
+// response writer which keep a copy of the response body to save the result of the request on audit log
+type auditResponseWriter struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (w *auditResponseWriter) Write(data []byte) (int, error) {
+	if remain := auditResponseLimit - w.body.Len(); remain > 0 {
+		if len(data) > remain {
+			w.body.Write(data[:remain])
+		} else {
+			w.body.Write(data)
+		}
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+// save every non GET request of admin with its payload and result on audit log
+// (should use after AuthenticateAdmin because the admin id is set on it)
+func (c *middleware) AuditAdminActions() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+
+		switch ctx.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			ctx.Next()
+			return
+		}
+
+		payload := auditRequestChanges(ctx.Request)
+
+		writer := &auditResponseWriter{ResponseWriter: ctx.Writer, body: &bytes.Buffer{}}
+		ctx.Writer = writer
+
+		ctx.Next()
+
+		statusCode := ctx.Writer.Status()
+
+		auditLog := domain.AdminAuditLog{
+			AdminID:    utils.GetUserIdFromContext(ctx),
+			Method:     ctx.Request.Method,
+			Route:      ctx.FullPath(),
+			Path:       ctx.Request.URL.RequestURI(),
+			Payload:    payload,
+			ClientIP:   ctx.ClientIP(),
+			StatusCode: statusCode,
+			Success:    statusCode < http.StatusBadRequest,
+			Message:    auditResultMessage(writer.body.Bytes()),
+			CreatedAt:  time.Now(),
+		}
+
+		// failure of saving audit log should not change the response of the action that is already done
+		if err := c.adminUseCase.SaveAuditLog(ctx, auditLog); err != nil {
+			log.Printf("failed to save audit log of admin %d for %s %s: %v",
+				auditLog.AdminID, auditLog.Method, auditLog.Path, err)
+		}
+	}
+}
+
+// find the fields requested to change by the request body as a json object of field path to its value
+// (secret values masked and files saved with its name), body which can't parse is not saved
+func auditRequestChanges(req *http.Request) string {
+
+	if req.Body == nil || req.ContentLength == 0 {
+		return ""
+	}
+
+	changes := make(map[string]interface{})
+	switch contentType := req.Header.Get("Content-Type"); {
+	case strings.HasPrefix(contentType, gin.MIMEJSON):
+		bodyBytes, err := io.ReadAll(req.Body)
+		// keep the body to read it again on handler
+		req.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+		if err != nil {
+			return redactedPayload
+		}
+		var body interface{}
+		if err := json.Unmarshal(bodyBytes, &body); err != nil {
+			return redactedPayload
+		}
+		addAuditChanges(changes, "", body)
+
+	case strings.HasPrefix(contentType, gin.MIMEPOSTForm), strings.HasPrefix(contentType, gin.MIMEMultipartPOSTForm):
+		// parsed form is kept on request so the handler can bind it again
+		if err := req.ParseMultipartForm(auditMultipartMemory); err != nil && !errors.Is(err, http.ErrNotMultipart) {
+			return redactedPayload
+		}
+		for key, values := range req.PostForm {
+			addAuditChanges(changes, key, formAuditValue(values))
+		}
+		if req.MultipartForm != nil {
+			for key, files := range req.MultipartForm.File {
+				fileNames := make([]string, len(files))
+				for i, file := range files {
+					fileNames[i] = file.Filename
+				}
+				changes[key] = formAuditValue(fileNames)
+			}
+		}
+
+	default:
+		return redactedPayload
+	}
+
+	if len(changes) == 0 {
+		return ""
+	}
+
+	changesData, err := json.Marshal(changes)
+	if err != nil {
+		return redactedPayload
+	}
+
+	return string(changesData)
+}
+
+// add the value of every field to changes with its path (eg: items.0.sku) and mask values of secret keys
+func addAuditChanges(changes map[string]interface{}, path string, value interface{}) {
+
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, fieldValue := range value {
+			if isAuditSecretKey(key) {
+				changes[auditFieldPath(path, key)] = maskedValue
+				continue
+			}
+			addAuditChanges(changes, auditFieldPath(path, key), fieldValue)
+		}
+	case []interface{}:
+		for i, fieldValue := range value {
+			addAuditChanges(changes, auditFieldPath(path, strconv.Itoa(i)), fieldValue)
+		}
+	default:
+		if isAuditSecretKey(path) {
+			value = maskedValue
+		}
+		changes[path] = value
+	}
+}
+
+func auditFieldPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// form field with a single value is saved as the value otherwise as list
+func formAuditValue(values []string) interface{} {
+	if len(values) == 1 {
+		return values[0]
+	}
+	list := make([]interface{}, len(values))
+	for i := range values {
+		list[i] = values[i]
+	}
+	return list
+}
+
+func isAuditSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, secretKey := range auditSecretKeys {
+		if strings.Contains(key, secretKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// find the result message from the response body of the request
+func auditResultMessage(body []byte) string {
+
+	var res struct {
+		Message string   `json:"message"`
+		Error   []string `json:"error"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return ""
+	}
+
+	if len(res.Error) > 0 {
+		return res.Message + ": " + strings.Join(res.Error, " ")
+	}
+
+	return res.Message
+}
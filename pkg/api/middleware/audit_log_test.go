@@ -0,0 +1,127 @@
+package middleware
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditRequestChanges(t *testing.T) {
+
+	tests := []struct {
+		testName       string
+		contentType    string
+		body           string
+		expectedOutput string
+	}{
+		{
+			testName:       "InvalidJsonShouldSaveRedacted",
+			contentType:    gin.MIMEJSON,
+			body:           `{"password":"pass123"`,
+			expectedOutput: redactedPayload,
+		},
+		{
+			testName:       "UnknownContentTypeShouldSaveRedacted",
+			contentType:    "text/csv",
+			body:           "sku,qty",
+			expectedOutput: redactedPayload,
+		},
+		{
+			testName:       "EmptyBodyShouldSaveNothing",
+			contentType:    gin.MIMEJSON,
+			body:           "",
+			expectedOutput: "",
+		},
+		{
+			testName:       "SecretValuesShouldBeMasked",
+			contentType:    gin.MIMEJSON,
+			body:           `{"user_name":"staff","password":"pass123","refresh_token":"token"}`,
+			expectedOutput: `{"password":"*****","refresh_token":"*****","user_name":"staff"}`,
+		},
+		{
+			testName:       "NestedFieldsShouldSaveWithPath",
+			contentType:    gin.MIMEJSON,
+			body:           `{"items":[{"sku":"SKU1","otp":"1234"}],"admin":{"Password":"pass123"}}`,
+			expectedOutput: `{"admin.Password":"*****","items.0.otp":"*****","items.0.sku":"SKU1"}`,
+		},
+		{
+			testName:       "FormFieldsShouldBeSaved",
+			contentType:    gin.MIMEPOSTForm,
+			body:           "block_status=true&user_id=3&otp=1234",
+			expectedOutput: `{"block_status":"true","otp":"*****","user_id":"3"}`,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+
+			req := httptest.NewRequest(http.MethodPost, "/api/admin/users/block", strings.NewReader(test.body))
+			req.Header.Set("Content-Type", test.contentType)
+
+			output := auditRequestChanges(req)
+			assert.Equal(t, test.expectedOutput, output)
+		})
+	}
+}
+
+func TestAuditRequestChangesOfMultipartForm(t *testing.T) {
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	assert.NoError(t, writer.WriteField("product_id", "4"))
+	fileWriter, err := writer.CreateFormFile("image", "review.png")
+	assert.NoError(t, err)
+	_, err = fileWriter.Write([]byte("image"))
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/admin/products", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	output := auditRequestChanges(req)
+	assert.Equal(t, `{"image":"review.png","product_id":"4"}`, output)
+
+	// parsed form should be available for the handler
+	assert.Equal(t, "4", req.FormValue("product_id"))
+}
+
+func TestAuditResultMessage(t *testing.T) {
+
+	tests := []struct {
+		testName       string
+		body           string
+		expectedOutput string
+	}{
+		{
+			testName:       "SuccessResponseShouldReturnMessage",
+			body:           `{"success":true,"message":"Successfully stock updated"}`,
+			expectedOutput: "Successfully stock updated",
+		},
+		{
+			testName:       "ErrorResponseShouldReturnMessageWithError",
+			body:           `{"success":false,"message":"Failed to update stock","error":["invalid sku"]}`,
+			expectedOutput: "Failed to update stock: invalid sku",
+		},
+		{
+			testName:       "NonJsonResponseShouldReturnEmpty",
+			body:           "sku,qty",
+			expectedOutput: "",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+
+			output := auditResultMessage([]byte(test.body))
+			assert.Equal(t, test.expectedOutput, output)
+		})
+	}
+}
@@ -66,6 +66,23 @@ func (c *middleware) authorize(tokenUser token.UserType) gin.HandlerFunc {
 			return
 		}
 
+		// check the access token's session is revoked (logged out)
+		revoked, err := c.authUseCase.IsSessionRevoked(ctx, verifyRes.SessionID)
+		if err != nil {
+			response.ErrorResponse(ctx, http.StatusInternalServerError, "Failed to authorize request", err, nil)
+			ctx.Abort()
+			return
+		}
+
+		if revoked {
+			err := errors.New("access token revoked")
+			response.ErrorResponse(ctx, http.StatusUnauthorized, "Unauthorized user", err, nil)
+			ctx.Abort()
+			return
+		}
+
 		ctx.Set("userId", verifyRes.UserID)
+		ctx.Set("sessionId", verifyRes.SessionID)
+		ctx.Set("permissions", verifyRes.Permissions)
 	}
 }
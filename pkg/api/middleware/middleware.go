@@ -2,21 +2,34 @@ package middleware
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/ratelimit"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/token"
+	usecaseInterface "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
 )
 
 type Middleware interface {
 	AuthenticateUser() gin.HandlerFunc
 	AuthenticateAdmin() gin.HandlerFunc
+	RequirePermission(permissions ...string) gin.HandlerFunc
+	AuditAdminActions() gin.HandlerFunc
+	RateLimit(policy ratelimit.Policy) gin.HandlerFunc
 	TrimSpaces() gin.HandlerFunc
 }
 
 type middleware struct {
 	tokenService token.TokenService
+	authUseCase  usecaseInterface.AuthUseCase
+	adminUseCase usecaseInterface.AdminUseCase
+
+	rateLimitStore ratelimit.Store
 }
 
-func NewMiddleware(tokenService token.TokenService) Middleware {
+func NewMiddleware(tokenService token.TokenService, authUseCase usecaseInterface.AuthUseCase,
+	adminUseCase usecaseInterface.AdminUseCase, rateLimitStore ratelimit.Store) Middleware {
 	return &middleware{
-		tokenService: tokenService,
+		tokenService:   tokenService,
+		authUseCase:    authUseCase,
+		adminUseCase:   adminUseCase,
+		rateLimitStore: rateLimitStore,
 	}
 }
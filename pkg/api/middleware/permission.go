@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+)
+
+// allow the request only if the admin have all the given permissions
+// (should use after AuthenticateAdmin because permissions are set on it from access token)
+func (c *middleware) RequirePermission(permissions ...string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+
+		adminPermissions := ctx.GetStringSlice("permissions")
+
+		for _, permission := range permissions {
+			if !containsPermission(adminPermissions, permission) {
+				err := fmt.Errorf("permission %s required for this request", permission)
+				response.ErrorResponse(ctx, http.StatusForbidden, "Permission denied", err, nil)
+				ctx.Abort()
+				return
+			}
+		}
+	}
+}
+
+func containsPermission(permissions []string, permission string) bool {
+	for _, p := range permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequirePermission(t *testing.T) {
+
+	tests := []struct {
+		testName           string
+		permissions        []string
+		requiredPermission []string
+		expectedStatusCode int
+	}{
+		{
+			testName:           "NoPermissionsShouldReturnForbidden",
+			permissions:        nil,
+			requiredPermission: []string{domain.PermissionManageCatalog},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			testName:           "MissingOneOfPermissionsShouldReturnForbidden",
+			permissions:        []string{domain.PermissionManageCatalog},
+			requiredPermission: []string{domain.PermissionManageCatalog, domain.PermissionManageStock},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			testName:           "AllPermissionsShouldCallNextHandler",
+			permissions:        []string{domain.PermissionManageStock, domain.PermissionManageCatalog},
+			requiredPermission: []string{domain.PermissionManageCatalog, domain.PermissionManageStock},
+			expectedStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+
+			mw := &middleware{}
+
+			engine := gin.New()
+			engine.GET("/", func(ctx *gin.Context) {
+				ctx.Set("permissions", test.permissions)
+			}, mw.RequirePermission(test.requiredPermission...), func(ctx *gin.Context) {
+				ctx.Status(http.StatusOK)
+			})
+
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			engine.ServeHTTP(recorder, req)
+
+			assert.Equal(t, test.expectedStatusCode, recorder.Code)
+		})
+	}
+}
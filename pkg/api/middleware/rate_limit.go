@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/ratelimit"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+)
+
+// limit the requests using the token bucket of the policy
+// requests are limited by user id when authenticated (use after authenticate middleware) otherwise by client ip
+func (c *middleware) RateLimit(policy ratelimit.Policy) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+
+		key := "ip:" + ctx.ClientIP()
+		if userID := utils.GetUserIdFromContext(ctx); userID != 0 {
+			key = fmt.Sprintf("user:%d", userID)
+		}
+
+		result, err := c.rateLimitStore.Take(ctx, key, policy)
+		if err != nil {
+			// requests are allowed when the store is not available
+			log.Printf("failed to take rate limit token of %s for policy %s: %v", key, policy.Name, err)
+			return
+		}
+
+		ctx.Header("RateLimit-Limit", strconv.FormatUint(uint64(result.Limit), 10))
+		ctx.Header("RateLimit-Remaining", strconv.FormatUint(uint64(result.Remaining), 10))
+		ctx.Header("RateLimit-Reset", durationToSeconds(result.ResetAfter))
+		ctx.Header("RateLimit-Policy", fmt.Sprintf("%d;w=%s", policy.Limit, durationToSeconds(policy.Period)))
+
+		if !result.Allowed {
+			ctx.Header("Retry-After", durationToSeconds(result.RetryAfter))
+			err := errors.New("rate limit exceeded")
+			response.ErrorResponse(ctx, http.StatusTooManyRequests, "Too many requests", err, nil)
+			ctx.Abort()
+			return
+		}
+	}
+}
+
+// rate limit headers are in whole seconds (rounded up to not retry early)
+func durationToSeconds(duration time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(duration.Seconds())), 10)
+}
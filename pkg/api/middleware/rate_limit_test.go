@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/ratelimit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimit(t *testing.T) {
+
+	policy := ratelimit.Policy{Name: "test", Limit: 2, Period: time.Minute}
+	mw := &middleware{rateLimitStore: ratelimit.NewMemoryStore()}
+
+	engine := gin.New()
+	engine.GET("/", mw.RateLimit(policy), func(ctx *gin.Context) {
+		ctx.Status(http.StatusOK)
+	})
+	engine.GET("/user/:user_id", func(ctx *gin.Context) {
+		ctx.Set("userId", uint(1))
+	}, mw.RateLimit(policy), func(ctx *gin.Context) {
+		ctx.Status(http.StatusOK)
+	})
+
+	sendRequest := func(path, remoteAddr string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = remoteAddr
+		engine.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	recorder := sendRequest("/", "10.0.0.1:1234")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "2", recorder.Header().Get("RateLimit-Limit"))
+	assert.Equal(t, "1", recorder.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, "2;w=60", recorder.Header().Get("RateLimit-Policy"))
+
+	recorder = sendRequest("/", "10.0.0.1:1234")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "0", recorder.Header().Get("RateLimit-Remaining"))
+
+	// bucket of the ip is empty
+	recorder = sendRequest("/", "10.0.0.1:1234")
+	assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	assert.Equal(t, "30", recorder.Header().Get("Retry-After"))
+	assert.Equal(t, "60", recorder.Header().Get("RateLimit-Reset"))
+
+	// other ip have its own bucket
+	recorder = sendRequest("/", "10.0.0.2:1234")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	// authenticated requests limited by user id instead of ip
+	recorder = sendRequest("/user/1", "10.0.0.1:1234")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "1", recorder.Header().Get("RateLimit-Remaining"))
+}
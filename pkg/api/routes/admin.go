@@ -4,6 +4,7 @@ import (
 	"github.com/gin-gonic/gin"
 	handlerInterface "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/interfaces"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/middleware"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
 )
 
 func AdminRoutes(api *gin.RouterGroup, authHandler handlerInterface.AuthHandler, middleware middleware.Middleware,
@@ -11,10 +12,12 @@ func AdminRoutes(api *gin.RouterGroup, authHandler handlerInterface.AuthHandler,
 	paymentHandler handlerInterface.PaymentHandler, orderHandler handlerInterface.OrderHandler,
 	couponHandler handlerInterface.CouponHandler, offerHandler handlerInterface.OfferHandler,
 	stockHandler handlerInterface.StockHandler, branHandler handlerInterface.BrandHandler,
-
+	reviewHandler handlerInterface.ReviewHandler, questionHandler handlerInterface.QuestionHandler,
+	shippingHandler handlerInterface.ShippingHandler, taxHandler handlerInterface.TaxHandler,
+	invoiceHandler handlerInterface.InvoiceHandler,
 ) {
 
-	auth := api.Group("/auth")
+	auth := api.Group("/auth", middleware.RateLimit(adminAuthRateLimit))
 	{
 		login := auth.Group("/sign-in")
 		{
@@ -27,23 +30,49 @@ func AdminRoutes(api *gin.RouterGroup, authHandler handlerInterface.AuthHandler,
 		// }
 
 		auth.POST("/renew-access-token", authHandler.AdminRenewAccessToken())
+		// invited staff set the password with the token of invitation mail
+		auth.POST("/set-password", adminHandler.SetStaffPassword)
+
+		auth.POST("/logout", middleware.AuthenticateAdmin(), authHandler.AdminLogout())
+		auth.POST("/logout-all", middleware.AuthenticateAdmin(), authHandler.AdminLogoutAll())
 	}
 
-	api.Use(middleware.AuthenticateAdmin())
+	api.Use(middleware.AuthenticateAdmin(), middleware.AuditAdminActions())
 	{
 
+		api.GET("/dashboard", middleware.RequirePermission(domain.PermissionViewReports), adminHandler.GetDashboard)
+
+		// logged in devices
+		account := api.Group("/account")
+		{
+			account.GET("/sessions", authHandler.AdminGetAllSessions())
+			account.DELETE("/sessions/:session_id", authHandler.AdminRevokeSession())
+		}
+
+		// staff
+		staff := api.Group("/staff", middleware.RequirePermission(domain.PermissionManageStaff))
+		{
+			staff.GET("/", adminHandler.GetAllStaff)
+			staff.POST("/", middleware.TrimSpaces(), adminHandler.InviteStaff)
+			staff.PUT("/:admin_id", adminHandler.UpdateStaff)
+			staff.DELETE("/:admin_id", adminHandler.DeleteStaff)
+		}
+		api.GET("/roles", middleware.RequirePermission(domain.PermissionManageStaff), adminHandler.GetAllRoles)
+		api.GET("/audit-logs", middleware.RequirePermission(domain.PermissionViewAuditLogs), adminHandler.GetAllAuditLogs)
+
 		// user side
-		user := api.Group("/users")
+		user := api.Group("/users", middleware.RequirePermission(domain.PermissionManageUsers))
 		{
 			user.GET("/", adminHandler.GetAllUsers)
 			user.PATCH("/block", adminHandler.BlockUser)
 		}
 		// category
-		category := api.Group("/categories")
+		category := api.Group("/categories", middleware.RequirePermission(domain.PermissionManageCatalog))
 		{
 			category.GET("/", productHandler.GetAllCategories)
 			category.POST("/", middleware.TrimSpaces(), productHandler.SaveCategory)
 			category.POST("/sub-categories", middleware.TrimSpaces(), productHandler.SaveSubCategory)
+			category.PATCH("/:category_id/tax-class", taxHandler.UpdateCategoryTaxClass)
 
 			variation := category.Group("/:category_id/variations")
 			{
@@ -58,7 +87,7 @@ func AdminRoutes(api *gin.RouterGroup, authHandler handlerInterface.AuthHandler,
 
 		}
 		// brand
-		brand := api.Group("/brands")
+		brand := api.Group("/brands", middleware.RequirePermission(domain.PermissionManageCatalog))
 		{
 			brand.POST("", branHandler.Save)
 			brand.GET("", branHandler.FindAll)
@@ -67,25 +96,58 @@ func AdminRoutes(api *gin.RouterGroup, authHandler handlerInterface.AuthHandler,
 			brand.DELETE("/:brand_id", branHandler.Delete)
 		}
 
+		// gst tax classes
+		taxClass := api.Group("/tax-classes", middleware.RequirePermission(domain.PermissionManageCatalog))
+		{
+			taxClass.GET("/", taxHandler.GetAllTaxClasses)
+			taxClass.POST("/", middleware.TrimSpaces(), taxHandler.SaveTaxClass)
+			taxClass.PUT("/:tax_class_id", middleware.TrimSpaces(), taxHandler.UpdateTaxClass)
+		}
+
 		// product
-		product := api.Group("/products")
+		product := api.Group("/products", middleware.RequirePermission(domain.PermissionManageCatalog))
 		{
 			product.GET("/", productHandler.GetAllProductsAdmin())
 			product.POST("/", middleware.TrimSpaces(), productHandler.SaveProduct)
 			product.PUT("/", middleware.TrimSpaces(), productHandler.UpdateProduct)
+			product.POST("/import", productHandler.ImportProducts)
+			product.GET("/export", productHandler.ExportProducts)
+			product.PATCH("/:product_id/tax-class", taxHandler.UpdateProductTaxClass)
 
 			productItem := product.Group("/:product_id/items")
 			{
 				productItem.GET("/", productHandler.GetAllProductItemsAdmin())
 				productItem.POST("/", productHandler.SaveProductItem)
+				productItem.PATCH("/:product_item_id", productHandler.UpdateProductItem)
+			}
+
+			// review moderation
+			review := product.Group("/reviews")
+			{
+				review.GET("/", reviewHandler.GetAllProductReviewsAdmin)
+				review.PATCH("/:review_id/approve", reviewHandler.ApproveProductReview)
+				review.PATCH("/:review_id/hide", reviewHandler.HideProductReview)
+			}
+
+			// question and answer moderation
+			question := product.Group("/questions")
+			{
+				question.GET("/", questionHandler.GetAllProductQuestionsAdmin)
+				question.PATCH("/:question_id", questionHandler.UpdateProductQuestionVisibility)
+				question.GET("/:question_id/answers", questionHandler.GetAllProductAnswersAdmin)
+				question.POST("/:question_id/answers", middleware.TrimSpaces(), questionHandler.SaveProductAnswerAdmin)
+				question.PATCH("/answers/:answer_id", questionHandler.UpdateProductAnswerVisibility)
 			}
 		}
 		// 	// order
-		order := api.Group("/orders")
+		order := api.Group("/orders", middleware.RequirePermission(domain.PermissionManageOrders))
 		{
 			order.GET("/all", orderHandler.GetAllShopOrders)
 			order.GET("/:shop_order_id/items", orderHandler.GetAllOrderItemsAdmin())
+			order.GET("/:shop_order_id/timeline", orderHandler.GetOrderTimelineAdmin())
+			order.GET("/:shop_order_id/invoice", invoiceHandler.GetOrderInvoiceAdmin)
 			order.PUT("/", orderHandler.UpdateOrderStatus)
+			order.POST("/cancel", orderHandler.CancelOrderAdmin)
 
 			status := order.Group("/statuses")
 			{
@@ -98,16 +160,32 @@ func AdminRoutes(api *gin.RouterGroup, authHandler handlerInterface.AuthHandler,
 			order.PUT("/returns/pending", orderHandler.UpdateReturnRequest)
 		}
 
+		// shipping zones and delivery charge rates
+		shippingZone := api.Group("/shipping-zones", middleware.RequirePermission(domain.PermissionManageOrders))
+		{
+			shippingZone.GET("/", shippingHandler.GetAllShippingZones)
+			shippingZone.POST("/", middleware.TrimSpaces(), shippingHandler.SaveShippingZone)
+			shippingZone.PUT("/:shipping_zone_id", middleware.TrimSpaces(), shippingHandler.UpdateShippingZone)
+			shippingZone.DELETE("/:shipping_zone_id", shippingHandler.DeleteShippingZone)
+		}
+
 		// payment_method
-		paymentMethod := api.Group("/payment-methods")
+		paymentMethod := api.Group("/payment-methods", middleware.RequirePermission(domain.PermissionManagePayments))
 		{
 			paymentMethod.GET("/", paymentHandler.GetAllPaymentMethodsAdmin())
 			// paymentMethod.POST("/", paymentHandler.AddPaymentMethod)
 			paymentMethod.PUT("/", paymentHandler.UpdatePaymentMethod)
 		}
 
+		// payment gateway webhook events
+		paymentEvent := api.Group("/payment-events", middleware.RequirePermission(domain.PermissionManagePayments))
+		{
+			paymentEvent.GET("/", paymentHandler.GetAllPaymentEvents)
+			paymentEvent.POST("/:payment_event_id/replay", paymentHandler.ReplayPaymentEvent)
+		}
+
 		// offer
-		offer := api.Group("/offers")
+		offer := api.Group("/offers", middleware.RequirePermission(domain.PermissionManagePromotions))
 		{
 			offer.POST("/", middleware.TrimSpaces(), offerHandler.SaveOffer) // add a new offer
 			offer.GET("/", offerHandler.GetAllOffers)                        // get all offers
@@ -125,7 +203,7 @@ func AdminRoutes(api *gin.RouterGroup, authHandler handlerInterface.AuthHandler,
 		}
 
 		// coupons
-		coupons := api.Group("/coupons")
+		coupons := api.Group("/coupons", middleware.RequirePermission(domain.PermissionManagePromotions))
 		{
 			coupons.POST("/", middleware.TrimSpaces(), couponHandler.SaveCoupon)
 			coupons.GET("/", couponHandler.GetAllCouponsAdmin)
@@ -133,16 +211,20 @@ func AdminRoutes(api *gin.RouterGroup, authHandler handlerInterface.AuthHandler,
 		}
 
 		// sales report
-		sales := api.Group("/sales")
+		sales := api.Group("/sales", middleware.RequirePermission(domain.PermissionViewReports))
 		{
 			sales.GET("/", adminHandler.GetFullSalesReport)
+			sales.GET("/summary", adminHandler.GetSalesSummary)
 		}
 
-		stock := api.Group("/stocks")
+		stock := api.Group("/stocks", middleware.RequirePermission(domain.PermissionManageStock))
 		{
 			stock.GET("/", stockHandler.GetAllStocks)
+			stock.GET("/low", stockHandler.GetAllLowStocks)
+			stock.GET("/:sku/movements", stockHandler.GetAllStockMovements)
 
 			stock.PATCH("/", stockHandler.UpdateStock)
+			stock.PATCH("/:sku/reorder-threshold", stockHandler.UpdateReorderThreshold)
 		}
 
 	}
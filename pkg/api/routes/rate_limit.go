@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"time"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/ratelimit"
+)
+
+// rate limit policies of route groups
+var (
+	// stricter on login, signup and otp routes
+	userAuthRateLimit  = ratelimit.Policy{Name: "user_auth", Limit: 10, Period: time.Minute}
+	adminAuthRateLimit = ratelimit.Policy{Name: "admin_auth", Limit: 10, Period: time.Minute}
+	// placing orders and payments
+	checkoutRateLimit = ratelimit.Policy{Name: "checkout", Limit: 10, Period: time.Minute}
+	// looser on catalog browsing
+	catalogRateLimit = ratelimit.Policy{Name: "catalog", Limit: 120, Period: time.Minute}
+)
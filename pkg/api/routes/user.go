@@ -10,9 +10,11 @@ func UserRoutes(api *gin.RouterGroup, authHandler handlerInterface.AuthHandler,
 	userHandler handlerInterface.UserHandler, cartHandler handlerInterface.CartHandler,
 	productHandler handlerInterface.ProductHandler, paymentHandler handlerInterface.PaymentHandler,
 	orderHandler handlerInterface.OrderHandler, couponHandler handlerInterface.CouponHandler,
+	reviewHandler handlerInterface.ReviewHandler, questionHandler handlerInterface.QuestionHandler,
+	invoiceHandler handlerInterface.InvoiceHandler,
 ) {
 
-	auth := api.Group("/auth")
+	auth := api.Group("/auth", middleware.RateLimit(userAuthRateLimit))
 	{
 		signup := auth.Group("/sign-up")
 		{
@@ -34,25 +36,54 @@ func UserRoutes(api *gin.RouterGroup, authHandler handlerInterface.AuthHandler,
 			goath.GET("/callback", authHandler.UserGoogleAuthCallBack)
 		}
 
+		auth.POST("/forgot-password", authHandler.UserForgotPassword)
+		auth.POST("/reset-password", authHandler.UserResetPassword)
+
 		auth.POST("/renew-access-token", authHandler.UserRenewAccessToken())
 
-		// api.POST("/logout")
+		auth.POST("/logout", middleware.AuthenticateUser(), authHandler.UserLogout())
+		auth.POST("/logout-all", middleware.AuthenticateUser(), authHandler.UserLogoutAll())
+	}
 
+	// payment gateway webhooks (verified by signature instead of token)
+	webhooks := api.Group("/webhooks")
+	{
+		webhooks.POST("/stripe", paymentHandler.StripeWebhook)
+		webhooks.POST("/razorpay", paymentHandler.RazorpayWebhook)
 	}
 
 	api.Use(middleware.AuthenticateUser())
 	{
 
-		// api.POST("/logout", userHandler.UserLogout)
-
-		product := api.Group("/products")
+		product := api.Group("/products", middleware.RateLimit(catalogRateLimit))
 		{
 			product.GET("/", productHandler.GetAllProductsUser())
+			product.GET("/search", productHandler.SearchProducts)
 
 			productItem := product.Group("/:product_id/items")
 			{
 				productItem.GET("/", productHandler.GetAllProductItemsUser())
 			}
+
+			review := product.Group("/:product_id/reviews")
+			{
+				review.GET("/", reviewHandler.GetAllProductReviewsUser)
+				review.POST("/", reviewHandler.SaveProductReview)
+			}
+
+			question := product.Group("/:product_id/questions")
+			{
+				question.GET("/", questionHandler.GetAllProductQuestionsUser)
+				question.POST("/", middleware.TrimSpaces(), questionHandler.SaveProductQuestion)
+
+				answer := question.Group("/:question_id/answers")
+				{
+					answer.GET("/", questionHandler.GetAllProductAnswersUser)
+					answer.POST("/", middleware.TrimSpaces(), questionHandler.SaveProductAnswerUser)
+					answer.POST("/:answer_id/upvote", questionHandler.UpvoteProductAnswer)
+					answer.DELETE("/:answer_id/upvote", questionHandler.RemoveProductAnswerUpvote)
+				}
+			}
 		}
 
 		// 	// cart
@@ -67,18 +98,24 @@ func UserRoutes(api *gin.RouterGroup, authHandler handlerInterface.AuthHandler,
 
 			cart.GET("/checkout/payment-select-page", paymentHandler.CartOrderPaymentSelectPage)
 			// 		cart.GET("/payment-methods", orderHandler.GetAllPaymentMethods)
-			cart.POST("/place-order", orderHandler.SaveOrder)
 
 			// 		//cart.GET("/checkout", userHandler.CheckOutCart, orderHandler.GetAllPaymentMethods)
-			cart.POST("/place-order/cod", paymentHandler.PaymentCOD)
+			checkout := cart.Group("/place-order", middleware.RateLimit(checkoutRateLimit))
+			{
+				checkout.POST("", orderHandler.SaveOrder)
+				checkout.POST("/cod", paymentHandler.PaymentCOD)
+
+				// razorpay payment
+				checkout.POST("/razorpay-checkout", paymentHandler.RazorpayCheckout)
+				checkout.POST("/razorpay-verify", paymentHandler.RazorpayVerify)
 
-			// razorpay payment
-			cart.POST("/place-order/razorpay-checkout", paymentHandler.RazorpayCheckout)
-			cart.POST("/place-order/razorpay-verify", paymentHandler.RazorpayVerify)
+				// 	stripe payment
+				checkout.POST("/stripe-checkout", paymentHandler.StripPaymentCheckout)
+				checkout.POST("/stripe-verify", paymentHandler.StripePaymentVeify)
 
-			// 	stripe payment
-			cart.POST("/place-order/stripe-checkout", paymentHandler.StripPaymentCheckout)
-			cart.POST("/place-order/stripe-verify", paymentHandler.StripePaymentVeify)
+				// wallet payment
+				checkout.POST("/wallet", paymentHandler.PaymentWallet)
+			}
 		}
 
 		// profile
@@ -86,6 +123,7 @@ func UserRoutes(api *gin.RouterGroup, authHandler handlerInterface.AuthHandler,
 		{
 			account.GET("/", userHandler.GetProfile)
 			account.PUT("/", userHandler.UpdateProfile)
+			account.PATCH("/password", authHandler.UserChangePassword)
 
 			account.GET("/address", userHandler.GetAllAddresses) // to show all address and // show countries
 			account.POST("/address", userHandler.SaveAddress)    // to add a new address
@@ -110,6 +148,13 @@ func UserRoutes(api *gin.RouterGroup, authHandler handlerInterface.AuthHandler,
 			{
 				coupons.GET("/", couponHandler.GetAllCouponsForUser)
 			}
+
+			// logged in devices
+			sessions := account.Group("/sessions")
+			{
+				sessions.GET("/", authHandler.UserGetAllSessions())
+				sessions.DELETE("/:session_id", authHandler.UserRevokeSession())
+			}
 		}
 
 		paymentMethod := api.Group("/payment-methods")
@@ -122,6 +167,8 @@ func UserRoutes(api *gin.RouterGroup, authHandler handlerInterface.AuthHandler,
 		{
 			orders.GET("/", orderHandler.GetUserOrder)                               // get all order list for user
 			orders.GET("/:shop_order_id/items", orderHandler.GetAllOrderItemsUser()) //get order items for specific order
+			orders.GET("/:shop_order_id/timeline", orderHandler.GetOrderTimelineUser())
+			orders.GET("/:shop_order_id/invoice", invoiceHandler.GetOrderInvoiceUser)
 
 			orders.POST("/return", orderHandler.SubmitReturnRequest)
 			orders.POST("/:shop_order_id/cancel", orderHandler.CancelOrder) // cancel an order
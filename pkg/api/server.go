@@ -1,13 +1,16 @@
 package http
 
 import (
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	_ "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/cmd/api/docs"
 	handlerInterface "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/interfaces"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/middleware"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/routes"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/config"
 	swaggerfiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 )
@@ -31,16 +34,25 @@ type ServerHTTP struct {
 // @In							header
 // @Description				Add prefix of Bearer before  token Ex: "Bearer token"
 // @Query.collection.format	multi
-func NewServerHTTP(authHandler handlerInterface.AuthHandler, middleware middleware.Middleware,
+func NewServerHTTP(cfg config.Config, authHandler handlerInterface.AuthHandler, middleware middleware.Middleware,
 	adminHandler handlerInterface.AdminHandler, userHandler handlerInterface.UserHandler,
 	cartHandler handlerInterface.CartHandler, paymentHandler handlerInterface.PaymentHandler,
 	productHandler handlerInterface.ProductHandler, orderHandler handlerInterface.OrderHandler,
 	couponHandler handlerInterface.CouponHandler, offerHandler handlerInterface.OfferHandler,
 	stockHandler handlerInterface.StockHandler, branHandler handlerInterface.BrandHandler,
-) *ServerHTTP {
+	reviewHandler handlerInterface.ReviewHandler, questionHandler handlerInterface.QuestionHandler,
+	shippingHandler handlerInterface.ShippingHandler, taxHandler handlerInterface.TaxHandler,
+	invoiceHandler handlerInterface.InvoiceHandler,
+) (*ServerHTTP, error) {
 
 	engine := gin.New()
 
+	// client ip is taken from the forwarded headers only when the request is from a trusted proxy
+	// (used for login attempts and rate limits so it should not trust the header of any client)
+	if err := engine.SetTrustedProxies(trustedProxies(cfg.TrustedProxies)); err != nil {
+		return nil, fmt.Errorf("failed to set trusted proxies: %w", err)
+	}
+
 	engine.LoadHTMLGlob("views/*.html")
 
 	engine.Use(gin.Logger())
@@ -50,9 +62,9 @@ func NewServerHTTP(authHandler handlerInterface.AuthHandler, middleware middlewa
 
 	// set up routes
 	routes.UserRoutes(engine.Group("/api"), authHandler, middleware, userHandler, cartHandler,
-		productHandler, paymentHandler, orderHandler, couponHandler)
+		productHandler, paymentHandler, orderHandler, couponHandler, reviewHandler, questionHandler, invoiceHandler)
 	routes.AdminRoutes(engine.Group("/api/admin"), authHandler, middleware, adminHandler,
-		productHandler, paymentHandler, orderHandler, couponHandler, offerHandler, stockHandler, branHandler)
+		productHandler, paymentHandler, orderHandler, couponHandler, offerHandler, stockHandler, branHandler, reviewHandler, questionHandler, shippingHandler, taxHandler, invoiceHandler)
 
 	// no handler
 	engine.NoRoute(func(ctx *gin.Context) {
@@ -61,7 +73,18 @@ func NewServerHTTP(authHandler handlerInterface.AuthHandler, middleware middlewa
 		})
 	})
 
-	return &ServerHTTP{Engine: engine}
+	return &ServerHTTP{Engine: engine}, nil
+}
+
+// nil trusts no proxy
+func trustedProxies(proxies []string) []string {
+	var trusted []string
+	for _, proxy := range proxies {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			trusted = append(trusted, proxy)
+		}
+	}
+	return trusted
 }
 
 func (s *ServerHTTP) Start() error {
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/go-playground/validator/v10"
 	"github.com/spf13/viper"
 )
@@ -22,9 +24,19 @@ type Config struct {
 	TwilioAuthToken  string `mapstructure:"AUTH_TOKEN"`
 	TwilioAccountSID string `mapstructure:"ACCOUNT_SID"`
 	TwilioServiceID  string `mapstructure:"SERVICE_SID"`
+	PhoneCountryCode string `mapstructure:"PHONE_COUNTRY_CODE"`
+
+	SmtpHost     string `mapstructure:"SMTP_HOST"`
+	SmtpPort     string `mapstructure:"SMTP_PORT"`
+	SmtpUserName string `mapstructure:"SMTP_USERNAME"`
+	SmtpPassword string `mapstructure:"SMTP_PASSWORD"`
+	SmtpFrom     string `mapstructure:"SMTP_FROM"`
+	// mails are only logged (without body) when smtp host is empty, only for development
+	MailDevLog bool `mapstructure:"MAIL_DEV_LOG"`
 
-	RazorPayKey    string `mapstructure:"RAZOR_PAY_KEY"`
-	RazorPaySecret string `mapstructure:"RAZOR_PAY_SECRET"`
+	RazorPayKey           string `mapstructure:"RAZOR_PAY_KEY"`
+	RazorPaySecret        string `mapstructure:"RAZOR_PAY_SECRET"`
+	RazorPayWebhookSecret string `mapstructure:"RAZOR_PAY_WEBHOOK_SECRET"`
 
 	StripSecretKey      string `mapstructure:"STRIPE_SECRET"`
 	StripPublishKey     string `mapstructure:"STRIPE_PUBLISH_KEY"`
@@ -38,6 +50,19 @@ type Config struct {
 	AwsSecretKey   string `mapstructure:"AWS_SECRET_ACCESS_KEY"`
 	AwsRegion      string `mapstructure:"AWS_REGION"`
 	AwsBucketName  string `mapstructure:"AWS_BUCKET_NAME"`
+
+	PaymentPendingOrderTTL time.Duration `mapstructure:"PAYMENT_PENDING_ORDER_TTL"`
+	OrderReaperInterval    time.Duration `mapstructure:"ORDER_REAPER_INTERVAL"`
+
+	NotifierType           string        `mapstructure:"NOTIFIER_TYPE"`
+	NotifierFilePath       string        `mapstructure:"NOTIFIER_FILE_PATH"`
+	LowStockDigestInterval time.Duration `mapstructure:"LOW_STOCK_DIGEST_INTERVAL"`
+
+	RateLimitStore string `mapstructure:"RATE_LIMIT_STORE"`
+	// comma separated ips or cidrs of proxies trusted to set the client ip header (empty means none)
+	TrustedProxies []string `mapstructure:"TRUSTED_PROXIES"`
+
+	SellerState string `mapstructure:"SELLER_STATE"`
 }
 
 // name of envs and used to read from system envs
@@ -45,11 +70,16 @@ var envsNames = []string{
 	"ADMIN_EMAIL", "ADMIN_USER_NAME", "ADMIN_PASSWORD",
 	"DB_HOST", "DB_NAME", "DB_USER", "DB_PASSWORD", "DB_PORT", // database
 	"ADMIN_AUTH_KEY", "USER_AUTH_KEY", // token auth
-	"AUTH_TOKEN", "ACCOUNT_SID", "SERVICE_SID", // twilio
-	"RAZOR_PAY_KEY", "RAZOR_PAY_SECRET", // razor pay
+	"AUTH_TOKEN", "ACCOUNT_SID", "SERVICE_SID", "PHONE_COUNTRY_CODE", // twilio
+	"SMTP_HOST", "SMTP_PORT", "SMTP_USERNAME", "SMTP_PASSWORD", "SMTP_FROM", "MAIL_DEV_LOG", // smtp mail
+	"RAZOR_PAY_KEY", "RAZOR_PAY_SECRET", "RAZOR_PAY_WEBHOOK_SECRET", // razor pay
 	"STRIPE_SECRET", "STRIPE_PUBLISH_KEY", "STRIPE_WEBHOOK", // stripe
 	"GOAUTH_CLIENT_ID", "GOAUTH_CLIENT_SECRET", "GOAUTH_CALL_BACK_URL", //goath
 	"AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "AWS_REGION", "AWS_BUCKET_NAME", // aws s3
+	"PAYMENT_PENDING_ORDER_TTL", "ORDER_REAPER_INTERVAL", // order reaper
+	"NOTIFIER_TYPE", "NOTIFIER_FILE_PATH", "LOW_STOCK_DIGEST_INTERVAL", // low stock notifier
+	"RATE_LIMIT_STORE", "TRUSTED_PROXIES", // rate limit
+	"SELLER_STATE", // gst
 }
 
 func LoadConfig() (config Config, err error) {
@@ -28,7 +28,9 @@ func ConnectDatabase(cfg config.Config) (*gorm.DB, error) {
 
 		//auth
 		domain.RefreshSession{},
+		domain.RevokedToken{},
 		domain.OtpSession{},
+		domain.LoginAttempt{},
 		//user
 		domain.User{},
 		domain.Country{},
@@ -36,7 +38,12 @@ func ConnectDatabase(cfg config.Config) (*gorm.DB, error) {
 		domain.UserAddress{},
 
 		//admin
+		domain.Role{},
+		domain.Permission{},
+		domain.RolePermission{},
 		domain.Admin{},
+		domain.AdminAuditLog{},
+		domain.StaffPasswordToken{},
 
 		//product
 		domain.Category{},
@@ -47,6 +54,28 @@ func ConnectDatabase(cfg config.Config) (*gorm.DB, error) {
 		domain.ProductConfiguration{},
 		domain.ProductImage{},
 
+		// review
+		domain.ProductReview{},
+		domain.ProductReviewImage{},
+
+		// question and answer
+		domain.ProductQuestion{},
+		domain.ProductAnswer{},
+		domain.ProductAnswerUpvote{},
+
+		// shipping
+		domain.ShippingZone{},
+
+		// tax
+		domain.TaxClass{},
+
+		// invoice
+		domain.Invoice{},
+		domain.InvoiceCounter{},
+
+		// stock
+		domain.StockMovement{},
+
 		// wish list
 		domain.WishList{},
 
@@ -56,9 +85,13 @@ func ConnectDatabase(cfg config.Config) (*gorm.DB, error) {
 
 		// order
 		domain.OrderStatus{},
+		domain.OrderStatusTransition{},
 		domain.ShopOrder{},
 		domain.OrderLine{},
 		domain.OrderReturn{},
+		domain.OrderCancellation{},
+		domain.ShopOrderStatusHistory{},
+		domain.PaymentEvent{},
 
 		//offer
 		domain.Offer{},
@@ -85,6 +118,9 @@ func ConnectDatabase(cfg config.Config) (*gorm.DB, error) {
 		return nil, err
 	}
 
+	if err := saveRolesAndPermissions(db); err != nil {
+		return nil, err
+	}
 	if err := saveAdmin(db, cfg.AdminEmail, cfg.AdminUserName, cfg.AdminPassword); err != nil {
 		return nil, err
 	}
@@ -92,6 +128,9 @@ func ConnectDatabase(cfg config.Config) (*gorm.DB, error) {
 	if err := saveOrderStatuses(db); err != nil {
 		return nil, err
 	}
+	if err := saveOrderStatusTransitions(db); err != nil {
+		return nil, err
+	}
 	if err := savePaymentMethods(db); err != nil {
 		return nil, err
 	}
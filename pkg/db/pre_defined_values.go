@@ -15,6 +15,9 @@ func saveOrderStatuses(db *gorm.DB) error {
 	statuses := []domain.OrderStatusType{
 		domain.StatusPaymentPending,
 		domain.StatusOrderPlaced,
+		domain.StatusOrderPacked,
+		domain.StatusOrderShipped,
+		domain.StatusOutForDelivery,
 		domain.StatusOrderCancelled,
 		domain.StatusOrderDelivered,
 		domain.StatusReturnRequested,
@@ -49,6 +52,26 @@ func saveOrderStatuses(db *gorm.DB) error {
 	return nil
 }
 
+// To save the declared order status transitions on database if its not exist
+func saveOrderStatusTransitions(db *gorm.DB) error {
+
+	insertQuery := `INSERT INTO order_status_transitions (from_status_id, to_status_id) 
+	VALUES (get_order_status_id($1), get_order_status_id($2)) 
+	ON CONFLICT (from_status_id, to_status_id) DO NOTHING`
+
+	return db.Transaction(func(trx *gorm.DB) error {
+
+		for fromStatus, toStatuses := range domain.OrderStatusTransitions {
+			for _, toStatus := range toStatuses {
+				if err := trx.Exec(insertQuery, fromStatus, toStatus).Error; err != nil {
+					return fmt.Errorf("failed to save order status transition %w", err)
+				}
+			}
+		}
+		return nil
+	})
+}
+
 // To save predefined payment methods on database if its not exist
 func savePaymentMethods(db *gorm.DB) error {
 	paymentMethods := []domain.PaymentMethod{
@@ -64,6 +87,10 @@ func savePaymentMethods(db *gorm.DB) error {
 			Name:          domain.StripePayment,
 			MaximumAmount: domain.StripeMaximumAmount,
 		},
+		{
+			Name:          domain.WalletPayment,
+			MaximumAmount: domain.WalletMaximumAmount,
+		},
 	}
 
 	var (
@@ -90,13 +117,55 @@ func savePaymentMethods(db *gorm.DB) error {
 	return nil
 }
 
+// To save predefined roles and permissions on database if its not exist
+// only the missing permissions of roles are added (permissions given on database are kept)
+func saveRolesAndPermissions(db *gorm.DB) error {
+
+	var (
+		permissionInsertQuery = `INSERT INTO permissions (name) VALUES ($1) ON CONFLICT (name) DO NOTHING`
+		roleInsertQuery       = `INSERT INTO roles (name) VALUES ($1) ON CONFLICT (name) DO NOTHING`
+		rolePermissionQuery   = `INSERT INTO role_permissions (role_id, permission_id) 
+		VALUES ((SELECT id FROM roles WHERE name = $1), (SELECT id FROM permissions WHERE name = $2)) 
+		ON CONFLICT (role_id, permission_id) DO NOTHING`
+	)
+
+	return db.Transaction(func(trx *gorm.DB) error {
+
+		// all permissions are given to super admin
+		for _, permission := range domain.RolePermissions[domain.RoleSuperAdmin] {
+			if err := trx.Exec(permissionInsertQuery, permission).Error; err != nil {
+				return fmt.Errorf("failed to save permission %w", err)
+			}
+		}
+
+		for role, permissions := range domain.RolePermissions {
+			if err := trx.Exec(roleInsertQuery, role).Error; err != nil {
+				return fmt.Errorf("failed to save role %w", err)
+			}
+			for _, permission := range permissions {
+				if err := trx.Exec(rolePermissionQuery, role, permission).Error; err != nil {
+					return fmt.Errorf("failed to save role permission %w", err)
+				}
+			}
+		}
+
+		// admins saved before roles are super admins
+		updateQuery := `UPDATE admins SET role_id = (SELECT id FROM roles WHERE name = $1) WHERE role_id IS NULL`
+		if err := trx.Exec(updateQuery, domain.RoleSuperAdmin).Error; err != nil {
+			return fmt.Errorf("failed to update role of admins %w", err)
+		}
+		return nil
+	})
+}
+
 func saveAdmin(db *gorm.DB, email, userName, password string) error {
 
 	var (
 		searchQuery = `SELECT CASE WHEN id != 0 THEN 'T' ELSE 'F' END as exist FROM admins WHERE email = $1`
-		insertQuery = `INSERT INTO admins (email, user_name, password, created_at) VALUES ($1, $2, $3, $4)`
-		exist       bool
-		err         error
+		insertQuery = `INSERT INTO admins (email, user_name, password, created_at, role_id) 
+		VALUES ($1, $2, $3, $4, (SELECT id FROM roles WHERE name = $5))`
+		exist bool
+		err   error
 	)
 
 	err = db.Raw(searchQuery, email).Scan(&exist).Error
@@ -110,7 +179,7 @@ func saveAdmin(db *gorm.DB, email, userName, password string) error {
 			return fmt.Errorf("failed to hash password err: %w", err)
 		}
 		createdAt := time.Now()
-		err = db.Exec(insertQuery, email, userName, hashPass, createdAt).Error
+		err = db.Exec(insertQuery, email, userName, hashPass, createdAt, domain.RoleSuperAdmin).Error
 		if err != nil {
 			return fmt.Errorf("failed to save admin details %w", err)
 		}
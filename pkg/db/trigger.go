@@ -19,26 +19,23 @@ func SetUpDBTriggers(db *gorm.DB) error {
 		return errors.New("failed to create trigger for update total price on cart")
 	}
 
-	// update product_item qty on order time
-	if db.Exec(orderProductUpdateOnPlaceOrder).Error != nil {
-		return errors.New("failed to execute orderProductUpdate() trigger function")
+	if db.Exec(orderStatusFindFunc).Error != nil {
+		return errors.New("failed to create orderStatusFindFunc function for return order_status")
 	}
 
-	if db.Exec(orderProductUpdateOnPlaceOrderTriggerExec).Error != nil {
-		return errors.New("failed to create orderProductUpdateTriggerExec trigger")
+	// stock of product items are changed with stock movements so drop the old stock update triggers
+	if db.Exec(dropStockUpdateTriggers).Error != nil {
+		return errors.New("failed to drop product_item qty update triggers")
 	}
 
-	//update product_item qty on order returned
-	if db.Exec(orderReturnProductUpdate).Error != nil {
-		return errors.New("failed to create orderReturnProductUpdate() trigger function")
+	// index for full text search on products
+	if db.Exec(productSearchIndex).Error != nil {
+		return errors.New("failed to create full text search index for products")
 	}
 
-	if db.Exec(orderStatusFindFunc).Error != nil {
-		return errors.New("failed to create orderStatusFindFunc function for return order_status")
-	}
-
-	if db.Exec(orderReturnProductUpdateExec).Error != nil {
-		return errors.New("failed to create orderReturnProductUpdateExec trigger")
+	// counter row which gives the invoice numbers (started from the numbers already given to invoices)
+	if db.Exec(invoiceCounterInsert).Error != nil {
+		return errors.New("failed to save invoice counter")
 	}
 
 	log.Printf("successfully triggers updated for database")
@@ -46,6 +43,16 @@ func SetUpDBTriggers(db *gorm.DB) error {
 }
 
 var (
+	// gin index for full text search of products on its name and description
+	productSearchIndex = `CREATE INDEX IF NOT EXISTS idx_products_search ON products 
+	USING GIN (to_tsvector('english', name || ' ' || description))`
+
+	// the sequence used before the counter is dropped (a sequence can't give numbers without gaps)
+	invoiceCounterInsert = `INSERT INTO invoice_counters (id, last) 
+	SELECT 1, COALESCE(MAX(invoice_number), 0) FROM invoices 
+	ON CONFLICT (id) DO NOTHING;
+	DROP SEQUENCE IF EXISTS invoice_number_seq;`
+
 	// function which return total price calculation on cart when product_item added or remove delete cart
 	// in here checking  first it delete any row from cart_item then take its cart_id an find all cart_items with this id and calculate total price and update it
 	// cart with this cart_id
@@ -81,53 +88,15 @@ var (
 	AFTER INSERT OR UPDATE OR DELETE ON cart_items
 	FOR EACH ROW EXECUTE FUNCTION update_cart_total_price();`
 
-	//for updating product_item quantity when order place
-	orderProductUpdateOnPlaceOrder = `CREATE OR REPLACE FUNCTION update_product_quantity() 
-	RETURNS TRIGGER AS $$ 
-	BEGIN 
-		IF (TG_OP = 'INSERT') THEN 
-			UPDATE product_items pi 
-			SET qty_in_stock = pi.qty_in_stock - NEW.qty 
-			WHERE pi.id = NEW.product_item_id; 
-	
-		END IF; 
-		RETURN NEW; 
-	END; 
-	$$ LANGUAGE plpgsql;`
-
-	orderProductUpdateOnPlaceOrderTriggerExec = `CREATE OR REPLACE TRIGGER update_product_quantity 
-	AFTER INSERT ON order_lines 
-	FOR EACH ROW EXECUTE FUNCTION update_product_quantity();`
-
-	//for order reuturn time product_item quantity update
-	orderReturnProductUpdate = `CREATE OR REPLACE FUNCTION update_product_quantity_on_return()
-	RETURNS TRIGGER AS $$
-	BEGIN
-	  IF (TG_OP = 'UPDATE') THEN 
-		EXECUTE format('UPDATE product_items pi
-						SET qty_in_stock = qty_in_stock + ol.qty
-						FROM %I ol
-						WHERE pi.id = ol.product_item_id
-						AND ol.shop_order_id = $1.id',
-						'order_lines')
-		USING NEW;
-	  
-		RETURN NEW;
-	  ELSE
-		RETURN NULL;
-	  END IF;
-	END;
-	$$ LANGUAGE plpgsql;`
+	// product_item qty is changed along with stock_movements from application so these old triggers not needed
+	dropStockUpdateTriggers = `DROP TRIGGER IF EXISTS update_product_quantity ON order_lines;
+	DROP TRIGGER IF EXISTS update_product_qty_on_order_return ON shop_orders;
+	DROP FUNCTION IF EXISTS update_product_quantity();
+	DROP FUNCTION IF EXISTS update_product_quantity_on_return();`
 
 	orderStatusFindFunc = `CREATE OR REPLACE FUNCTION get_order_status_id(status_name text)
 	RETURNS integer
 	AS $$
 	SELECT id FROM order_statuses WHERE status = status_name;
 	$$ LANGUAGE SQL;`
-
-	orderReturnProductUpdateExec = `CREATE OR REPLACE TRIGGER update_product_qty_on_order_return 
-	AFTER UPDATE OF order_status_id ON shop_orders
-	FOR EACH ROW 
-	WHEN (NEW.order_status_id =  get_order_status_id('order returned'))
-	EXECUTE FUNCTION update_product_quantity_on_return();`
 )
@@ -0,0 +1,21 @@
+package di
+
+import (
+	http "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/worker"
+)
+
+// App is the api server with the background workers sharing the same database connection
+type App struct {
+	Server         *http.ServerHTTP
+	OrderReaper    *worker.OrderReaper
+	LowStockDigest *worker.LowStockDigest
+}
+
+func NewApp(server *http.ServerHTTP, orderReaper *worker.OrderReaper, lowStockDigest *worker.LowStockDigest) *App {
+	return &App{
+		Server:         server,
+		OrderReaper:    orderReaper,
+		LowStockDigest: lowStockDigest,
+	}
+}
@@ -12,18 +12,29 @@ import (
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/db"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/cloud"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/mail"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/notification"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/otp"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/payment"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/ratelimit"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/tax"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/token"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/worker"
 )
 
-func InitializeApi(cfg config.Config) (*http.ServerHTTP, error) {
+func InitializeApi(cfg config.Config) (*App, error) {
 
 	wire.Build(db.ConnectDatabase,
 		//external
 		token.NewTokenService,
-		otp.NewOtpAuth,
+		mail.NewSender,
+		otp.NewOtpChannels,
 		cloud.NewAWSCloudService,
+		payment.NewPaymentGateways,
+		notification.NewNotifier,
+		ratelimit.NewStore,
+		tax.NewEngine,
 
 		// repository
 
@@ -39,6 +50,11 @@ func InitializeApi(cfg config.Config) (*http.ServerHTTP, error) {
 		repository.NewOfferRepository,
 		repository.NewStockRepository,
 		repository.NewBrandDatabaseRepository,
+		repository.NewReviewRepository,
+		repository.NewQuestionRepository,
+		repository.NewShippingRepository,
+		repository.NewTaxRepository,
+		repository.NewInvoiceRepository,
 
 		//usecase
 		usecase.NewAuthUseCase,
@@ -52,6 +68,11 @@ func InitializeApi(cfg config.Config) (*http.ServerHTTP, error) {
 		usecase.NewOfferUseCase,
 		usecase.NewStockUseCase,
 		usecase.NewBrandUseCase,
+		usecase.NewReviewUseCase,
+		usecase.NewQuestionUseCase,
+		usecase.NewShippingUseCase,
+		usecase.NewTaxUseCase,
+		usecase.NewInvoiceUseCase,
 		// handler
 		handler.NewAuthHandler,
 		handler.NewAdminHandler,
@@ -64,9 +85,19 @@ func InitializeApi(cfg config.Config) (*http.ServerHTTP, error) {
 		handler.NewOfferHandler,
 		handler.NewStockHandler,
 		handler.NewBrandHandler,
+		handler.NewReviewHandler,
+		handler.NewQuestionHandler,
+		handler.NewShippingHandler,
+		handler.NewTaxHandler,
+		handler.NewInvoiceHandler,
 
 		http.NewServerHTTP,
+		// worker
+		worker.NewOrderReaper,
+		worker.NewLowStockDigest,
+
+		NewApp,
 	)
 
-	return &http.ServerHTTP{}, nil
+	return &App{}, nil
 }
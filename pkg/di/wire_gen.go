@@ -14,14 +14,20 @@ import (
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/db"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/cloud"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/mail"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/notification"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/otp"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/payment"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/ratelimit"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/tax"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/token"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/worker"
 )
 
 // Injectors from wire.go:
 
-func InitializeApi(cfg config.Config) (*http.ServerHTTP, error) {
+func InitializeApi(cfg config.Config) (*App, error) {
 	gormDB, err := db.ConnectDatabase(cfg)
 	if err != nil {
 		return nil, err
@@ -30,11 +36,19 @@ func InitializeApi(cfg config.Config) (*http.ServerHTTP, error) {
 	tokenService := token.NewTokenService(cfg)
 	userRepository := repository.NewUserRepository(gormDB)
 	adminRepository := repository.NewAdminRepository(gormDB)
-	otpAuth := otp.NewOtpAuth(cfg)
-	authUseCase := usecase.NewAuthUseCase(authRepository, tokenService, userRepository, adminRepository, otpAuth)
+	sender, err := mail.NewSender(cfg)
+	if err != nil {
+		return nil, err
+	}
+	channels := otp.NewOtpChannels(cfg, sender)
+	authUseCase := usecase.NewAuthUseCase(authRepository, tokenService, userRepository, adminRepository, channels)
 	authHandler := handler.NewAuthHandler(authUseCase, cfg)
-	middlewareMiddleware := middleware.NewMiddleware(tokenService)
-	adminUseCase := usecase.NewAdminUseCase(adminRepository, userRepository)
+	adminUseCase := usecase.NewAdminUseCase(adminRepository, userRepository, authRepository, sender)
+	store, err := ratelimit.NewStore(cfg)
+	if err != nil {
+		return nil, err
+	}
+	middlewareMiddleware := middleware.NewMiddleware(tokenService, authUseCase, adminUseCase, store)
 	adminHandler := handler.NewAdminHandler(adminUseCase)
 	cartRepository := repository.NewCartRepository(gormDB)
 	productRepository := repository.NewProductRepository(gormDB)
@@ -45,7 +59,13 @@ func InitializeApi(cfg config.Config) (*http.ServerHTTP, error) {
 	paymentRepository := repository.NewPaymentRepository(gormDB)
 	orderRepository := repository.NewOrderRepository(gormDB)
 	couponRepository := repository.NewCouponRepository(gormDB)
-	paymentUseCase := usecase.NewPaymentUseCase(paymentRepository, orderRepository, userRepository, cartRepository, couponRepository, cfg)
+	stockRepository := repository.NewStockRepository(gormDB)
+	gateways := payment.NewPaymentGateways(cfg)
+	notifier, err := notification.NewNotifier(cfg)
+	if err != nil {
+		return nil, err
+	}
+	paymentUseCase := usecase.NewPaymentUseCase(paymentRepository, orderRepository, userRepository, stockRepository, gateways, notifier, sender)
 	paymentHandler := handler.NewPaymentHandler(paymentUseCase)
 	cloudService, err := cloud.NewAWSCloudService(cfg)
 	if err != nil {
@@ -53,19 +73,43 @@ func InitializeApi(cfg config.Config) (*http.ServerHTTP, error) {
 	}
 	productUseCase := usecase.NewProductUseCase(productRepository, cloudService)
 	productHandler := handler.NewProductHandler(productUseCase)
-	orderUseCase := usecase.NewOrderUseCase(orderRepository, cartRepository, userRepository, paymentRepository)
+	shippingRepository := repository.NewShippingRepository(gormDB)
+	taxRepository := repository.NewTaxRepository(gormDB)
+	engine, err := tax.NewEngine(cfg)
+	if err != nil {
+		return nil, err
+	}
+	orderUseCase := usecase.NewOrderUseCase(orderRepository, cartRepository, userRepository, paymentRepository, shippingRepository, taxRepository, engine, gateways)
 	orderHandler := handler.NewOrderHandler(orderUseCase)
 	couponUseCase := usecase.NewCouponUseCase(couponRepository, cartRepository)
 	couponHandler := handler.NewCouponHandler(couponUseCase)
 	offerRepository := repository.NewOfferRepository(gormDB)
 	offerUseCase := usecase.NewOfferUseCase(offerRepository)
 	offerHandler := handler.NewOfferHandler(offerUseCase)
-	stockRepository := repository.NewStockRepository(gormDB)
-	stockUseCase := usecase.NewStockUseCase(stockRepository)
+	stockUseCase := usecase.NewStockUseCase(stockRepository, notifier)
 	stockHandler := handler.NewStockHandler(stockUseCase)
 	brandRepository := repository.NewBrandDatabaseRepository(gormDB)
 	brandUseCase := usecase.NewBrandUseCase(brandRepository)
 	brandHandler := handler.NewBrandHandler(brandUseCase)
-	serverHTTP := http.NewServerHTTP(authHandler, middlewareMiddleware, adminHandler, userHandler, cartHandler, paymentHandler, productHandler, orderHandler, couponHandler, offerHandler, stockHandler, brandHandler)
-	return serverHTTP, nil
+	reviewRepository := repository.NewReviewRepository(gormDB)
+	reviewUseCase := usecase.NewReviewUseCase(reviewRepository, cloudService)
+	reviewHandler := handler.NewReviewHandler(reviewUseCase)
+	questionRepository := repository.NewQuestionRepository(gormDB)
+	questionUseCase := usecase.NewQuestionUseCase(questionRepository, productRepository, reviewRepository, userRepository, sender)
+	questionHandler := handler.NewQuestionHandler(questionUseCase)
+	shippingUseCase := usecase.NewShippingUseCase(shippingRepository)
+	shippingHandler := handler.NewShippingHandler(shippingUseCase)
+	taxUseCase := usecase.NewTaxUseCase(taxRepository)
+	taxHandler := handler.NewTaxHandler(taxUseCase)
+	invoiceRepository := repository.NewInvoiceRepository(gormDB)
+	invoiceUseCase := usecase.NewInvoiceUseCase(invoiceRepository, orderRepository, cloudService)
+	invoiceHandler := handler.NewInvoiceHandler(invoiceUseCase)
+	serverHTTP, err := http.NewServerHTTP(cfg, authHandler, middlewareMiddleware, adminHandler, userHandler, cartHandler, paymentHandler, productHandler, orderHandler, couponHandler, offerHandler, stockHandler, brandHandler, reviewHandler, questionHandler, shippingHandler, taxHandler, invoiceHandler)
+	if err != nil {
+		return nil, err
+	}
+	orderReaper := worker.NewOrderReaper(orderUseCase, cfg)
+	lowStockDigest := worker.NewLowStockDigest(stockUseCase, cfg)
+	app := NewApp(serverHTTP, orderReaper, lowStockDigest)
+	return app, nil
 }
@@ -3,10 +3,94 @@ package domain
 import "time"
 
 type Admin struct {
+	ID          uint      `json:"id" gorm:"primaryKey;not null"`
+	UserName    string    `json:"user_name" gorm:"not null" binding:"required,min=3,max=15"`
+	Email       string    `json:"email" gorm:"not null" binding:"required,email"`
+	Password    string    `json:"password" gorm:"not null" binding:"required,min=5,max=30"`
+	RoleID      uint      `json:"role_id" gorm:"default:null"`
+	Role        Role      `json:"-"`
+	BlockStatus bool      `json:"block_status" gorm:"not null;default:false"`
+	CreatedAt   time.Time `json:"created_at" gorm:"not null"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// single use token sent to invited staff to set their own password
+// only the hash of the token is stored
+type StaffPasswordToken struct {
 	ID        uint      `json:"id" gorm:"primaryKey;not null"`
-	UserName  string    `json:"user_name" gorm:"not null" binding:"required,min=3,max=15"`
-	Email     string    `json:"email" gorm:"not null" binding:"required,email"`
-	Password  string    `json:"password" gorm:"not null" binding:"required,min=5,max=30"`
+	AdminID   uint      `json:"admin_id" gorm:"not null;index"`
+	Admin     Admin     `json:"-" gorm:"constraint:OnDelete:CASCADE"`
+	TokenHash string    `json:"-" gorm:"not null;unique"`
+	Used      bool      `json:"used" gorm:"not null;default:false"`
+	ExpireAt  time.Time `json:"expire_at" gorm:"not null"`
 	CreatedAt time.Time `json:"created_at" gorm:"not null"`
-	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// admin staff permissions (each route group of admin is allowed by one permission)
+const (
+	PermissionManageStaff      = "manage_staff"
+	PermissionManageUsers      = "manage_users"
+	PermissionManageCatalog    = "manage_catalog"
+	PermissionManageStock      = "manage_stock"
+	PermissionManageOrders     = "manage_orders"
+	PermissionManagePayments   = "manage_payments"
+	PermissionManagePromotions = "manage_promotions"
+	PermissionViewReports      = "view_reports"
+	PermissionViewAuditLogs    = "view_audit_logs"
+)
+
+// admin staff roles
+const (
+	RoleSuperAdmin     = "super admin"
+	RoleCatalogManager = "catalog manager"
+	RoleOrderManager   = "order manager"
+	RoleSupport        = "support"
+	RoleFinance        = "finance"
+)
+
+// permissions of each role
+// the missing permissions of this table are seeded on database on startup (existing ones are not removed)
+var RolePermissions = map[string][]string{
+	RoleSuperAdmin: {
+		PermissionManageStaff, PermissionManageUsers, PermissionManageCatalog, PermissionManageStock,
+		PermissionManageOrders, PermissionManagePayments, PermissionManagePromotions, PermissionViewReports,
+		PermissionViewAuditLogs,
+	},
+	RoleCatalogManager: {PermissionManageCatalog, PermissionManageStock, PermissionManagePromotions},
+	RoleOrderManager:   {PermissionManageOrders, PermissionManageStock},
+	RoleSupport:        {PermissionManageUsers, PermissionManageOrders},
+	RoleFinance:        {PermissionViewReports, PermissionManagePayments},
+}
+
+type Role struct {
+	ID   uint   `json:"id" gorm:"primaryKey;not null"`
+	Name string `json:"name" gorm:"unique;not null"`
+}
+
+type Permission struct {
+	ID   uint   `json:"id" gorm:"primaryKey;not null"`
+	Name string `json:"name" gorm:"unique;not null"`
+}
+
+type RolePermission struct {
+	ID           uint       `json:"id" gorm:"primaryKey;not null"`
+	RoleID       uint       `json:"role_id" gorm:"not null;uniqueIndex:idx_role_permission"`
+	Role         Role       `json:"-"`
+	PermissionID uint       `json:"permission_id" gorm:"not null;uniqueIndex:idx_role_permission"`
+	Permission   Permission `json:"-"`
+}
+
+// record of a mutating (non GET) request done by an admin
+type AdminAuditLog struct {
+	ID         uint      `json:"id" gorm:"primaryKey;not null"`
+	AdminID    uint      `json:"admin_id" gorm:"not null;index"`
+	Method     string    `json:"method" gorm:"not null"`
+	Route      string    `json:"route" gorm:"not null"` // route pattern of the request (eg: /api/admin/staff/:admin_id)
+	Path       string    `json:"path" gorm:"not null"`  // actual path with query of the request
+	Payload    string    `json:"payload"`               // fields changed by the request with its values (secrets masked)
+	ClientIP   string    `json:"client_ip"`
+	StatusCode int       `json:"status_code" gorm:"not null"`
+	Success    bool      `json:"success" gorm:"not null"`
+	Message    string    `json:"message"` // message or error of the response
+	CreatedAt  time.Time `json:"created_at" gorm:"not null;index"`
 }
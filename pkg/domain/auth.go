@@ -10,12 +10,46 @@ type RefreshSession struct {
 	RefreshToken string    `json:"refresh_token" gorm:"not null"`
 	ExpireAt     time.Time `json:"expire_at" gorm:"not null"`
 	IsBlocked    bool      `json:"is_blocked" gorm:"not null;default:false"`
+	IsUsed       bool      `json:"is_used" gorm:"not null;default:false"` // rotated to a new refresh token
+	FamilyID     string    `json:"family_id" gorm:"not null;default:''"`  // token id of the first session of the login
+	UsedFor      string    `json:"used_for" gorm:"not null;default:'user'"`
+	UserAgent    string    `json:"user_agent"`
+	ClientIP     string    `json:"client_ip"`
+	CreatedAt    time.Time `json:"created_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
 }
 
-type OtpSession struct {
-	ID       uint      `json:"id" gorm:"primaryKey;not null"`
-	OtpID    string    `json:"otp_id" gorm:"unique;not null"`
-	UserID   uint      `json:"user_id" gorm:"not null"`
-	Phone    string    `json:"phone" gorm:"not null"`
+// revoked access tokens (by token id or session id) kept until the access token expires
+type RevokedToken struct {
+	TokenID  string    `json:"token_id" gorm:"primaryKey;not null"`
 	ExpireAt time.Time `json:"expire_at" gorm:"not null"`
 }
+
+// purpose the otp is sent for, otp sent for a purpose can't use for another
+type OtpPurpose string
+
+const (
+	OtpPurposeLogin         OtpPurpose = "login"
+	OtpPurposeSignUp        OtpPurpose = "sign_up"
+	OtpPurposeResetPassword OtpPurpose = "reset_password"
+)
+
+type OtpSession struct {
+	ID        uint       `json:"id" gorm:"primaryKey;not null"`
+	OtpID     string     `json:"otp_id" gorm:"unique;not null"`
+	UserID    uint       `json:"user_id" gorm:"not null"`
+	Phone     string     `json:"phone" gorm:"not null;index"`
+	Channel   string     `json:"channel" gorm:"not null;default:'sms'"`
+	Recipient string     `json:"recipient" gorm:"not null;default:''"` // phone number or email the otp sent to
+	Purpose   OtpPurpose `json:"purpose" gorm:"not null;default:'login'"`
+	VerifyKey string     `json:"-" gorm:"not null;default:''"` // key to verify the otp of the session (cleared once verified)
+	ExpireAt  time.Time  `json:"expire_at" gorm:"not null"`
+	CreatedAt time.Time  `json:"created_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
+}
+
+// failed login attempts of an account or ip (key is the user type with account id or ip)
+type LoginAttempt struct {
+	Key          string    `json:"key" gorm:"primaryKey;not null"`
+	FailedCount  uint      `json:"failed_count" gorm:"not null"`
+	LastFailedAt time.Time `json:"last_failed_at" gorm:"not null"`
+	LockedUntil  time.Time `json:"locked_until" gorm:"not null"`
+}
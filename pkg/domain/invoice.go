@@ -0,0 +1,20 @@
+package domain
+
+import "time"
+
+// invoice of a placed shop order with its sequential invoice number
+type Invoice struct {
+	ID            uint      `json:"id" gorm:"primaryKey;not null"`
+	ShopOrderID   uint      `json:"shop_order_id" gorm:"not null;unique"`
+	ShopOrder     ShopOrder `json:"-"`
+	InvoiceNumber uint      `json:"invoice_number" gorm:"not null;unique"`
+	FileKey       string    `json:"-"` // upload id of the invoice pdf when it's stored on cloud
+	CreatedAt     time.Time `json:"created_at" gorm:"not null"`
+}
+
+// single row counter which gives the invoice numbers without gaps
+// the counter is increased on the transaction the invoice is saved so a rolled back invoice not take a number
+type InvoiceCounter struct {
+	ID   uint `json:"id" gorm:"primaryKey;not null"`
+	Last uint `json:"last" gorm:"not null;default:0"`
+}
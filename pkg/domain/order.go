@@ -14,6 +14,9 @@ const (
 	// order status
 	StatusPaymentPending  OrderStatusType = "payment pending"
 	StatusOrderPlaced     OrderStatusType = "order placed"
+	StatusOrderPacked     OrderStatusType = "order packed"
+	StatusOrderShipped    OrderStatusType = "order shipped"
+	StatusOutForDelivery  OrderStatusType = "out for delivery"
 	StatusOrderCancelled  OrderStatusType = "order cancelled"
 	StatusOrderDelivered  OrderStatusType = "order delivered"
 	StatusReturnRequested OrderStatusType = "return requested"
@@ -28,6 +31,8 @@ const (
 	CodMaximumAmount                  = 20000
 	StripePayment         PaymentType = "stripe"
 	StripeMaximumAmount               = 50000
+	WalletPayment         PaymentType = "wallet"
+	WalletMaximumAmount               = 100000
 )
 
 type PaymentMethod struct {
@@ -37,10 +42,55 @@ type PaymentMethod struct {
 	MaximumAmount uint        `json:"maximum_amount" gorm:"not null"`
 }
 
+// raw webhook event received from payment gateway (saved for replay)
+type PaymentEvent struct {
+	ID             uint        `json:"id" gorm:"primaryKey;not null"`
+	Gateway        PaymentType `json:"gateway" gorm:"not null;uniqueIndex:idx_payment_event"`
+	EventID        string      `json:"event_id" gorm:"not null;uniqueIndex:idx_payment_event"`
+	EventType      string      `json:"event_type" gorm:"not null"`
+	GatewayOrderID string      `json:"gateway_order_id"`
+	Amount         uint        `json:"amount" gorm:"not null;default:0"` // captured or refunded amount of event
+	Payload        string      `json:"payload" gorm:"not null"`
+	Processed      bool        `json:"processed" gorm:"not null;default:false"`
+	Error          string      `json:"error"`
+	ReceivedAt     time.Time   `json:"received_at" gorm:"not null"`
+	ProcessedAt    time.Time   `json:"processed_at"`
+}
+
+// allowed order status transitions (from status to its next statuses)
+// this table is saved on database on startup and all status changes are validated with it
+var OrderStatusTransitions = map[OrderStatusType][]OrderStatusType{
+	StatusPaymentPending:  {StatusOrderPlaced, StatusOrderCancelled},
+	StatusOrderPlaced:     {StatusOrderPacked, StatusOrderCancelled},
+	StatusOrderPacked:     {StatusOrderShipped, StatusOrderCancelled},
+	StatusOrderShipped:    {StatusOutForDelivery},
+	StatusOutForDelivery:  {StatusOrderDelivered},
+	StatusOrderDelivered:  {StatusReturnRequested},
+	StatusReturnRequested: {StatusReturnApproved, StatusReturnCancelled},
+	StatusReturnApproved:  {StatusOrderReturned},
+}
+
+// who changed the order status
+type ActorType string
+
+const (
+	ActorUser   ActorType = "user"
+	ActorAdmin  ActorType = "admin"
+	ActorSystem ActorType = "system"
+)
+
 type OrderStatus struct {
 	ID     uint            `json:"id" gorm:"primaryKey;not null"`
 	Status OrderStatusType `json:"status" gorm:"unique;not null"`
 }
+
+type OrderStatusTransition struct {
+	ID           uint        `json:"id" gorm:"primaryKey;not null"`
+	FromStatusID uint        `json:"from_status_id" gorm:"not null;uniqueIndex:idx_order_status_transition"`
+	FromStatus   OrderStatus `json:"-" gorm:"foreignKey:FromStatusID"`
+	ToStatusID   uint        `json:"to_status_id" gorm:"not null;uniqueIndex:idx_order_status_transition"`
+	ToStatus     OrderStatus `json:"-" gorm:"foreignKey:ToStatusID"`
+}
 type ShopOrder struct {
 	ID              uint          `json:"shop_order_id" gorm:"primaryKey;not null"`
 	UserID          uint          `json:"user_id" gorm:"not null"`
@@ -50,10 +100,14 @@ type ShopOrder struct {
 	Address         Address       `json:"-"`
 	OrderTotalPrice uint          `json:"order_total_price" gorm:"not null"`
 	Discount        uint          `json:"discount" gorm:"not null"`
+	DeliveryCharge  uint          `json:"delivery_charge" gorm:"not null;default:0"` // included on order total price
 	OrderStatusID   uint          `json:"order_status_id" gorm:"not null"`
 	OrderStatus     OrderStatus   `json:"-"`
 	PaymentMethodID uint          `json:"payment_method_id"`
 	PaymentMethod   PaymentMethod `json:"-"`
+	WalletAmount    uint          `json:"wallet_amount" gorm:"not null;default:0"` // amount paid from wallet
+	CouponID        uint          `json:"coupon_id"`                               // coupon applied on cart when order placed
+	GatewayOrderID  string        `json:"gateway_order_id"`                        // razorpay order id or stripe payment intent id
 }
 
 type OrderLine struct {
@@ -63,6 +117,13 @@ type OrderLine struct {
 	ShopOrder     ShopOrder `json:"-"`
 	Qty           uint      `json:"qty" gorm:"not null"`
 	Price         uint      `json:"price" gorm:"not null"`
+
+	// GST included on the line amount
+	TaxRate       float64 `json:"tax_rate" gorm:"not null;default:0"`
+	TaxableAmount uint    `json:"taxable_amount" gorm:"not null;default:0"`
+	CGST          uint    `json:"cgst" gorm:"not null;default:0"`
+	SGST          uint    `json:"sgst" gorm:"not null;default:0"`
+	IGST          uint    `json:"igst" gorm:"not null;default:0"`
 }
 
 type OrderReturn struct {
@@ -78,3 +139,32 @@ type OrderReturn struct {
 	ApprovalDate time.Time `json:"approval_date"`
 	AdminComment string    `json:"admin_comment"`
 }
+
+// audit record of a cancelled order
+type OrderCancellation struct {
+	ID             uint      `json:"id" gorm:"primaryKey;not null"`
+	ShopOrderID    uint      `json:"shop_order_id" gorm:"not null;unique"`
+	ShopOrder      ShopOrder `json:"-"`
+	CancelledBy    uint      `json:"cancelled_by" gorm:"not null"`
+	ActorType      ActorType `json:"actor_type" gorm:"not null"`
+	CancelledAt    time.Time `json:"cancelled_at" gorm:"not null"`
+	PreviousStatus string    `json:"previous_status" gorm:"not null"`
+	RefundAmount   uint      `json:"refund_amount" gorm:"not null"`
+	// paid amount of an online payment which is refunded by the gateway
+	GatewayRefundAmount uint   `json:"gateway_refund_amount" gorm:"not null;default:0"`
+	GatewayRefundID     string `json:"gateway_refund_id"`
+	CouponReverted      bool   `json:"coupon_reverted" gorm:"not null"`
+}
+
+// history of every order status change of a shop order
+type ShopOrderStatusHistory struct {
+	ID            uint        `json:"id" gorm:"primaryKey;not null"`
+	ShopOrderID   uint        `json:"shop_order_id" gorm:"not null;index"`
+	ShopOrder     ShopOrder   `json:"-"`
+	OrderStatusID uint        `json:"order_status_id" gorm:"not null"`
+	OrderStatus   OrderStatus `json:"-"`
+	ActorID       uint        `json:"actor_id" gorm:"not null"`
+	ActorType     ActorType   `json:"actor_type" gorm:"not null"`
+	Comment       string      `json:"comment"`
+	CreatedAt     time.Time   `json:"created_at" gorm:"not null"`
+}
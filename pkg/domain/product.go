@@ -14,21 +14,24 @@ type Product struct {
 	Price         uint      `json:"price" gorm:"not null" binding:"required,numeric"`
 	DiscountPrice uint      `json:"discount_price"`
 	Image         string    `json:"image" gorm:"not null"`
+	TaxClassID    uint      `json:"tax_class_id" gorm:"not null;default:0"` // zero to use tax class of category
 	CreatedAt     time.Time `json:"created_at" gorm:"not null"`
 	UpdatedAt     time.Time `json:"updated_at"`
 }
 
 // this for a specific variant of product
 type ProductItem struct {
-	ID            uint `json:"id" gorm:"primaryKey;not null"`
-	ProductID     uint `json:"product_id" gorm:"not null" binding:"required,numeric"`
-	Product       Product
-	QtyInStock    uint      `json:"qty_in_stock" gorm:"not null" binding:"required,numeric"`
-	Price         uint      `json:"price" gorm:"not null" binding:"required,numeric"`
-	SKU           string    `json:"sku" gorm:"unique;not null"`
-	DiscountPrice uint      `json:"discount_price"`
-	CreatedAt     time.Time `json:"created_at" gorm:"not null"`
-	UpdatedAt     time.Time `json:"updated_at"`
+	ID               uint `json:"id" gorm:"primaryKey;not null"`
+	ProductID        uint `json:"product_id" gorm:"not null" binding:"required,numeric"`
+	Product          Product
+	QtyInStock       uint      `json:"qty_in_stock" gorm:"not null" binding:"required,numeric"`
+	Price            uint      `json:"price" gorm:"not null" binding:"required,numeric"`
+	SKU              string    `json:"sku" gorm:"unique;not null"`
+	DiscountPrice    uint      `json:"discount_price"`
+	ReorderThreshold uint      `json:"reorder_threshold" gorm:"not null;default:0"` // stock is low on reaching this (zero for no threshold)
+	Weight           uint      `json:"weight" gorm:"not null;default:0"`            // in grams used for weight based delivery charge
+	CreatedAt        time.Time `json:"created_at" gorm:"not null"`
+	UpdatedAt        time.Time `json:"updated_at"`
 }
 
 // for a products category main and sub category as self joining
@@ -37,6 +40,7 @@ type Category struct {
 	CategoryID uint      `json:"category_id"`
 	Category   *Category `json:"-"`
 	Name       string    `json:"category_name" gorm:"not null" binding:"required,min=1,max=30"`
+	TaxClassID uint      `json:"tax_class_id" gorm:"not null;default:0"`
 }
 
 type Brand struct {
@@ -0,0 +1,37 @@
+package domain
+
+import "time"
+
+// question of a user about a product
+type ProductQuestion struct {
+	ID             uint      `json:"id" gorm:"primaryKey;not null"`
+	ProductID      uint      `json:"product_id" gorm:"not null;index"`
+	Product        Product   `json:"-"`
+	UserID         uint      `json:"user_id" gorm:"not null"`
+	User           User      `json:"-"`
+	Question       string    `json:"question" gorm:"not null"`
+	NotifyOnAnswer bool      `json:"notify_on_answer" gorm:"not null;default:false"` // mail the user when question answered
+	IsHidden       bool      `json:"is_hidden" gorm:"not null;default:false"`        // hidden by admin
+	CreatedAt      time.Time `json:"created_at" gorm:"not null"`
+}
+
+// answer of a question by an admin or a user who have a delivered order of the product
+type ProductAnswer struct {
+	ID                uint            `json:"id" gorm:"primaryKey;not null"`
+	ProductQuestionID uint            `json:"product_question_id" gorm:"not null;index"`
+	ProductQuestion   ProductQuestion `json:"-"`
+	ActorID           uint            `json:"actor_id" gorm:"not null"`
+	ActorType         ActorType       `json:"actor_type" gorm:"not null"`
+	Answer            string          `json:"answer" gorm:"not null"`
+	IsHidden          bool            `json:"is_hidden" gorm:"not null;default:false"` // hidden by admin
+	CreatedAt         time.Time       `json:"created_at" gorm:"not null"`
+}
+
+// a user can upvote an answer only once
+type ProductAnswerUpvote struct {
+	ProductAnswerID uint          `json:"product_answer_id" gorm:"primaryKey;not null"`
+	ProductAnswer   ProductAnswer `json:"-"`
+	UserID          uint          `json:"user_id" gorm:"primaryKey;not null"`
+	User            User          `json:"-"`
+	CreatedAt       time.Time     `json:"created_at" gorm:"not null"`
+}
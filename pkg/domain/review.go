@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+type ReviewStatus string
+
+// a review is shown on the product only after admin approve it
+const (
+	ReviewPending  ReviewStatus = "pending"
+	ReviewApproved ReviewStatus = "approved"
+	ReviewHidden   ReviewStatus = "hidden"
+)
+
+// review of a product by a user who have a delivered order of the product (one review for a user on a product)
+type ProductReview struct {
+	ID        uint         `json:"id" gorm:"primaryKey;not null"`
+	ProductID uint         `json:"product_id" gorm:"not null;uniqueIndex:idx_product_review_user"`
+	Product   Product      `json:"-"`
+	UserID    uint         `json:"user_id" gorm:"not null;uniqueIndex:idx_product_review_user"`
+	User      User         `json:"-"`
+	Rating    uint         `json:"rating" gorm:"not null"` // 1 to 5
+	Title     string       `json:"title" gorm:"not null"`
+	Body      string       `json:"body" gorm:"not null"`
+	Status    ReviewStatus `json:"status" gorm:"not null;default:'pending';index"`
+	CreatedAt time.Time    `json:"created_at" gorm:"not null"`
+	UpdatedAt time.Time    `json:"updated_at"`
+}
+
+type ProductReviewImage struct {
+	ID              uint          `json:"id" gorm:"primaryKey;not null"`
+	ProductReviewID uint          `json:"product_review_id" gorm:"not null;index"`
+	ProductReview   ProductReview `json:"-"`
+	Image           string        `json:"image" gorm:"not null"`
+}
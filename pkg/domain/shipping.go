@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+type ShippingRateType string
+
+// how the delivery charge of an order is calculated on a shipping zone
+const (
+	ShippingRateFlat     ShippingRateType = "flat"      // base charge for every order
+	ShippingRateWeight   ShippingRateType = "weight"    // base charge plus charge for each started kg
+	ShippingRateFreeOver ShippingRateType = "free_over" // base charge only for orders below the free over amount
+)
+
+// shipping zone covers a pincode range of a country
+// (when zones overlap the zone with the narrowest pincode range is selected)
+type ShippingZone struct {
+	ID             uint             `json:"id" gorm:"primaryKey;not null"`
+	Name           string           `json:"name" gorm:"unique;not null"`
+	CountryID      uint             `json:"country_id" gorm:"not null;index:idx_shipping_zone_pincode"`
+	Country        Country          `json:"-"`
+	PincodeFrom    uint             `json:"pincode_from" gorm:"not null;index:idx_shipping_zone_pincode"`
+	PincodeTo      uint             `json:"pincode_to" gorm:"not null;index:idx_shipping_zone_pincode"`
+	RateType       ShippingRateType `json:"rate_type" gorm:"not null"`
+	BaseCharge     uint             `json:"base_charge" gorm:"not null;default:0"`
+	ChargePerKg    uint             `json:"charge_per_kg" gorm:"not null;default:0"`
+	FreeOverAmount uint             `json:"free_over_amount" gorm:"not null;default:0"`
+	CreatedAt      time.Time        `json:"created_at" gorm:"not null"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+}
@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+type StockMovementReason string
+
+const (
+	StockRestock          StockMovementReason = "restock"
+	StockSale             StockMovementReason = "sale"
+	StockReservation      StockMovementReason = "reservation"
+	StockRelease          StockMovementReason = "release"
+	StockReturn           StockMovementReason = "return"
+	StockManualAdjustment StockMovementReason = "manual adjustment"
+)
+
+// ledger of every change on qty_in_stock of product items
+// reservation hold the stock on order placement and on payment completion it's released and changed to sale
+type StockMovement struct {
+	ID            uint                `json:"stock_movement_id" gorm:"primaryKey;not null"`
+	ProductItemID uint                `json:"product_item_id" gorm:"not null;index"`
+	ProductItem   ProductItem         `json:"-"`
+	Quantity      int                 `json:"quantity" gorm:"not null"` // change on qty_in_stock (negative for stock out)
+	Reason        StockMovementReason `json:"reason" gorm:"not null"`
+	ActorType     ActorType           `json:"actor_type" gorm:"not null"`
+	ActorID       uint                `json:"actor_id"`
+	ReferenceID   uint                `json:"reference_id"` // shop order id for order movements
+	Note          string              `json:"note"`
+	CreatedAt     time.Time           `json:"created_at" gorm:"not null"`
+}
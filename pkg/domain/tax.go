@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+// GST rate assignable to a category or a product
+// (product tax class is used first, then the category and then the main category tax class)
+type TaxClass struct {
+	ID        uint      `json:"id" gorm:"primaryKey;not null"`
+	Name      string    `json:"name" gorm:"unique;not null"`
+	Rate      float64   `json:"rate" gorm:"not null"` // percentage of GST
+	CreatedAt time.Time `json:"created_at" gorm:"not null"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
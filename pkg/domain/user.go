@@ -37,6 +37,7 @@ type Address struct {
 	LandMark    string `json:"land_mark" gorm:"not null" binding:"required"`
 	City        string `json:"city" gorm:"not null"`
 	Pincode     uint   `json:"pincode" gorm:"not null" binding:"required,numeric,min=6,max=6"`
+	State       string `json:"state"`
 	CountryID   uint   `json:"country_id" gorm:"not null" binding:"required"`
 	Country     Country
 	CreatedAt   time.Time `json:"created_at" gorm:"not null"`
@@ -0,0 +1,467 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interfaces/admin.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	request "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	response "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	domain "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	interfaces "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+)
+
+// MockAdminRepository is a mock of AdminRepository interface.
+type MockAdminRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminRepositoryMockRecorder
+}
+
+// MockAdminRepositoryMockRecorder is the mock recorder for MockAdminRepository.
+type MockAdminRepositoryMockRecorder struct {
+	mock *MockAdminRepository
+}
+
+// NewMockAdminRepository creates a new mock instance.
+func NewMockAdminRepository(ctrl *gomock.Controller) *MockAdminRepository {
+	mock := &MockAdminRepository{ctrl: ctrl}
+	mock.recorder = &MockAdminRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminRepository) EXPECT() *MockAdminRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateFullSalesReport mocks base method.
+func (m *MockAdminRepository) CreateFullSalesReport(ctc context.Context, reqData request.SalesReport) ([]response.SalesReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFullSalesReport", ctc, reqData)
+	ret0, _ := ret[0].([]response.SalesReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFullSalesReport indicates an expected call of CreateFullSalesReport.
+func (mr *MockAdminRepositoryMockRecorder) CreateFullSalesReport(ctc, reqData interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFullSalesReport", reflect.TypeOf((*MockAdminRepository)(nil).CreateFullSalesReport), ctc, reqData)
+}
+
+// DeleteAdmin mocks base method.
+func (m *MockAdminRepository) DeleteAdmin(ctx context.Context, adminID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAdmin", ctx, adminID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAdmin indicates an expected call of DeleteAdmin.
+func (mr *MockAdminRepositoryMockRecorder) DeleteAdmin(ctx, adminID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAdmin", reflect.TypeOf((*MockAdminRepository)(nil).DeleteAdmin), ctx, adminID)
+}
+
+// FindAdminByEmail mocks base method.
+func (m *MockAdminRepository) FindAdminByEmail(ctx context.Context, email string) (domain.Admin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAdminByEmail", ctx, email)
+	ret0, _ := ret[0].(domain.Admin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAdminByEmail indicates an expected call of FindAdminByEmail.
+func (mr *MockAdminRepositoryMockRecorder) FindAdminByEmail(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAdminByEmail", reflect.TypeOf((*MockAdminRepository)(nil).FindAdminByEmail), ctx, email)
+}
+
+// FindAdminByID mocks base method.
+func (m *MockAdminRepository) FindAdminByID(ctx context.Context, adminID uint) (domain.Admin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAdminByID", ctx, adminID)
+	ret0, _ := ret[0].(domain.Admin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAdminByID indicates an expected call of FindAdminByID.
+func (mr *MockAdminRepositoryMockRecorder) FindAdminByID(ctx, adminID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAdminByID", reflect.TypeOf((*MockAdminRepository)(nil).FindAdminByID), ctx, adminID)
+}
+
+// FindAdminByUserName mocks base method.
+func (m *MockAdminRepository) FindAdminByUserName(ctx context.Context, userName string) (domain.Admin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAdminByUserName", ctx, userName)
+	ret0, _ := ret[0].(domain.Admin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAdminByUserName indicates an expected call of FindAdminByUserName.
+func (mr *MockAdminRepositoryMockRecorder) FindAdminByUserName(ctx, userName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAdminByUserName", reflect.TypeOf((*MockAdminRepository)(nil).FindAdminByUserName), ctx, userName)
+}
+
+// FindAllAuditLogs mocks base method.
+func (m *MockAdminRepository) FindAllAuditLogs(ctx context.Context, filter request.AuditLogFilter) ([]response.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllAuditLogs", ctx, filter)
+	ret0, _ := ret[0].([]response.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllAuditLogs indicates an expected call of FindAllAuditLogs.
+func (mr *MockAdminRepositoryMockRecorder) FindAllAuditLogs(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllAuditLogs", reflect.TypeOf((*MockAdminRepository)(nil).FindAllAuditLogs), ctx, filter)
+}
+
+// FindAllDashboardPoints mocks base method.
+func (m *MockAdminRepository) FindAllDashboardPoints(ctx context.Context, reqData request.SalesReport) ([]response.DashboardPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllDashboardPoints", ctx, reqData)
+	ret0, _ := ret[0].([]response.DashboardPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllDashboardPoints indicates an expected call of FindAllDashboardPoints.
+func (mr *MockAdminRepositoryMockRecorder) FindAllDashboardPoints(ctx, reqData interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllDashboardPoints", reflect.TypeOf((*MockAdminRepository)(nil).FindAllDashboardPoints), ctx, reqData)
+}
+
+// FindAllOrderCountsByPaymentMethod mocks base method.
+func (m *MockAdminRepository) FindAllOrderCountsByPaymentMethod(ctx context.Context, reqData request.SalesReport) ([]response.SalesCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllOrderCountsByPaymentMethod", ctx, reqData)
+	ret0, _ := ret[0].([]response.SalesCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllOrderCountsByPaymentMethod indicates an expected call of FindAllOrderCountsByPaymentMethod.
+func (mr *MockAdminRepositoryMockRecorder) FindAllOrderCountsByPaymentMethod(ctx, reqData interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllOrderCountsByPaymentMethod", reflect.TypeOf((*MockAdminRepository)(nil).FindAllOrderCountsByPaymentMethod), ctx, reqData)
+}
+
+// FindAllOrderCountsByStatus mocks base method.
+func (m *MockAdminRepository) FindAllOrderCountsByStatus(ctx context.Context, reqData request.SalesReport) ([]response.SalesCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllOrderCountsByStatus", ctx, reqData)
+	ret0, _ := ret[0].([]response.SalesCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllOrderCountsByStatus indicates an expected call of FindAllOrderCountsByStatus.
+func (mr *MockAdminRepositoryMockRecorder) FindAllOrderCountsByStatus(ctx, reqData interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllOrderCountsByStatus", reflect.TypeOf((*MockAdminRepository)(nil).FindAllOrderCountsByStatus), ctx, reqData)
+}
+
+// FindAllPermissionsByAdminID mocks base method.
+func (m *MockAdminRepository) FindAllPermissionsByAdminID(ctx context.Context, adminID uint) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllPermissionsByAdminID", ctx, adminID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllPermissionsByAdminID indicates an expected call of FindAllPermissionsByAdminID.
+func (mr *MockAdminRepositoryMockRecorder) FindAllPermissionsByAdminID(ctx, adminID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllPermissionsByAdminID", reflect.TypeOf((*MockAdminRepository)(nil).FindAllPermissionsByAdminID), ctx, adminID)
+}
+
+// FindAllRoles mocks base method.
+func (m *MockAdminRepository) FindAllRoles(ctx context.Context) ([]response.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllRoles", ctx)
+	ret0, _ := ret[0].([]response.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllRoles indicates an expected call of FindAllRoles.
+func (mr *MockAdminRepositoryMockRecorder) FindAllRoles(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllRoles", reflect.TypeOf((*MockAdminRepository)(nil).FindAllRoles), ctx)
+}
+
+// FindAllSalesBuckets mocks base method.
+func (m *MockAdminRepository) FindAllSalesBuckets(ctx context.Context, reqData request.SalesReport) ([]response.SalesBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllSalesBuckets", ctx, reqData)
+	ret0, _ := ret[0].([]response.SalesBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllSalesBuckets indicates an expected call of FindAllSalesBuckets.
+func (mr *MockAdminRepositoryMockRecorder) FindAllSalesBuckets(ctx, reqData interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllSalesBuckets", reflect.TypeOf((*MockAdminRepository)(nil).FindAllSalesBuckets), ctx, reqData)
+}
+
+// FindAllStaff mocks base method.
+func (m *MockAdminRepository) FindAllStaff(ctx context.Context, pagination request.Pagination) ([]response.Staff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllStaff", ctx, pagination)
+	ret0, _ := ret[0].([]response.Staff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllStaff indicates an expected call of FindAllStaff.
+func (mr *MockAdminRepositoryMockRecorder) FindAllStaff(ctx, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllStaff", reflect.TypeOf((*MockAdminRepository)(nil).FindAllStaff), ctx, pagination)
+}
+
+// FindAllUser mocks base method.
+func (m *MockAdminRepository) FindAllUser(ctx context.Context, pagination request.Pagination) ([]response.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllUser", ctx, pagination)
+	ret0, _ := ret[0].([]response.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllUser indicates an expected call of FindAllUser.
+func (mr *MockAdminRepositoryMockRecorder) FindAllUser(ctx, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllUser", reflect.TypeOf((*MockAdminRepository)(nil).FindAllUser), ctx, pagination)
+}
+
+// FindDashboardStats mocks base method.
+func (m *MockAdminRepository) FindDashboardStats(ctx context.Context, reqData request.SalesReport) (response.DashboardStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDashboardStats", ctx, reqData)
+	ret0, _ := ret[0].(response.DashboardStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDashboardStats indicates an expected call of FindDashboardStats.
+func (mr *MockAdminRepositoryMockRecorder) FindDashboardStats(ctx, reqData interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDashboardStats", reflect.TypeOf((*MockAdminRepository)(nil).FindDashboardStats), ctx, reqData)
+}
+
+// FindRoleByID mocks base method.
+func (m *MockAdminRepository) FindRoleByID(ctx context.Context, roleID uint) (domain.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRoleByID", ctx, roleID)
+	ret0, _ := ret[0].(domain.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRoleByID indicates an expected call of FindRoleByID.
+func (mr *MockAdminRepositoryMockRecorder) FindRoleByID(ctx, roleID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRoleByID", reflect.TypeOf((*MockAdminRepository)(nil).FindRoleByID), ctx, roleID)
+}
+
+// FindSalesTotal mocks base method.
+func (m *MockAdminRepository) FindSalesTotal(ctx context.Context, reqData request.SalesReport) (response.SalesBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSalesTotal", ctx, reqData)
+	ret0, _ := ret[0].(response.SalesBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSalesTotal indicates an expected call of FindSalesTotal.
+func (mr *MockAdminRepositoryMockRecorder) FindSalesTotal(ctx, reqData interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSalesTotal", reflect.TypeOf((*MockAdminRepository)(nil).FindSalesTotal), ctx, reqData)
+}
+
+// FindStaffPasswordToken mocks base method.
+func (m *MockAdminRepository) FindStaffPasswordToken(ctx context.Context, tokenHash string) (domain.StaffPasswordToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindStaffPasswordToken", ctx, tokenHash)
+	ret0, _ := ret[0].(domain.StaffPasswordToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindStaffPasswordToken indicates an expected call of FindStaffPasswordToken.
+func (mr *MockAdminRepositoryMockRecorder) FindStaffPasswordToken(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindStaffPasswordToken", reflect.TypeOf((*MockAdminRepository)(nil).FindStaffPasswordToken), ctx, tokenHash)
+}
+
+// FindStockBySKU mocks base method.
+func (m *MockAdminRepository) FindStockBySKU(ctx context.Context, sku string) (response.Stock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindStockBySKU", ctx, sku)
+	ret0, _ := ret[0].(response.Stock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindStockBySKU indicates an expected call of FindStockBySKU.
+func (mr *MockAdminRepositoryMockRecorder) FindStockBySKU(ctx, sku interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindStockBySKU", reflect.TypeOf((*MockAdminRepository)(nil).FindStockBySKU), ctx, sku)
+}
+
+// FindTopSellingCategories mocks base method.
+func (m *MockAdminRepository) FindTopSellingCategories(ctx context.Context, reqData request.SalesReport, limit uint) ([]response.TopSellingItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTopSellingCategories", ctx, reqData, limit)
+	ret0, _ := ret[0].([]response.TopSellingItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTopSellingCategories indicates an expected call of FindTopSellingCategories.
+func (mr *MockAdminRepositoryMockRecorder) FindTopSellingCategories(ctx, reqData, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTopSellingCategories", reflect.TypeOf((*MockAdminRepository)(nil).FindTopSellingCategories), ctx, reqData, limit)
+}
+
+// FindTopSellingProducts mocks base method.
+func (m *MockAdminRepository) FindTopSellingProducts(ctx context.Context, reqData request.SalesReport, limit uint) ([]response.TopSellingItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTopSellingProducts", ctx, reqData, limit)
+	ret0, _ := ret[0].([]response.TopSellingItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTopSellingProducts indicates an expected call of FindTopSellingProducts.
+func (mr *MockAdminRepositoryMockRecorder) FindTopSellingProducts(ctx, reqData, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTopSellingProducts", reflect.TypeOf((*MockAdminRepository)(nil).FindTopSellingProducts), ctx, reqData, limit)
+}
+
+// SaveAdmin mocks base method.
+func (m *MockAdminRepository) SaveAdmin(ctx context.Context, admin domain.Admin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAdmin", ctx, admin)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAdmin indicates an expected call of SaveAdmin.
+func (mr *MockAdminRepositoryMockRecorder) SaveAdmin(ctx, admin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAdmin", reflect.TypeOf((*MockAdminRepository)(nil).SaveAdmin), ctx, admin)
+}
+
+// SaveAuditLog mocks base method.
+func (m *MockAdminRepository) SaveAuditLog(ctx context.Context, auditLog domain.AdminAuditLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAuditLog", ctx, auditLog)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAuditLog indicates an expected call of SaveAuditLog.
+func (mr *MockAdminRepositoryMockRecorder) SaveAuditLog(ctx, auditLog interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAuditLog", reflect.TypeOf((*MockAdminRepository)(nil).SaveAuditLog), ctx, auditLog)
+}
+
+// SaveStaff mocks base method.
+func (m *MockAdminRepository) SaveStaff(ctx context.Context, admin domain.Admin) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveStaff", ctx, admin)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveStaff indicates an expected call of SaveStaff.
+func (mr *MockAdminRepositoryMockRecorder) SaveStaff(ctx, admin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveStaff", reflect.TypeOf((*MockAdminRepository)(nil).SaveStaff), ctx, admin)
+}
+
+// SaveStaffPasswordToken mocks base method.
+func (m *MockAdminRepository) SaveStaffPasswordToken(ctx context.Context, passwordToken domain.StaffPasswordToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveStaffPasswordToken", ctx, passwordToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveStaffPasswordToken indicates an expected call of SaveStaffPasswordToken.
+func (mr *MockAdminRepositoryMockRecorder) SaveStaffPasswordToken(ctx, passwordToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveStaffPasswordToken", reflect.TypeOf((*MockAdminRepository)(nil).SaveStaffPasswordToken), ctx, passwordToken)
+}
+
+// Transaction mocks base method.
+func (m *MockAdminRepository) Transaction(callBack func(interfaces.AdminRepository) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transaction", callBack)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transaction indicates an expected call of Transaction.
+func (mr *MockAdminRepositoryMockRecorder) Transaction(callBack interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockAdminRepository)(nil).Transaction), callBack)
+}
+
+// UpdateAdminPassword mocks base method.
+func (m *MockAdminRepository) UpdateAdminPassword(ctx context.Context, adminID uint, hashPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAdminPassword", ctx, adminID, hashPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAdminPassword indicates an expected call of UpdateAdminPassword.
+func (mr *MockAdminRepositoryMockRecorder) UpdateAdminPassword(ctx, adminID, hashPassword interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAdminPassword", reflect.TypeOf((*MockAdminRepository)(nil).UpdateAdminPassword), ctx, adminID, hashPassword)
+}
+
+// UpdateStaff mocks base method.
+func (m *MockAdminRepository) UpdateStaff(ctx context.Context, adminID, roleID uint, blockStatus bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStaff", ctx, adminID, roleID, blockStatus)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStaff indicates an expected call of UpdateStaff.
+func (mr *MockAdminRepositoryMockRecorder) UpdateStaff(ctx, adminID, roleID, blockStatus interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStaff", reflect.TypeOf((*MockAdminRepository)(nil).UpdateStaff), ctx, adminID, roleID, blockStatus)
+}
+
+// UseStaffPasswordToken mocks base method.
+func (m *MockAdminRepository) UseStaffPasswordToken(ctx context.Context, tokenID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseStaffPasswordToken", ctx, tokenID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseStaffPasswordToken indicates an expected call of UseStaffPasswordToken.
+func (mr *MockAdminRepositoryMockRecorder) UseStaffPasswordToken(ctx, tokenID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseStaffPasswordToken", reflect.TypeOf((*MockAdminRepository)(nil).UseStaffPasswordToken), ctx, tokenID)
+}
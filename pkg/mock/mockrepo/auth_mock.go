@@ -7,9 +7,11 @@ package mockrepo
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	domain "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	interfaces "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
 )
 
 // MockAuthRepository is a mock of AuthRepository interface.
@@ -35,6 +37,66 @@ func (m *MockAuthRepository) EXPECT() *MockAuthRepositoryMockRecorder {
 	return m.recorder
 }
 
+// DeleteLoginAttempt mocks base method.
+func (m *MockAuthRepository) DeleteLoginAttempt(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLoginAttempt", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLoginAttempt indicates an expected call of DeleteLoginAttempt.
+func (mr *MockAuthRepositoryMockRecorder) DeleteLoginAttempt(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoginAttempt", reflect.TypeOf((*MockAuthRepository)(nil).DeleteLoginAttempt), ctx, key)
+}
+
+// FindAllActiveRefreshSessions mocks base method.
+func (m *MockAuthRepository) FindAllActiveRefreshSessions(ctx context.Context, userID uint, usedFor string) ([]domain.RefreshSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllActiveRefreshSessions", ctx, userID, usedFor)
+	ret0, _ := ret[0].([]domain.RefreshSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllActiveRefreshSessions indicates an expected call of FindAllActiveRefreshSessions.
+func (mr *MockAuthRepositoryMockRecorder) FindAllActiveRefreshSessions(ctx, userID, usedFor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllActiveRefreshSessions", reflect.TypeOf((*MockAuthRepository)(nil).FindAllActiveRefreshSessions), ctx, userID, usedFor)
+}
+
+// FindLoginAttempt mocks base method.
+func (m *MockAuthRepository) FindLoginAttempt(ctx context.Context, key string) (domain.LoginAttempt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLoginAttempt", ctx, key)
+	ret0, _ := ret[0].(domain.LoginAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLoginAttempt indicates an expected call of FindLoginAttempt.
+func (mr *MockAuthRepositoryMockRecorder) FindLoginAttempt(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLoginAttempt", reflect.TypeOf((*MockAuthRepository)(nil).FindLoginAttempt), ctx, key)
+}
+
+// FindOtpSendStats mocks base method.
+func (m *MockAuthRepository) FindOtpSendStats(ctx context.Context, phone string, since time.Time) (uint, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOtpSendStats", ctx, phone, since)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindOtpSendStats indicates an expected call of FindOtpSendStats.
+func (mr *MockAuthRepositoryMockRecorder) FindOtpSendStats(ctx, phone, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOtpSendStats", reflect.TypeOf((*MockAuthRepository)(nil).FindOtpSendStats), ctx, phone, since)
+}
+
 // FindOtpSession mocks base method.
 func (m *MockAuthRepository) FindOtpSession(ctx context.Context, otpID string) (domain.OtpSession, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +127,108 @@ func (mr *MockAuthRepositoryMockRecorder) FindRefreshSessionByTokenID(ctx, token
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRefreshSessionByTokenID", reflect.TypeOf((*MockAuthRepository)(nil).FindRefreshSessionByTokenID), ctx, tokenID)
 }
 
+// IsTokenRevoked mocks base method.
+func (m *MockAuthRepository) IsTokenRevoked(ctx context.Context, tokenID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTokenRevoked", ctx, tokenID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTokenRevoked indicates an expected call of IsTokenRevoked.
+func (mr *MockAuthRepositoryMockRecorder) IsTokenRevoked(ctx, tokenID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTokenRevoked", reflect.TypeOf((*MockAuthRepository)(nil).IsTokenRevoked), ctx, tokenID)
+}
+
+// LockLoginAttempt mocks base method.
+func (m *MockAuthRepository) LockLoginAttempt(ctx context.Context, key string, lockedUntil time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockLoginAttempt", ctx, key, lockedUntil)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockLoginAttempt indicates an expected call of LockLoginAttempt.
+func (mr *MockAuthRepositoryMockRecorder) LockLoginAttempt(ctx, key, lockedUntil interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockLoginAttempt", reflect.TypeOf((*MockAuthRepository)(nil).LockLoginAttempt), ctx, key, lockedUntil)
+}
+
+// LockOtpSendsOfPhone mocks base method.
+func (m *MockAuthRepository) LockOtpSendsOfPhone(ctx context.Context, phone string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockOtpSendsOfPhone", ctx, phone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockOtpSendsOfPhone indicates an expected call of LockOtpSendsOfPhone.
+func (mr *MockAuthRepositoryMockRecorder) LockOtpSendsOfPhone(ctx, phone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockOtpSendsOfPhone", reflect.TypeOf((*MockAuthRepository)(nil).LockOtpSendsOfPhone), ctx, phone)
+}
+
+// MarkRefreshSessionUsed mocks base method.
+func (m *MockAuthRepository) MarkRefreshSessionUsed(ctx context.Context, tokenID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRefreshSessionUsed", ctx, tokenID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRefreshSessionUsed indicates an expected call of MarkRefreshSessionUsed.
+func (mr *MockAuthRepositoryMockRecorder) MarkRefreshSessionUsed(ctx, tokenID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRefreshSessionUsed", reflect.TypeOf((*MockAuthRepository)(nil).MarkRefreshSessionUsed), ctx, tokenID)
+}
+
+// RevokeAllRefreshSessions mocks base method.
+func (m *MockAuthRepository) RevokeAllRefreshSessions(ctx context.Context, userID uint, usedFor string, revokeUntil time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllRefreshSessions", ctx, userID, usedFor, revokeUntil)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAllRefreshSessions indicates an expected call of RevokeAllRefreshSessions.
+func (mr *MockAuthRepositoryMockRecorder) RevokeAllRefreshSessions(ctx, userID, usedFor, revokeUntil interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllRefreshSessions", reflect.TypeOf((*MockAuthRepository)(nil).RevokeAllRefreshSessions), ctx, userID, usedFor, revokeUntil)
+}
+
+// RevokeRefreshSession mocks base method.
+func (m *MockAuthRepository) RevokeRefreshSession(ctx context.Context, userID uint, usedFor, tokenID string, revokeUntil time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeRefreshSession", ctx, userID, usedFor, tokenID, revokeUntil)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeRefreshSession indicates an expected call of RevokeRefreshSession.
+func (mr *MockAuthRepositoryMockRecorder) RevokeRefreshSession(ctx, userID, usedFor, tokenID, revokeUntil interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRefreshSession", reflect.TypeOf((*MockAuthRepository)(nil).RevokeRefreshSession), ctx, userID, usedFor, tokenID, revokeUntil)
+}
+
+// SaveFailedLoginAttempt mocks base method.
+func (m *MockAuthRepository) SaveFailedLoginAttempt(ctx context.Context, key string, failedAt, resetBefore time.Time) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveFailedLoginAttempt", ctx, key, failedAt, resetBefore)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveFailedLoginAttempt indicates an expected call of SaveFailedLoginAttempt.
+func (mr *MockAuthRepositoryMockRecorder) SaveFailedLoginAttempt(ctx, key, failedAt, resetBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFailedLoginAttempt", reflect.TypeOf((*MockAuthRepository)(nil).SaveFailedLoginAttempt), ctx, key, failedAt, resetBefore)
+}
+
 // SaveOtpSession mocks base method.
 func (m *MockAuthRepository) SaveOtpSession(ctx context.Context, otpSession domain.OtpSession) error {
 	m.ctrl.T.Helper()
@@ -92,3 +256,46 @@ func (mr *MockAuthRepositoryMockRecorder) SaveRefreshSession(ctx, refreshSession
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRefreshSession", reflect.TypeOf((*MockAuthRepository)(nil).SaveRefreshSession), ctx, refreshSession)
 }
+
+// Transaction mocks base method.
+func (m *MockAuthRepository) Transaction(callBack func(interfaces.AuthRepository) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transaction", callBack)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transaction indicates an expected call of Transaction.
+func (mr *MockAuthRepositoryMockRecorder) Transaction(callBack interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockAuthRepository)(nil).Transaction), callBack)
+}
+
+// UpdateOtpSessionVerifyKey mocks base method.
+func (m *MockAuthRepository) UpdateOtpSessionVerifyKey(ctx context.Context, otpID, verifyKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOtpSessionVerifyKey", ctx, otpID, verifyKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOtpSessionVerifyKey indicates an expected call of UpdateOtpSessionVerifyKey.
+func (mr *MockAuthRepositoryMockRecorder) UpdateOtpSessionVerifyKey(ctx, otpID, verifyKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOtpSessionVerifyKey", reflect.TypeOf((*MockAuthRepository)(nil).UpdateOtpSessionVerifyKey), ctx, otpID, verifyKey)
+}
+
+// UseOtpSession mocks base method.
+func (m *MockAuthRepository) UseOtpSession(ctx context.Context, otpID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseOtpSession", ctx, otpID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseOtpSession indicates an expected call of UseOtpSession.
+func (mr *MockAuthRepositoryMockRecorder) UseOtpSession(ctx, otpID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseOtpSession", reflect.TypeOf((*MockAuthRepository)(nil).UseOtpSession), ctx, otpID)
+}
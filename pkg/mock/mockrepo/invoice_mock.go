@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interfaces/invoice.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	response "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	domain "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+)
+
+// MockInvoiceRepository is a mock of InvoiceRepository interface.
+type MockInvoiceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvoiceRepositoryMockRecorder
+}
+
+// MockInvoiceRepositoryMockRecorder is the mock recorder for MockInvoiceRepository.
+type MockInvoiceRepositoryMockRecorder struct {
+	mock *MockInvoiceRepository
+}
+
+// NewMockInvoiceRepository creates a new mock instance.
+func NewMockInvoiceRepository(ctrl *gomock.Controller) *MockInvoiceRepository {
+	mock := &MockInvoiceRepository{ctrl: ctrl}
+	mock.recorder = &MockInvoiceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvoiceRepository) EXPECT() *MockInvoiceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindInvoiceByShopOrderID mocks base method.
+func (m *MockInvoiceRepository) FindInvoiceByShopOrderID(ctx context.Context, shopOrderID uint) (domain.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindInvoiceByShopOrderID", ctx, shopOrderID)
+	ret0, _ := ret[0].(domain.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindInvoiceByShopOrderID indicates an expected call of FindInvoiceByShopOrderID.
+func (mr *MockInvoiceRepositoryMockRecorder) FindInvoiceByShopOrderID(ctx, shopOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInvoiceByShopOrderID", reflect.TypeOf((*MockInvoiceRepository)(nil).FindInvoiceByShopOrderID), ctx, shopOrderID)
+}
+
+// FindInvoiceOrder mocks base method.
+func (m *MockInvoiceRepository) FindInvoiceOrder(ctx context.Context, shopOrderID uint) (response.InvoiceOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindInvoiceOrder", ctx, shopOrderID)
+	ret0, _ := ret[0].(response.InvoiceOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindInvoiceOrder indicates an expected call of FindInvoiceOrder.
+func (mr *MockInvoiceRepositoryMockRecorder) FindInvoiceOrder(ctx, shopOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInvoiceOrder", reflect.TypeOf((*MockInvoiceRepository)(nil).FindInvoiceOrder), ctx, shopOrderID)
+}
+
+// UpdateInvoiceFileKey mocks base method.
+func (m *MockInvoiceRepository) UpdateInvoiceFileKey(ctx context.Context, invoiceID uint, fileKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInvoiceFileKey", ctx, invoiceID, fileKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateInvoiceFileKey indicates an expected call of UpdateInvoiceFileKey.
+func (mr *MockInvoiceRepositoryMockRecorder) UpdateInvoiceFileKey(ctx, invoiceID, fileKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInvoiceFileKey", reflect.TypeOf((*MockInvoiceRepository)(nil).UpdateInvoiceFileKey), ctx, invoiceID, fileKey)
+}
@@ -0,0 +1,628 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interfaces/order.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	request "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	response "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	domain "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	interfaces "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+)
+
+// MockOrderRepository is a mock of OrderRepository interface.
+type MockOrderRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrderRepositoryMockRecorder
+}
+
+// MockOrderRepositoryMockRecorder is the mock recorder for MockOrderRepository.
+type MockOrderRepositoryMockRecorder struct {
+	mock *MockOrderRepository
+}
+
+// NewMockOrderRepository creates a new mock instance.
+func NewMockOrderRepository(ctrl *gomock.Controller) *MockOrderRepository {
+	mock := &MockOrderRepository{ctrl: ctrl}
+	mock.recorder = &MockOrderRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrderRepository) EXPECT() *MockOrderRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ApplyStockMovement mocks base method.
+func (m *MockOrderRepository) ApplyStockMovement(ctx context.Context, movement domain.StockMovement) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyStockMovement", ctx, movement)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyStockMovement indicates an expected call of ApplyStockMovement.
+func (mr *MockOrderRepositoryMockRecorder) ApplyStockMovement(ctx, movement interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyStockMovement", reflect.TypeOf((*MockOrderRepository)(nil).ApplyStockMovement), ctx, movement)
+}
+
+// CreditWallet mocks base method.
+func (m *MockOrderRepository) CreditWallet(ctx context.Context, walletID, amount uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreditWallet", ctx, walletID, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreditWallet indicates an expected call of CreditWallet.
+func (mr *MockOrderRepositoryMockRecorder) CreditWallet(ctx, walletID, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreditWallet", reflect.TypeOf((*MockOrderRepository)(nil).CreditWallet), ctx, walletID, amount)
+}
+
+// DebitWallet mocks base method.
+func (m *MockOrderRepository) DebitWallet(ctx context.Context, userID, amount uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DebitWallet", ctx, userID, amount)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DebitWallet indicates an expected call of DebitWallet.
+func (mr *MockOrderRepositoryMockRecorder) DebitWallet(ctx, userID, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DebitWallet", reflect.TypeOf((*MockOrderRepository)(nil).DebitWallet), ctx, userID, amount)
+}
+
+// DeleteCouponUses mocks base method.
+func (m *MockOrderRepository) DeleteCouponUses(ctx context.Context, userID, couponID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCouponUses", ctx, userID, couponID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCouponUses indicates an expected call of DeleteCouponUses.
+func (mr *MockOrderRepositoryMockRecorder) DeleteCouponUses(ctx, userID, couponID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCouponUses", reflect.TypeOf((*MockOrderRepository)(nil).DeleteCouponUses), ctx, userID, couponID)
+}
+
+// DeleteOrderedCartItems mocks base method.
+func (m *MockOrderRepository) DeleteOrderedCartItems(ctx context.Context, userID, shopOrderID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrderedCartItems", ctx, userID, shopOrderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrderedCartItems indicates an expected call of DeleteOrderedCartItems.
+func (mr *MockOrderRepositoryMockRecorder) DeleteOrderedCartItems(ctx, userID, shopOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrderedCartItems", reflect.TypeOf((*MockOrderRepository)(nil).DeleteOrderedCartItems), ctx, userID, shopOrderID)
+}
+
+// FindAllOrderLinesByShopOrderID mocks base method.
+func (m *MockOrderRepository) FindAllOrderLinesByShopOrderID(ctx context.Context, shopOrderID uint) ([]domain.OrderLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllOrderLinesByShopOrderID", ctx, shopOrderID)
+	ret0, _ := ret[0].([]domain.OrderLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllOrderLinesByShopOrderID indicates an expected call of FindAllOrderLinesByShopOrderID.
+func (mr *MockOrderRepositoryMockRecorder) FindAllOrderLinesByShopOrderID(ctx, shopOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllOrderLinesByShopOrderID", reflect.TypeOf((*MockOrderRepository)(nil).FindAllOrderLinesByShopOrderID), ctx, shopOrderID)
+}
+
+// FindAllOrderReturns mocks base method.
+func (m *MockOrderRepository) FindAllOrderReturns(ctx context.Context, pagination request.Pagination) ([]response.OrderReturn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllOrderReturns", ctx, pagination)
+	ret0, _ := ret[0].([]response.OrderReturn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllOrderReturns indicates an expected call of FindAllOrderReturns.
+func (mr *MockOrderRepositoryMockRecorder) FindAllOrderReturns(ctx, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllOrderReturns", reflect.TypeOf((*MockOrderRepository)(nil).FindAllOrderReturns), ctx, pagination)
+}
+
+// FindAllOrderStatuses mocks base method.
+func (m *MockOrderRepository) FindAllOrderStatuses(ctx context.Context) ([]domain.OrderStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllOrderStatuses", ctx)
+	ret0, _ := ret[0].([]domain.OrderStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllOrderStatuses indicates an expected call of FindAllOrderStatuses.
+func (mr *MockOrderRepositoryMockRecorder) FindAllOrderStatuses(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllOrderStatuses", reflect.TypeOf((*MockOrderRepository)(nil).FindAllOrderStatuses), ctx)
+}
+
+// FindAllOrdersItemsByShopOrderID mocks base method.
+func (m *MockOrderRepository) FindAllOrdersItemsByShopOrderID(ctx context.Context, shopOrderID uint, pagination request.Pagination) ([]response.OrderItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllOrdersItemsByShopOrderID", ctx, shopOrderID, pagination)
+	ret0, _ := ret[0].([]response.OrderItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllOrdersItemsByShopOrderID indicates an expected call of FindAllOrdersItemsByShopOrderID.
+func (mr *MockOrderRepositoryMockRecorder) FindAllOrdersItemsByShopOrderID(ctx, shopOrderID, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllOrdersItemsByShopOrderID", reflect.TypeOf((*MockOrderRepository)(nil).FindAllOrdersItemsByShopOrderID), ctx, shopOrderID, pagination)
+}
+
+// FindAllPendingOrderReturns mocks base method.
+func (m *MockOrderRepository) FindAllPendingOrderReturns(ctx context.Context, pagination request.Pagination) ([]response.OrderReturn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllPendingOrderReturns", ctx, pagination)
+	ret0, _ := ret[0].([]response.OrderReturn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllPendingOrderReturns indicates an expected call of FindAllPendingOrderReturns.
+func (mr *MockOrderRepositoryMockRecorder) FindAllPendingOrderReturns(ctx, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllPendingOrderReturns", reflect.TypeOf((*MockOrderRepository)(nil).FindAllPendingOrderReturns), ctx, pagination)
+}
+
+// FindAllShopOrderStatusHistories mocks base method.
+func (m *MockOrderRepository) FindAllShopOrderStatusHistories(ctx context.Context, shopOrderID uint) ([]response.OrderStatusHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllShopOrderStatusHistories", ctx, shopOrderID)
+	ret0, _ := ret[0].([]response.OrderStatusHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllShopOrderStatusHistories indicates an expected call of FindAllShopOrderStatusHistories.
+func (mr *MockOrderRepositoryMockRecorder) FindAllShopOrderStatusHistories(ctx, shopOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllShopOrderStatusHistories", reflect.TypeOf((*MockOrderRepository)(nil).FindAllShopOrderStatusHistories), ctx, shopOrderID)
+}
+
+// FindAllShopOrders mocks base method.
+func (m *MockOrderRepository) FindAllShopOrders(ctx context.Context, pagination request.Pagination) ([]response.ShopOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllShopOrders", ctx, pagination)
+	ret0, _ := ret[0].([]response.ShopOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllShopOrders indicates an expected call of FindAllShopOrders.
+func (mr *MockOrderRepositoryMockRecorder) FindAllShopOrders(ctx, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllShopOrders", reflect.TypeOf((*MockOrderRepository)(nil).FindAllShopOrders), ctx, pagination)
+}
+
+// FindAllShopOrdersByStatusBefore mocks base method.
+func (m *MockOrderRepository) FindAllShopOrdersByStatusBefore(ctx context.Context, orderStatusID uint, orderedBefore time.Time) ([]domain.ShopOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllShopOrdersByStatusBefore", ctx, orderStatusID, orderedBefore)
+	ret0, _ := ret[0].([]domain.ShopOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllShopOrdersByStatusBefore indicates an expected call of FindAllShopOrdersByStatusBefore.
+func (mr *MockOrderRepositoryMockRecorder) FindAllShopOrdersByStatusBefore(ctx, orderStatusID, orderedBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllShopOrdersByStatusBefore", reflect.TypeOf((*MockOrderRepository)(nil).FindAllShopOrdersByStatusBefore), ctx, orderStatusID, orderedBefore)
+}
+
+// FindAllShopOrdersByUserID mocks base method.
+func (m *MockOrderRepository) FindAllShopOrdersByUserID(ctx context.Context, userID uint, pagination request.Pagination) ([]response.ShopOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllShopOrdersByUserID", ctx, userID, pagination)
+	ret0, _ := ret[0].([]response.ShopOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllShopOrdersByUserID indicates an expected call of FindAllShopOrdersByUserID.
+func (mr *MockOrderRepositoryMockRecorder) FindAllShopOrdersByUserID(ctx, userID, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllShopOrdersByUserID", reflect.TypeOf((*MockOrderRepository)(nil).FindAllShopOrdersByUserID), ctx, userID, pagination)
+}
+
+// FindOrderReturnByReturnID mocks base method.
+func (m *MockOrderRepository) FindOrderReturnByReturnID(ctx context.Context, orderReturnID uint) (domain.OrderReturn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrderReturnByReturnID", ctx, orderReturnID)
+	ret0, _ := ret[0].(domain.OrderReturn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOrderReturnByReturnID indicates an expected call of FindOrderReturnByReturnID.
+func (mr *MockOrderRepositoryMockRecorder) FindOrderReturnByReturnID(ctx, orderReturnID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrderReturnByReturnID", reflect.TypeOf((*MockOrderRepository)(nil).FindOrderReturnByReturnID), ctx, orderReturnID)
+}
+
+// FindOrderReturnByShopOrderID mocks base method.
+func (m *MockOrderRepository) FindOrderReturnByShopOrderID(ctx context.Context, shopOrderID uint) (domain.OrderReturn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrderReturnByShopOrderID", ctx, shopOrderID)
+	ret0, _ := ret[0].(domain.OrderReturn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOrderReturnByShopOrderID indicates an expected call of FindOrderReturnByShopOrderID.
+func (mr *MockOrderRepositoryMockRecorder) FindOrderReturnByShopOrderID(ctx, shopOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrderReturnByShopOrderID", reflect.TypeOf((*MockOrderRepository)(nil).FindOrderReturnByShopOrderID), ctx, shopOrderID)
+}
+
+// FindOrderStatusByID mocks base method.
+func (m *MockOrderRepository) FindOrderStatusByID(ctx context.Context, orderStatusID uint) (domain.OrderStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrderStatusByID", ctx, orderStatusID)
+	ret0, _ := ret[0].(domain.OrderStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOrderStatusByID indicates an expected call of FindOrderStatusByID.
+func (mr *MockOrderRepositoryMockRecorder) FindOrderStatusByID(ctx, orderStatusID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrderStatusByID", reflect.TypeOf((*MockOrderRepository)(nil).FindOrderStatusByID), ctx, orderStatusID)
+}
+
+// FindOrderStatusByShopOrderID mocks base method.
+func (m *MockOrderRepository) FindOrderStatusByShopOrderID(ctx context.Context, shopOrderID uint) (domain.OrderStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrderStatusByShopOrderID", ctx, shopOrderID)
+	ret0, _ := ret[0].(domain.OrderStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOrderStatusByShopOrderID indicates an expected call of FindOrderStatusByShopOrderID.
+func (mr *MockOrderRepositoryMockRecorder) FindOrderStatusByShopOrderID(ctx, shopOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrderStatusByShopOrderID", reflect.TypeOf((*MockOrderRepository)(nil).FindOrderStatusByShopOrderID), ctx, shopOrderID)
+}
+
+// FindOrderStatusByStatus mocks base method.
+func (m *MockOrderRepository) FindOrderStatusByStatus(ctx context.Context, orderStatus domain.OrderStatusType) (domain.OrderStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrderStatusByStatus", ctx, orderStatus)
+	ret0, _ := ret[0].(domain.OrderStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOrderStatusByStatus indicates an expected call of FindOrderStatusByStatus.
+func (mr *MockOrderRepositoryMockRecorder) FindOrderStatusByStatus(ctx, orderStatus interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrderStatusByStatus", reflect.TypeOf((*MockOrderRepository)(nil).FindOrderStatusByStatus), ctx, orderStatus)
+}
+
+// FindShopOrderByGatewayOrderID mocks base method.
+func (m *MockOrderRepository) FindShopOrderByGatewayOrderID(ctx context.Context, gatewayOrderID string) (domain.ShopOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindShopOrderByGatewayOrderID", ctx, gatewayOrderID)
+	ret0, _ := ret[0].(domain.ShopOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindShopOrderByGatewayOrderID indicates an expected call of FindShopOrderByGatewayOrderID.
+func (mr *MockOrderRepositoryMockRecorder) FindShopOrderByGatewayOrderID(ctx, gatewayOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindShopOrderByGatewayOrderID", reflect.TypeOf((*MockOrderRepository)(nil).FindShopOrderByGatewayOrderID), ctx, gatewayOrderID)
+}
+
+// FindShopOrderByShopOrderID mocks base method.
+func (m *MockOrderRepository) FindShopOrderByShopOrderID(ctx context.Context, shopOrderID uint) (domain.ShopOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindShopOrderByShopOrderID", ctx, shopOrderID)
+	ret0, _ := ret[0].(domain.ShopOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindShopOrderByShopOrderID indicates an expected call of FindShopOrderByShopOrderID.
+func (mr *MockOrderRepositoryMockRecorder) FindShopOrderByShopOrderID(ctx, shopOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindShopOrderByShopOrderID", reflect.TypeOf((*MockOrderRepository)(nil).FindShopOrderByShopOrderID), ctx, shopOrderID)
+}
+
+// FindWalletByUserID mocks base method.
+func (m *MockOrderRepository) FindWalletByUserID(ctx context.Context, userID uint) (domain.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWalletByUserID", ctx, userID)
+	ret0, _ := ret[0].(domain.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWalletByUserID indicates an expected call of FindWalletByUserID.
+func (mr *MockOrderRepositoryMockRecorder) FindWalletByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWalletByUserID", reflect.TypeOf((*MockOrderRepository)(nil).FindWalletByUserID), ctx, userID)
+}
+
+// FindWalletTransactions mocks base method.
+func (m *MockOrderRepository) FindWalletTransactions(ctx context.Context, walletID uint, pagination request.Pagination) ([]domain.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWalletTransactions", ctx, walletID, pagination)
+	ret0, _ := ret[0].([]domain.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWalletTransactions indicates an expected call of FindWalletTransactions.
+func (mr *MockOrderRepositoryMockRecorder) FindWalletTransactions(ctx, walletID, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWalletTransactions", reflect.TypeOf((*MockOrderRepository)(nil).FindWalletTransactions), ctx, walletID, pagination)
+}
+
+// IsOrderStatusTransitionExist mocks base method.
+func (m *MockOrderRepository) IsOrderStatusTransitionExist(ctx context.Context, fromStatusID, toStatusID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsOrderStatusTransitionExist", ctx, fromStatusID, toStatusID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsOrderStatusTransitionExist indicates an expected call of IsOrderStatusTransitionExist.
+func (mr *MockOrderRepositoryMockRecorder) IsOrderStatusTransitionExist(ctx, fromStatusID, toStatusID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOrderStatusTransitionExist", reflect.TypeOf((*MockOrderRepository)(nil).IsOrderStatusTransitionExist), ctx, fromStatusID, toStatusID)
+}
+
+// RemoveCouponFromCart mocks base method.
+func (m *MockOrderRepository) RemoveCouponFromCart(ctx context.Context, userID, couponID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCouponFromCart", ctx, userID, couponID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveCouponFromCart indicates an expected call of RemoveCouponFromCart.
+func (mr *MockOrderRepositoryMockRecorder) RemoveCouponFromCart(ctx, userID, couponID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCouponFromCart", reflect.TypeOf((*MockOrderRepository)(nil).RemoveCouponFromCart), ctx, userID, couponID)
+}
+
+// SaveCouponUses mocks base method.
+func (m *MockOrderRepository) SaveCouponUses(ctx context.Context, couponUses domain.CouponUses) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCouponUses", ctx, couponUses)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCouponUses indicates an expected call of SaveCouponUses.
+func (mr *MockOrderRepositoryMockRecorder) SaveCouponUses(ctx, couponUses interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCouponUses", reflect.TypeOf((*MockOrderRepository)(nil).SaveCouponUses), ctx, couponUses)
+}
+
+// SaveInvoice mocks base method.
+func (m *MockOrderRepository) SaveInvoice(ctx context.Context, shopOrderID uint) (domain.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveInvoice", ctx, shopOrderID)
+	ret0, _ := ret[0].(domain.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveInvoice indicates an expected call of SaveInvoice.
+func (mr *MockOrderRepositoryMockRecorder) SaveInvoice(ctx, shopOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveInvoice", reflect.TypeOf((*MockOrderRepository)(nil).SaveInvoice), ctx, shopOrderID)
+}
+
+// SaveOrderCancellation mocks base method.
+func (m *MockOrderRepository) SaveOrderCancellation(ctx context.Context, cancellation domain.OrderCancellation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveOrderCancellation", ctx, cancellation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveOrderCancellation indicates an expected call of SaveOrderCancellation.
+func (mr *MockOrderRepositoryMockRecorder) SaveOrderCancellation(ctx, cancellation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveOrderCancellation", reflect.TypeOf((*MockOrderRepository)(nil).SaveOrderCancellation), ctx, cancellation)
+}
+
+// SaveOrderLine mocks base method.
+func (m *MockOrderRepository) SaveOrderLine(ctx context.Context, orderLine domain.OrderLine) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveOrderLine", ctx, orderLine)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveOrderLine indicates an expected call of SaveOrderLine.
+func (mr *MockOrderRepositoryMockRecorder) SaveOrderLine(ctx, orderLine interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveOrderLine", reflect.TypeOf((*MockOrderRepository)(nil).SaveOrderLine), ctx, orderLine)
+}
+
+// SaveOrderReturn mocks base method.
+func (m *MockOrderRepository) SaveOrderReturn(ctx context.Context, orderReturn domain.OrderReturn) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveOrderReturn", ctx, orderReturn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveOrderReturn indicates an expected call of SaveOrderReturn.
+func (mr *MockOrderRepositoryMockRecorder) SaveOrderReturn(ctx, orderReturn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveOrderReturn", reflect.TypeOf((*MockOrderRepository)(nil).SaveOrderReturn), ctx, orderReturn)
+}
+
+// SaveShopOrder mocks base method.
+func (m *MockOrderRepository) SaveShopOrder(ctx context.Context, shopOrder domain.ShopOrder) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveShopOrder", ctx, shopOrder)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveShopOrder indicates an expected call of SaveShopOrder.
+func (mr *MockOrderRepositoryMockRecorder) SaveShopOrder(ctx, shopOrder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveShopOrder", reflect.TypeOf((*MockOrderRepository)(nil).SaveShopOrder), ctx, shopOrder)
+}
+
+// SaveShopOrderStatusHistory mocks base method.
+func (m *MockOrderRepository) SaveShopOrderStatusHistory(ctx context.Context, history domain.ShopOrderStatusHistory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveShopOrderStatusHistory", ctx, history)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveShopOrderStatusHistory indicates an expected call of SaveShopOrderStatusHistory.
+func (mr *MockOrderRepositoryMockRecorder) SaveShopOrderStatusHistory(ctx, history interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveShopOrderStatusHistory", reflect.TypeOf((*MockOrderRepository)(nil).SaveShopOrderStatusHistory), ctx, history)
+}
+
+// SaveWallet mocks base method.
+func (m *MockOrderRepository) SaveWallet(ctx context.Context, userID uint) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveWallet", ctx, userID)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveWallet indicates an expected call of SaveWallet.
+func (mr *MockOrderRepositoryMockRecorder) SaveWallet(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveWallet", reflect.TypeOf((*MockOrderRepository)(nil).SaveWallet), ctx, userID)
+}
+
+// SaveWalletTransaction mocks base method.
+func (m *MockOrderRepository) SaveWalletTransaction(ctx context.Context, walletTrx domain.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveWalletTransaction", ctx, walletTrx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveWalletTransaction indicates an expected call of SaveWalletTransaction.
+func (mr *MockOrderRepositoryMockRecorder) SaveWalletTransaction(ctx, walletTrx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveWalletTransaction", reflect.TypeOf((*MockOrderRepository)(nil).SaveWalletTransaction), ctx, walletTrx)
+}
+
+// Transaction mocks base method.
+func (m *MockOrderRepository) Transaction(callBack func(interfaces.OrderRepository) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transaction", callBack)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transaction indicates an expected call of Transaction.
+func (mr *MockOrderRepositoryMockRecorder) Transaction(callBack interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockOrderRepository)(nil).Transaction), callBack)
+}
+
+// UpdateOrderCancellationGatewayRefund mocks base method.
+func (m *MockOrderRepository) UpdateOrderCancellationGatewayRefund(ctx context.Context, shopOrderID, gatewayRefundAmount uint, gatewayRefundID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrderCancellationGatewayRefund", ctx, shopOrderID, gatewayRefundAmount, gatewayRefundID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOrderCancellationGatewayRefund indicates an expected call of UpdateOrderCancellationGatewayRefund.
+func (mr *MockOrderRepositoryMockRecorder) UpdateOrderCancellationGatewayRefund(ctx, shopOrderID, gatewayRefundAmount, gatewayRefundID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderCancellationGatewayRefund", reflect.TypeOf((*MockOrderRepository)(nil).UpdateOrderCancellationGatewayRefund), ctx, shopOrderID, gatewayRefundAmount, gatewayRefundID)
+}
+
+// UpdateOrderReturn mocks base method.
+func (m *MockOrderRepository) UpdateOrderReturn(ctx context.Context, orderReturn domain.OrderReturn) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrderReturn", ctx, orderReturn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOrderReturn indicates an expected call of UpdateOrderReturn.
+func (mr *MockOrderRepositoryMockRecorder) UpdateOrderReturn(ctx, orderReturn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderReturn", reflect.TypeOf((*MockOrderRepository)(nil).UpdateOrderReturn), ctx, orderReturn)
+}
+
+// UpdateShopOrderGatewayOrder mocks base method.
+func (m *MockOrderRepository) UpdateShopOrderGatewayOrder(ctx context.Context, shopOrderID, orderStatusID, paymentMethodID uint, gatewayOrderID string, walletAmount uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShopOrderGatewayOrder", ctx, shopOrderID, orderStatusID, paymentMethodID, gatewayOrderID, walletAmount)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShopOrderGatewayOrder indicates an expected call of UpdateShopOrderGatewayOrder.
+func (mr *MockOrderRepositoryMockRecorder) UpdateShopOrderGatewayOrder(ctx, shopOrderID, orderStatusID, paymentMethodID, gatewayOrderID, walletAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShopOrderGatewayOrder", reflect.TypeOf((*MockOrderRepository)(nil).UpdateShopOrderGatewayOrder), ctx, shopOrderID, orderStatusID, paymentMethodID, gatewayOrderID, walletAmount)
+}
+
+// UpdateShopOrderOrderStatus mocks base method.
+func (m *MockOrderRepository) UpdateShopOrderOrderStatus(ctx context.Context, shopOrderID, currentStatusID, changeStatusID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShopOrderOrderStatus", ctx, shopOrderID, currentStatusID, changeStatusID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShopOrderOrderStatus indicates an expected call of UpdateShopOrderOrderStatus.
+func (mr *MockOrderRepositoryMockRecorder) UpdateShopOrderOrderStatus(ctx, shopOrderID, currentStatusID, changeStatusID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShopOrderOrderStatus", reflect.TypeOf((*MockOrderRepository)(nil).UpdateShopOrderOrderStatus), ctx, shopOrderID, currentStatusID, changeStatusID)
+}
+
+// UpdateShopOrderStatusAndSavePaymentMethod mocks base method.
+func (m *MockOrderRepository) UpdateShopOrderStatusAndSavePaymentMethod(ctx context.Context, shopOrderID, currentStatusID, orderStatusID, paymentID, walletAmount uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShopOrderStatusAndSavePaymentMethod", ctx, shopOrderID, currentStatusID, orderStatusID, paymentID, walletAmount)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShopOrderStatusAndSavePaymentMethod indicates an expected call of UpdateShopOrderStatusAndSavePaymentMethod.
+func (mr *MockOrderRepositoryMockRecorder) UpdateShopOrderStatusAndSavePaymentMethod(ctx, shopOrderID, currentStatusID, orderStatusID, paymentID, walletAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShopOrderStatusAndSavePaymentMethod", reflect.TypeOf((*MockOrderRepository)(nil).UpdateShopOrderStatusAndSavePaymentMethod), ctx, shopOrderID, currentStatusID, orderStatusID, paymentID, walletAmount)
+}
@@ -0,0 +1,170 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interfaces/payment.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	request "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	domain "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+)
+
+// MockPaymentRepository is a mock of PaymentRepository interface.
+type MockPaymentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentRepositoryMockRecorder
+}
+
+// MockPaymentRepositoryMockRecorder is the mock recorder for MockPaymentRepository.
+type MockPaymentRepositoryMockRecorder struct {
+	mock *MockPaymentRepository
+}
+
+// NewMockPaymentRepository creates a new mock instance.
+func NewMockPaymentRepository(ctrl *gomock.Controller) *MockPaymentRepository {
+	mock := &MockPaymentRepository{ctrl: ctrl}
+	mock.recorder = &MockPaymentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentRepository) EXPECT() *MockPaymentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAllPaymentEvents mocks base method.
+func (m *MockPaymentRepository) FindAllPaymentEvents(ctx context.Context, pagination request.Pagination) ([]domain.PaymentEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllPaymentEvents", ctx, pagination)
+	ret0, _ := ret[0].([]domain.PaymentEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllPaymentEvents indicates an expected call of FindAllPaymentEvents.
+func (mr *MockPaymentRepositoryMockRecorder) FindAllPaymentEvents(ctx, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllPaymentEvents", reflect.TypeOf((*MockPaymentRepository)(nil).FindAllPaymentEvents), ctx, pagination)
+}
+
+// FindAllPaymentMethods mocks base method.
+func (m *MockPaymentRepository) FindAllPaymentMethods(ctx context.Context) ([]domain.PaymentMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllPaymentMethods", ctx)
+	ret0, _ := ret[0].([]domain.PaymentMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllPaymentMethods indicates an expected call of FindAllPaymentMethods.
+func (mr *MockPaymentRepositoryMockRecorder) FindAllPaymentMethods(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllPaymentMethods", reflect.TypeOf((*MockPaymentRepository)(nil).FindAllPaymentMethods), ctx)
+}
+
+// FindPaymentEventByEventID mocks base method.
+func (m *MockPaymentRepository) FindPaymentEventByEventID(ctx context.Context, gateway domain.PaymentType, eventID string) (domain.PaymentEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPaymentEventByEventID", ctx, gateway, eventID)
+	ret0, _ := ret[0].(domain.PaymentEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPaymentEventByEventID indicates an expected call of FindPaymentEventByEventID.
+func (mr *MockPaymentRepositoryMockRecorder) FindPaymentEventByEventID(ctx, gateway, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPaymentEventByEventID", reflect.TypeOf((*MockPaymentRepository)(nil).FindPaymentEventByEventID), ctx, gateway, eventID)
+}
+
+// FindPaymentEventByID mocks base method.
+func (m *MockPaymentRepository) FindPaymentEventByID(ctx context.Context, paymentEventID uint) (domain.PaymentEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPaymentEventByID", ctx, paymentEventID)
+	ret0, _ := ret[0].(domain.PaymentEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPaymentEventByID indicates an expected call of FindPaymentEventByID.
+func (mr *MockPaymentRepositoryMockRecorder) FindPaymentEventByID(ctx, paymentEventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPaymentEventByID", reflect.TypeOf((*MockPaymentRepository)(nil).FindPaymentEventByID), ctx, paymentEventID)
+}
+
+// FindPaymentMethodByID mocks base method.
+func (m *MockPaymentRepository) FindPaymentMethodByID(ctx context.Context, paymentMethodID uint) (domain.PaymentMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPaymentMethodByID", ctx, paymentMethodID)
+	ret0, _ := ret[0].(domain.PaymentMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPaymentMethodByID indicates an expected call of FindPaymentMethodByID.
+func (mr *MockPaymentRepositoryMockRecorder) FindPaymentMethodByID(ctx, paymentMethodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPaymentMethodByID", reflect.TypeOf((*MockPaymentRepository)(nil).FindPaymentMethodByID), ctx, paymentMethodID)
+}
+
+// FindPaymentMethodByType mocks base method.
+func (m *MockPaymentRepository) FindPaymentMethodByType(ctx context.Context, paymentType domain.PaymentType) (domain.PaymentMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPaymentMethodByType", ctx, paymentType)
+	ret0, _ := ret[0].(domain.PaymentMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPaymentMethodByType indicates an expected call of FindPaymentMethodByType.
+func (mr *MockPaymentRepositoryMockRecorder) FindPaymentMethodByType(ctx, paymentType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPaymentMethodByType", reflect.TypeOf((*MockPaymentRepository)(nil).FindPaymentMethodByType), ctx, paymentType)
+}
+
+// SavePaymentEvent mocks base method.
+func (m *MockPaymentRepository) SavePaymentEvent(ctx context.Context, event domain.PaymentEvent) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePaymentEvent", ctx, event)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SavePaymentEvent indicates an expected call of SavePaymentEvent.
+func (mr *MockPaymentRepositoryMockRecorder) SavePaymentEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePaymentEvent", reflect.TypeOf((*MockPaymentRepository)(nil).SavePaymentEvent), ctx, event)
+}
+
+// UpdatePaymentEventResult mocks base method.
+func (m *MockPaymentRepository) UpdatePaymentEventResult(ctx context.Context, paymentEventID uint, processed bool, errMessage string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePaymentEventResult", ctx, paymentEventID, processed, errMessage)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePaymentEventResult indicates an expected call of UpdatePaymentEventResult.
+func (mr *MockPaymentRepositoryMockRecorder) UpdatePaymentEventResult(ctx, paymentEventID, processed, errMessage interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentEventResult", reflect.TypeOf((*MockPaymentRepository)(nil).UpdatePaymentEventResult), ctx, paymentEventID, processed, errMessage)
+}
+
+// UpdatePaymentMethod mocks base method.
+func (m *MockPaymentRepository) UpdatePaymentMethod(ctx context.Context, paymentMethod request.PaymentMethodUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePaymentMethod", ctx, paymentMethod)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePaymentMethod indicates an expected call of UpdatePaymentMethod.
+func (mr *MockPaymentRepositoryMockRecorder) UpdatePaymentMethod(ctx, paymentMethod interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentMethod", reflect.TypeOf((*MockPaymentRepository)(nil).UpdatePaymentMethod), ctx, paymentMethod)
+}
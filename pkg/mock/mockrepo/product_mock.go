@@ -0,0 +1,584 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interfaces/product.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	request "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	response "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	domain "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	interfaces "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+)
+
+// MockProductRepository is a mock of ProductRepository interface.
+type MockProductRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductRepositoryMockRecorder
+}
+
+// MockProductRepositoryMockRecorder is the mock recorder for MockProductRepository.
+type MockProductRepositoryMockRecorder struct {
+	mock *MockProductRepository
+}
+
+// NewMockProductRepository creates a new mock instance.
+func NewMockProductRepository(ctrl *gomock.Controller) *MockProductRepository {
+	mock := &MockProductRepository{ctrl: ctrl}
+	mock.recorder = &MockProductRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductRepository) EXPECT() *MockProductRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAllMainCategories mocks base method.
+func (m *MockProductRepository) FindAllMainCategories(ctx context.Context, pagination request.Pagination) ([]response.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllMainCategories", ctx, pagination)
+	ret0, _ := ret[0].([]response.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllMainCategories indicates an expected call of FindAllMainCategories.
+func (mr *MockProductRepositoryMockRecorder) FindAllMainCategories(ctx, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllMainCategories", reflect.TypeOf((*MockProductRepository)(nil).FindAllMainCategories), ctx, pagination)
+}
+
+// FindAllProductItemIDsByProductIDAndVariationOptionID mocks base method.
+func (m *MockProductRepository) FindAllProductItemIDsByProductIDAndVariationOptionID(ctx context.Context, productID, variationOptionID uint) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllProductItemIDsByProductIDAndVariationOptionID", ctx, productID, variationOptionID)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllProductItemIDsByProductIDAndVariationOptionID indicates an expected call of FindAllProductItemIDsByProductIDAndVariationOptionID.
+func (mr *MockProductRepositoryMockRecorder) FindAllProductItemIDsByProductIDAndVariationOptionID(ctx, productID, variationOptionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllProductItemIDsByProductIDAndVariationOptionID", reflect.TypeOf((*MockProductRepository)(nil).FindAllProductItemIDsByProductIDAndVariationOptionID), ctx, productID, variationOptionID)
+}
+
+// FindAllProductItemImages mocks base method.
+func (m *MockProductRepository) FindAllProductItemImages(ctx context.Context, productItemID uint) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllProductItemImages", ctx, productItemID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllProductItemImages indicates an expected call of FindAllProductItemImages.
+func (mr *MockProductRepositoryMockRecorder) FindAllProductItemImages(ctx, productItemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllProductItemImages", reflect.TypeOf((*MockProductRepository)(nil).FindAllProductItemImages), ctx, productItemID)
+}
+
+// FindAllProductItems mocks base method.
+func (m *MockProductRepository) FindAllProductItems(ctx context.Context, productID uint) ([]response.ProductItems, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllProductItems", ctx, productID)
+	ret0, _ := ret[0].([]response.ProductItems)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllProductItems indicates an expected call of FindAllProductItems.
+func (mr *MockProductRepositoryMockRecorder) FindAllProductItems(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllProductItems", reflect.TypeOf((*MockProductRepository)(nil).FindAllProductItems), ctx, productID)
+}
+
+// FindAllProductItemsForExport mocks base method.
+func (m *MockProductRepository) FindAllProductItemsForExport(ctx context.Context, pagination request.Pagination) ([]response.ProductItemExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllProductItemsForExport", ctx, pagination)
+	ret0, _ := ret[0].([]response.ProductItemExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllProductItemsForExport indicates an expected call of FindAllProductItemsForExport.
+func (mr *MockProductRepositoryMockRecorder) FindAllProductItemsForExport(ctx, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllProductItemsForExport", reflect.TypeOf((*MockProductRepository)(nil).FindAllProductItemsForExport), ctx, pagination)
+}
+
+// FindAllProducts mocks base method.
+func (m *MockProductRepository) FindAllProducts(ctx context.Context, pagination request.Pagination) ([]response.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllProducts", ctx, pagination)
+	ret0, _ := ret[0].([]response.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllProducts indicates an expected call of FindAllProducts.
+func (mr *MockProductRepositoryMockRecorder) FindAllProducts(ctx, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllProducts", reflect.TypeOf((*MockProductRepository)(nil).FindAllProducts), ctx, pagination)
+}
+
+// FindAllSubCategories mocks base method.
+func (m *MockProductRepository) FindAllSubCategories(ctx context.Context, categoryID uint) ([]response.SubCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllSubCategories", ctx, categoryID)
+	ret0, _ := ret[0].([]response.SubCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllSubCategories indicates an expected call of FindAllSubCategories.
+func (mr *MockProductRepositoryMockRecorder) FindAllSubCategories(ctx, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllSubCategories", reflect.TypeOf((*MockProductRepository)(nil).FindAllSubCategories), ctx, categoryID)
+}
+
+// FindAllVariationOptionsByVariationID mocks base method.
+func (m *MockProductRepository) FindAllVariationOptionsByVariationID(ctx context.Context, variationID uint) ([]response.VariationOption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllVariationOptionsByVariationID", ctx, variationID)
+	ret0, _ := ret[0].([]response.VariationOption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllVariationOptionsByVariationID indicates an expected call of FindAllVariationOptionsByVariationID.
+func (mr *MockProductRepositoryMockRecorder) FindAllVariationOptionsByVariationID(ctx, variationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllVariationOptionsByVariationID", reflect.TypeOf((*MockProductRepository)(nil).FindAllVariationOptionsByVariationID), ctx, variationID)
+}
+
+// FindAllVariationValuesOfProductItem mocks base method.
+func (m *MockProductRepository) FindAllVariationValuesOfProductItem(ctx context.Context, productItemID uint) ([]response.ProductVariationValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllVariationValuesOfProductItem", ctx, productItemID)
+	ret0, _ := ret[0].([]response.ProductVariationValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllVariationValuesOfProductItem indicates an expected call of FindAllVariationValuesOfProductItem.
+func (mr *MockProductRepositoryMockRecorder) FindAllVariationValuesOfProductItem(ctx, productItemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllVariationValuesOfProductItem", reflect.TypeOf((*MockProductRepository)(nil).FindAllVariationValuesOfProductItem), ctx, productItemID)
+}
+
+// FindAllVariationsByCategoryID mocks base method.
+func (m *MockProductRepository) FindAllVariationsByCategoryID(ctx context.Context, categoryID uint) ([]response.Variation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllVariationsByCategoryID", ctx, categoryID)
+	ret0, _ := ret[0].([]response.Variation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllVariationsByCategoryID indicates an expected call of FindAllVariationsByCategoryID.
+func (mr *MockProductRepositoryMockRecorder) FindAllVariationsByCategoryID(ctx, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllVariationsByCategoryID", reflect.TypeOf((*MockProductRepository)(nil).FindAllVariationsByCategoryID), ctx, categoryID)
+}
+
+// FindBrandByName mocks base method.
+func (m *MockProductRepository) FindBrandByName(ctx context.Context, name string) (domain.Brand, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBrandByName", ctx, name)
+	ret0, _ := ret[0].(domain.Brand)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBrandByName indicates an expected call of FindBrandByName.
+func (mr *MockProductRepositoryMockRecorder) FindBrandByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBrandByName", reflect.TypeOf((*MockProductRepository)(nil).FindBrandByName), ctx, name)
+}
+
+// FindProductByID mocks base method.
+func (m *MockProductRepository) FindProductByID(ctx context.Context, productID uint) (domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProductByID", ctx, productID)
+	ret0, _ := ret[0].(domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProductByID indicates an expected call of FindProductByID.
+func (mr *MockProductRepositoryMockRecorder) FindProductByID(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProductByID", reflect.TypeOf((*MockProductRepository)(nil).FindProductByID), ctx, productID)
+}
+
+// FindProductByName mocks base method.
+func (m *MockProductRepository) FindProductByName(ctx context.Context, name string) (domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProductByName", ctx, name)
+	ret0, _ := ret[0].(domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProductByName indicates an expected call of FindProductByName.
+func (mr *MockProductRepositoryMockRecorder) FindProductByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProductByName", reflect.TypeOf((*MockProductRepository)(nil).FindProductByName), ctx, name)
+}
+
+// FindProductItemByID mocks base method.
+func (m *MockProductRepository) FindProductItemByID(ctx context.Context, productItemID uint) (domain.ProductItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProductItemByID", ctx, productItemID)
+	ret0, _ := ret[0].(domain.ProductItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProductItemByID indicates an expected call of FindProductItemByID.
+func (mr *MockProductRepositoryMockRecorder) FindProductItemByID(ctx, productItemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProductItemByID", reflect.TypeOf((*MockProductRepository)(nil).FindProductItemByID), ctx, productItemID)
+}
+
+// FindProductSearchFacets mocks base method.
+func (m *MockProductRepository) FindProductSearchFacets(ctx context.Context, search request.ProductSearch) (response.ProductFacet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProductSearchFacets", ctx, search)
+	ret0, _ := ret[0].(response.ProductFacet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProductSearchFacets indicates an expected call of FindProductSearchFacets.
+func (mr *MockProductRepositoryMockRecorder) FindProductSearchFacets(ctx, search interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProductSearchFacets", reflect.TypeOf((*MockProductRepository)(nil).FindProductSearchFacets), ctx, search)
+}
+
+// FindSubCategoryByName mocks base method.
+func (m *MockProductRepository) FindSubCategoryByName(ctx context.Context, name string) (domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSubCategoryByName", ctx, name)
+	ret0, _ := ret[0].(domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSubCategoryByName indicates an expected call of FindSubCategoryByName.
+func (mr *MockProductRepositoryMockRecorder) FindSubCategoryByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSubCategoryByName", reflect.TypeOf((*MockProductRepository)(nil).FindSubCategoryByName), ctx, name)
+}
+
+// FindVariationCountForProduct mocks base method.
+func (m *MockProductRepository) FindVariationCountForProduct(ctx context.Context, productID uint) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindVariationCountForProduct", ctx, productID)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindVariationCountForProduct indicates an expected call of FindVariationCountForProduct.
+func (mr *MockProductRepositoryMockRecorder) FindVariationCountForProduct(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindVariationCountForProduct", reflect.TypeOf((*MockProductRepository)(nil).FindVariationCountForProduct), ctx, productID)
+}
+
+// IsCategoryNameExist mocks base method.
+func (m *MockProductRepository) IsCategoryNameExist(ctx context.Context, categoryName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsCategoryNameExist", ctx, categoryName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsCategoryNameExist indicates an expected call of IsCategoryNameExist.
+func (mr *MockProductRepositoryMockRecorder) IsCategoryNameExist(ctx, categoryName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsCategoryNameExist", reflect.TypeOf((*MockProductRepository)(nil).IsCategoryNameExist), ctx, categoryName)
+}
+
+// IsProductNameExist mocks base method.
+func (m *MockProductRepository) IsProductNameExist(ctx context.Context, productName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsProductNameExist", ctx, productName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsProductNameExist indicates an expected call of IsProductNameExist.
+func (mr *MockProductRepositoryMockRecorder) IsProductNameExist(ctx, productName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsProductNameExist", reflect.TypeOf((*MockProductRepository)(nil).IsProductNameExist), ctx, productName)
+}
+
+// IsProductNameExistForOtherProduct mocks base method.
+func (m *MockProductRepository) IsProductNameExistForOtherProduct(ctx context.Context, name string, productID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsProductNameExistForOtherProduct", ctx, name, productID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsProductNameExistForOtherProduct indicates an expected call of IsProductNameExistForOtherProduct.
+func (mr *MockProductRepositoryMockRecorder) IsProductNameExistForOtherProduct(ctx, name, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsProductNameExistForOtherProduct", reflect.TypeOf((*MockProductRepository)(nil).IsProductNameExistForOtherProduct), ctx, name, productID)
+}
+
+// IsSKUExist mocks base method.
+func (m *MockProductRepository) IsSKUExist(ctx context.Context, sku string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSKUExist", ctx, sku)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSKUExist indicates an expected call of IsSKUExist.
+func (mr *MockProductRepositoryMockRecorder) IsSKUExist(ctx, sku interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSKUExist", reflect.TypeOf((*MockProductRepository)(nil).IsSKUExist), ctx, sku)
+}
+
+// IsSubCategoryNameExist mocks base method.
+func (m *MockProductRepository) IsSubCategoryNameExist(ctx context.Context, categoryName string, categoryID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSubCategoryNameExist", ctx, categoryName, categoryID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSubCategoryNameExist indicates an expected call of IsSubCategoryNameExist.
+func (mr *MockProductRepositoryMockRecorder) IsSubCategoryNameExist(ctx, categoryName, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSubCategoryNameExist", reflect.TypeOf((*MockProductRepository)(nil).IsSubCategoryNameExist), ctx, categoryName, categoryID)
+}
+
+// IsVariationNameExistForCategory mocks base method.
+func (m *MockProductRepository) IsVariationNameExistForCategory(ctx context.Context, name string, categoryID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsVariationNameExistForCategory", ctx, name, categoryID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsVariationNameExistForCategory indicates an expected call of IsVariationNameExistForCategory.
+func (mr *MockProductRepositoryMockRecorder) IsVariationNameExistForCategory(ctx, name, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsVariationNameExistForCategory", reflect.TypeOf((*MockProductRepository)(nil).IsVariationNameExistForCategory), ctx, name, categoryID)
+}
+
+// IsVariationValueExistForVariation mocks base method.
+func (m *MockProductRepository) IsVariationValueExistForVariation(ctx context.Context, value string, variationID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsVariationValueExistForVariation", ctx, value, variationID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsVariationValueExistForVariation indicates an expected call of IsVariationValueExistForVariation.
+func (mr *MockProductRepositoryMockRecorder) IsVariationValueExistForVariation(ctx, value, variationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsVariationValueExistForVariation", reflect.TypeOf((*MockProductRepository)(nil).IsVariationValueExistForVariation), ctx, value, variationID)
+}
+
+// SaveCategory mocks base method.
+func (m *MockProductRepository) SaveCategory(ctx context.Context, categoryName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCategory", ctx, categoryName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCategory indicates an expected call of SaveCategory.
+func (mr *MockProductRepositoryMockRecorder) SaveCategory(ctx, categoryName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCategory", reflect.TypeOf((*MockProductRepository)(nil).SaveCategory), ctx, categoryName)
+}
+
+// SaveProduct mocks base method.
+func (m *MockProductRepository) SaveProduct(ctx context.Context, product domain.Product) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveProduct", ctx, product)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveProduct indicates an expected call of SaveProduct.
+func (mr *MockProductRepositoryMockRecorder) SaveProduct(ctx, product interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProduct", reflect.TypeOf((*MockProductRepository)(nil).SaveProduct), ctx, product)
+}
+
+// SaveProductConfiguration mocks base method.
+func (m *MockProductRepository) SaveProductConfiguration(ctx context.Context, productItemID, variationOptionID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveProductConfiguration", ctx, productItemID, variationOptionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveProductConfiguration indicates an expected call of SaveProductConfiguration.
+func (mr *MockProductRepositoryMockRecorder) SaveProductConfiguration(ctx, productItemID, variationOptionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProductConfiguration", reflect.TypeOf((*MockProductRepository)(nil).SaveProductConfiguration), ctx, productItemID, variationOptionID)
+}
+
+// SaveProductItem mocks base method.
+func (m *MockProductRepository) SaveProductItem(ctx context.Context, productItem domain.ProductItem) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveProductItem", ctx, productItem)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveProductItem indicates an expected call of SaveProductItem.
+func (mr *MockProductRepositoryMockRecorder) SaveProductItem(ctx, productItem interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProductItem", reflect.TypeOf((*MockProductRepository)(nil).SaveProductItem), ctx, productItem)
+}
+
+// SaveProductItemImage mocks base method.
+func (m *MockProductRepository) SaveProductItemImage(ctx context.Context, productItemID uint, image string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveProductItemImage", ctx, productItemID, image)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveProductItemImage indicates an expected call of SaveProductItemImage.
+func (mr *MockProductRepositoryMockRecorder) SaveProductItemImage(ctx, productItemID, image interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProductItemImage", reflect.TypeOf((*MockProductRepository)(nil).SaveProductItemImage), ctx, productItemID, image)
+}
+
+// SaveStockMovement mocks base method.
+func (m *MockProductRepository) SaveStockMovement(ctx context.Context, movement domain.StockMovement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveStockMovement", ctx, movement)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveStockMovement indicates an expected call of SaveStockMovement.
+func (mr *MockProductRepositoryMockRecorder) SaveStockMovement(ctx, movement interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveStockMovement", reflect.TypeOf((*MockProductRepository)(nil).SaveStockMovement), ctx, movement)
+}
+
+// SaveSubCategory mocks base method.
+func (m *MockProductRepository) SaveSubCategory(ctx context.Context, categoryID uint, categoryName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSubCategory", ctx, categoryID, categoryName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveSubCategory indicates an expected call of SaveSubCategory.
+func (mr *MockProductRepositoryMockRecorder) SaveSubCategory(ctx, categoryID, categoryName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSubCategory", reflect.TypeOf((*MockProductRepository)(nil).SaveSubCategory), ctx, categoryID, categoryName)
+}
+
+// SaveVariation mocks base method.
+func (m *MockProductRepository) SaveVariation(ctx context.Context, categoryID uint, variationName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveVariation", ctx, categoryID, variationName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveVariation indicates an expected call of SaveVariation.
+func (mr *MockProductRepositoryMockRecorder) SaveVariation(ctx, categoryID, variationName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveVariation", reflect.TypeOf((*MockProductRepository)(nil).SaveVariation), ctx, categoryID, variationName)
+}
+
+// SaveVariationOption mocks base method.
+func (m *MockProductRepository) SaveVariationOption(ctx context.Context, variationID uint, variationValue string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveVariationOption", ctx, variationID, variationValue)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveVariationOption indicates an expected call of SaveVariationOption.
+func (mr *MockProductRepositoryMockRecorder) SaveVariationOption(ctx, variationID, variationValue interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveVariationOption", reflect.TypeOf((*MockProductRepository)(nil).SaveVariationOption), ctx, variationID, variationValue)
+}
+
+// SearchProducts mocks base method.
+func (m *MockProductRepository) SearchProducts(ctx context.Context, search request.ProductSearch) ([]response.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchProducts", ctx, search)
+	ret0, _ := ret[0].([]response.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchProducts indicates an expected call of SearchProducts.
+func (mr *MockProductRepositoryMockRecorder) SearchProducts(ctx, search interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchProducts", reflect.TypeOf((*MockProductRepository)(nil).SearchProducts), ctx, search)
+}
+
+// Transactions mocks base method.
+func (m *MockProductRepository) Transactions(ctx context.Context, trxFn func(interfaces.ProductRepository) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transactions", ctx, trxFn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transactions indicates an expected call of Transactions.
+func (mr *MockProductRepositoryMockRecorder) Transactions(ctx, trxFn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transactions", reflect.TypeOf((*MockProductRepository)(nil).Transactions), ctx, trxFn)
+}
+
+// UpdateProduct mocks base method.
+func (m *MockProductRepository) UpdateProduct(ctx context.Context, product domain.Product) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProduct", ctx, product)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProduct indicates an expected call of UpdateProduct.
+func (mr *MockProductRepositoryMockRecorder) UpdateProduct(ctx, product interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProduct", reflect.TypeOf((*MockProductRepository)(nil).UpdateProduct), ctx, product)
+}
+
+// UpdateProductItemWeight mocks base method.
+func (m *MockProductRepository) UpdateProductItemWeight(ctx context.Context, productID, productItemID, weight uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductItemWeight", ctx, productID, productItemID, weight)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProductItemWeight indicates an expected call of UpdateProductItemWeight.
+func (mr *MockProductRepositoryMockRecorder) UpdateProductItemWeight(ctx, productID, productItemID, weight interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductItemWeight", reflect.TypeOf((*MockProductRepository)(nil).UpdateProductItemWeight), ctx, productID, productItemID, weight)
+}
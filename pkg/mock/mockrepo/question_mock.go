@@ -0,0 +1,184 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interfaces/question.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	request "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	response "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	domain "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+)
+
+// MockQuestionRepository is a mock of QuestionRepository interface.
+type MockQuestionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuestionRepositoryMockRecorder
+}
+
+// MockQuestionRepositoryMockRecorder is the mock recorder for MockQuestionRepository.
+type MockQuestionRepositoryMockRecorder struct {
+	mock *MockQuestionRepository
+}
+
+// NewMockQuestionRepository creates a new mock instance.
+func NewMockQuestionRepository(ctrl *gomock.Controller) *MockQuestionRepository {
+	mock := &MockQuestionRepository{ctrl: ctrl}
+	mock.recorder = &MockQuestionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuestionRepository) EXPECT() *MockQuestionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteProductAnswerUpvote mocks base method.
+func (m *MockQuestionRepository) DeleteProductAnswerUpvote(ctx context.Context, answerID, userID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductAnswerUpvote", ctx, answerID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProductAnswerUpvote indicates an expected call of DeleteProductAnswerUpvote.
+func (mr *MockQuestionRepositoryMockRecorder) DeleteProductAnswerUpvote(ctx, answerID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductAnswerUpvote", reflect.TypeOf((*MockQuestionRepository)(nil).DeleteProductAnswerUpvote), ctx, answerID, userID)
+}
+
+// FindAllProductAnswers mocks base method.
+func (m *MockQuestionRepository) FindAllProductAnswers(ctx context.Context, questionID uint, visibleOnly bool, pagination request.Pagination) ([]response.ProductAnswer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllProductAnswers", ctx, questionID, visibleOnly, pagination)
+	ret0, _ := ret[0].([]response.ProductAnswer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllProductAnswers indicates an expected call of FindAllProductAnswers.
+func (mr *MockQuestionRepositoryMockRecorder) FindAllProductAnswers(ctx, questionID, visibleOnly, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllProductAnswers", reflect.TypeOf((*MockQuestionRepository)(nil).FindAllProductAnswers), ctx, questionID, visibleOnly, pagination)
+}
+
+// FindAllProductQuestions mocks base method.
+func (m *MockQuestionRepository) FindAllProductQuestions(ctx context.Context, filter request.QuestionFilter) ([]response.ProductQuestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllProductQuestions", ctx, filter)
+	ret0, _ := ret[0].([]response.ProductQuestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllProductQuestions indicates an expected call of FindAllProductQuestions.
+func (mr *MockQuestionRepositoryMockRecorder) FindAllProductQuestions(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllProductQuestions", reflect.TypeOf((*MockQuestionRepository)(nil).FindAllProductQuestions), ctx, filter)
+}
+
+// FindProductAnswerByID mocks base method.
+func (m *MockQuestionRepository) FindProductAnswerByID(ctx context.Context, answerID uint) (domain.ProductAnswer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProductAnswerByID", ctx, answerID)
+	ret0, _ := ret[0].(domain.ProductAnswer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProductAnswerByID indicates an expected call of FindProductAnswerByID.
+func (mr *MockQuestionRepositoryMockRecorder) FindProductAnswerByID(ctx, answerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProductAnswerByID", reflect.TypeOf((*MockQuestionRepository)(nil).FindProductAnswerByID), ctx, answerID)
+}
+
+// FindProductQuestionByID mocks base method.
+func (m *MockQuestionRepository) FindProductQuestionByID(ctx context.Context, questionID uint) (domain.ProductQuestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProductQuestionByID", ctx, questionID)
+	ret0, _ := ret[0].(domain.ProductQuestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProductQuestionByID indicates an expected call of FindProductQuestionByID.
+func (mr *MockQuestionRepositoryMockRecorder) FindProductQuestionByID(ctx, questionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProductQuestionByID", reflect.TypeOf((*MockQuestionRepository)(nil).FindProductQuestionByID), ctx, questionID)
+}
+
+// SaveProductAnswer mocks base method.
+func (m *MockQuestionRepository) SaveProductAnswer(ctx context.Context, answer domain.ProductAnswer) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveProductAnswer", ctx, answer)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveProductAnswer indicates an expected call of SaveProductAnswer.
+func (mr *MockQuestionRepositoryMockRecorder) SaveProductAnswer(ctx, answer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProductAnswer", reflect.TypeOf((*MockQuestionRepository)(nil).SaveProductAnswer), ctx, answer)
+}
+
+// SaveProductAnswerUpvote mocks base method.
+func (m *MockQuestionRepository) SaveProductAnswerUpvote(ctx context.Context, answerID, userID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveProductAnswerUpvote", ctx, answerID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveProductAnswerUpvote indicates an expected call of SaveProductAnswerUpvote.
+func (mr *MockQuestionRepositoryMockRecorder) SaveProductAnswerUpvote(ctx, answerID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProductAnswerUpvote", reflect.TypeOf((*MockQuestionRepository)(nil).SaveProductAnswerUpvote), ctx, answerID, userID)
+}
+
+// SaveProductQuestion mocks base method.
+func (m *MockQuestionRepository) SaveProductQuestion(ctx context.Context, question domain.ProductQuestion) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveProductQuestion", ctx, question)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveProductQuestion indicates an expected call of SaveProductQuestion.
+func (mr *MockQuestionRepositoryMockRecorder) SaveProductQuestion(ctx, question interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProductQuestion", reflect.TypeOf((*MockQuestionRepository)(nil).SaveProductQuestion), ctx, question)
+}
+
+// UpdateProductAnswerHidden mocks base method.
+func (m *MockQuestionRepository) UpdateProductAnswerHidden(ctx context.Context, answerID uint, isHidden bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductAnswerHidden", ctx, answerID, isHidden)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProductAnswerHidden indicates an expected call of UpdateProductAnswerHidden.
+func (mr *MockQuestionRepositoryMockRecorder) UpdateProductAnswerHidden(ctx, answerID, isHidden interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductAnswerHidden", reflect.TypeOf((*MockQuestionRepository)(nil).UpdateProductAnswerHidden), ctx, answerID, isHidden)
+}
+
+// UpdateProductQuestionHidden mocks base method.
+func (m *MockQuestionRepository) UpdateProductQuestionHidden(ctx context.Context, questionID uint, isHidden bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductQuestionHidden", ctx, questionID, isHidden)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProductQuestionHidden indicates an expected call of UpdateProductQuestionHidden.
+func (mr *MockQuestionRepositoryMockRecorder) UpdateProductQuestionHidden(ctx, questionID, isHidden interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductQuestionHidden", reflect.TypeOf((*MockQuestionRepository)(nil).UpdateProductQuestionHidden), ctx, questionID, isHidden)
+}
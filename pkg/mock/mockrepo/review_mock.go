@@ -0,0 +1,171 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interfaces/review.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	request "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	response "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	domain "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	interfaces "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+)
+
+// MockReviewRepository is a mock of ReviewRepository interface.
+type MockReviewRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewRepositoryMockRecorder
+}
+
+// MockReviewRepositoryMockRecorder is the mock recorder for MockReviewRepository.
+type MockReviewRepositoryMockRecorder struct {
+	mock *MockReviewRepository
+}
+
+// NewMockReviewRepository creates a new mock instance.
+func NewMockReviewRepository(ctrl *gomock.Controller) *MockReviewRepository {
+	mock := &MockReviewRepository{ctrl: ctrl}
+	mock.recorder = &MockReviewRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewRepository) EXPECT() *MockReviewRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAllProductReviewImages mocks base method.
+func (m *MockReviewRepository) FindAllProductReviewImages(ctx context.Context, reviewID uint) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllProductReviewImages", ctx, reviewID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllProductReviewImages indicates an expected call of FindAllProductReviewImages.
+func (mr *MockReviewRepositoryMockRecorder) FindAllProductReviewImages(ctx, reviewID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllProductReviewImages", reflect.TypeOf((*MockReviewRepository)(nil).FindAllProductReviewImages), ctx, reviewID)
+}
+
+// FindAllProductReviews mocks base method.
+func (m *MockReviewRepository) FindAllProductReviews(ctx context.Context, filter request.ReviewFilter) ([]response.ProductReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllProductReviews", ctx, filter)
+	ret0, _ := ret[0].([]response.ProductReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllProductReviews indicates an expected call of FindAllProductReviews.
+func (mr *MockReviewRepositoryMockRecorder) FindAllProductReviews(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllProductReviews", reflect.TypeOf((*MockReviewRepository)(nil).FindAllProductReviews), ctx, filter)
+}
+
+// FindProductReviewByID mocks base method.
+func (m *MockReviewRepository) FindProductReviewByID(ctx context.Context, reviewID uint) (domain.ProductReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProductReviewByID", ctx, reviewID)
+	ret0, _ := ret[0].(domain.ProductReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProductReviewByID indicates an expected call of FindProductReviewByID.
+func (mr *MockReviewRepositoryMockRecorder) FindProductReviewByID(ctx, reviewID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProductReviewByID", reflect.TypeOf((*MockReviewRepository)(nil).FindProductReviewByID), ctx, reviewID)
+}
+
+// FindProductReviewByUserID mocks base method.
+func (m *MockReviewRepository) FindProductReviewByUserID(ctx context.Context, productID, userID uint) (domain.ProductReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProductReviewByUserID", ctx, productID, userID)
+	ret0, _ := ret[0].(domain.ProductReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProductReviewByUserID indicates an expected call of FindProductReviewByUserID.
+func (mr *MockReviewRepositoryMockRecorder) FindProductReviewByUserID(ctx, productID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProductReviewByUserID", reflect.TypeOf((*MockReviewRepository)(nil).FindProductReviewByUserID), ctx, productID, userID)
+}
+
+// IsDeliveredProductOfUser mocks base method.
+func (m *MockReviewRepository) IsDeliveredProductOfUser(ctx context.Context, userID, productID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDeliveredProductOfUser", ctx, userID, productID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsDeliveredProductOfUser indicates an expected call of IsDeliveredProductOfUser.
+func (mr *MockReviewRepositoryMockRecorder) IsDeliveredProductOfUser(ctx, userID, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDeliveredProductOfUser", reflect.TypeOf((*MockReviewRepository)(nil).IsDeliveredProductOfUser), ctx, userID, productID)
+}
+
+// SaveProductReview mocks base method.
+func (m *MockReviewRepository) SaveProductReview(ctx context.Context, review domain.ProductReview) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveProductReview", ctx, review)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveProductReview indicates an expected call of SaveProductReview.
+func (mr *MockReviewRepositoryMockRecorder) SaveProductReview(ctx, review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProductReview", reflect.TypeOf((*MockReviewRepository)(nil).SaveProductReview), ctx, review)
+}
+
+// SaveProductReviewImage mocks base method.
+func (m *MockReviewRepository) SaveProductReviewImage(ctx context.Context, reviewID uint, image string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveProductReviewImage", ctx, reviewID, image)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveProductReviewImage indicates an expected call of SaveProductReviewImage.
+func (mr *MockReviewRepositoryMockRecorder) SaveProductReviewImage(ctx, reviewID, image interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProductReviewImage", reflect.TypeOf((*MockReviewRepository)(nil).SaveProductReviewImage), ctx, reviewID, image)
+}
+
+// Transactions mocks base method.
+func (m *MockReviewRepository) Transactions(ctx context.Context, trxFn func(interfaces.ReviewRepository) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transactions", ctx, trxFn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transactions indicates an expected call of Transactions.
+func (mr *MockReviewRepositoryMockRecorder) Transactions(ctx, trxFn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transactions", reflect.TypeOf((*MockReviewRepository)(nil).Transactions), ctx, trxFn)
+}
+
+// UpdateProductReviewStatus mocks base method.
+func (m *MockReviewRepository) UpdateProductReviewStatus(ctx context.Context, reviewID uint, status domain.ReviewStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductReviewStatus", ctx, reviewID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProductReviewStatus indicates an expected call of UpdateProductReviewStatus.
+func (mr *MockReviewRepositoryMockRecorder) UpdateProductReviewStatus(ctx, reviewID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductReviewStatus", reflect.TypeOf((*MockReviewRepository)(nil).UpdateProductReviewStatus), ctx, reviewID, status)
+}
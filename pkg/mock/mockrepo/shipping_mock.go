@@ -0,0 +1,170 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interfaces/shipping.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	request "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	domain "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+)
+
+// MockShippingRepository is a mock of ShippingRepository interface.
+type MockShippingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockShippingRepositoryMockRecorder
+}
+
+// MockShippingRepositoryMockRecorder is the mock recorder for MockShippingRepository.
+type MockShippingRepositoryMockRecorder struct {
+	mock *MockShippingRepository
+}
+
+// NewMockShippingRepository creates a new mock instance.
+func NewMockShippingRepository(ctrl *gomock.Controller) *MockShippingRepository {
+	mock := &MockShippingRepository{ctrl: ctrl}
+	mock.recorder = &MockShippingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShippingRepository) EXPECT() *MockShippingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteShippingZone mocks base method.
+func (m *MockShippingRepository) DeleteShippingZone(ctx context.Context, zoneID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteShippingZone", ctx, zoneID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteShippingZone indicates an expected call of DeleteShippingZone.
+func (mr *MockShippingRepositoryMockRecorder) DeleteShippingZone(ctx, zoneID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteShippingZone", reflect.TypeOf((*MockShippingRepository)(nil).DeleteShippingZone), ctx, zoneID)
+}
+
+// FindAllShippingZones mocks base method.
+func (m *MockShippingRepository) FindAllShippingZones(ctx context.Context, pagination request.Pagination) ([]domain.ShippingZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllShippingZones", ctx, pagination)
+	ret0, _ := ret[0].([]domain.ShippingZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllShippingZones indicates an expected call of FindAllShippingZones.
+func (mr *MockShippingRepositoryMockRecorder) FindAllShippingZones(ctx, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllShippingZones", reflect.TypeOf((*MockShippingRepository)(nil).FindAllShippingZones), ctx, pagination)
+}
+
+// FindCartWeight mocks base method.
+func (m *MockShippingRepository) FindCartWeight(ctx context.Context, cartID uint) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCartWeight", ctx, cartID)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCartWeight indicates an expected call of FindCartWeight.
+func (mr *MockShippingRepositoryMockRecorder) FindCartWeight(ctx, cartID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCartWeight", reflect.TypeOf((*MockShippingRepository)(nil).FindCartWeight), ctx, cartID)
+}
+
+// FindShippingZoneByID mocks base method.
+func (m *MockShippingRepository) FindShippingZoneByID(ctx context.Context, zoneID uint) (domain.ShippingZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindShippingZoneByID", ctx, zoneID)
+	ret0, _ := ret[0].(domain.ShippingZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindShippingZoneByID indicates an expected call of FindShippingZoneByID.
+func (mr *MockShippingRepositoryMockRecorder) FindShippingZoneByID(ctx, zoneID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindShippingZoneByID", reflect.TypeOf((*MockShippingRepository)(nil).FindShippingZoneByID), ctx, zoneID)
+}
+
+// FindShippingZoneOfPincode mocks base method.
+func (m *MockShippingRepository) FindShippingZoneOfPincode(ctx context.Context, countryID, pincode uint) (domain.ShippingZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindShippingZoneOfPincode", ctx, countryID, pincode)
+	ret0, _ := ret[0].(domain.ShippingZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindShippingZoneOfPincode indicates an expected call of FindShippingZoneOfPincode.
+func (mr *MockShippingRepositoryMockRecorder) FindShippingZoneOfPincode(ctx, countryID, pincode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindShippingZoneOfPincode", reflect.TypeOf((*MockShippingRepository)(nil).FindShippingZoneOfPincode), ctx, countryID, pincode)
+}
+
+// IsAnyShippingZoneExist mocks base method.
+func (m *MockShippingRepository) IsAnyShippingZoneExist(ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAnyShippingZoneExist", ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAnyShippingZoneExist indicates an expected call of IsAnyShippingZoneExist.
+func (mr *MockShippingRepositoryMockRecorder) IsAnyShippingZoneExist(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAnyShippingZoneExist", reflect.TypeOf((*MockShippingRepository)(nil).IsAnyShippingZoneExist), ctx)
+}
+
+// IsShippingZoneNameExist mocks base method.
+func (m *MockShippingRepository) IsShippingZoneNameExist(ctx context.Context, name string, excludeZoneID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsShippingZoneNameExist", ctx, name, excludeZoneID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsShippingZoneNameExist indicates an expected call of IsShippingZoneNameExist.
+func (mr *MockShippingRepositoryMockRecorder) IsShippingZoneNameExist(ctx, name, excludeZoneID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsShippingZoneNameExist", reflect.TypeOf((*MockShippingRepository)(nil).IsShippingZoneNameExist), ctx, name, excludeZoneID)
+}
+
+// SaveShippingZone mocks base method.
+func (m *MockShippingRepository) SaveShippingZone(ctx context.Context, zone domain.ShippingZone) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveShippingZone", ctx, zone)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveShippingZone indicates an expected call of SaveShippingZone.
+func (mr *MockShippingRepositoryMockRecorder) SaveShippingZone(ctx, zone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveShippingZone", reflect.TypeOf((*MockShippingRepository)(nil).SaveShippingZone), ctx, zone)
+}
+
+// UpdateShippingZone mocks base method.
+func (m *MockShippingRepository) UpdateShippingZone(ctx context.Context, zone domain.ShippingZone) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShippingZone", ctx, zone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateShippingZone indicates an expected call of UpdateShippingZone.
+func (mr *MockShippingRepositoryMockRecorder) UpdateShippingZone(ctx, zone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShippingZone", reflect.TypeOf((*MockShippingRepository)(nil).UpdateShippingZone), ctx, zone)
+}
@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interfaces/stock.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	request "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	response "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	domain "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+)
+
+// MockStockRepository is a mock of StockRepository interface.
+type MockStockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStockRepositoryMockRecorder
+}
+
+// MockStockRepositoryMockRecorder is the mock recorder for MockStockRepository.
+type MockStockRepositoryMockRecorder struct {
+	mock *MockStockRepository
+}
+
+// NewMockStockRepository creates a new mock instance.
+func NewMockStockRepository(ctrl *gomock.Controller) *MockStockRepository {
+	mock := &MockStockRepository{ctrl: ctrl}
+	mock.recorder = &MockStockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStockRepository) EXPECT() *MockStockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ApplyStockMovement mocks base method.
+func (m *MockStockRepository) ApplyStockMovement(ctx context.Context, movement domain.StockMovement) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyStockMovement", ctx, movement)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyStockMovement indicates an expected call of ApplyStockMovement.
+func (mr *MockStockRepositoryMockRecorder) ApplyStockMovement(ctx, movement interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyStockMovement", reflect.TypeOf((*MockStockRepository)(nil).ApplyStockMovement), ctx, movement)
+}
+
+// FindAll mocks base method.
+func (m *MockStockRepository) FindAll(ctx context.Context, pagination request.Pagination) ([]response.Stock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, pagination)
+	ret0, _ := ret[0].([]response.Stock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockStockRepositoryMockRecorder) FindAll(ctx, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockStockRepository)(nil).FindAll), ctx, pagination)
+}
+
+// FindAllLowStocks mocks base method.
+func (m *MockStockRepository) FindAllLowStocks(ctx context.Context, pagination request.Pagination) ([]response.Stock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllLowStocks", ctx, pagination)
+	ret0, _ := ret[0].([]response.Stock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllLowStocks indicates an expected call of FindAllLowStocks.
+func (mr *MockStockRepositoryMockRecorder) FindAllLowStocks(ctx, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllLowStocks", reflect.TypeOf((*MockStockRepository)(nil).FindAllLowStocks), ctx, pagination)
+}
+
+// FindAllLowStocksByShopOrderID mocks base method.
+func (m *MockStockRepository) FindAllLowStocksByShopOrderID(ctx context.Context, shopOrderID uint) ([]response.Stock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllLowStocksByShopOrderID", ctx, shopOrderID)
+	ret0, _ := ret[0].([]response.Stock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllLowStocksByShopOrderID indicates an expected call of FindAllLowStocksByShopOrderID.
+func (mr *MockStockRepositoryMockRecorder) FindAllLowStocksByShopOrderID(ctx, shopOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllLowStocksByShopOrderID", reflect.TypeOf((*MockStockRepository)(nil).FindAllLowStocksByShopOrderID), ctx, shopOrderID)
+}
+
+// FindAllStockMovements mocks base method.
+func (m *MockStockRepository) FindAllStockMovements(ctx context.Context, productItemID uint, pagination request.Pagination) ([]domain.StockMovement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllStockMovements", ctx, productItemID, pagination)
+	ret0, _ := ret[0].([]domain.StockMovement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllStockMovements indicates an expected call of FindAllStockMovements.
+func (mr *MockStockRepositoryMockRecorder) FindAllStockMovements(ctx, productItemID, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllStockMovements", reflect.TypeOf((*MockStockRepository)(nil).FindAllStockMovements), ctx, productItemID, pagination)
+}
+
+// FindProductItemBySKU mocks base method.
+func (m *MockStockRepository) FindProductItemBySKU(ctx context.Context, sku string) (domain.ProductItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProductItemBySKU", ctx, sku)
+	ret0, _ := ret[0].(domain.ProductItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProductItemBySKU indicates an expected call of FindProductItemBySKU.
+func (mr *MockStockRepositoryMockRecorder) FindProductItemBySKU(ctx, sku interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProductItemBySKU", reflect.TypeOf((*MockStockRepository)(nil).FindProductItemBySKU), ctx, sku)
+}
+
+// UpdateReorderThreshold mocks base method.
+func (m *MockStockRepository) UpdateReorderThreshold(ctx context.Context, productItemID, reorderThreshold uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReorderThreshold", ctx, productItemID, reorderThreshold)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReorderThreshold indicates an expected call of UpdateReorderThreshold.
+func (mr *MockStockRepositoryMockRecorder) UpdateReorderThreshold(ctx, productItemID, reorderThreshold interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReorderThreshold", reflect.TypeOf((*MockStockRepository)(nil).UpdateReorderThreshold), ctx, productItemID, reorderThreshold)
+}
@@ -0,0 +1,184 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/repository/interfaces/tax.go
+
+// Package mockrepo is a generated GoMock package.
+package mockrepo
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	request "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	domain "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+)
+
+// MockTaxRepository is a mock of TaxRepository interface.
+type MockTaxRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaxRepositoryMockRecorder
+}
+
+// MockTaxRepositoryMockRecorder is the mock recorder for MockTaxRepository.
+type MockTaxRepositoryMockRecorder struct {
+	mock *MockTaxRepository
+}
+
+// NewMockTaxRepository creates a new mock instance.
+func NewMockTaxRepository(ctrl *gomock.Controller) *MockTaxRepository {
+	mock := &MockTaxRepository{ctrl: ctrl}
+	mock.recorder = &MockTaxRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaxRepository) EXPECT() *MockTaxRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAllTaxClasses mocks base method.
+func (m *MockTaxRepository) FindAllTaxClasses(ctx context.Context, pagination request.Pagination) ([]domain.TaxClass, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllTaxClasses", ctx, pagination)
+	ret0, _ := ret[0].([]domain.TaxClass)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllTaxClasses indicates an expected call of FindAllTaxClasses.
+func (mr *MockTaxRepositoryMockRecorder) FindAllTaxClasses(ctx, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllTaxClasses", reflect.TypeOf((*MockTaxRepository)(nil).FindAllTaxClasses), ctx, pagination)
+}
+
+// FindTaxClassByID mocks base method.
+func (m *MockTaxRepository) FindTaxClassByID(ctx context.Context, taxClassID uint) (domain.TaxClass, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTaxClassByID", ctx, taxClassID)
+	ret0, _ := ret[0].(domain.TaxClass)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTaxClassByID indicates an expected call of FindTaxClassByID.
+func (mr *MockTaxRepositoryMockRecorder) FindTaxClassByID(ctx, taxClassID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTaxClassByID", reflect.TypeOf((*MockTaxRepository)(nil).FindTaxClassByID), ctx, taxClassID)
+}
+
+// FindTaxRateOfProductItem mocks base method.
+func (m *MockTaxRepository) FindTaxRateOfProductItem(ctx context.Context, productItemID uint) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTaxRateOfProductItem", ctx, productItemID)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTaxRateOfProductItem indicates an expected call of FindTaxRateOfProductItem.
+func (mr *MockTaxRepositoryMockRecorder) FindTaxRateOfProductItem(ctx, productItemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTaxRateOfProductItem", reflect.TypeOf((*MockTaxRepository)(nil).FindTaxRateOfProductItem), ctx, productItemID)
+}
+
+// IsCategoryIDExist mocks base method.
+func (m *MockTaxRepository) IsCategoryIDExist(ctx context.Context, categoryID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsCategoryIDExist", ctx, categoryID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsCategoryIDExist indicates an expected call of IsCategoryIDExist.
+func (mr *MockTaxRepositoryMockRecorder) IsCategoryIDExist(ctx, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsCategoryIDExist", reflect.TypeOf((*MockTaxRepository)(nil).IsCategoryIDExist), ctx, categoryID)
+}
+
+// IsProductIDExist mocks base method.
+func (m *MockTaxRepository) IsProductIDExist(ctx context.Context, productID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsProductIDExist", ctx, productID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsProductIDExist indicates an expected call of IsProductIDExist.
+func (mr *MockTaxRepositoryMockRecorder) IsProductIDExist(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsProductIDExist", reflect.TypeOf((*MockTaxRepository)(nil).IsProductIDExist), ctx, productID)
+}
+
+// IsTaxClassNameExist mocks base method.
+func (m *MockTaxRepository) IsTaxClassNameExist(ctx context.Context, name string, excludeTaxClassID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTaxClassNameExist", ctx, name, excludeTaxClassID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTaxClassNameExist indicates an expected call of IsTaxClassNameExist.
+func (mr *MockTaxRepositoryMockRecorder) IsTaxClassNameExist(ctx, name, excludeTaxClassID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTaxClassNameExist", reflect.TypeOf((*MockTaxRepository)(nil).IsTaxClassNameExist), ctx, name, excludeTaxClassID)
+}
+
+// SaveTaxClass mocks base method.
+func (m *MockTaxRepository) SaveTaxClass(ctx context.Context, taxClass domain.TaxClass) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTaxClass", ctx, taxClass)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveTaxClass indicates an expected call of SaveTaxClass.
+func (mr *MockTaxRepositoryMockRecorder) SaveTaxClass(ctx, taxClass interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTaxClass", reflect.TypeOf((*MockTaxRepository)(nil).SaveTaxClass), ctx, taxClass)
+}
+
+// UpdateCategoryTaxClass mocks base method.
+func (m *MockTaxRepository) UpdateCategoryTaxClass(ctx context.Context, categoryID, taxClassID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategoryTaxClass", ctx, categoryID, taxClassID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCategoryTaxClass indicates an expected call of UpdateCategoryTaxClass.
+func (mr *MockTaxRepositoryMockRecorder) UpdateCategoryTaxClass(ctx, categoryID, taxClassID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategoryTaxClass", reflect.TypeOf((*MockTaxRepository)(nil).UpdateCategoryTaxClass), ctx, categoryID, taxClassID)
+}
+
+// UpdateProductTaxClass mocks base method.
+func (m *MockTaxRepository) UpdateProductTaxClass(ctx context.Context, productID, taxClassID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductTaxClass", ctx, productID, taxClassID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProductTaxClass indicates an expected call of UpdateProductTaxClass.
+func (mr *MockTaxRepositoryMockRecorder) UpdateProductTaxClass(ctx, productID, taxClassID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductTaxClass", reflect.TypeOf((*MockTaxRepository)(nil).UpdateProductTaxClass), ctx, productID, taxClassID)
+}
+
+// UpdateTaxClass mocks base method.
+func (m *MockTaxRepository) UpdateTaxClass(ctx context.Context, taxClass domain.TaxClass) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaxClass", ctx, taxClass)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTaxClass indicates an expected call of UpdateTaxClass.
+func (mr *MockTaxRepositoryMockRecorder) UpdateTaxClass(ctx, taxClass interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaxClass", reflect.TypeOf((*MockTaxRepository)(nil).UpdateTaxClass), ctx, taxClass)
+}
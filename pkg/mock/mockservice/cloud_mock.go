@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/service/cloud/cloud.go
+
+// Package mockservice is a generated GoMock package.
+package mockservice
+
+import (
+	context "context"
+	multipart "mime/multipart"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCloudService is a mock of CloudService interface.
+type MockCloudService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCloudServiceMockRecorder
+}
+
+// MockCloudServiceMockRecorder is the mock recorder for MockCloudService.
+type MockCloudServiceMockRecorder struct {
+	mock *MockCloudService
+}
+
+// NewMockCloudService creates a new mock instance.
+func NewMockCloudService(ctrl *gomock.Controller) *MockCloudService {
+	mock := &MockCloudService{ctrl: ctrl}
+	mock.recorder = &MockCloudServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCloudService) EXPECT() *MockCloudServiceMockRecorder {
+	return m.recorder
+}
+
+// DeleteFile mocks base method.
+func (m *MockCloudService) DeleteFile(ctx context.Context, uploadID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFile", ctx, uploadID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFile indicates an expected call of DeleteFile.
+func (mr *MockCloudServiceMockRecorder) DeleteFile(ctx, uploadID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFile", reflect.TypeOf((*MockCloudService)(nil).DeleteFile), ctx, uploadID)
+}
+
+// GetFileUrl mocks base method.
+func (m *MockCloudService) GetFileUrl(ctx context.Context, uploadID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileUrl", ctx, uploadID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileUrl indicates an expected call of GetFileUrl.
+func (mr *MockCloudServiceMockRecorder) GetFileUrl(ctx, uploadID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileUrl", reflect.TypeOf((*MockCloudService)(nil).GetFileUrl), ctx, uploadID)
+}
+
+// SaveFile mocks base method.
+func (m *MockCloudService) SaveFile(ctx context.Context, fileHeader *multipart.FileHeader) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveFile", ctx, fileHeader)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveFile indicates an expected call of SaveFile.
+func (mr *MockCloudServiceMockRecorder) SaveFile(ctx, fileHeader interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFile", reflect.TypeOf((*MockCloudService)(nil).SaveFile), ctx, fileHeader)
+}
+
+// SaveFileContent mocks base method.
+func (m *MockCloudService) SaveFileContent(ctx context.Context, content []byte, contentType string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveFileContent", ctx, content, contentType)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveFileContent indicates an expected call of SaveFileContent.
+func (mr *MockCloudServiceMockRecorder) SaveFileContent(ctx, content, contentType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFileContent", reflect.TypeOf((*MockCloudService)(nil).SaveFileContent), ctx, content, contentType)
+}
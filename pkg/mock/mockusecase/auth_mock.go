@@ -10,7 +10,9 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	request "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	response "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
 	domain "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	otp "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/otp"
 	token "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/token"
 	interfaces "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
 )
@@ -53,6 +55,50 @@ func (mr *MockAuthUseCaseMockRecorder) AdminLogin(ctx, loginDetails interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminLogin", reflect.TypeOf((*MockAuthUseCase)(nil).AdminLogin), ctx, loginDetails)
 }
 
+// ChangePassword mocks base method.
+func (m *MockAuthUseCase) ChangePassword(ctx context.Context, userID uint, reqData request.ChangePassword) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, userID, reqData)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockAuthUseCaseMockRecorder) ChangePassword(ctx, userID, reqData interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAuthUseCase)(nil).ChangePassword), ctx, userID, reqData)
+}
+
+// FindAllSessions mocks base method.
+func (m *MockAuthUseCase) FindAllSessions(ctx context.Context, userID uint, usedFor token.UserType) ([]response.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllSessions", ctx, userID, usedFor)
+	ret0, _ := ret[0].([]response.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllSessions indicates an expected call of FindAllSessions.
+func (mr *MockAuthUseCaseMockRecorder) FindAllSessions(ctx, userID, usedFor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllSessions", reflect.TypeOf((*MockAuthUseCase)(nil).FindAllSessions), ctx, userID, usedFor)
+}
+
+// ForgotPasswordOtpSend mocks base method.
+func (m *MockAuthUseCase) ForgotPasswordOtpSend(ctx context.Context, reqData request.OTPLogin) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForgotPasswordOtpSend", ctx, reqData)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForgotPasswordOtpSend indicates an expected call of ForgotPasswordOtpSend.
+func (mr *MockAuthUseCaseMockRecorder) ForgotPasswordOtpSend(ctx, reqData interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForgotPasswordOtpSend", reflect.TypeOf((*MockAuthUseCase)(nil).ForgotPasswordOtpSend), ctx, reqData)
+}
+
 // GenerateAccessToken mocks base method.
 func (m *MockAuthUseCase) GenerateAccessToken(ctx context.Context, tokenParams interfaces.GenerateTokenParams) (string, error) {
 	m.ctrl.T.Helper()
@@ -69,10 +115,10 @@ func (mr *MockAuthUseCaseMockRecorder) GenerateAccessToken(ctx, tokenParams inte
 }
 
 // GenerateRefreshToken mocks base method.
-func (m *MockAuthUseCase) GenerateRefreshToken(ctx context.Context, tokenParams interfaces.GenerateTokenParams) (string, error) {
+func (m *MockAuthUseCase) GenerateRefreshToken(ctx context.Context, tokenParams interfaces.GenerateTokenParams) (domain.RefreshSession, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GenerateRefreshToken", ctx, tokenParams)
-	ret0, _ := ret[0].(string)
+	ret0, _ := ret[0].(domain.RefreshSession)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -98,6 +144,21 @@ func (mr *MockAuthUseCaseMockRecorder) GoogleLogin(ctx, user interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GoogleLogin", reflect.TypeOf((*MockAuthUseCase)(nil).GoogleLogin), ctx, user)
 }
 
+// IsSessionRevoked mocks base method.
+func (m *MockAuthUseCase) IsSessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSessionRevoked", ctx, sessionID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSessionRevoked indicates an expected call of IsSessionRevoked.
+func (mr *MockAuthUseCaseMockRecorder) IsSessionRevoked(ctx, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSessionRevoked", reflect.TypeOf((*MockAuthUseCase)(nil).IsSessionRevoked), ctx, sessionID)
+}
+
 // LoginOtpVerify mocks base method.
 func (m *MockAuthUseCase) LoginOtpVerify(ctx context.Context, otpVerifyDetails request.OTPVerify) (uint, error) {
 	m.ctrl.T.Helper()
@@ -113,6 +174,63 @@ func (mr *MockAuthUseCaseMockRecorder) LoginOtpVerify(ctx, otpVerifyDetails inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginOtpVerify", reflect.TypeOf((*MockAuthUseCase)(nil).LoginOtpVerify), ctx, otpVerifyDetails)
 }
 
+// ResetPassword mocks base method.
+func (m *MockAuthUseCase) ResetPassword(ctx context.Context, reqData request.ResetPassword) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, reqData)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockAuthUseCaseMockRecorder) ResetPassword(ctx, reqData interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockAuthUseCase)(nil).ResetPassword), ctx, reqData)
+}
+
+// RevokeAllSessions mocks base method.
+func (m *MockAuthUseCase) RevokeAllSessions(ctx context.Context, userID uint, usedFor token.UserType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllSessions", ctx, userID, usedFor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAllSessions indicates an expected call of RevokeAllSessions.
+func (mr *MockAuthUseCaseMockRecorder) RevokeAllSessions(ctx, userID, usedFor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllSessions", reflect.TypeOf((*MockAuthUseCase)(nil).RevokeAllSessions), ctx, userID, usedFor)
+}
+
+// RevokeSession mocks base method.
+func (m *MockAuthUseCase) RevokeSession(ctx context.Context, userID uint, usedFor token.UserType, sessionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, userID, usedFor, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockAuthUseCaseMockRecorder) RevokeSession(ctx, userID, usedFor, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockAuthUseCase)(nil).RevokeSession), ctx, userID, usedFor, sessionID)
+}
+
+// RotateRefreshToken mocks base method.
+func (m *MockAuthUseCase) RotateRefreshToken(ctx context.Context, refreshSession domain.RefreshSession, tokenParams interfaces.GenerateTokenParams) (domain.RefreshSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateRefreshToken", ctx, refreshSession, tokenParams)
+	ret0, _ := ret[0].(domain.RefreshSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateRefreshToken indicates an expected call of RotateRefreshToken.
+func (mr *MockAuthUseCaseMockRecorder) RotateRefreshToken(ctx, refreshSession, tokenParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateRefreshToken", reflect.TypeOf((*MockAuthUseCase)(nil).RotateRefreshToken), ctx, refreshSession, tokenParams)
+}
+
 // SingUpOtpVerify mocks base method.
 func (m *MockAuthUseCase) SingUpOtpVerify(ctx context.Context, otpVerifyDetails request.OTPVerify) (uint, error) {
 	m.ctrl.T.Helper()
@@ -159,18 +277,18 @@ func (mr *MockAuthUseCaseMockRecorder) UserLoginOtpSend(ctx, loginDetails interf
 }
 
 // UserSignUp mocks base method.
-func (m *MockAuthUseCase) UserSignUp(ctx context.Context, signUpDetails domain.User) (string, error) {
+func (m *MockAuthUseCase) UserSignUp(ctx context.Context, signUpDetails domain.User, otpChannel otp.Channel) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UserSignUp", ctx, signUpDetails)
+	ret := m.ctrl.Call(m, "UserSignUp", ctx, signUpDetails, otpChannel)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UserSignUp indicates an expected call of UserSignUp.
-func (mr *MockAuthUseCaseMockRecorder) UserSignUp(ctx, signUpDetails interface{}) *gomock.Call {
+func (mr *MockAuthUseCaseMockRecorder) UserSignUp(ctx, signUpDetails, otpChannel interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserSignUp", reflect.TypeOf((*MockAuthUseCase)(nil).UserSignUp), ctx, signUpDetails)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserSignUp", reflect.TypeOf((*MockAuthUseCase)(nil).UserSignUp), ctx, signUpDetails, otpChannel)
 }
 
 // VerifyAndGetRefreshTokenSession mocks base method.
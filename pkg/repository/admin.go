@@ -20,6 +20,21 @@ func NewAdminRepository(DB *gorm.DB) interfaces.AdminRepository {
 	return &adminDatabase{DB: DB}
 }
 
+func (c *adminDatabase) Transaction(callBack func(trxRepo interfaces.AdminRepository) error) error {
+
+	trx := c.DB.Begin()
+	transactionRepo := NewAdminRepository(trx)
+
+	err := callBack(transactionRepo)
+	if err != nil {
+		trx.Rollback()
+		return fmt.Errorf("failed to complete transaction \nerror:%w", err)
+	}
+
+	err = trx.Commit().Error
+	return err
+}
+
 func (c *adminDatabase) FindAdminByEmail(ctx context.Context, email string) (domain.Admin, error) {
 
 	var admin domain.Admin
@@ -56,19 +71,22 @@ func (c *adminDatabase) FindAllUser(ctx context.Context, pagination request.Pagi
 	return users, err
 }
 
-// sales report from order // !add  product wise report
+// sales report from order (product wise report is on FindTopSellingProducts)
 func (c *adminDatabase) CreateFullSalesReport(ctc context.Context, salesReq request.SalesReport) (salesReport []response.SalesReport, err error) {
 
 	limit := salesReq.Pagination.Count
 	offset := (salesReq.Pagination.PageNumber - 1) * limit
 
 	query := `SELECT u.first_name, u.email,  so.id AS shop_order_id, so.user_id, so.order_date, 
-	so.order_total_price, so.discount, os.status AS order_status, pm.payment_type FROM shop_orders so
+	so.order_total_price, so.discount, os.status AS order_status, COALESCE(pm.name, '') AS payment_type, 
+	COALESCE(ot.cgst, 0) AS cgst, COALESCE(ot.sgst, 0) AS sgst, COALESCE(ot.igst, 0) AS igst 
+	FROM shop_orders so
 	INNER JOIN order_statuses os ON so.order_status_id = os.id 
-	INNER JOIN  payment_methods pm ON so.payment_method_id = pm.id 
+	LEFT JOIN (` + orderTaxesQuery + `) ot ON ot.shop_order_id = so.id 
+	LEFT JOIN  payment_methods pm ON so.payment_method_id = pm.id 
 	INNER JOIN users u ON so.user_id = u.id 
 	WHERE order_date >= $1 AND order_date <= $2
-	ORDER BY so.order_date LIMIT  $3 OFFSET $4`
+	ORDER BY so.order_date, so.id LIMIT  $3 OFFSET $4`
 
 	err = c.DB.Raw(query, salesReq.StartDate, salesReq.EndDate, limit, offset).Scan(&salesReport).Error
 
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+)
+
+func (c *adminDatabase) SaveAuditLog(ctx context.Context, auditLog domain.AdminAuditLog) error {
+
+	query := `INSERT INTO admin_audit_logs (admin_id, method, route, path, payload, 
+	client_ip, status_code, success, message, created_at) 
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+
+	err := c.DB.Exec(query, auditLog.AdminID, auditLog.Method, auditLog.Route, auditLog.Path, auditLog.Payload,
+		auditLog.ClientIP, auditLog.StatusCode, auditLog.Success, auditLog.Message, auditLog.CreatedAt).Error
+
+	return err
+}
+
+func (c *adminDatabase) FindAllAuditLogs(ctx context.Context,
+	filter request.AuditLogFilter) (auditLogs []response.AuditLog, err error) {
+
+	limit := filter.Pagination.Count
+	offset := (filter.Pagination.PageNumber - 1) * limit
+
+	condition, args := auditLogCondition(filter)
+
+	// admin may be deleted after the action, so left join for the user_name
+	query := fmt.Sprintf(`SELECT al.id, al.admin_id, a.user_name, al.method, al.route, al.path, 
+	al.payload, al.client_ip, al.status_code, al.success, al.message, al.created_at 
+	FROM admin_audit_logs al 
+	LEFT JOIN admins a ON a.id = al.admin_id 
+	WHERE %s ORDER BY al.created_at DESC LIMIT $%d OFFSET $%d`, condition, len(args)+1, len(args)+2)
+
+	args = append(args, limit, offset)
+	err = c.DB.Raw(query, args...).Scan(&auditLogs).Error
+
+	return
+}
+
+func auditLogCondition(filter request.AuditLogFilter) (condition string, args []interface{}) {
+
+	var where whereCondition
+
+	if filter.AdminID != 0 {
+		where.Add("al.admin_id = " + where.Arg(filter.AdminID))
+	}
+	if filter.Method != "" {
+		where.Add("al.method = " + where.Arg(filter.Method))
+	}
+	if filter.Route != "" {
+		where.Add("al.route ILIKE '%' || " + where.Arg(filter.Route) + " || '%'")
+	}
+	switch filter.Status {
+	case request.AuditLogStatusSuccess:
+		where.Add("al.success = 't'")
+	case request.AuditLogStatusFailure:
+		where.Add("al.success = 'f'")
+	}
+	if !filter.StartDate.IsZero() {
+		where.Add("al.created_at >= " + where.Arg(filter.StartDate))
+	}
+	if !filter.EndDate.IsZero() {
+		where.Add("al.created_at <= " + where.Arg(filter.EndDate))
+	}
+
+	return where.Build()
+}
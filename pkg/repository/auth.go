@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
@@ -18,10 +20,26 @@ func NewAuthRepository(db *gorm.DB) interfaces.AuthRepository {
 	}
 }
 
+func (c *authDatabase) Transaction(callBack func(trxRepo interfaces.AuthRepository) error) error {
+
+	trx := c.DB.Begin()
+	transactionRepo := NewAuthRepository(trx)
+
+	err := callBack(transactionRepo)
+	if err != nil {
+		trx.Rollback()
+		return fmt.Errorf("failed to complete transaction \nerror:%w", err)
+	}
+
+	err = trx.Commit().Error
+	return err
+}
+
 func (c *authDatabase) SaveRefreshSession(ctx context.Context, refreshSession domain.RefreshSession) error {
-	query := `INSERT INTO refresh_sessions (token_id, user_id, refresh_token, expire_at) 
-VALUES ($1, $2, $3, $4)`
-	err := c.DB.Exec(query, refreshSession.TokenID, refreshSession.UserID, refreshSession.RefreshToken, refreshSession.ExpireAt).Error
+	query := `INSERT INTO refresh_sessions (token_id, user_id, refresh_token, expire_at, family_id, used_for, user_agent, client_ip, created_at) 
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	err := c.DB.Exec(query, refreshSession.TokenID, refreshSession.UserID, refreshSession.RefreshToken, refreshSession.ExpireAt,
+		refreshSession.FamilyID, refreshSession.UsedFor, refreshSession.UserAgent, refreshSession.ClientIP, refreshSession.CreatedAt).Error
 
 	return err
 }
@@ -33,13 +51,110 @@ func (c *authDatabase) FindRefreshSessionByTokenID(ctx context.Context, tokenID
 	return
 }
 
+// find all not blocked, not rotated and not expired refresh sessions of the user (devices the user logged in)
+func (c *authDatabase) FindAllActiveRefreshSessions(ctx context.Context,
+	userID uint, usedFor string) (refreshSessions []domain.RefreshSession, err error) {
+
+	query := `SELECT * FROM refresh_sessions WHERE user_id = $1 AND used_for = $2 
+	AND is_blocked = 'f' AND is_used = 'f' AND expire_at > NOW() ORDER BY created_at DESC`
+
+	err = c.DB.Raw(query, userID, usedFor).Scan(&refreshSessions).Error
+
+	return
+}
+
+// block the refresh session of the user with all sessions of it's family (rotated sessions of the same login)
+// and save their token ids on revoked tokens so the access tokens generated for the sessions also can't use after it
+// (expired revoked tokens are deleted on the same query)
+func (c *authDatabase) RevokeRefreshSession(ctx context.Context, userID uint,
+	usedFor, tokenID string, revokeUntil time.Time) (revoked bool, err error) {
+
+	query := `WITH blocked AS (
+	UPDATE refresh_sessions SET is_blocked = 't' 
+	WHERE user_id = $2 AND used_for = $3 AND is_blocked = 'f' AND family_id = (
+	SELECT family_id FROM refresh_sessions WHERE token_id = $1 AND user_id = $2 AND used_for = $3) RETURNING token_id), 
+	cleared AS (DELETE FROM revoked_tokens WHERE expire_at <= NOW()) 
+	INSERT INTO revoked_tokens (token_id, expire_at) 
+	SELECT token_id, $4 FROM blocked ON CONFLICT (token_id) DO NOTHING`
+
+	result := c.DB.Exec(query, tokenID, userID, usedFor, revokeUntil)
+
+	return result.RowsAffected > 0, result.Error
+}
+
+// block all refresh sessions of the user and save their token ids on revoked tokens
+func (c *authDatabase) RevokeAllRefreshSessions(ctx context.Context, userID uint,
+	usedFor string, revokeUntil time.Time) error {
+
+	query := `WITH blocked AS (
+	UPDATE refresh_sessions SET is_blocked = 't' 
+	WHERE user_id = $1 AND used_for = $2 AND is_blocked = 'f' RETURNING token_id), 
+	cleared AS (DELETE FROM revoked_tokens WHERE expire_at <= NOW()) 
+	INSERT INTO revoked_tokens (token_id, expire_at) 
+	SELECT token_id, $3 FROM blocked ON CONFLICT (token_id) DO NOTHING`
+
+	err := c.DB.Exec(query, userID, usedFor, revokeUntil).Error
+
+	return err
+}
+
+// mark the refresh session as used when it rotated
+// only one call can mark the session so a false return means the session already used
+func (c *authDatabase) MarkRefreshSessionUsed(ctx context.Context, tokenID string) (marked bool, err error) {
+
+	query := `UPDATE refresh_sessions SET is_used = 't' WHERE token_id = $1 AND is_used = 'f'`
+
+	result := c.DB.Exec(query, tokenID)
+
+	return result.RowsAffected > 0, result.Error
+}
+
+func (c *authDatabase) IsTokenRevoked(ctx context.Context, tokenID string) (revoked bool, err error) {
+
+	query := `SELECT EXISTS(SELECT 1 FROM revoked_tokens WHERE token_id = $1 AND expire_at > NOW()) AS revoked`
+
+	err = c.DB.Raw(query, tokenID).Scan(&revoked).Error
+
+	return
+}
+
 func (c *authDatabase) SaveOtpSession(ctx context.Context, otpSession domain.OtpSession) error {
 
-	query := `INSERT INTO otp_sessions (otp_id, user_id, phone ,expire_at) 
-	VALUES ($1, $2, $3, $4)`
-	err := c.DB.Exec(query, otpSession.OtpID, otpSession.UserID, otpSession.Phone, otpSession.ExpireAt).Error
+	query := `INSERT INTO otp_sessions (otp_id, user_id, phone, channel, recipient, purpose, expire_at, created_at) 
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	err := c.DB.Exec(query, otpSession.OtpID, otpSession.UserID, otpSession.Phone, otpSession.Channel,
+		otpSession.Recipient, otpSession.Purpose, otpSession.ExpireAt, time.Now()).Error
+	return err
+}
+
+// find count of otp sent to the phone after the given time and the time of last otp sent
+func (c *authDatabase) FindOtpSendStats(ctx context.Context, phone string,
+	since time.Time) (count uint, lastSentAt time.Time, err error) {
+
+	var stats struct {
+		Count      uint
+		LastSentAt *time.Time
+	}
+
+	query := `SELECT COUNT(id) AS count, MAX(created_at) AS last_sent_at 
+	FROM otp_sessions WHERE phone = $1 AND created_at > $2`
+	err = c.DB.Raw(query, phone, since).Scan(&stats).Error
+	if err != nil || stats.LastSentAt == nil {
+		return stats.Count, lastSentAt, err
+	}
+
+	return stats.Count, *stats.LastSentAt, nil
+}
+
+// lock the otp sends of the phone until the transaction ends (should call inside a transaction)
+func (c *authDatabase) LockOtpSendsOfPhone(ctx context.Context, phone string) error {
+
+	query := `SELECT pg_advisory_xact_lock(hashtext($1))`
+	err := c.DB.Exec(query, "otp_send:"+phone).Error
+
 	return err
 }
+
 func (c *authDatabase) FindOtpSession(ctx context.Context, otpID string) (otpSession domain.OtpSession, err error) {
 
 	query := `SELECT * FROM otp_sessions WHERE otp_id = $1`
@@ -48,3 +163,61 @@ func (c *authDatabase) FindOtpSession(ctx context.Context, otpID string) (otpSes
 
 	return otpSession, err
 }
+
+func (c *authDatabase) UpdateOtpSessionVerifyKey(ctx context.Context, otpID, verifyKey string) error {
+
+	query := `UPDATE otp_sessions SET verify_key = $1 WHERE otp_id = $2`
+	err := c.DB.Exec(query, verifyKey, otpID).Error
+
+	return err
+}
+
+// clear the verify key of the otp session so the otp can't verify again
+// used will be false when the otp session is already used by another request
+func (c *authDatabase) UseOtpSession(ctx context.Context, otpID string) (used bool, err error) {
+
+	query := `UPDATE otp_sessions SET verify_key = '' WHERE otp_id = $1 AND verify_key != ''`
+	result := c.DB.Exec(query, otpID)
+
+	return result.RowsAffected > 0, result.Error
+}
+
+func (c *authDatabase) FindLoginAttempt(ctx context.Context, key string) (loginAttempt domain.LoginAttempt, err error) {
+
+	query := `SELECT * FROM login_attempts WHERE key = $1`
+	err = c.DB.Raw(query, key).Scan(&loginAttempt).Error
+
+	return
+}
+
+// increment the failed count of the key and return the updated count
+// the count is restarted when the last failure is before the resetBefore time
+func (c *authDatabase) SaveFailedLoginAttempt(ctx context.Context, key string,
+	failedAt, resetBefore time.Time) (failedCount uint, err error) {
+
+	query := `INSERT INTO login_attempts (key, failed_count, last_failed_at, locked_until) VALUES ($1, 1, $2, $2) 
+	ON CONFLICT (key) DO UPDATE SET 
+	failed_count = CASE WHEN login_attempts.last_failed_at < $3 THEN 1 ELSE login_attempts.failed_count + 1 END, 
+	last_failed_at = $2 
+	RETURNING failed_count`
+
+	err = c.DB.Raw(query, key, failedAt, resetBefore).Scan(&failedCount).Error
+
+	return
+}
+
+func (c *authDatabase) LockLoginAttempt(ctx context.Context, key string, lockedUntil time.Time) error {
+
+	query := `UPDATE login_attempts SET locked_until = $1 WHERE key = $2`
+	err := c.DB.Exec(query, lockedUntil, key).Error
+
+	return err
+}
+
+func (c *authDatabase) DeleteLoginAttempt(ctx context.Context, key string) error {
+
+	query := `DELETE FROM login_attempts WHERE key = $1`
+	err := c.DB.Exec(query, key).Error
+
+	return err
+}
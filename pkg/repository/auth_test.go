@@ -15,8 +15,8 @@ import (
 )
 
 func TestSaveRefreshSession(t *testing.T) {
-	refreshInsertQuery := `INSERT INTO refresh_sessions \(token_id, user_id, refresh_token, expire_at\) 
-VALUES \(\$1, \$2, \$3, \$4\)`
+	refreshInsertQuery := `INSERT INTO refresh_sessions \(token_id, user_id, refresh_token, expire_at, family_id, used_for, user_agent, client_ip, created_at\) 
+VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9\)`
 	tests := []struct {
 		testName      string
 		inputField    domain.RefreshSession
@@ -28,7 +28,8 @@ VALUES \(\$1, \$2, \$3, \$4\)`
 			inputField: domain.RefreshSession{},
 			buildStub: func(mock sqlmock.Sqlmock, input domain.RefreshSession) {
 				mock.ExpectExec(refreshInsertQuery).
-					WithArgs(input.TokenID, input.UserID, input.RefreshToken, input.ExpireAt).
+					WithArgs(input.TokenID, input.UserID, input.RefreshToken, input.ExpireAt,
+						input.FamilyID, input.UsedFor, input.UserAgent, input.ClientIP, input.CreatedAt).
 					WillReturnError(errors.New("insert into refresh_table violate not null constraints"))
 			},
 			expectedError: errors.New("insert into refresh_table violate not null constraints"),
@@ -38,7 +39,8 @@ VALUES \(\$1, \$2, \$3, \$4\)`
 			inputField: domain.RefreshSession{TokenID: "token_id", RefreshToken: "refreshTokenString", ExpireAt: time.Now()},
 			buildStub: func(mock sqlmock.Sqlmock, input domain.RefreshSession) {
 				mock.ExpectExec(refreshInsertQuery).
-					WithArgs(input.TokenID, input.UserID, input.RefreshToken, input.ExpireAt).
+					WithArgs(input.TokenID, input.UserID, input.RefreshToken, input.ExpireAt,
+						input.FamilyID, input.UsedFor, input.UserAgent, input.ClientIP, input.CreatedAt).
 					WillReturnResult(driver.ResultNoRows)
 			},
 			expectedError: nil,
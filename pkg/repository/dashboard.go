@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+)
+
+// To find the counts for dashboard kpi tiles of the period
+// pending returns and low stocks are the current counts (not only of the period)
+// placed orders are found from the current order status (orders before status histories don't have any history)
+func (c *adminDatabase) FindDashboardStats(ctx context.Context,
+	reqData request.SalesReport) (stats response.DashboardStats, err error) {
+
+	query := `SELECT
+	(SELECT COALESCE(SUM(so.order_total_price), 0) FROM shop_orders so
+	INNER JOIN order_statuses os ON os.id = so.order_status_id
+	WHERE ` + paidOrderCondition + `) AS revenue,
+	(SELECT COUNT(so.id) FROM shop_orders so
+	INNER JOIN order_statuses os ON os.id = so.order_status_id
+	WHERE ` + paidOrderCondition + `) AS order_count,
+	(SELECT COUNT(u.id) FROM users u WHERE u.created_at >= $1 AND u.created_at <= $2) AS new_users,
+	(SELECT COUNT(ors.id) FROM order_returns ors
+	INNER JOIN shop_orders so ON so.id = ors.shop_order_id
+	INNER JOIN order_statuses os ON os.id = so.order_status_id
+	WHERE os.status IN ('return requested', 'return approved')) AS pending_returns,
+	(SELECT COUNT(pi.id) FROM product_items pi
+	WHERE pi.reorder_threshold > 0 AND pi.qty_in_stock <= pi.reorder_threshold) AS low_stock_count,
+	(SELECT COUNT(so.id) FROM shop_orders so
+	WHERE so.order_date >= $1 AND so.order_date <= $2) AS created_orders,
+	(SELECT COUNT(so.id) FROM shop_orders so
+	INNER JOIN order_statuses os ON os.id = so.order_status_id
+	WHERE ` + paidOrderCondition + `) AS placed_orders`
+
+	err = c.DB.Raw(query, reqData.StartDate, reqData.EndDate).Scan(&stats).Error
+
+	return
+}
+
+// To find revenue, order count and new users of each day, week or month of the period
+// periods without any orders or users are also included with zero values
+func (c *adminDatabase) FindAllDashboardPoints(ctx context.Context,
+	reqData request.SalesReport) (points []response.DashboardPoint, err error) {
+
+	query := `SELECT p.period, COALESCE(o.revenue, 0) AS revenue,
+	COALESCE(o.order_count, 0) AS order_count, COALESCE(u.new_users, 0) AS new_users
+	FROM GENERATE_SERIES(DATE_TRUNC($3, $1::timestamptz), $2::timestamptz, ('1 ' || $3)::interval) AS p(period)
+	LEFT JOIN (SELECT DATE_TRUNC($3, so.order_date) AS period, SUM(so.order_total_price) AS revenue,
+	COUNT(so.id) AS order_count
+	FROM shop_orders so
+	INNER JOIN order_statuses os ON os.id = so.order_status_id
+	WHERE ` + paidOrderCondition + `
+	GROUP BY 1) o ON o.period = p.period
+	LEFT JOIN (SELECT DATE_TRUNC($3, u.created_at) AS period, COUNT(u.id) AS new_users
+	FROM users u WHERE u.created_at >= $1 AND u.created_at <= $2
+	GROUP BY 1) u ON u.period = p.period
+	ORDER BY p.period`
+
+	err = c.DB.Raw(query, reqData.StartDate, reqData.EndDate, reqData.Bucket).Scan(&points).Error
+
+	return
+}
@@ -9,13 +9,44 @@ import (
 )
 
 type AdminRepository interface {
+	Transaction(callBack func(trxRepo AdminRepository) error) error
+
 	FindAdminByEmail(ctx context.Context, email string) (domain.Admin, error)
 	FindAdminByUserName(ctx context.Context, userName string) (domain.Admin, error)
 	SaveAdmin(ctx context.Context, admin domain.Admin) error
+	FindAdminByID(ctx context.Context, adminID uint) (domain.Admin, error)
+
+	// staff
+	FindAllPermissionsByAdminID(ctx context.Context, adminID uint) (permissions []string, err error)
+	FindRoleByID(ctx context.Context, roleID uint) (domain.Role, error)
+	FindAllRoles(ctx context.Context) ([]response.Role, error)
+	FindAllStaff(ctx context.Context, pagination request.Pagination) ([]response.Staff, error)
+	SaveStaff(ctx context.Context, admin domain.Admin) (adminID uint, err error)
+	UpdateStaff(ctx context.Context, adminID, roleID uint, blockStatus bool) error
+	DeleteAdmin(ctx context.Context, adminID uint) error
+	SaveStaffPasswordToken(ctx context.Context, passwordToken domain.StaffPasswordToken) error
+	FindStaffPasswordToken(ctx context.Context, tokenHash string) (domain.StaffPasswordToken, error)
+	// mark the token used only if it's not used yet
+	UseStaffPasswordToken(ctx context.Context, tokenID uint) (used bool, err error)
+	UpdateAdminPassword(ctx context.Context, adminID uint, hashPassword string) error
+
+	// audit log
+	SaveAuditLog(ctx context.Context, auditLog domain.AdminAuditLog) error
+	FindAllAuditLogs(ctx context.Context, filter request.AuditLogFilter) ([]response.AuditLog, error)
 
 	FindAllUser(ctx context.Context, pagination request.Pagination) (users []response.User, err error)
 
 	CreateFullSalesReport(ctc context.Context, reqData request.SalesReport) (salesReport []response.SalesReport, err error)
+	FindSalesTotal(ctx context.Context, reqData request.SalesReport) (response.SalesBucket, error)
+	FindAllSalesBuckets(ctx context.Context, reqData request.SalesReport) ([]response.SalesBucket, error)
+	FindAllOrderCountsByStatus(ctx context.Context, reqData request.SalesReport) ([]response.SalesCount, error)
+	FindAllOrderCountsByPaymentMethod(ctx context.Context, reqData request.SalesReport) ([]response.SalesCount, error)
+	FindTopSellingProducts(ctx context.Context, reqData request.SalesReport, limit uint) ([]response.TopSellingItem, error)
+	FindTopSellingCategories(ctx context.Context, reqData request.SalesReport, limit uint) ([]response.TopSellingItem, error)
+
+	// dashboard
+	FindDashboardStats(ctx context.Context, reqData request.SalesReport) (response.DashboardStats, error)
+	FindAllDashboardPoints(ctx context.Context, reqData request.SalesReport) ([]response.DashboardPoint, error)
 
 	//stock side
 	FindStockBySKU(ctx context.Context, sku string) (stock response.Stock, err error)
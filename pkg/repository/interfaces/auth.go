@@ -2,15 +2,33 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
 )
 
 // //go:generate mockgen -destination=../../mock/mockrepo/auth_mock.go -package=mockrepo . AuthRepository
 type AuthRepository interface {
+	Transaction(callBack func(trxRepo AuthRepository) error) error
+
 	SaveRefreshSession(ctx context.Context, refreshSession domain.RefreshSession) error
 	FindRefreshSessionByTokenID(ctx context.Context, tokenID string) (domain.RefreshSession, error)
+	FindAllActiveRefreshSessions(ctx context.Context, userID uint, usedFor string) ([]domain.RefreshSession, error)
+	RevokeRefreshSession(ctx context.Context, userID uint, usedFor, tokenID string, revokeUntil time.Time) (revoked bool, err error)
+	RevokeAllRefreshSessions(ctx context.Context, userID uint, usedFor string, revokeUntil time.Time) error
+	MarkRefreshSessionUsed(ctx context.Context, tokenID string) (marked bool, err error)
+	IsTokenRevoked(ctx context.Context, tokenID string) (revoked bool, err error)
 
 	SaveOtpSession(ctx context.Context, otpSession domain.OtpSession) error
 	FindOtpSession(ctx context.Context, otpID string) (domain.OtpSession, error)
+	UpdateOtpSessionVerifyKey(ctx context.Context, otpID, verifyKey string) error
+	UseOtpSession(ctx context.Context, otpID string) (used bool, err error)
+	FindOtpSendStats(ctx context.Context, phone string, since time.Time) (count uint, lastSentAt time.Time, err error)
+	LockOtpSendsOfPhone(ctx context.Context, phone string) error
+
+	// login attempts
+	FindLoginAttempt(ctx context.Context, key string) (domain.LoginAttempt, error)
+	SaveFailedLoginAttempt(ctx context.Context, key string, failedAt, resetBefore time.Time) (failedCount uint, err error)
+	LockLoginAttempt(ctx context.Context, key string, lockedUntil time.Time) error
+	DeleteLoginAttempt(ctx context.Context, key string) error
 }
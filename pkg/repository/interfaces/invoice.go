@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+)
+
+type InvoiceRepository interface {
+	FindInvoiceByShopOrderID(ctx context.Context, shopOrderID uint) (domain.Invoice, error)
+	UpdateInvoiceFileKey(ctx context.Context, invoiceID uint, fileKey string) error
+
+	FindInvoiceOrder(ctx context.Context, shopOrderID uint) (response.InvoiceOrder, error)
+}
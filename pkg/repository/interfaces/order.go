@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
@@ -13,12 +14,17 @@ type OrderRepository interface {
 
 	SaveOrderLine(ctx context.Context, orderLine domain.OrderLine) error
 
-	UpdateShopOrderOrderStatus(ctx context.Context, shopOrderID, changeStatusID uint) error
-	UpdateShopOrderStatusAndSavePaymentMethod(ctx context.Context, shopOrderID, orderStatusID, paymentID uint) error
+	UpdateShopOrderOrderStatus(ctx context.Context, shopOrderID, currentStatusID, changeStatusID uint) (updated bool, err error)
+	UpdateShopOrderStatusAndSavePaymentMethod(ctx context.Context, shopOrderID, currentStatusID, orderStatusID,
+		paymentID, walletAmount uint) (updated bool, err error)
 
 	// shop order order
 	SaveShopOrder(ctx context.Context, shopOrder domain.ShopOrder) (shopOrderID uint, err error)
 	FindShopOrderByShopOrderID(ctx context.Context, shopOrderID uint) (domain.ShopOrder, error)
+	FindShopOrderByGatewayOrderID(ctx context.Context, gatewayOrderID string) (domain.ShopOrder, error)
+	FindAllShopOrdersByStatusBefore(ctx context.Context, orderStatusID uint, orderedBefore time.Time) ([]domain.ShopOrder, error)
+	UpdateShopOrderGatewayOrder(ctx context.Context, shopOrderID, orderStatusID, paymentMethodID uint,
+		gatewayOrderID string, walletAmount uint) (updated bool, err error)
 	FindAllShopOrders(ctx context.Context, pagination request.Pagination) (shopOrders []response.ShopOrder, err error)
 	FindAllShopOrdersByUserID(ctx context.Context, userID uint, pagination request.Pagination) ([]response.ShopOrder, error)
 
@@ -31,6 +37,27 @@ type OrderRepository interface {
 	FindOrderStatusByID(ctx context.Context, orderStatusID uint) (domain.OrderStatus, error)
 	FindOrderStatusByStatus(ctx context.Context, orderStatus domain.OrderStatusType) (domain.OrderStatus, error)
 	FindAllOrderStatuses(ctx context.Context) ([]domain.OrderStatus, error)
+	IsOrderStatusTransitionExist(ctx context.Context, fromStatusID, toStatusID uint) (bool, error)
+
+	// order status history
+	SaveShopOrderStatusHistory(ctx context.Context, history domain.ShopOrderStatusHistory) error
+	FindAllShopOrderStatusHistories(ctx context.Context, shopOrderID uint) ([]response.OrderStatusHistory, error)
+
+	// order approve
+	SaveCouponUses(ctx context.Context, couponUses domain.CouponUses) error
+	DeleteOrderedCartItems(ctx context.Context, userID, shopOrderID uint) error
+	RemoveCouponFromCart(ctx context.Context, userID, couponID uint) error
+	// save invoice of shop order with the next invoice number (the invoice already saved is returned)
+	// should call inside a transaction so the invoice number is taken only when the invoice is saved
+	SaveInvoice(ctx context.Context, shopOrderID uint) (domain.Invoice, error)
+
+	// order cancel
+	FindAllOrderLinesByShopOrderID(ctx context.Context, shopOrderID uint) ([]domain.OrderLine, error)
+	ApplyStockMovement(ctx context.Context, movement domain.StockMovement) (applied bool, err error)
+	DeleteCouponUses(ctx context.Context, userID, couponID uint) error
+	SaveOrderCancellation(ctx context.Context, cancellation domain.OrderCancellation) error
+	UpdateOrderCancellationGatewayRefund(ctx context.Context, shopOrderID, gatewayRefundAmount uint,
+		gatewayRefundID string) (updated bool, err error)
 
 	//order return
 	FindOrderReturnByReturnID(ctx context.Context, orderReturnID uint) (domain.OrderReturn, error)
@@ -43,7 +70,8 @@ type OrderRepository interface {
 	// wallet
 	FindWalletByUserID(ctx context.Context, userID uint) (wallet domain.Wallet, err error)
 	SaveWallet(ctx context.Context, userID uint) (walletID uint, err error)
-	UpdateWallet(ctx context.Context, walletID, updateTotalAmount uint) error
+	DebitWallet(ctx context.Context, userID, amount uint) (debited bool, err error)
+	CreditWallet(ctx context.Context, walletID, amount uint) error
 	SaveWalletTransaction(ctx context.Context, walletTrx domain.Transaction) error
 
 	FindWalletTransactions(ctx context.Context, walletID uint,
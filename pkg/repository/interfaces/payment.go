@@ -12,4 +12,11 @@ type PaymentRepository interface {
 	FindPaymentMethodByType(ctx context.Context, paymentType domain.PaymentType) (paymentMethod domain.PaymentMethod, err error)
 	FindAllPaymentMethods(ctx context.Context) ([]domain.PaymentMethod, error)
 	UpdatePaymentMethod(ctx context.Context, paymentMethod request.PaymentMethodUpdate) error
+
+	// payment events
+	SavePaymentEvent(ctx context.Context, event domain.PaymentEvent) (eventID uint, err error)
+	FindPaymentEventByID(ctx context.Context, paymentEventID uint) (domain.PaymentEvent, error)
+	FindPaymentEventByEventID(ctx context.Context, gateway domain.PaymentType, eventID string) (domain.PaymentEvent, error)
+	FindAllPaymentEvents(ctx context.Context, pagination request.Pagination) ([]domain.PaymentEvent, error)
+	UpdatePaymentEventResult(ctx context.Context, paymentEventID uint, processed bool, errMessage string) error
 }
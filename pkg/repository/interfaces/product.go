@@ -15,6 +15,10 @@ type ProductRepository interface {
 	IsCategoryNameExist(ctx context.Context, categoryName string) (bool, error)
 	FindAllMainCategories(ctx context.Context, pagination request.Pagination) ([]response.Category, error)
 	SaveCategory(ctx context.Context, categoryName string) error
+	FindSubCategoryByName(ctx context.Context, name string) (domain.Category, error)
+
+	// brand
+	FindBrandByName(ctx context.Context, name string) (domain.Brand, error)
 
 	// sub category
 	IsSubCategoryNameExist(ctx context.Context, categoryName string, categoryID uint) (bool, error)
@@ -34,10 +38,13 @@ type ProductRepository interface {
 	FindAllVariationValuesOfProductItem(ctx context.Context, productItemID uint) ([]response.ProductVariationValue, error)
 	//product
 	FindProductByID(ctx context.Context, productID uint) (product domain.Product, err error)
+	FindProductByName(ctx context.Context, name string) (domain.Product, error)
 	IsProductNameExistForOtherProduct(ctx context.Context, name string, productID uint) (bool, error)
 	IsProductNameExist(ctx context.Context, productName string) (exist bool, err error)
 
 	FindAllProducts(ctx context.Context, pagination request.Pagination) ([]response.Product, error)
+	SearchProducts(ctx context.Context, search request.ProductSearch) ([]response.Product, error)
+	FindProductSearchFacets(ctx context.Context, search request.ProductSearch) (response.ProductFacet, error)
 	SaveProduct(ctx context.Context, product domain.Product) error
 	UpdateProduct(ctx context.Context, product domain.Product) error
 
@@ -48,6 +55,10 @@ type ProductRepository interface {
 	FindAllProductItemIDsByProductIDAndVariationOptionID(ctx context.Context, productID, variationOptionID uint) ([]uint, error)
 	SaveProductConfiguration(ctx context.Context, productItemID, variationOptionID uint) error
 	SaveProductItem(ctx context.Context, productItem domain.ProductItem) (productItemID uint, err error)
+	UpdateProductItemWeight(ctx context.Context, productID, productItemID, weight uint) (updated bool, err error)
+	SaveStockMovement(ctx context.Context, movement domain.StockMovement) error
+	IsSKUExist(ctx context.Context, sku string) (bool, error)
+	FindAllProductItemsForExport(ctx context.Context, pagination request.Pagination) ([]response.ProductItemExport, error)
 	// product item image
 	FindAllProductItemImages(ctx context.Context, productItemID uint) (images []string, err error)
 	SaveProductItemImage(ctx context.Context, productItemID uint, image string) error
@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+)
+
+type QuestionRepository interface {
+	SaveProductQuestion(ctx context.Context, question domain.ProductQuestion) (questionID uint, err error)
+	FindProductQuestionByID(ctx context.Context, questionID uint) (domain.ProductQuestion, error)
+	FindAllProductQuestions(ctx context.Context, filter request.QuestionFilter) ([]response.ProductQuestion, error)
+	UpdateProductQuestionHidden(ctx context.Context, questionID uint, isHidden bool) error
+
+	SaveProductAnswer(ctx context.Context, answer domain.ProductAnswer) (answerID uint, err error)
+	FindProductAnswerByID(ctx context.Context, answerID uint) (domain.ProductAnswer, error)
+	FindAllProductAnswers(ctx context.Context, questionID uint, visibleOnly bool,
+		pagination request.Pagination) ([]response.ProductAnswer, error)
+	UpdateProductAnswerHidden(ctx context.Context, answerID uint, isHidden bool) error
+
+	SaveProductAnswerUpvote(ctx context.Context, answerID, userID uint) error
+	DeleteProductAnswerUpvote(ctx context.Context, answerID, userID uint) error
+}
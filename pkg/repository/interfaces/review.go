@@ -0,0 +1,24 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+)
+
+type ReviewRepository interface {
+	Transactions(ctx context.Context, trxFn func(repo ReviewRepository) error) error
+
+	IsDeliveredProductOfUser(ctx context.Context, userID, productID uint) (delivered bool, err error)
+
+	FindProductReviewByID(ctx context.Context, reviewID uint) (domain.ProductReview, error)
+	FindProductReviewByUserID(ctx context.Context, productID, userID uint) (domain.ProductReview, error)
+	SaveProductReview(ctx context.Context, review domain.ProductReview) (reviewID uint, err error)
+	SaveProductReviewImage(ctx context.Context, reviewID uint, image string) error
+	UpdateProductReviewStatus(ctx context.Context, reviewID uint, status domain.ReviewStatus) error
+
+	FindAllProductReviews(ctx context.Context, filter request.ReviewFilter) ([]response.ProductReview, error)
+	FindAllProductReviewImages(ctx context.Context, reviewID uint) (images []string, err error)
+}
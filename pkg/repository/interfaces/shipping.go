@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+)
+
+type ShippingRepository interface {
+	IsShippingZoneNameExist(ctx context.Context, name string, excludeZoneID uint) (exist bool, err error)
+	SaveShippingZone(ctx context.Context, zone domain.ShippingZone) (zoneID uint, err error)
+	UpdateShippingZone(ctx context.Context, zone domain.ShippingZone) error
+	DeleteShippingZone(ctx context.Context, zoneID uint) error
+	FindShippingZoneByID(ctx context.Context, zoneID uint) (domain.ShippingZone, error)
+	FindAllShippingZones(ctx context.Context, pagination request.Pagination) ([]domain.ShippingZone, error)
+
+	IsAnyShippingZoneExist(ctx context.Context) (exist bool, err error)
+	// zone of the pincode with narrowest pincode range (empty zone if pincode not serviceable)
+	FindShippingZoneOfPincode(ctx context.Context, countryID, pincode uint) (domain.ShippingZone, error)
+	// total weight in grams of all items on the cart
+	FindCartWeight(ctx context.Context, cartID uint) (weight uint, err error)
+}
@@ -5,9 +5,15 @@ import (
 
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
 )
 
 type StockRepository interface {
 	FindAll(ctx context.Context, pagination request.Pagination) (stocks []response.Stock, err error)
-	Update(ctx context.Context, updateValues request.UpdateStock) error
+	FindAllLowStocks(ctx context.Context, pagination request.Pagination) ([]response.Stock, error)
+	FindAllLowStocksByShopOrderID(ctx context.Context, shopOrderID uint) ([]response.Stock, error)
+	FindProductItemBySKU(ctx context.Context, sku string) (domain.ProductItem, error)
+	UpdateReorderThreshold(ctx context.Context, productItemID, reorderThreshold uint) error
+	ApplyStockMovement(ctx context.Context, movement domain.StockMovement) (applied bool, err error)
+	FindAllStockMovements(ctx context.Context, productItemID uint, pagination request.Pagination) ([]domain.StockMovement, error)
 }
@@ -0,0 +1,24 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+)
+
+type TaxRepository interface {
+	IsTaxClassNameExist(ctx context.Context, name string, excludeTaxClassID uint) (exist bool, err error)
+	SaveTaxClass(ctx context.Context, taxClass domain.TaxClass) (taxClassID uint, err error)
+	UpdateTaxClass(ctx context.Context, taxClass domain.TaxClass) error
+	FindTaxClassByID(ctx context.Context, taxClassID uint) (domain.TaxClass, error)
+	FindAllTaxClasses(ctx context.Context, pagination request.Pagination) ([]domain.TaxClass, error)
+
+	IsCategoryIDExist(ctx context.Context, categoryID uint) (exist bool, err error)
+	UpdateCategoryTaxClass(ctx context.Context, categoryID, taxClassID uint) error
+	IsProductIDExist(ctx context.Context, productID uint) (exist bool, err error)
+	UpdateProductTaxClass(ctx context.Context, productID, taxClassID uint) error
+
+	// tax rate of product item from tax class of its product or category (zero if no tax class)
+	FindTaxRateOfProductItem(ctx context.Context, productItemID uint) (rate float64, err error)
+}
@@ -18,6 +18,7 @@ type UserRepository interface {
 	SaveUser(ctx context.Context, user domain.User) (userID uint, err error)
 	UpdateVerified(ctx context.Context, userID uint) error
 	UpdateUser(ctx context.Context, user domain.User) (err error)
+	UpdatePassword(ctx context.Context, userID uint, hashPassword string) error
 	UpdateBlockStatus(ctx context.Context, userID uint, blockStatus bool) error
 
 	//address
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	"gorm.io/gorm"
+)
+
+type invoiceDatabase struct {
+	DB *gorm.DB
+}
+
+func NewInvoiceRepository(db *gorm.DB) interfaces.InvoiceRepository {
+	return &invoiceDatabase{
+		DB: db,
+	}
+}
+
+func (c *invoiceDatabase) FindInvoiceByShopOrderID(ctx context.Context,
+	shopOrderID uint) (invoice domain.Invoice, err error) {
+
+	query := `SELECT * FROM invoices WHERE shop_order_id = $1`
+	err = c.DB.Raw(query, shopOrderID).Scan(&invoice).Error
+
+	return
+}
+
+func (c *invoiceDatabase) UpdateInvoiceFileKey(ctx context.Context, invoiceID uint, fileKey string) error {
+
+	query := `UPDATE invoices SET file_key = $1 WHERE id = $2`
+	err := c.DB.Exec(query, fileKey, invoiceID).Error
+
+	return err
+}
+
+func (c *invoiceDatabase) FindInvoiceOrder(ctx context.Context,
+	shopOrderID uint) (invoiceOrder response.InvoiceOrder, err error) {
+
+	query := `SELECT so.id AS shop_order_id, so.user_id, so.order_date, u.first_name, u.last_name, u.email, 
+	so.order_total_price, so.discount, so.delivery_charge, so.wallet_amount, 
+	COALESCE(pm.name, '') AS payment_method_name, COALESCE(cp.coupon_code, '') AS coupon_code, 
+	a.name AS address_name, a.phone_number, a.house, a.area, a.land_mark, a.city, a.pincode, a.state, c.country_name 
+	FROM shop_orders so 
+	INNER JOIN users u ON u.id = so.user_id 
+	INNER JOIN addresses a ON a.id = so.address_id 
+	INNER JOIN countries c ON c.id = a.country_id 
+	LEFT JOIN payment_methods pm ON pm.id = so.payment_method_id 
+	LEFT JOIN coupons cp ON cp.coupon_id = so.coupon_id 
+	WHERE so.id = $1`
+	err = c.DB.Raw(query, shopOrderID).Scan(&invoiceOrder).Error
+
+	return
+}
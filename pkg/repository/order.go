@@ -13,6 +13,10 @@ import (
 	"gorm.io/gorm"
 )
 
+// GST of each shop order from its order lines
+const orderTaxesQuery = `SELECT shop_order_id, SUM(cgst) AS cgst, SUM(sgst) AS sgst, SUM(igst) AS igst,
+	SUM(cgst + sgst + igst) AS total_tax FROM order_lines GROUP BY shop_order_id`
+
 type OrderDatabase struct {
 	DB *gorm.DB
 }
@@ -29,7 +33,7 @@ func (c *OrderDatabase) Transaction(callBack func(trxRepo interfaces.OrderReposi
 	err := callBack(transactionRepo)
 	if err != nil {
 		trx.Rollback()
-		return fmt.Errorf("failed to complete transaction \nerror:%v", err.Error())
+		return fmt.Errorf("failed to complete transaction \nerror:%w", err)
 	}
 
 	err = trx.Commit().Error
@@ -52,10 +56,11 @@ func (c *OrderDatabase) FindAllShopOrdersByUserID(ctx context.Context, userID ui
 	limit := pagination.Count
 	offset := (pagination.PageNumber - 1) * limit
 
-	query := `SELECT so.user_id, so.id AS shop_order_id, so.order_date, so.order_total_price, so.discount, 
-	so.order_status_id, os.status AS order_status,so.address_id, so.payment_method_id, pm.name AS payment_method_name  
+	query := `SELECT so.user_id, so.id AS shop_order_id, so.order_date, so.order_total_price, so.discount, so.delivery_charge, so.wallet_amount, 
+	so.order_status_id, os.status AS order_status, COALESCE(ot.total_tax, 0) AS total_tax,so.address_id, so.payment_method_id, pm.name AS payment_method_name  
 	FROM shop_orders so 
 	INNER JOIN order_statuses os ON so.order_status_id = os.id 
+	LEFT JOIN (` + orderTaxesQuery + `) ot ON ot.shop_order_id = so.id 
 	INNER JOIN payment_methods pm ON pm.id = so.payment_method_id 
 	WHERE user_id = $1 
 	ORDER BY order_date DESC LIMIT $2 OFFSET  $3`
@@ -71,10 +76,11 @@ func (c *OrderDatabase) FindAllShopOrders(ctx context.Context,
 	limit := pagination.Count
 	offset := (pagination.PageNumber - 1) * limit
 
-	query := `SELECT so.user_id, so.id AS shop_order_id, so.order_date, so.order_total_price, so.discount, 
-	so.order_status_id, os.status AS order_status, so.address_id, so.payment_method_id, pm.name AS payment_method_name   
+	query := `SELECT so.user_id, so.id AS shop_order_id, so.order_date, so.order_total_price, so.discount, so.delivery_charge, so.wallet_amount, 
+	so.order_status_id, os.status AS order_status, COALESCE(ot.total_tax, 0) AS total_tax, so.address_id, so.payment_method_id, pm.name AS payment_method_name   
 	FROM shop_orders so 
 	INNER JOIN order_statuses os ON so.order_status_id = os.id 
+	LEFT JOIN (` + orderTaxesQuery + `) ot ON ot.shop_order_id = so.id 
 	INNER JOIN payment_methods pm ON so.payment_method_id = pm.id 
 	ORDER BY so.order_date DESC LIMIT $1 OFFSET $2`
 
@@ -91,7 +97,8 @@ func (c *OrderDatabase) FindAllOrdersItemsByShopOrderID(ctx context.Context,
 	offset := (pagination.PageNumber - 1) * limit
 
 	query := `SELECT ol.product_item_id, p.name AS product_name, p.image, ol.price, so.order_date, os.status,ol.qty, 
-	(ol.price * ol.qty) AS sub_total FROM  order_lines ol 
+	(ol.price * ol.qty) AS sub_total, ol.tax_rate, ol.taxable_amount, ol.cgst, ol.sgst, ol.igst 
+	FROM  order_lines ol 
 	INNER JOIN shop_orders so ON ol.shop_order_id = so.id 
 	INNER JOIN product_items pi ON ol.product_item_id = pi.id
 	INNER JOIN products p ON pi.product_id = p.id 
@@ -109,22 +116,24 @@ func (c *OrderDatabase) FindAllOrdersItemsByShopOrderID(ctx context.Context,
 func (c *OrderDatabase) SaveShopOrder(ctx context.Context, shopOrder domain.ShopOrder) (shopOrderID uint, err error) {
 
 	// save the shop_order
-	query := `INSERT INTO shop_orders (user_id, address_id, order_total_price, discount, 
-	order_status_id, order_date) 
-	VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`
+	query := `INSERT INTO shop_orders (user_id, address_id, order_total_price, discount, delivery_charge, 
+	order_status_id, coupon_id, order_date) 
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id`
 
 	orderDate := time.Now()
 	err = c.DB.Raw(query, shopOrder.UserID, shopOrder.AddressID, shopOrder.OrderTotalPrice, shopOrder.Discount,
-		shopOrder.OrderStatusID, orderDate).Scan(&shopOrderID).Error
+		shopOrder.DeliveryCharge, shopOrder.OrderStatusID, shopOrder.CouponID, orderDate).Scan(&shopOrderID).Error
 
 	return shopOrderID, err
 }
 
 func (c *OrderDatabase) SaveOrderLine(ctx context.Context, orderLine domain.OrderLine) error {
 
-	query := `INSERT INTO order_lines (product_item_id, shop_order_id, qty, price) 
-	VALUES ($1, $2, $3, $4)`
-	err := c.DB.Exec(query, orderLine.ProductItemID, orderLine.ShopOrderID, orderLine.Qty, orderLine.Price).Error
+	query := `INSERT INTO order_lines (product_item_id, shop_order_id, qty, price, 
+	tax_rate, taxable_amount, cgst, sgst, igst) 
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	err := c.DB.Exec(query, orderLine.ProductItemID, orderLine.ShopOrderID, orderLine.Qty, orderLine.Price,
+		orderLine.TaxRate, orderLine.TaxableAmount, orderLine.CGST, orderLine.SGST, orderLine.IGST).Error
 
 	return err
 }
@@ -166,23 +175,203 @@ func (c *OrderDatabase) FindAllOrderStatuses(ctx context.Context) (orderStatuses
 	return orderStatuses, err
 }
 
-func (c *OrderDatabase) UpdateShopOrderOrderStatus(ctx context.Context, shopOrderID, changeStatusID uint) error {
+// update the order status only if the order is still on the current status
+func (c *OrderDatabase) UpdateShopOrderOrderStatus(ctx context.Context,
+	shopOrderID, currentStatusID, changeStatusID uint) (updated bool, err error) {
 
-	query := `UPDATE shop_orders SET order_status_id = $1 WHERE id = $2`
-	err := c.DB.Exec(query, changeStatusID, shopOrderID).Error
+	query := `UPDATE shop_orders SET order_status_id = $1 WHERE id = $2 AND order_status_id = $3`
+	result := c.DB.Exec(query, changeStatusID, shopOrderID, currentStatusID)
 
-	return err
+	return result.RowsAffected > 0, result.Error
 }
 
+// update the order status with payment details only if the order is still on the current status
 func (c *OrderDatabase) UpdateShopOrderStatusAndSavePaymentMethod(ctx context.Context,
-	shopOrderID, orderStatusID, paymentID uint) error {
+	shopOrderID, currentStatusID, orderStatusID, paymentID, walletAmount uint) (updated bool, err error) {
+
+	query := `UPDATE shop_orders SET order_status_id = $1, payment_method_id = $2, wallet_amount = $3 
+	WHERE id = $4 AND order_status_id = $5`
+	result := c.DB.Exec(query, orderStatusID, paymentID, walletAmount, shopOrderID, currentStatusID)
+
+	return result.RowsAffected > 0, result.Error
+}
+
+// To check the order status can change from the status to the other status
+func (c *OrderDatabase) IsOrderStatusTransitionExist(ctx context.Context,
+	fromStatusID, toStatusID uint) (exist bool, err error) {
+
+	query := `SELECT EXISTS(SELECT 1 FROM order_status_transitions 
+	WHERE from_status_id = $1 AND to_status_id = $2)`
+	err = c.DB.Raw(query, fromStatusID, toStatusID).Scan(&exist).Error
+
+	return
+}
+
+func (c *OrderDatabase) SaveShopOrderStatusHistory(ctx context.Context, history domain.ShopOrderStatusHistory) error {
+
+	createdAt := time.Now()
+	query := `INSERT INTO shop_order_status_histories (shop_order_id, order_status_id, actor_id, actor_type, 
+	comment, created_at) VALUES ($1, $2, $3, $4, $5, $6)`
+	err := c.DB.Exec(query, history.ShopOrderID, history.OrderStatusID, history.ActorID, history.ActorType,
+		history.Comment, createdAt).Error
+
+	return err
+}
+
+// find all status changes of a shop order in the order of time
+func (c *OrderDatabase) FindAllShopOrderStatusHistories(ctx context.Context,
+	shopOrderID uint) (histories []response.OrderStatusHistory, err error) {
+
+	query := `SELECT sh.order_status_id, os.status AS order_status, sh.actor_id, sh.actor_type, 
+	sh.comment, sh.created_at AS changed_at 
+	FROM shop_order_status_histories sh 
+	INNER JOIN order_statuses os ON os.id = sh.order_status_id 
+	WHERE sh.shop_order_id = $1 
+	ORDER BY sh.created_at, sh.id`
+	err = c.DB.Raw(query, shopOrderID).Scan(&histories).Error
+
+	return
+}
+
+// To save the order id (or payment intent id) created on payment gateway for the shop order with the payment method
+// and the amount paying from wallet (only updated when the shop order is still on the given status)
+func (c *OrderDatabase) UpdateShopOrderGatewayOrder(ctx context.Context, shopOrderID, orderStatusID, paymentMethodID uint,
+	gatewayOrderID string, walletAmount uint) (bool, error) {
+
+	query := `UPDATE shop_orders SET gateway_order_id = $1, payment_method_id = $2, wallet_amount = $3 
+	WHERE id = $4 AND order_status_id = $5`
+	result := c.DB.Exec(query, gatewayOrderID, paymentMethodID, walletAmount, shopOrderID, orderStatusID)
+
+	return result.RowsAffected > 0, result.Error
+}
+
+func (c *OrderDatabase) FindShopOrderByGatewayOrderID(ctx context.Context,
+	gatewayOrderID string) (shopOrder domain.ShopOrder, err error) {
+
+	query := `SELECT * FROM shop_orders WHERE gateway_order_id = $1`
+	err = c.DB.Raw(query, gatewayOrderID).Scan(&shopOrder).Error
+
+	return shopOrder, err
+}
+
+// To find all shop orders on the given order status which are ordered before the given time
+func (c *OrderDatabase) FindAllShopOrdersByStatusBefore(ctx context.Context, orderStatusID uint,
+	orderedBefore time.Time) (shopOrders []domain.ShopOrder, err error) {
+
+	query := `SELECT * FROM shop_orders WHERE order_status_id = $1 AND order_date < $2 ORDER BY order_date`
+	err = c.DB.Raw(query, orderStatusID, orderedBefore).Scan(&shopOrders).Error
+
+	return shopOrders, err
+}
+
+// To save the amount which is going to pay from wallet for the shop order
+func (c *OrderDatabase) FindAllOrderLinesByShopOrderID(ctx context.Context,
+	shopOrderID uint) (orderLines []domain.OrderLine, err error) {
+
+	query := `SELECT * FROM order_lines WHERE shop_order_id = $1`
+	err = c.DB.Raw(query, shopOrderID).Scan(&orderLines).Error
+
+	return orderLines, err
+}
+
+// To change the stock of order line product item and save it on stock movements
+func (c *OrderDatabase) ApplyStockMovement(ctx context.Context, movement domain.StockMovement) (bool, error) {
+	return applyStockMovement(c.DB, movement)
+}
 
-	query := `UPDATE shop_orders SET order_status_id = $1, payment_method_id = $2 WHERE id = $3`
-	err := c.DB.Exec(query, orderStatusID, paymentID, shopOrderID).Error
+// To remove the coupon uses of user (when the order which coupon applied is cancelled)
+func (c *OrderDatabase) SaveCouponUses(ctx context.Context, couponUses domain.CouponUses) error {
+
+	query := `INSERT INTO coupon_uses (user_id, coupon_id, used_at) VALUES ($1, $2, $3)`
+	err := c.DB.Exec(query, couponUses.UserID, couponUses.CouponID, time.Now()).Error
+
+	return err
+}
+
+// delete the cart items of user which are ordered on the shop order
+func (c *OrderDatabase) DeleteOrderedCartItems(ctx context.Context, userID, shopOrderID uint) error {
+
+	query := `DELETE FROM cart_items ci USING carts c 
+	WHERE ci.cart_id = c.id AND c.user_id = $1 
+	AND ci.product_item_id IN (SELECT ol.product_item_id FROM order_lines ol WHERE ol.shop_order_id = $2)`
+	err := c.DB.Exec(query, userID, shopOrderID).Error
+
+	return err
+}
+
+// remove the coupon from user cart if it's still applied
+func (c *OrderDatabase) RemoveCouponFromCart(ctx context.Context, userID, couponID uint) error {
+
+	query := `UPDATE carts SET applied_coupon_id = 0, discount_amount = 0 WHERE user_id = $1 AND applied_coupon_id = $2`
+	err := c.DB.Exec(query, userID, couponID).Error
+
+	return err
+}
+
+// save invoice of shop order with the next number of invoice counter (should call inside a transaction)
+// the counter row is locked till the transaction end so the invoices are saved one by one without gaps on numbers
+// a shop order has only one invoice so the already saved invoice is returned without taking a number
+func (c *OrderDatabase) SaveInvoice(ctx context.Context, shopOrderID uint) (invoice domain.Invoice, err error) {
+
+	query := `SELECT last FROM invoice_counters WHERE id = 1 FOR UPDATE`
+	err = c.DB.Exec(query).Error
+	if err != nil {
+		return invoice, err
+	}
+
+	query = `SELECT * FROM invoices WHERE shop_order_id = $1`
+	err = c.DB.Raw(query, shopOrderID).Scan(&invoice).Error
+	if err != nil || invoice.ID != 0 {
+		return invoice, err
+	}
+
+	var invoiceNumber uint
+	query = `UPDATE invoice_counters SET last = last + 1 WHERE id = 1 RETURNING last`
+	err = c.DB.Raw(query).Scan(&invoiceNumber).Error
+	if err != nil {
+		return invoice, err
+	}
+	if invoiceNumber == 0 {
+		return invoice, errors.New("invoice counter not exist")
+	}
+
+	query = `INSERT INTO invoices (shop_order_id, invoice_number, created_at) VALUES ($1, $2, $3) 
+	RETURNING *`
+	err = c.DB.Raw(query, shopOrderID, invoiceNumber, time.Now()).Scan(&invoice).Error
+
+	return
+}
+
+func (c *OrderDatabase) DeleteCouponUses(ctx context.Context, userID, couponID uint) error {
+
+	query := `DELETE FROM coupon_uses WHERE user_id = $1 AND coupon_id = $2`
+	err := c.DB.Exec(query, userID, couponID).Error
 
 	return err
 }
 
+func (c *OrderDatabase) SaveOrderCancellation(ctx context.Context, cancellation domain.OrderCancellation) error {
+
+	query := `INSERT INTO order_cancellations (shop_order_id, cancelled_by, actor_type, cancelled_at, previous_status, 
+	refund_amount, gateway_refund_amount, gateway_refund_id, coupon_reverted) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	err := c.DB.Exec(query, cancellation.ShopOrderID, cancellation.CancelledBy, cancellation.ActorType, cancellation.CancelledAt,
+		cancellation.PreviousStatus, cancellation.RefundAmount, cancellation.GatewayRefundAmount, cancellation.GatewayRefundID,
+		cancellation.CouponReverted).Error
+
+	return err
+}
+
+// To save the refund on gateway for a cancelled order (only updated when the gateway refund id is not saved)
+func (c *OrderDatabase) UpdateOrderCancellationGatewayRefund(ctx context.Context, shopOrderID,
+	gatewayRefundAmount uint, gatewayRefundID string) (bool, error) {
+
+	query := `UPDATE order_cancellations SET gateway_refund_amount = $1, gateway_refund_id = $2 
+	WHERE shop_order_id = $3 AND COALESCE(gateway_refund_id, '') = ''`
+	result := c.DB.Exec(query, gatewayRefundAmount, gatewayRefundID, shopOrderID)
+
+	return result.RowsAffected > 0, result.Error
+}
+
 func (c *OrderDatabase) FindOrderReturnByReturnID(ctx context.Context,
 	orderReturnID uint) (orderReturn domain.OrderReturn, err error) {
 
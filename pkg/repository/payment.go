@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
@@ -61,3 +62,56 @@ func (c *paymentDatabase) UpdatePaymentMethod(ctx context.Context, paymentMethod
 
 	return err
 }
+
+// payment events
+
+func (c *paymentDatabase) SavePaymentEvent(ctx context.Context, event domain.PaymentEvent) (eventID uint, err error) {
+
+	receivedAt := time.Now()
+	query := `INSERT INTO payment_events (gateway, event_id, event_type, gateway_order_id, amount, payload, received_at) 
+	VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`
+	err = c.db.Raw(query, event.Gateway, event.EventID, event.EventType, event.GatewayOrderID,
+		event.Amount, event.Payload, receivedAt).Scan(&eventID).Error
+
+	return eventID, err
+}
+
+func (c *paymentDatabase) FindPaymentEventByID(ctx context.Context, paymentEventID uint) (event domain.PaymentEvent, err error) {
+
+	query := `SELECT * FROM payment_events WHERE id = $1`
+	err = c.db.Raw(query, paymentEventID).Scan(&event).Error
+
+	return event, err
+}
+
+func (c *paymentDatabase) FindPaymentEventByEventID(ctx context.Context, gateway domain.PaymentType,
+	eventID string) (event domain.PaymentEvent, err error) {
+
+	query := `SELECT * FROM payment_events WHERE gateway = $1 AND event_id = $2`
+	err = c.db.Raw(query, gateway, eventID).Scan(&event).Error
+
+	return event, err
+}
+
+func (c *paymentDatabase) FindAllPaymentEvents(ctx context.Context,
+	pagination request.Pagination) (events []domain.PaymentEvent, err error) {
+
+	limit := pagination.Count
+	offset := (pagination.PageNumber - 1) * limit
+
+	query := `SELECT * FROM payment_events ORDER BY received_at DESC LIMIT $1 OFFSET $2`
+	err = c.db.Raw(query, limit, offset).Scan(&events).Error
+
+	return events, err
+}
+
+// To save the result of processing the payment event
+func (c *paymentDatabase) UpdatePaymentEventResult(ctx context.Context, paymentEventID uint,
+	processed bool, errMessage string) error {
+
+	processedAt := time.Now()
+	query := `UPDATE payment_events SET processed = $1, error = $2, processed_at = $3 WHERE id = $4`
+	err := c.db.Exec(query, processed, errMessage, processedAt, paymentEventID).Error
+
+	return err
+}
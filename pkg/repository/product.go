@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
@@ -39,6 +40,24 @@ func (c *productDatabase) Transactions(ctx context.Context, trxFn func(repo inte
 	return nil
 }
 
+// To find sub category by name (products are saved with sub category)
+func (c *productDatabase) FindSubCategoryByName(ctx context.Context, name string) (category domain.Category, err error) {
+
+	query := `SELECT * FROM categories WHERE name = $1 AND category_id IS NOT NULL`
+	err = c.DB.Raw(query, name).Scan(&category).Error
+
+	return
+}
+
+// To find brand by name
+func (c *productDatabase) FindBrandByName(ctx context.Context, name string) (brand domain.Brand, err error) {
+
+	query := `SELECT * FROM brands WHERE name = $1`
+	err = c.DB.Raw(query, name).Scan(&brand).Error
+
+	return
+}
+
 // To check the category name exist
 func (c *productDatabase) IsCategoryNameExist(ctx context.Context, name string) (exist bool, err error) {
 
@@ -166,6 +185,15 @@ func (c *productDatabase) FindProductByID(ctx context.Context, productID uint) (
 	return
 }
 
+// To find product by name
+func (c *productDatabase) FindProductByName(ctx context.Context, name string) (product domain.Product, err error) {
+
+	query := `SELECT * FROM products WHERE name = $1`
+	err = c.DB.Raw(query, name).Scan(&product).Error
+
+	return
+}
+
 func (c *productDatabase) IsProductNameExistForOtherProduct(ctx context.Context,
 	name string, productID uint) (exist bool, err error) {
 
@@ -211,6 +239,10 @@ func (c *productDatabase) UpdateProduct(ctx context.Context, product domain.Prod
 	return err
 }
 
+// average rating and count of approved reviews of each product
+var productRatingQuery = `SELECT product_id, ROUND(AVG(rating), 1) AS average_rating, COUNT(id) AS review_count 
+	FROM product_reviews WHERE status = '` + string(domain.ReviewApproved) + `' GROUP BY product_id`
+
 // get all products from database
 func (c *productDatabase) FindAllProducts(ctx context.Context, pagination request.Pagination) (products []response.Product, err error) {
 
@@ -220,18 +252,143 @@ func (c *productDatabase) FindAllProducts(ctx context.Context, pagination reques
 	query := `SELECT p.id, p.name, p.description, p.price, p.discount_price, 
 	p.image, p.image, p.category_id, sc.name AS category_name, 
 	mc.name AS main_category_name, p.brand_id, b.name AS brand_name,
+	COALESCE(pr.average_rating, 0) AS average_rating, COALESCE(pr.review_count, 0) AS review_count, 
 	p.created_at, p.updated_at 
 	FROM products p 
 	INNER JOIN categories sc ON p.category_id = sc.id 
 	INNER JOIN categories mc ON sc.category_id = mc.id 
 	INNER JOIN brands b ON b.id = p.brand_id 
-	ORDER BY created_at DESC LIMIT $1 OFFSET $2`
+	LEFT JOIN (` + productRatingQuery + `) pr ON pr.product_id = p.id 
+	ORDER BY p.created_at DESC LIMIT $1 OFFSET $2`
 
 	err = c.DB.Raw(query, limit, offset).Scan(&products).Error
 
 	return
 }
 
+// price shown to the user (discount price when an offer is applied on the product)
+const productEffectivePrice = "CASE WHEN p.discount_price > 0 THEN p.discount_price ELSE p.price END"
+
+// To build the where condition and its arguments for product search
+func productSearchCondition(search request.ProductSearch) (condition string, args []interface{}) {
+
+	var where whereCondition
+
+	if search.Keyword != "" {
+		where.Add(`to_tsvector('english', p.name || ' ' || p.description) @@ plainto_tsquery('english', ` +
+			where.Arg(search.Keyword) + `)`)
+	}
+	if search.CategoryID != 0 {
+		where.Add("p.category_id = " + where.Arg(search.CategoryID))
+	}
+	if search.BrandID != 0 {
+		where.Add("p.brand_id = " + where.Arg(search.BrandID))
+	}
+	if search.MinPrice != 0 {
+		where.Add(productEffectivePrice + " >= " + where.Arg(search.MinPrice))
+	}
+	if search.MaxPrice != 0 {
+		where.Add(productEffectivePrice + " <= " + where.Arg(search.MaxPrice))
+	}
+	if search.InStockOnly {
+		where.Add(`EXISTS(SELECT 1 FROM product_items pi 
+		WHERE pi.product_id = p.id AND pi.qty_in_stock > 0)`)
+	}
+	// product should have an item with each of the given variation options
+	for _, variationOptionID := range search.VariationOptionIDs {
+		where.Add(`EXISTS(SELECT 1 FROM product_items pi 
+		INNER JOIN product_configurations pc ON pc.product_item_id = pi.id 
+		WHERE pi.product_id = p.id AND pc.variation_option_id = ` + where.Arg(variationOptionID) + `)`)
+	}
+
+	return where.Build()
+}
+
+// search products using full text search with filters and sort
+func (c *productDatabase) SearchProducts(ctx context.Context,
+	search request.ProductSearch) (products []response.Product, err error) {
+
+	limit := search.Pagination.Count
+	offset := (search.Pagination.PageNumber - 1) * limit
+
+	condition, args := productSearchCondition(search)
+
+	orderBy := "p.created_at DESC"
+	switch search.SortBy {
+	case request.SortByPriceAsc:
+		orderBy = productEffectivePrice + " ASC"
+	case request.SortByPriceDesc:
+		orderBy = productEffectivePrice + " DESC"
+	case request.SortByPopularity:
+		orderBy = `(SELECT COALESCE(SUM(ol.qty), 0) FROM order_lines ol 
+		INNER JOIN product_items pi ON pi.id = ol.product_item_id 
+		WHERE pi.product_id = p.id) DESC, p.created_at DESC`
+	case request.SortByRating:
+		orderBy = "COALESCE(pr.average_rating, 0) DESC, COALESCE(pr.review_count, 0) DESC, p.created_at DESC"
+	case request.SortByNewest:
+	default:
+		// when searching with keyword without a sort option then sort with the match rank
+		if search.Keyword != "" {
+			orderBy = `ts_rank(to_tsvector('english', p.name || ' ' || p.description), 
+			plainto_tsquery('english', $1)) DESC, p.created_at DESC`
+		}
+	}
+
+	query := fmt.Sprintf(`SELECT p.id, p.name, p.description, p.price, p.discount_price, 
+	p.image, p.category_id, sc.name AS category_name, 
+	mc.name AS main_category_name, p.brand_id, b.name AS brand_name,
+	COALESCE(pr.average_rating, 0) AS average_rating, COALESCE(pr.review_count, 0) AS review_count, 
+	p.created_at, p.updated_at 
+	FROM products p 
+	INNER JOIN categories sc ON p.category_id = sc.id 
+	INNER JOIN categories mc ON sc.category_id = mc.id 
+	INNER JOIN brands b ON b.id = p.brand_id 
+	LEFT JOIN (`+productRatingQuery+`) pr ON pr.product_id = p.id 
+	WHERE %s ORDER BY %s LIMIT $%d OFFSET $%d`, condition, orderBy, len(args)+1, len(args)+2)
+
+	args = append(args, limit, offset)
+	err = c.DB.Raw(query, args...).Scan(&products).Error
+
+	return
+}
+
+// find facet counts of brand, category and variation value for the product search
+func (c *productDatabase) FindProductSearchFacets(ctx context.Context,
+	search request.ProductSearch) (facet response.ProductFacet, err error) {
+
+	condition, args := productSearchCondition(search)
+
+	query := fmt.Sprintf(`SELECT b.id, b.name, COUNT(p.id) AS count 
+	FROM products p 
+	INNER JOIN brands b ON b.id = p.brand_id 
+	WHERE %s GROUP BY b.id, b.name ORDER BY count DESC`, condition)
+	if err = c.DB.Raw(query, args...).Scan(&facet.Brands).Error; err != nil {
+		return facet, fmt.Errorf("failed to find brand facets: %w", err)
+	}
+
+	query = fmt.Sprintf(`SELECT sc.id, sc.name, COUNT(p.id) AS count 
+	FROM products p 
+	INNER JOIN categories sc ON sc.id = p.category_id 
+	WHERE %s GROUP BY sc.id, sc.name ORDER BY count DESC`, condition)
+	if err = c.DB.Raw(query, args...).Scan(&facet.Categories).Error; err != nil {
+		return facet, fmt.Errorf("failed to find category facets: %w", err)
+	}
+
+	query = fmt.Sprintf(`SELECT v.id AS variation_id, v.name AS variation_name, 
+	vo.id AS variation_option_id, vo.value, COUNT(DISTINCT p.id) AS count 
+	FROM products p 
+	INNER JOIN product_items pi ON pi.product_id = p.id 
+	INNER JOIN product_configurations pc ON pc.product_item_id = pi.id 
+	INNER JOIN variation_options vo ON vo.id = pc.variation_option_id 
+	INNER JOIN variations v ON v.id = vo.variation_id 
+	WHERE %s GROUP BY v.id, v.name, vo.id, vo.value ORDER BY v.id, count DESC`, condition)
+	if err = c.DB.Raw(query, args...).Scan(&facet.VariationValues).Error; err != nil {
+		return facet, fmt.Errorf("failed to find variation value facets: %w", err)
+	}
+
+	return facet, nil
+}
+
 // to get productItem id
 func (c *productDatabase) FindProductItemByID(ctx context.Context, productItemID uint) (productItem domain.ProductItem, err error) {
 
@@ -276,15 +433,64 @@ func (c *productDatabase) SaveProductConfiguration(ctx context.Context, productI
 
 func (c *productDatabase) SaveProductItem(ctx context.Context, productItem domain.ProductItem) (productItemID uint, err error) {
 
-	query := `INSERT INTO product_items (product_id, qty_in_stock, price, sku,created_at) VALUES($1, $2, $3, $4, $5) 
-	 RETURNING id AS product_item_id`
+	query := `INSERT INTO product_items (product_id, qty_in_stock, price, sku, weight, created_at) 
+	VALUES($1, $2, $3, $4, $5, $6) RETURNING id AS product_item_id`
 	createdAt := time.Now()
-	err = c.DB.Raw(query, productItem.ProductID, productItem.QtyInStock, productItem.Price, productItem.SKU, createdAt).
-		Scan(&productItemID).Error
+	err = c.DB.Raw(query, productItem.ProductID, productItem.QtyInStock, productItem.Price, productItem.SKU,
+		productItem.Weight, createdAt).Scan(&productItemID).Error
+
+	return
+}
+
+// To check the sku is already used for a product item
+func (c *productDatabase) IsSKUExist(ctx context.Context, sku string) (exist bool, err error) {
+
+	query := `SELECT EXISTS(SELECT 1 FROM product_items WHERE sku = $1)`
+	err = c.DB.Raw(query, sku).Scan(&exist).Error
+
+	return
+}
+
+// To find all product items with their product details and variation values for export
+func (c *productDatabase) FindAllProductItemsForExport(ctx context.Context,
+	pagination request.Pagination) (productItems []response.ProductItemExport, err error) {
+
+	limit := pagination.Count
+	offset := (pagination.PageNumber - 1) * limit
+
+	query := `SELECT p.name AS product_name, p.description, c.name AS category_name, b.name AS brand_name, 
+	p.price AS product_price, pi.sku, pi.price, pi.qty_in_stock, pi.weight, 
+	COALESCE(STRING_AGG(v.name || ':' || vo.value, '|' ORDER BY v.id), '') AS variations 
+	FROM product_items pi 
+	INNER JOIN products p ON p.id = pi.product_id 
+	INNER JOIN categories c ON c.id = p.category_id 
+	INNER JOIN brands b ON b.id = p.brand_id 
+	LEFT JOIN product_configurations pc ON pc.product_item_id = pi.id 
+	LEFT JOIN variation_options vo ON vo.id = pc.variation_option_id 
+	LEFT JOIN variations v ON v.id = vo.variation_id 
+	GROUP BY p.id, c.name, b.name, pi.id 
+	ORDER BY p.id, pi.id LIMIT $1 OFFSET $2`
+
+	err = c.DB.Raw(query, limit, offset).Scan(&productItems).Error
 
 	return
 }
 
+// updated will be false when there is no product item with the id for the product
+func (c *productDatabase) UpdateProductItemWeight(ctx context.Context,
+	productID, productItemID, weight uint) (updated bool, err error) {
+
+	query := `UPDATE product_items SET weight = $1, updated_at = $2 WHERE id = $3 AND product_id = $4`
+	result := c.DB.Exec(query, weight, time.Now(), productItemID, productID)
+
+	return result.RowsAffected > 0, result.Error
+}
+
+// To save stock movement of product item which stock is already saved with product item
+func (c *productDatabase) SaveStockMovement(ctx context.Context, movement domain.StockMovement) error {
+	return saveStockMovement(c.DB, movement)
+}
+
 // for get all products items for a product
 func (c *productDatabase) FindAllProductItems(ctx context.Context,
 	productID uint) (productItems []response.ProductItems, err error) {
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProductSearchCondition(t *testing.T) {
+
+	condition, args := productSearchCondition(request.ProductSearch{
+		BrandID:  2,
+		MinPrice: 100,
+		MaxPrice: 500,
+	})
+
+	// price filter should be on the price shown to user
+	assert.Equal(t, "p.brand_id = $1 AND "+
+		productEffectivePrice+" >= $2 AND "+
+		productEffectivePrice+" <= $3", condition)
+	assert.Equal(t, []interface{}{uint(2), uint(100), uint(500)}, args)
+}
@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	"gorm.io/gorm"
+)
+
+type questionDatabase struct {
+	DB *gorm.DB
+}
+
+func NewQuestionRepository(db *gorm.DB) interfaces.QuestionRepository {
+	return &questionDatabase{
+		DB: db,
+	}
+}
+
+func (c *questionDatabase) SaveProductQuestion(ctx context.Context,
+	question domain.ProductQuestion) (questionID uint, err error) {
+
+	query := `INSERT INTO product_questions (product_id, user_id, question, notify_on_answer, created_at) 
+	VALUES ($1, $2, $3, $4, $5) RETURNING id`
+
+	createdAt := time.Now()
+	err = c.DB.Raw(query, question.ProductID, question.UserID, question.Question,
+		question.NotifyOnAnswer, createdAt).Scan(&questionID).Error
+
+	return
+}
+
+func (c *questionDatabase) FindProductQuestionByID(ctx context.Context,
+	questionID uint) (question domain.ProductQuestion, err error) {
+
+	query := `SELECT * FROM product_questions WHERE id = $1`
+	err = c.DB.Raw(query, questionID).Scan(&question).Error
+
+	return
+}
+
+// find all product questions with given filter (latest first)
+// count of answers are only the visible answers
+func (c *questionDatabase) FindAllProductQuestions(ctx context.Context,
+	filter request.QuestionFilter) (questions []response.ProductQuestion, err error) {
+
+	limit := filter.Pagination.Count
+	offset := (filter.Pagination.PageNumber - 1) * limit
+
+	condition, args := questionCondition(filter)
+
+	query := fmt.Sprintf(`SELECT pq.id, pq.product_id, pq.user_id, u.first_name, pq.question, pq.is_hidden, 
+	(SELECT COUNT(pa.id) FROM product_answers pa 
+	WHERE pa.product_question_id = pq.id AND pa.is_hidden = 'f') AS answer_count, pq.created_at 
+	FROM product_questions pq 
+	INNER JOIN users u ON u.id = pq.user_id 
+	WHERE %s ORDER BY pq.created_at DESC LIMIT $%d OFFSET $%d`, condition, len(args)+1, len(args)+2)
+
+	args = append(args, limit, offset)
+	err = c.DB.Raw(query, args...).Scan(&questions).Error
+
+	return
+}
+
+func questionCondition(filter request.QuestionFilter) (condition string, args []interface{}) {
+
+	var where whereCondition
+
+	if filter.ProductID != 0 {
+		where.Add("pq.product_id = " + where.Arg(filter.ProductID))
+	}
+	if filter.VisibleOnly {
+		where.Add("pq.is_hidden = 'f'")
+	}
+	if filter.Unanswered {
+		where.Add(`NOT EXISTS(SELECT 1 FROM product_answers pa 
+		WHERE pa.product_question_id = pq.id AND pa.is_hidden = 'f')`)
+	}
+
+	return where.Build()
+}
+
+func (c *questionDatabase) UpdateProductQuestionHidden(ctx context.Context, questionID uint, isHidden bool) error {
+
+	query := `UPDATE product_questions SET is_hidden = $1 WHERE id = $2`
+	err := c.DB.Exec(query, isHidden, questionID).Error
+
+	return err
+}
+
+func (c *questionDatabase) SaveProductAnswer(ctx context.Context, answer domain.ProductAnswer) (answerID uint, err error) {
+
+	query := `INSERT INTO product_answers (product_question_id, actor_id, actor_type, answer, created_at) 
+	VALUES ($1, $2, $3, $4, $5) RETURNING id`
+
+	createdAt := time.Now()
+	err = c.DB.Raw(query, answer.ProductQuestionID, answer.ActorID, answer.ActorType,
+		answer.Answer, createdAt).Scan(&answerID).Error
+
+	return
+}
+
+func (c *questionDatabase) FindProductAnswerByID(ctx context.Context, answerID uint) (answer domain.ProductAnswer, err error) {
+
+	query := `SELECT * FROM product_answers WHERE id = $1`
+	err = c.DB.Raw(query, answerID).Scan(&answer).Error
+
+	return
+}
+
+// find all answers of the question (most upvoted first)
+func (c *questionDatabase) FindAllProductAnswers(ctx context.Context, questionID uint, visibleOnly bool,
+	pagination request.Pagination) (answers []response.ProductAnswer, err error) {
+
+	limit := pagination.Count
+	offset := (pagination.PageNumber - 1) * limit
+
+	// answerer may be an admin or a user, so left join both for the name
+	query := `SELECT pa.id, pa.product_question_id, pa.actor_id, pa.actor_type, 
+	CASE WHEN pa.actor_type = $1 THEN a.user_name ELSE u.first_name END AS answerer_name, 
+	pa.answer, pa.is_hidden, pa.created_at, 
+	(SELECT COUNT(*) FROM product_answer_upvotes pau WHERE pau.product_answer_id = pa.id) AS upvote_count 
+	FROM product_answers pa 
+	LEFT JOIN admins a ON pa.actor_type = $1 AND a.id = pa.actor_id 
+	LEFT JOIN users u ON pa.actor_type = $2 AND u.id = pa.actor_id 
+	WHERE pa.product_question_id = $3 AND (NOT $4::BOOLEAN OR pa.is_hidden = 'f') 
+	ORDER BY upvote_count DESC, pa.created_at LIMIT $5 OFFSET $6`
+
+	err = c.DB.Raw(query, domain.ActorAdmin, domain.ActorUser, questionID, visibleOnly, limit, offset).
+		Scan(&answers).Error
+
+	return
+}
+
+func (c *questionDatabase) UpdateProductAnswerHidden(ctx context.Context, answerID uint, isHidden bool) error {
+
+	query := `UPDATE product_answers SET is_hidden = $1 WHERE id = $2`
+	err := c.DB.Exec(query, isHidden, answerID).Error
+
+	return err
+}
+
+// upvoting an already upvoted answer will not change anything
+func (c *questionDatabase) SaveProductAnswerUpvote(ctx context.Context, answerID, userID uint) error {
+
+	query := `INSERT INTO product_answer_upvotes (product_answer_id, user_id, created_at) 
+	VALUES ($1, $2, $3) ON CONFLICT (product_answer_id, user_id) DO NOTHING`
+
+	createdAt := time.Now()
+	err := c.DB.Exec(query, answerID, userID, createdAt).Error
+
+	return err
+}
+
+func (c *questionDatabase) DeleteProductAnswerUpvote(ctx context.Context, answerID, userID uint) error {
+
+	query := `DELETE FROM product_answer_upvotes WHERE product_answer_id = $1 AND user_id = $2`
+	err := c.DB.Exec(query, answerID, userID).Error
+
+	return err
+}
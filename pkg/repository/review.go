@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	"gorm.io/gorm"
+)
+
+type reviewDatabase struct {
+	DB *gorm.DB
+}
+
+func NewReviewRepository(db *gorm.DB) interfaces.ReviewRepository {
+	return &reviewDatabase{
+		DB: db,
+	}
+}
+
+func (c *reviewDatabase) Transactions(ctx context.Context, trxFn func(repo interfaces.ReviewRepository) error) error {
+
+	trx := c.DB.Begin()
+
+	repo := NewReviewRepository(trx)
+
+	if err := trxFn(repo); err != nil {
+		trx.Rollback()
+		return err
+	}
+
+	if err := trx.Commit().Error; err != nil {
+		trx.Rollback()
+		return err
+	}
+	return nil
+}
+
+// To check the user have a delivered order line of the product
+// orders on the return flow are also delivered before
+func (c *reviewDatabase) IsDeliveredProductOfUser(ctx context.Context, userID, productID uint) (delivered bool, err error) {
+
+	query := `SELECT EXISTS(SELECT 1 FROM order_lines ol 
+	INNER JOIN shop_orders so ON so.id = ol.shop_order_id 
+	INNER JOIN order_statuses os ON os.id = so.order_status_id 
+	INNER JOIN product_items pi ON pi.id = ol.product_item_id 
+	WHERE so.user_id = $1 AND pi.product_id = $2 AND os.status IN ($3, $4, $5, $6, $7))`
+
+	err = c.DB.Raw(query, userID, productID, domain.StatusOrderDelivered, domain.StatusReturnRequested,
+		domain.StatusReturnApproved, domain.StatusReturnCancelled, domain.StatusOrderReturned).Scan(&delivered).Error
+
+	return
+}
+
+func (c *reviewDatabase) FindProductReviewByID(ctx context.Context, reviewID uint) (review domain.ProductReview, err error) {
+
+	query := `SELECT * FROM product_reviews WHERE id = $1`
+	err = c.DB.Raw(query, reviewID).Scan(&review).Error
+
+	return
+}
+
+func (c *reviewDatabase) FindProductReviewByUserID(ctx context.Context,
+	productID, userID uint) (review domain.ProductReview, err error) {
+
+	query := `SELECT * FROM product_reviews WHERE product_id = $1 AND user_id = $2`
+	err = c.DB.Raw(query, productID, userID).Scan(&review).Error
+
+	return
+}
+
+func (c *reviewDatabase) SaveProductReview(ctx context.Context, review domain.ProductReview) (reviewID uint, err error) {
+
+	query := `INSERT INTO product_reviews (product_id, user_id, rating, title, body, status, created_at) 
+	VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`
+
+	createdAt := time.Now()
+	err = c.DB.Raw(query, review.ProductID, review.UserID, review.Rating, review.Title, review.Body,
+		review.Status, createdAt).Scan(&reviewID).Error
+
+	return
+}
+
+func (c *reviewDatabase) SaveProductReviewImage(ctx context.Context, reviewID uint, image string) error {
+
+	query := `INSERT INTO product_review_images (product_review_id, image) VALUES ($1, $2)`
+	err := c.DB.Exec(query, reviewID, image).Error
+
+	return err
+}
+
+func (c *reviewDatabase) UpdateProductReviewStatus(ctx context.Context, reviewID uint, status domain.ReviewStatus) error {
+
+	query := `UPDATE product_reviews SET status = $1, updated_at = $2 WHERE id = $3`
+	updatedAt := time.Now()
+	err := c.DB.Exec(query, status, updatedAt, reviewID).Error
+
+	return err
+}
+
+// find all product reviews with given filter (latest first)
+func (c *reviewDatabase) FindAllProductReviews(ctx context.Context,
+	filter request.ReviewFilter) (reviews []response.ProductReview, err error) {
+
+	limit := filter.Pagination.Count
+	offset := (filter.Pagination.PageNumber - 1) * limit
+
+	condition, args := reviewCondition(filter)
+
+	query := fmt.Sprintf(`SELECT pr.id, pr.product_id, p.name AS product_name, pr.user_id, u.first_name, 
+	pr.rating, pr.title, pr.body, pr.status, pr.created_at, pr.updated_at 
+	FROM product_reviews pr 
+	INNER JOIN products p ON p.id = pr.product_id 
+	INNER JOIN users u ON u.id = pr.user_id 
+	WHERE %s ORDER BY pr.created_at DESC LIMIT $%d OFFSET $%d`, condition, len(args)+1, len(args)+2)
+
+	args = append(args, limit, offset)
+	err = c.DB.Raw(query, args...).Scan(&reviews).Error
+
+	return
+}
+
+func reviewCondition(filter request.ReviewFilter) (condition string, args []interface{}) {
+
+	var where whereCondition
+
+	if filter.ProductID != 0 {
+		where.Add("pr.product_id = " + where.Arg(filter.ProductID))
+	}
+	if filter.Status != "" {
+		where.Add("pr.status = " + where.Arg(filter.Status))
+	}
+
+	return where.Build()
+}
+
+func (c *reviewDatabase) FindAllProductReviewImages(ctx context.Context, reviewID uint) (images []string, err error) {
+
+	query := `SELECT image FROM product_review_images WHERE product_review_id = $1 ORDER BY id`
+	err = c.DB.Raw(query, reviewID).Scan(&images).Error
+
+	return
+}
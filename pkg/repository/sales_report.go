@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+)
+
+// orders are considered as sales when they are paid
+// (not payment pending and not cancelled before the payment)
+const paidOrderCondition = `so.order_date >= $1 AND so.order_date <= $2
+	AND os.status != 'payment pending'
+	AND NOT EXISTS (SELECT 1 FROM order_cancellations oc
+	WHERE oc.shop_order_id = so.id AND oc.previous_status = 'payment pending')`
+
+// refund of each cancelled and returned order
+const orderRefundsQuery = `SELECT shop_order_id, refund_amount FROM order_cancellations
+	UNION ALL
+	SELECT ort.shop_order_id, ort.refund_amount FROM order_returns ort
+	INNER JOIN shop_orders rso ON rso.id = ort.shop_order_id
+	INNER JOIN order_statuses ros ON ros.id = rso.order_status_id
+	WHERE ros.status = 'order returned'`
+
+// To find the total sales of the period
+func (c *adminDatabase) FindSalesTotal(ctx context.Context,
+	reqData request.SalesReport) (total response.SalesBucket, err error) {
+
+	query := `SELECT COUNT(so.id) AS orders,
+	COALESCE(SUM(so.order_total_price + so.discount), 0) AS gross_revenue,
+	COALESCE(SUM(so.discount), 0) AS discounts, COALESCE(SUM(r.refund_amount), 0) AS refunds,
+	COALESCE(SUM(ot.cgst), 0) AS cgst, COALESCE(SUM(ot.sgst), 0) AS sgst, COALESCE(SUM(ot.igst), 0) AS igst
+	FROM shop_orders so
+	INNER JOIN order_statuses os ON os.id = so.order_status_id
+	LEFT JOIN (` + orderRefundsQuery + `) r ON r.shop_order_id = so.id
+	LEFT JOIN (` + orderTaxesQuery + `) ot ON ot.shop_order_id = so.id
+	WHERE ` + paidOrderCondition
+
+	err = c.DB.Raw(query, reqData.StartDate, reqData.EndDate).Scan(&total).Error
+
+	return
+}
+
+// To find the sales of the period on each day, week or month
+func (c *adminDatabase) FindAllSalesBuckets(ctx context.Context,
+	reqData request.SalesReport) (buckets []response.SalesBucket, err error) {
+
+	query := `SELECT DATE_TRUNC($3, so.order_date) AS period, COUNT(so.id) AS orders,
+	SUM(so.order_total_price + so.discount) AS gross_revenue,
+	SUM(so.discount) AS discounts, COALESCE(SUM(r.refund_amount), 0) AS refunds,
+	COALESCE(SUM(ot.cgst), 0) AS cgst, COALESCE(SUM(ot.sgst), 0) AS sgst, COALESCE(SUM(ot.igst), 0) AS igst
+	FROM shop_orders so
+	INNER JOIN order_statuses os ON os.id = so.order_status_id
+	LEFT JOIN (` + orderRefundsQuery + `) r ON r.shop_order_id = so.id
+	LEFT JOIN (` + orderTaxesQuery + `) ot ON ot.shop_order_id = so.id
+	WHERE ` + paidOrderCondition + `
+	GROUP BY period ORDER BY period`
+
+	err = c.DB.Raw(query, reqData.StartDate, reqData.EndDate, reqData.Bucket).Scan(&buckets).Error
+
+	return
+}
+
+// To find count of all orders of the period on each order status
+func (c *adminDatabase) FindAllOrderCountsByStatus(ctx context.Context,
+	reqData request.SalesReport) (counts []response.SalesCount, err error) {
+
+	query := `SELECT os.status AS name, COUNT(so.id) AS count, SUM(so.order_total_price) AS amount
+	FROM shop_orders so
+	INNER JOIN order_statuses os ON os.id = so.order_status_id
+	WHERE so.order_date >= $1 AND so.order_date <= $2
+	GROUP BY os.status ORDER BY count DESC, os.status`
+
+	err = c.DB.Raw(query, reqData.StartDate, reqData.EndDate).Scan(&counts).Error
+
+	return
+}
+
+// To find count of paid orders of the period on each payment method
+func (c *adminDatabase) FindAllOrderCountsByPaymentMethod(ctx context.Context,
+	reqData request.SalesReport) (counts []response.SalesCount, err error) {
+
+	query := `SELECT pm.name, COUNT(so.id) AS count, SUM(so.order_total_price) AS amount
+	FROM shop_orders so
+	INNER JOIN order_statuses os ON os.id = so.order_status_id
+	INNER JOIN payment_methods pm ON pm.id = so.payment_method_id
+	WHERE ` + paidOrderCondition + `
+	GROUP BY pm.name ORDER BY count DESC, pm.name`
+
+	err = c.DB.Raw(query, reqData.StartDate, reqData.EndDate).Scan(&counts).Error
+
+	return
+}
+
+// To find the products sold most on revenue for the period
+func (c *adminDatabase) FindTopSellingProducts(ctx context.Context,
+	reqData request.SalesReport, limit uint) (products []response.TopSellingItem, err error) {
+
+	query := `SELECT p.id, p.name, SUM(ol.qty) AS quantity, SUM(ol.qty * ol.price) AS revenue
+	FROM order_lines ol
+	INNER JOIN shop_orders so ON so.id = ol.shop_order_id
+	INNER JOIN order_statuses os ON os.id = so.order_status_id
+	INNER JOIN product_items pi ON pi.id = ol.product_item_id
+	INNER JOIN products p ON p.id = pi.product_id
+	WHERE ` + paidOrderCondition + `
+	GROUP BY p.id, p.name ORDER BY revenue DESC, p.id LIMIT $3`
+
+	err = c.DB.Raw(query, reqData.StartDate, reqData.EndDate, limit).Scan(&products).Error
+
+	return
+}
+
+// To find the categories sold most on revenue for the period
+func (c *adminDatabase) FindTopSellingCategories(ctx context.Context,
+	reqData request.SalesReport, limit uint) (categories []response.TopSellingItem, err error) {
+
+	query := `SELECT c.id, c.name, SUM(ol.qty) AS quantity, SUM(ol.qty * ol.price) AS revenue
+	FROM order_lines ol
+	INNER JOIN shop_orders so ON so.id = ol.shop_order_id
+	INNER JOIN order_statuses os ON os.id = so.order_status_id
+	INNER JOIN product_items pi ON pi.id = ol.product_item_id
+	INNER JOIN products p ON p.id = pi.product_id
+	INNER JOIN categories c ON c.id = p.category_id
+	WHERE ` + paidOrderCondition + `
+	GROUP BY c.id, c.name ORDER BY revenue DESC, c.id LIMIT $3`
+
+	err = c.DB.Raw(query, reqData.StartDate, reqData.EndDate, limit).Scan(&categories).Error
+
+	return
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	"gorm.io/gorm"
+)
+
+type shippingDatabase struct {
+	DB *gorm.DB
+}
+
+func NewShippingRepository(db *gorm.DB) interfaces.ShippingRepository {
+	return &shippingDatabase{
+		DB: db,
+	}
+}
+
+func (c *shippingDatabase) IsShippingZoneNameExist(ctx context.Context, name string,
+	excludeZoneID uint) (exist bool, err error) {
+
+	query := `SELECT EXISTS(SELECT 1 FROM shipping_zones WHERE name = $1 AND id != $2)`
+	err = c.DB.Raw(query, name, excludeZoneID).Scan(&exist).Error
+
+	return
+}
+
+func (c *shippingDatabase) SaveShippingZone(ctx context.Context, zone domain.ShippingZone) (zoneID uint, err error) {
+
+	query := `INSERT INTO shipping_zones (name, country_id, pincode_from, pincode_to, rate_type, 
+	base_charge, charge_per_kg, free_over_amount, created_at) 
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id`
+
+	createdAt := time.Now()
+	err = c.DB.Raw(query, zone.Name, zone.CountryID, zone.PincodeFrom, zone.PincodeTo, zone.RateType,
+		zone.BaseCharge, zone.ChargePerKg, zone.FreeOverAmount, createdAt).Scan(&zoneID).Error
+
+	return
+}
+
+func (c *shippingDatabase) UpdateShippingZone(ctx context.Context, zone domain.ShippingZone) error {
+
+	query := `UPDATE shipping_zones SET name = $1, country_id = $2, pincode_from = $3, pincode_to = $4, 
+	rate_type = $5, base_charge = $6, charge_per_kg = $7, free_over_amount = $8, updated_at = $9 
+	WHERE id = $10`
+
+	updatedAt := time.Now()
+	err := c.DB.Exec(query, zone.Name, zone.CountryID, zone.PincodeFrom, zone.PincodeTo, zone.RateType,
+		zone.BaseCharge, zone.ChargePerKg, zone.FreeOverAmount, updatedAt, zone.ID).Error
+
+	return err
+}
+
+func (c *shippingDatabase) DeleteShippingZone(ctx context.Context, zoneID uint) error {
+
+	query := `DELETE FROM shipping_zones WHERE id = $1`
+	err := c.DB.Exec(query, zoneID).Error
+
+	return err
+}
+
+func (c *shippingDatabase) FindShippingZoneByID(ctx context.Context, zoneID uint) (zone domain.ShippingZone, err error) {
+
+	query := `SELECT * FROM shipping_zones WHERE id = $1`
+	err = c.DB.Raw(query, zoneID).Scan(&zone).Error
+
+	return
+}
+
+func (c *shippingDatabase) FindAllShippingZones(ctx context.Context,
+	pagination request.Pagination) (zones []domain.ShippingZone, err error) {
+
+	limit := pagination.Count
+	offset := (pagination.PageNumber - 1) * limit
+
+	query := `SELECT * FROM shipping_zones ORDER BY country_id, pincode_from LIMIT $1 OFFSET $2`
+	err = c.DB.Raw(query, limit, offset).Scan(&zones).Error
+
+	return
+}
+
+func (c *shippingDatabase) IsAnyShippingZoneExist(ctx context.Context) (exist bool, err error) {
+
+	query := `SELECT EXISTS(SELECT 1 FROM shipping_zones)`
+	err = c.DB.Raw(query).Scan(&exist).Error
+
+	return
+}
+
+func (c *shippingDatabase) FindShippingZoneOfPincode(ctx context.Context,
+	countryID, pincode uint) (zone domain.ShippingZone, err error) {
+
+	query := `SELECT * FROM shipping_zones WHERE country_id = $1 AND $2 BETWEEN pincode_from AND pincode_to 
+	ORDER BY (pincode_to - pincode_from), id LIMIT 1`
+	err = c.DB.Raw(query, countryID, pincode).Scan(&zone).Error
+
+	return
+}
+
+func (c *shippingDatabase) FindCartWeight(ctx context.Context, cartID uint) (weight uint, err error) {
+
+	query := `SELECT COALESCE(SUM(pi.weight * ci.qty), 0) FROM cart_items ci 
+	INNER JOIN product_items pi ON ci.product_item_id = pi.id 
+	WHERE ci.cart_id = $1`
+	err = c.DB.Raw(query, cartID).Scan(&weight).Error
+
+	return
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+)
+
+func (c *adminDatabase) FindAdminByID(ctx context.Context, adminID uint) (admin domain.Admin, err error) {
+
+	query := `SELECT * FROM admins WHERE id = $1`
+	err = c.DB.Raw(query, adminID).Scan(&admin).Error
+
+	return
+}
+
+// To find all permission names of the admin's role
+func (c *adminDatabase) FindAllPermissionsByAdminID(ctx context.Context, adminID uint) (permissions []string, err error) {
+
+	query := `SELECT p.name FROM admins a 
+	INNER JOIN role_permissions rp ON rp.role_id = a.role_id 
+	INNER JOIN permissions p ON p.id = rp.permission_id 
+	WHERE a.id = $1 ORDER BY p.name`
+
+	err = c.DB.Raw(query, adminID).Scan(&permissions).Error
+
+	return
+}
+
+func (c *adminDatabase) FindRoleByID(ctx context.Context, roleID uint) (role domain.Role, err error) {
+
+	query := `SELECT * FROM roles WHERE id = $1`
+	err = c.DB.Raw(query, roleID).Scan(&role).Error
+
+	return
+}
+
+// To find all roles with its permission names (permission names are joined by comma)
+func (c *adminDatabase) FindAllRoles(ctx context.Context) (roles []response.Role, err error) {
+
+	query := `SELECT r.id, r.name, COALESCE(STRING_AGG(p.name, ',' ORDER BY p.name), '') AS permission_names 
+	FROM roles r 
+	LEFT JOIN role_permissions rp ON rp.role_id = r.id 
+	LEFT JOIN permissions p ON p.id = rp.permission_id 
+	GROUP BY r.id ORDER BY r.id`
+
+	err = c.DB.Raw(query).Scan(&roles).Error
+
+	return
+}
+
+func (c *adminDatabase) FindAllStaff(ctx context.Context, pagination request.Pagination) (staff []response.Staff, err error) {
+
+	limit := pagination.Count
+	offset := (pagination.PageNumber - 1) * limit
+
+	query := `SELECT a.id, a.user_name, a.email, a.role_id, COALESCE(r.name, '') AS role_name, 
+	a.block_status, a.created_at 
+	FROM admins a 
+	LEFT JOIN roles r ON r.id = a.role_id 
+	ORDER BY a.created_at DESC LIMIT $1 OFFSET $2`
+
+	err = c.DB.Raw(query, limit, offset).Scan(&staff).Error
+
+	return
+}
+
+func (c *adminDatabase) SaveStaff(ctx context.Context, admin domain.Admin) (adminID uint, err error) {
+
+	query := `INSERT INTO admins (user_name, email, password, role_id, created_at) 
+	VALUES ($1, $2, $3, $4, $5) RETURNING id`
+
+	createdAt := time.Now()
+	err = c.DB.Raw(query, admin.UserName, admin.Email, admin.Password, admin.RoleID, createdAt).Scan(&adminID).Error
+
+	return
+}
+
+func (c *adminDatabase) UpdateStaff(ctx context.Context, adminID, roleID uint, blockStatus bool) error {
+
+	query := `UPDATE admins SET role_id = $1, block_status = $2, updated_at = $3 WHERE id = $4`
+
+	updatedAt := time.Now()
+	err := c.DB.Exec(query, roleID, blockStatus, updatedAt, adminID).Error
+
+	return err
+}
+
+func (c *adminDatabase) DeleteAdmin(ctx context.Context, adminID uint) error {
+
+	query := `DELETE FROM admins WHERE id = $1`
+	err := c.DB.Exec(query, adminID).Error
+
+	return err
+}
+
+func (c *adminDatabase) SaveStaffPasswordToken(ctx context.Context, passwordToken domain.StaffPasswordToken) error {
+
+	query := `INSERT INTO staff_password_tokens (admin_id, token_hash, expire_at, created_at) 
+	VALUES ($1, $2, $3, $4)`
+
+	createdAt := time.Now()
+	err := c.DB.Exec(query, passwordToken.AdminID, passwordToken.TokenHash, passwordToken.ExpireAt, createdAt).Error
+
+	return err
+}
+
+func (c *adminDatabase) FindStaffPasswordToken(ctx context.Context,
+	tokenHash string) (passwordToken domain.StaffPasswordToken, err error) {
+
+	query := `SELECT * FROM staff_password_tokens WHERE token_hash = $1`
+	err = c.DB.Raw(query, tokenHash).Scan(&passwordToken).Error
+
+	return
+}
+
+func (c *adminDatabase) UseStaffPasswordToken(ctx context.Context, tokenID uint) (bool, error) {
+
+	query := `UPDATE staff_password_tokens SET used = 'T' WHERE id = $1 AND used = 'F'`
+	result := c.DB.Exec(query, tokenID)
+
+	return result.RowsAffected > 0, result.Error
+}
+
+func (c *adminDatabase) UpdateAdminPassword(ctx context.Context, adminID uint, hashPassword string) error {
+
+	query := `UPDATE admins SET password = $1, updated_at = $2 WHERE id = $3`
+
+	updatedAt := time.Now()
+	err := c.DB.Exec(query, hashPassword, updatedAt, adminID).Error
+
+	return err
+}
@@ -2,9 +2,11 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
 	"gorm.io/gorm"
 )
@@ -19,13 +21,36 @@ func NewStockRepository(db *gorm.DB) interfaces.StockRepository {
 	}
 }
 
-func (c *stockDatabase) Update(ctx context.Context, valuesToUpdate request.UpdateStock) error {
+func (c *stockDatabase) FindProductItemBySKU(ctx context.Context, sku string) (productItem domain.ProductItem, err error) {
 
-	query := `UPDATE product_items SET qty_in_stock = qty_in_stock + $1 WHERE sku = $2`
+	query := `SELECT * FROM product_items WHERE sku = $1`
+	err = c.DB.Raw(query, sku).Scan(&productItem).Error
 
-	err := c.DB.Exec(query, valuesToUpdate.QtyToAdd, valuesToUpdate.SKU).Error
+	return
+}
 
-	return err
+// To change the stock of product item and save it on stock movements on a single transaction
+func (c *stockDatabase) ApplyStockMovement(ctx context.Context, movement domain.StockMovement) (applied bool, err error) {
+
+	err = c.DB.Transaction(func(trx *gorm.DB) error {
+		applied, err = applyStockMovement(trx, movement)
+		return err
+	})
+
+	return applied, err
+}
+
+func (c *stockDatabase) FindAllStockMovements(ctx context.Context, productItemID uint,
+	pagination request.Pagination) (movements []domain.StockMovement, err error) {
+
+	limit := pagination.Count
+	offset := (pagination.PageNumber - 1) * limit
+
+	query := `SELECT * FROM stock_movements WHERE product_item_id = $1 
+	ORDER BY created_at DESC, id DESC LIMIT $2 OFFSET $3`
+	err = c.DB.Raw(query, productItemID, limit, offset).Scan(&movements).Error
+
+	return
 }
 
 func (c *stockDatabase) FindAll(ctx context.Context, pagination request.Pagination) (stocks []response.Stock, err error) {
@@ -33,7 +58,8 @@ func (c *stockDatabase) FindAll(ctx context.Context, pagination request.Paginati
 	limit := pagination.Count
 	offset := (pagination.PageNumber - 1) * limit
 
-	query := `SELECT pi.id AS product_item_id, pi.sku, pi.qty_in_stock, pi.price, p.name AS product_name
+	query := `SELECT pi.id AS product_item_id, pi.sku, pi.qty_in_stock, pi.reorder_threshold, pi.price, 
+	p.name AS product_name 
 	FROM product_items pi 
 	INNER JOIN products p ON p.id = pi.product_id
 	ORDER BY qty_in_stock LIMIT $1 OFFSET $2`
@@ -43,20 +69,72 @@ func (c *stockDatabase) FindAll(ctx context.Context, pagination request.Paginati
 		return nil, err
 	}
 
-	// insert each stocks variation full values
-	query = `SELECT vo.id, vo.value FROM variation_options vo 
+	return c.setStocksVariationOptions(stocks)
+}
+
+// To find all stocks which reached its reorder threshold
+func (c *stockDatabase) FindAllLowStocks(ctx context.Context,
+	pagination request.Pagination) (stocks []response.Stock, err error) {
+
+	limit := pagination.Count
+	offset := (pagination.PageNumber - 1) * limit
+
+	query := `SELECT pi.id AS product_item_id, pi.sku, pi.qty_in_stock, pi.reorder_threshold, pi.price, 
+	p.name AS product_name 
+	FROM product_items pi 
+	INNER JOIN products p ON p.id = pi.product_id 
+	WHERE pi.reorder_threshold > 0 AND pi.qty_in_stock <= pi.reorder_threshold 
+	ORDER BY pi.qty_in_stock, pi.id LIMIT $1 OFFSET $2`
+
+	err = c.DB.Raw(query, limit, offset).Scan(&stocks).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return c.setStocksVariationOptions(stocks)
+}
+
+// To find the stocks of the shop order which are reached reorder threshold by this order
+func (c *stockDatabase) FindAllLowStocksByShopOrderID(ctx context.Context,
+	shopOrderID uint) (stocks []response.Stock, err error) {
+
+	query := `SELECT pi.id AS product_item_id, pi.sku, pi.qty_in_stock, pi.reorder_threshold, pi.price, 
+	p.name AS product_name 
+	FROM order_lines ol 
+	INNER JOIN product_items pi ON pi.id = ol.product_item_id 
+	INNER JOIN products p ON p.id = pi.product_id 
+	WHERE ol.shop_order_id = $1 AND pi.reorder_threshold > 0 
+	AND pi.qty_in_stock <= pi.reorder_threshold AND pi.qty_in_stock + ol.qty > pi.reorder_threshold`
+
+	err = c.DB.Raw(query, shopOrderID).Scan(&stocks).Error
+
+	return
+}
+
+func (c *stockDatabase) UpdateReorderThreshold(ctx context.Context, productItemID, reorderThreshold uint) error {
+
+	query := `UPDATE product_items SET reorder_threshold = $1, updated_at = $2 WHERE id = $3`
+	err := c.DB.Exec(query, reorderThreshold, time.Now(), productItemID).Error
+
+	return err
+}
+
+// insert each stocks variation full values
+func (c *stockDatabase) setStocksVariationOptions(stocks []response.Stock) ([]response.Stock, error) {
+
+	query := `SELECT vo.id, vo.value FROM variation_options vo 
 	INNER JOIN product_configurations pc ON vo.id = pc.variation_option_id 
 	WHERE pc.product_item_id = $1`
 
 	for i, stock := range stocks {
 
 		var variationValue []response.VariationOption
-		err = c.DB.Raw(query, stock.ProductItemID).Scan(&variationValue).Error
+		err := c.DB.Raw(query, stock.ProductItemID).Scan(&variationValue).Error
 		if err != nil {
 			return nil, err
 		}
 		stocks[i].VariationOptions = variationValue
 	}
 
-	return
+	return stocks, nil
 }
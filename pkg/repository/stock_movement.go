@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"gorm.io/gorm"
+)
+
+// stock movements are saved from order, product and stock repositories (inside their transactions)
+// so the queries are shared from here
+
+// To change the qty_in_stock of product item with the movement quantity and save the movement
+// stock will not change if it goes to negative and return applied as false
+func applyStockMovement(db *gorm.DB, movement domain.StockMovement) (applied bool, err error) {
+
+	query := `UPDATE product_items SET qty_in_stock = qty_in_stock + $1
+	WHERE id = $2 AND qty_in_stock + $1 >= 0`
+	result := db.Exec(query, movement.Quantity, movement.ProductItemID)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	err = saveStockMovement(db, movement)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// To save the movement on ledger only (stock of product item should be changed by caller)
+func saveStockMovement(db *gorm.DB, movement domain.StockMovement) error {
+
+	query := `INSERT INTO stock_movements (product_item_id, quantity, reason, actor_type, actor_id,
+	reference_id, note, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	err := db.Exec(query, movement.ProductItemID, movement.Quantity, movement.Reason, movement.ActorType,
+		movement.ActorID, movement.ReferenceID, movement.Note, time.Now()).Error
+
+	return err
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	"gorm.io/gorm"
+)
+
+type taxDatabase struct {
+	DB *gorm.DB
+}
+
+func NewTaxRepository(db *gorm.DB) interfaces.TaxRepository {
+	return &taxDatabase{
+		DB: db,
+	}
+}
+
+func (c *taxDatabase) IsTaxClassNameExist(ctx context.Context, name string,
+	excludeTaxClassID uint) (exist bool, err error) {
+
+	query := `SELECT EXISTS(SELECT 1 FROM tax_classes WHERE name = $1 AND id != $2)`
+	err = c.DB.Raw(query, name, excludeTaxClassID).Scan(&exist).Error
+
+	return
+}
+
+func (c *taxDatabase) SaveTaxClass(ctx context.Context, taxClass domain.TaxClass) (taxClassID uint, err error) {
+
+	query := `INSERT INTO tax_classes (name, rate, created_at) VALUES ($1, $2, $3) RETURNING id`
+
+	createdAt := time.Now()
+	err = c.DB.Raw(query, taxClass.Name, taxClass.Rate, createdAt).Scan(&taxClassID).Error
+
+	return
+}
+
+func (c *taxDatabase) UpdateTaxClass(ctx context.Context, taxClass domain.TaxClass) error {
+
+	query := `UPDATE tax_classes SET name = $1, rate = $2, updated_at = $3 WHERE id = $4`
+
+	updatedAt := time.Now()
+	err := c.DB.Exec(query, taxClass.Name, taxClass.Rate, updatedAt, taxClass.ID).Error
+
+	return err
+}
+
+func (c *taxDatabase) FindTaxClassByID(ctx context.Context, taxClassID uint) (taxClass domain.TaxClass, err error) {
+
+	query := `SELECT * FROM tax_classes WHERE id = $1`
+	err = c.DB.Raw(query, taxClassID).Scan(&taxClass).Error
+
+	return
+}
+
+func (c *taxDatabase) FindAllTaxClasses(ctx context.Context,
+	pagination request.Pagination) (taxClasses []domain.TaxClass, err error) {
+
+	limit := pagination.Count
+	offset := (pagination.PageNumber - 1) * limit
+
+	query := `SELECT * FROM tax_classes ORDER BY rate, name LIMIT $1 OFFSET $2`
+	err = c.DB.Raw(query, limit, offset).Scan(&taxClasses).Error
+
+	return
+}
+
+func (c *taxDatabase) IsCategoryIDExist(ctx context.Context, categoryID uint) (exist bool, err error) {
+
+	query := `SELECT EXISTS(SELECT 1 FROM categories WHERE id = $1)`
+	err = c.DB.Raw(query, categoryID).Scan(&exist).Error
+
+	return
+}
+
+func (c *taxDatabase) UpdateCategoryTaxClass(ctx context.Context, categoryID, taxClassID uint) error {
+
+	query := `UPDATE categories SET tax_class_id = $1 WHERE id = $2`
+	err := c.DB.Exec(query, taxClassID, categoryID).Error
+
+	return err
+}
+
+func (c *taxDatabase) IsProductIDExist(ctx context.Context, productID uint) (exist bool, err error) {
+
+	query := `SELECT EXISTS(SELECT 1 FROM products WHERE id = $1)`
+	err = c.DB.Raw(query, productID).Scan(&exist).Error
+
+	return
+}
+
+func (c *taxDatabase) UpdateProductTaxClass(ctx context.Context, productID, taxClassID uint) error {
+
+	query := `UPDATE products SET tax_class_id = $1, updated_at = $2 WHERE id = $3`
+
+	updatedAt := time.Now()
+	err := c.DB.Exec(query, taxClassID, updatedAt, productID).Error
+
+	return err
+}
+
+func (c *taxDatabase) FindTaxRateOfProductItem(ctx context.Context, productItemID uint) (rate float64, err error) {
+
+	query := `SELECT COALESCE(ptc.rate, ctc.rate, mtc.rate, 0) FROM product_items pi 
+	INNER JOIN products p ON p.id = pi.product_id 
+	LEFT JOIN tax_classes ptc ON ptc.id = p.tax_class_id 
+	LEFT JOIN categories c ON c.id = p.category_id 
+	LEFT JOIN tax_classes ctc ON ctc.id = c.tax_class_id 
+	LEFT JOIN categories mc ON mc.id = c.category_id 
+	LEFT JOIN tax_classes mtc ON mtc.id = mc.tax_class_id 
+	WHERE pi.id = $1`
+	err = c.DB.Raw(query, productItemID).Scan(&rate).Error
+
+	return
+}
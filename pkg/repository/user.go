@@ -85,18 +85,11 @@ func (c *userDatabase) UpdateVerified(ctx context.Context, userID uint) error {
 func (c *userDatabase) UpdateUser(ctx context.Context, user domain.User) (err error) {
 
 	updatedAt := time.Now()
-	// check password need to update or not
-	if user.Password != "" {
-		query := `UPDATE users SET user_name = $1, first_name = $2, last_name = $3,age = $4, 
-		email = $5, phone = $6, password = $7, updated_at = $8 WHERE id = $9`
-		err = c.DB.Exec(query, user.UserName, user.FirstName, user.LastName, user.Age, user.Email,
-			user.Phone, user.Password, updatedAt, user.ID).Error
-	} else {
-		query := `UPDATE users SET user_name = $1, first_name = $2, last_name = $3,age = $4, 
-		email = $5, phone = $6, updated_at = $7 WHERE id = $8`
-		err = c.DB.Exec(query, user.UserName, user.FirstName, user.LastName, user.Age, user.Email,
-			user.Phone, updatedAt, user.ID).Error
-	}
+	// password is updated only through UpdatePassword
+	query := `UPDATE users SET user_name = $1, first_name = $2, last_name = $3,age = $4, 
+	email = $5, phone = $6, updated_at = $7 WHERE id = $8`
+	err = c.DB.Exec(query, user.UserName, user.FirstName, user.LastName, user.Age, user.Email,
+		user.Phone, updatedAt, user.ID).Error
 
 	if err != nil {
 		return fmt.Errorf("filed to update user detail of user with user_id %d", user.ID)
@@ -104,6 +97,14 @@ func (c *userDatabase) UpdateUser(ctx context.Context, user domain.User) (err er
 	return nil
 }
 
+func (c *userDatabase) UpdatePassword(ctx context.Context, userID uint, hashPassword string) error {
+
+	query := `UPDATE users SET password = $1, updated_at = $2 WHERE id = $3`
+	err := c.DB.Exec(query, hashPassword, time.Now(), userID).Error
+
+	return err
+}
+
 func (c *userDatabase) UpdateBlockStatus(ctx context.Context, userID uint, blockStatus bool) error {
 
 	query := `UPDATE users SET block_status = $1 WHERE id = $2`
@@ -121,7 +122,7 @@ func (c *userDatabase) IsAddressIDExist(ctx context.Context, addressID uint) (ex
 func (c *userDatabase) FindAddressByID(ctx context.Context, addressID uint) (address response.Address, err error) {
 
 	query := `SELECT adrs.id, adrs.house, adrs.name, adrs.phone_number, adrs.area, adrs.land_mark, 
-	adrs.city, adrs.pincode, country_id, country_name FROM addresses adrs 
+	adrs.city, adrs.pincode, adrs.state, country_id, country_name FROM addresses adrs 
 	INNER JOIN countries c ON c.id = adrs.country_id  
 	INNER JOIN user_addresses uadrs ON uadrs.address_id = adrs.id 
 	WHERE adrs.id = $1 `
@@ -146,7 +147,7 @@ func (c *userDatabase) IsAddressAlreadyExistForUser(ctx context.Context, address
 func (c *userDatabase) FindAllAddressByUserID(ctx context.Context, userID uint) (addresses []response.Address, err error) {
 
 	query := `SELECT a.id, a.house,a.name, a.phone_number, a.area, a.land_mark,a.city, 
-	a.pincode, a.country_id, c.country_name, ua.is_default
+	a.pincode, a.state, a.country_id, c.country_name, ua.is_default
 	FROM user_addresses ua JOIN addresses a ON ua.address_id=a.id 
 	INNER JOIN countries c ON a.country_id=c.id AND ua.user_id = $1`
 
@@ -169,14 +170,14 @@ func (c *userDatabase) FindCountryByID(ctx context.Context, countryID uint) (dom
 // save address
 func (c *userDatabase) SaveAddress(ctx context.Context, address domain.Address) (addressID uint, err error) {
 	address.CountryID = 1 // hardcoded !!!! should change
-	query := `INSERT INTO addresses (name, phone_number, house,area, land_mark, city, pincode, country_id, created_at) 
-	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id`
+	query := `INSERT INTO addresses (name, phone_number, house,area, land_mark, city, pincode, state, country_id, created_at) 
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING id`
 
 	createdAt := time.Now()
 
 	if c.DB.Raw(query, address.Name, address.PhoneNumber,
 		address.House, address.Area, address.LandMark, address.City,
-		address.Pincode, address.CountryID, createdAt,
+		address.Pincode, address.State, address.CountryID, createdAt,
 	).Scan(&address).Error != nil {
 		return addressID, errors.New("filed to insert address on database")
 	}
@@ -188,11 +189,11 @@ func (c *userDatabase) UpdateAddress(ctx context.Context, address domain.Address
 
 	address.CountryID = 1 // hardcoded !!!! should change
 	query := `UPDATE addresses SET name=$1, phone_number=$2, house=$3, area=$4, land_mark=$5, 
-	city=$6, pincode=$7,country_id=$8, updated_at = $9 WHERE id=$10`
+	city=$6, pincode=$7, state=$8, country_id=$9, updated_at = $10 WHERE id=$11`
 
 	updatedAt := time.Now()
 	if c.DB.Raw(query, address.Name, address.PhoneNumber, address.House,
-		address.Area, address.LandMark, address.City, address.Pincode,
+		address.Area, address.LandMark, address.City, address.Pincode, address.State,
 		address.CountryID, updatedAt, address.ID).Scan(&address).Error != nil {
 		return errors.New("filed to update the address for edit address")
 	}
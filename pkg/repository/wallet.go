@@ -25,10 +25,21 @@ func (c *OrderDatabase) SaveWallet(ctx context.Context, userID uint) (walletID u
 
 	return
 }
-func (c *OrderDatabase) UpdateWallet(ctx context.Context, walletID, upateTotalAmount uint) error {
 
-	query := `UPDATE wallets SET total_amount = $1 WHERE id = $2`
-	err := c.DB.Exec(query, upateTotalAmount, walletID).Error
+// debit the amount from user wallet only if the wallet have enough balance
+// the balance is checked on the same update so concurrent payments can't spend the same amount
+func (c *OrderDatabase) DebitWallet(ctx context.Context, userID, amount uint) (debited bool, err error) {
+
+	query := `UPDATE wallets SET total_amount = total_amount - $1 WHERE user_id = $2 AND total_amount >= $1`
+	result := c.DB.Exec(query, amount, userID)
+
+	return result.RowsAffected > 0, result.Error
+}
+
+func (c *OrderDatabase) CreditWallet(ctx context.Context, walletID, amount uint) error {
+
+	query := `UPDATE wallets SET total_amount = total_amount + $1 WHERE id = $2`
+	err := c.DB.Exec(query, amount, walletID).Error
 
 	return err
 }
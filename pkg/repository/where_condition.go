@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+)
+
+// conditions of a where clause with its positional arguments ($1, $2 ...) for the filters of a query
+type whereCondition struct {
+	conditions []string
+	args       []interface{}
+}
+
+// To add the argument and return its placeholder to use on the condition
+func (c *whereCondition) Arg(arg interface{}) string {
+	c.args = append(c.args, arg)
+	return fmt.Sprintf("$%d", len(c.args))
+}
+
+func (c *whereCondition) Add(condition string) {
+	c.conditions = append(c.conditions, condition)
+}
+
+// To build the conditions joined with AND and its arguments (true condition when there is no condition)
+// placeholders of the other arguments of the query should start after the returned arguments
+func (c *whereCondition) Build() (condition string, args []interface{}) {
+	if len(c.conditions) == 0 {
+		return "1 = 1", c.args
+	}
+	return strings.Join(c.conditions, " AND "), c.args
+}
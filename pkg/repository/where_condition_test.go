@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWhereConditionBuild(t *testing.T) {
+
+	var where whereCondition
+	condition, args := where.Build()
+	assert.Equal(t, "1 = 1", condition)
+	assert.Empty(t, args)
+
+	where.Add("p.brand_id = " + where.Arg(uint(2)))
+	where.Add("p.price >= " + where.Arg(uint(100)))
+	where.Add("p.is_hidden = 'f'")
+
+	condition, args = where.Build()
+	assert.Equal(t, "p.brand_id = $1 AND p.price >= $2 AND p.is_hidden = 'f'", condition)
+	assert.Equal(t, []interface{}{uint(2), uint(100)}, args)
+}
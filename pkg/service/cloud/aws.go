@@ -1,6 +1,7 @@
 package cloud
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"mime/multipart"
@@ -62,6 +63,24 @@ func (c *awsService) SaveFile(ctx context.Context, fileHeader *multipart.FileHea
 
 	return uploadID, nil
 }
+
+func (c *awsService) SaveFileContent(ctx context.Context, content []byte, contentType string) (string, error) {
+
+	uploadID := uuid.New().String()
+
+	_, err := c.service.PutObject(&s3.PutObjectInput{
+		Body:        bytes.NewReader(content),
+		Bucket:      aws.String(c.bucketName),
+		Key:         aws.String(uploadID),
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return "", utils.PrependMessageToError(err, "failed to upload file")
+	}
+
+	return uploadID, nil
+}
+
 func (c *awsService) GetFileUrl(ctx context.Context, uploadID string) (string, error) {
 
 	req, _ := c.service.GetObjectRequest(&s3.GetObjectInput{
@@ -76,3 +95,16 @@ func (c *awsService) GetFileUrl(ctx context.Context, uploadID string) (string, e
 
 	return url, nil
 }
+
+func (c *awsService) DeleteFile(ctx context.Context, uploadID string) error {
+
+	_, err := c.service.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(c.bucketName),
+		Key:    aws.String(uploadID),
+	})
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to delete file")
+	}
+
+	return nil
+}
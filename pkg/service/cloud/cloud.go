@@ -7,5 +7,7 @@ import (
 
 type CloudService interface {
 	SaveFile(ctx context.Context, fileHeader *multipart.FileHeader) (uploadId string, err error)
+	SaveFileContent(ctx context.Context, content []byte, contentType string) (uploadId string, err error)
 	GetFileUrl(ctx context.Context, uploadID string) (url string, err error)
+	DeleteFile(ctx context.Context, uploadID string) error
 }
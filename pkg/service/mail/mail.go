@@ -0,0 +1,36 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/config"
+)
+
+type Sender interface {
+	Send(ctx context.Context, mail Mail) error
+}
+
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// To create the mail sender from config
+// smtp sender is used when the smtp host is given, mails are only written on the application log when
+// smtp host is empty and the mail dev log is enabled (mails like otp can't send without smtp otherwise)
+func NewSender(cfg config.Config) (Sender, error) {
+
+	if cfg.SmtpHost == "" {
+		if !cfg.MailDevLog {
+			return nil, fmt.Errorf("smtp host is required for mail sender (enable mail dev log to only log mails on development)")
+		}
+		return NewLogSender(), nil
+	}
+	if cfg.SmtpFrom == "" {
+		return nil, fmt.Errorf("smtp from address is required for smtp mail sender")
+	}
+
+	return NewSmtpSender(cfg.SmtpHost, cfg.SmtpPort, cfg.SmtpUserName, cfg.SmtpPassword, cfg.SmtpFrom), nil
+}
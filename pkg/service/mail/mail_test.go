@@ -0,0 +1,48 @@
+package mail
+
+import (
+	"testing"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSender(t *testing.T) {
+
+	tests := []struct {
+		testName       string
+		cfg            config.Config
+		expectedSender Sender
+		expectError    bool
+	}{
+		{
+			testName:    "EmptySmtpHostShouldReturnError",
+			cfg:         config.Config{},
+			expectError: true,
+		},
+		{
+			testName:       "EmptySmtpHostWithDevLogShouldReturnLogSender",
+			cfg:            config.Config{MailDevLog: true},
+			expectedSender: &logSender{},
+		},
+		{
+			testName:    "SmtpHostWithoutFromShouldReturnError",
+			cfg:         config.Config{SmtpHost: "localhost", SmtpPort: "25"},
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+
+			sender, err := NewSender(test.cfg)
+			if test.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedSender, sender)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+// Package mailtest provides a local smtp server to test the mail senders
+package mailtest
+
+import (
+	"bufio"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// a received mail on the stub server
+type Message struct {
+	From string
+	To   []string
+	Data string
+}
+
+// Server is a minimal smtp stub which accept all mails and keep them on memory
+type Server struct {
+	Host string
+	Port string
+
+	listener net.Listener
+	wait     sync.WaitGroup
+	mu       sync.Mutex
+	messages []Message
+}
+
+// To start a stub server on a random local port, the server is closed on the test cleanup
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start smtp stub server: %v", err)
+	}
+
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	server := &Server{
+		Host:     host,
+		Port:     port,
+		listener: listener,
+	}
+
+	server.wait.Add(1)
+	go server.serve()
+
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// To get all received mails
+func (s *Server) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Message(nil), s.messages...)
+}
+
+func (s *Server) Close() {
+	s.listener.Close()
+	s.wait.Wait()
+}
+
+func (s *Server) serve() {
+	defer s.wait.Done()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wait.Add(1)
+		go func() {
+			defer s.wait.Done()
+			s.handle(conn)
+		}()
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	defer conn.Close()
+
+	text := textproto.NewConn(conn)
+	reply := func(code int, message string) {
+		text.PrintfLine("%d %s", code, message)
+	}
+
+	var message Message
+	reply(220, "localhost smtp stub")
+
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+		command, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(command) {
+		case "EHLO", "HELO":
+			reply(250, "localhost")
+		case "MAIL":
+			message = Message{From: trimAddress(arg)}
+			reply(250, "OK")
+		case "RCPT":
+			message.To = append(message.To, trimAddress(arg))
+			reply(250, "OK")
+		case "DATA":
+			reply(354, "end data with <CR><LF>.<CR><LF>")
+			data, err := readData(text.R)
+			if err != nil {
+				return
+			}
+			message.Data = data
+			s.mu.Lock()
+			s.messages = append(s.messages, message)
+			s.mu.Unlock()
+			reply(250, "OK")
+		case "RSET", "NOOP":
+			reply(250, "OK")
+		case "QUIT":
+			reply(221, "bye")
+			return
+		default:
+			reply(502, "command not implemented")
+		}
+	}
+}
+
+func readData(reader *bufio.Reader) (string, error) {
+	data, err := textproto.NewReader(reader).ReadDotBytes()
+	return string(data), err
+}
+
+// remove the command prefix and brackets of address (eg: "TO:<user@example.com>")
+func trimAddress(arg string) string {
+	_, address, _ := strings.Cut(arg, ":")
+	address, _, _ = strings.Cut(address, " ")
+
+	return strings.Trim(address, "<>")
+}
@@ -0,0 +1,59 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+type smtpSender struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+// sender which send the mails through the smtp server
+// plain auth is used only when the username is given
+func NewSmtpSender(host, port, userName, password, from string) Sender {
+
+	var auth smtp.Auth
+	if userName != "" {
+		auth = smtp.PlainAuth("", userName, password, host)
+	}
+
+	return &smtpSender{
+		addr: net.JoinHostPort(host, port),
+		auth: auth,
+		from: from,
+	}
+}
+
+func (c *smtpSender) Send(ctx context.Context, mail Mail) error {
+
+	message := c.buildMessage(mail)
+
+	if err := smtp.SendMail(c.addr, c.auth, c.from, []string{mail.To}, message); err != nil {
+		return fmt.Errorf("failed to send mail to %s: %w", mail.To, err)
+	}
+
+	return nil
+}
+
+func (c *smtpSender) buildMessage(mail Mail) []byte {
+
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "From: %s\r\n", c.from)
+	fmt.Fprintf(&builder, "To: %s\r\n", mail.To)
+	fmt.Fprintf(&builder, "Subject: %s\r\n", mail.Subject)
+	fmt.Fprintf(&builder, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	builder.WriteString("MIME-Version: 1.0\r\n")
+	builder.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	builder.WriteString("\r\n")
+	builder.WriteString(strings.ReplaceAll(mail.Body, "\n", "\r\n"))
+
+	return []byte(builder.String())
+}
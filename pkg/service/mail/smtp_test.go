@@ -0,0 +1,30 @@
+package mail
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/mail/mailtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSmtpSenderSend(t *testing.T) {
+
+	server := mailtest.NewServer(t)
+	sender := NewSmtpSender(server.Host, server.Port, "", "", "shop@example.com")
+
+	err := sender.Send(context.Background(), Mail{
+		To:      "user@example.com",
+		Subject: "Order confirmed",
+		Body:    "order id: 1\ntotal: 500",
+	})
+	assert.NoError(t, err)
+
+	messages := server.Messages()
+	if assert.Len(t, messages, 1) {
+		assert.Equal(t, "shop@example.com", messages[0].From)
+		assert.Equal(t, []string{"user@example.com"}, messages[0].To)
+		assert.Contains(t, messages[0].Data, "Subject: Order confirmed")
+		assert.Contains(t, messages[0].Data, "order id: 1\ntotal: 500")
+	}
+}
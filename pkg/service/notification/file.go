@@ -0,0 +1,40 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+type fileNotifier struct {
+	mu       sync.Mutex
+	filePath string
+}
+
+// notifier which append the notifications to the given file
+func NewFileNotifier(filePath string) Notifier {
+	return &fileNotifier{
+		filePath: filePath,
+	}
+}
+
+func (c *fileNotifier) Notify(ctx context.Context, notification Notification) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	file, err := os.OpenFile(c.filePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open notification file: %w", err)
+	}
+	defer file.Close()
+
+	_, err = fmt.Fprintf(file, "[%s] %s\n%s\n\n", time.Now().Format(time.RFC3339),
+		notification.Subject, notification.Message)
+	if err != nil {
+		return fmt.Errorf("failed to write notification on file: %w", err)
+	}
+
+	return nil
+}
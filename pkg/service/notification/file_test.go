@@ -0,0 +1,34 @@
+package notification
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileNotifierNotify(t *testing.T) {
+
+	filePath := filepath.Join(t.TempDir(), "notifications.log")
+	notifier := NewFileNotifier(filePath)
+
+	notifications := []Notification{
+		{Subject: "Low stock", Message: "sku SKU1 qty 2 threshold 5"},
+		{Subject: "Low stock digest", Message: "sku SKU2 qty 0 threshold 3"},
+	}
+
+	for _, notification := range notifications {
+		err := notifier.Notify(context.Background(), notification)
+		assert.NoError(t, err)
+	}
+
+	content, err := os.ReadFile(filePath)
+	assert.NoError(t, err)
+
+	for _, notification := range notifications {
+		assert.Contains(t, string(content), notification.Subject)
+		assert.Contains(t, string(content), notification.Message)
+	}
+}
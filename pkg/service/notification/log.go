@@ -0,0 +1,19 @@
+package notification
+
+import (
+	"context"
+	"log"
+)
+
+type logNotifier struct{}
+
+// notifier which only write the notifications on application log
+func NewLogNotifier() Notifier {
+	return &logNotifier{}
+}
+
+func (c *logNotifier) Notify(ctx context.Context, notification Notification) error {
+
+	log.Printf("notification: %s\n%s", notification.Subject, notification.Message)
+	return nil
+}
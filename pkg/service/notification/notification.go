@@ -0,0 +1,38 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/config"
+)
+
+type Notifier interface {
+	Notify(ctx context.Context, notification Notification) error
+}
+
+type Notification struct {
+	Subject string
+	Message string
+}
+
+const (
+	LogNotifier  = "log"
+	FileNotifier = "file"
+)
+
+// To create the notifier selected on config (log notifier is the default)
+func NewNotifier(cfg config.Config) (Notifier, error) {
+
+	switch cfg.NotifierType {
+	case "", LogNotifier:
+		return NewLogNotifier(), nil
+	case FileNotifier:
+		if cfg.NotifierFilePath == "" {
+			return nil, fmt.Errorf("notifier file path is required for %s notifier", FileNotifier)
+		}
+		return NewFileNotifier(cfg.NotifierFilePath), nil
+	}
+
+	return nil, fmt.Errorf("invalid notifier type %s", cfg.NotifierType)
+}
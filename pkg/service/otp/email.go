@@ -0,0 +1,75 @@
+package otp
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/mail"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+)
+
+const (
+	emailOtpLength         = 6
+	emailOtpExpireDuration = time.Minute * 2
+)
+
+type emailOtp struct {
+	sender mail.Sender
+}
+
+// otp auth which generate the otp and send it as mail
+// the verify key of the sent otp is the hash of the code which is saved on the otp session
+// so a code is verified only against the session it sent for
+func NewEmailOtpAuth(sender mail.Sender) OtpAuth {
+	return &emailOtp{
+		sender: sender,
+	}
+}
+
+func (c *emailOtp) SentOtp(email string) (string, error) {
+
+	code, err := generateCode(emailOtpLength)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate otp: %w", err)
+	}
+	hash, err := utils.GenerateHashFromPassword(code)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash otp: %w", err)
+	}
+
+	err = c.sender.Send(context.Background(), mail.Mail{
+		To:      email,
+		Subject: "Your verification code",
+		Body: fmt.Sprintf("Your verification code is %s\nIt will expire in %v minutes.",
+			code, emailOtpExpireDuration.Minutes()),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return hash, nil
+}
+
+func (c *emailOtp) VerifyOtp(verifyKey string, code string) (valid bool, err error) {
+
+	if verifyKey == "" {
+		return false, nil
+	}
+
+	return utils.VerifyHashAndPassword(verifyKey, code), nil
+}
+
+// generate a random numeric code of the given length
+func generateCode(length int) (string, error) {
+
+	limit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(length)), nil)
+	number, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%0*d", length, number), nil
+}
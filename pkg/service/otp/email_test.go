@@ -0,0 +1,84 @@
+package otp
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/mail"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/mail/mailtest"
+	"github.com/stretchr/testify/assert"
+)
+
+var codeRegex = regexp.MustCompile(`code is (\d{6})`)
+
+// send the otp through the stub smtp server and return the verify key and the code from the last received mail
+func sendEmailOtp(t *testing.T, server *mailtest.Server, otpAuth OtpAuth, email string) (string, string) {
+	t.Helper()
+
+	verifyKey, err := otpAuth.SentOtp(email)
+	assert.NoError(t, err)
+
+	messages := server.Messages()
+	if !assert.NotEmpty(t, messages) {
+		t.FailNow()
+	}
+	message := messages[len(messages)-1]
+	assert.Equal(t, []string{email}, message.To)
+
+	matches := codeRegex.FindStringSubmatch(message.Data)
+	if !assert.Len(t, matches, 2) {
+		t.FailNow()
+	}
+	return verifyKey, matches[1]
+}
+
+func TestEmailOtpVerify(t *testing.T) {
+
+	const email = "user@example.com"
+
+	server := mailtest.NewServer(t)
+	sender := mail.NewSmtpSender(server.Host, server.Port, "", "", "shop@example.com")
+	otpAuth := NewEmailOtpAuth(sender)
+
+	t.Run("CodeShouldBeVerifiedWithVerifyKeyOfItsOwnOtp", func(t *testing.T) {
+		verifyKey, code := sendEmailOtp(t, server, otpAuth, email)
+
+		// only the hash of the code is returned to save
+		assert.NotContains(t, verifyKey, code)
+
+		valid, err := otpAuth.VerifyOtp(verifyKey, code)
+		assert.NoError(t, err)
+		assert.True(t, valid)
+
+		valid, err = otpAuth.VerifyOtp(verifyKey, "wrong")
+		assert.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("NewOtpShouldNotReplaceOtpOfAnotherSession", func(t *testing.T) {
+		firstKey, firstCode := sendEmailOtp(t, server, otpAuth, email)
+		secondKey, secondCode := sendEmailOtp(t, server, otpAuth, email)
+
+		valid, err := otpAuth.VerifyOtp(firstKey, firstCode)
+		assert.NoError(t, err)
+		assert.True(t, valid)
+
+		valid, err = otpAuth.VerifyOtp(secondKey, secondCode)
+		assert.NoError(t, err)
+		assert.True(t, valid)
+
+		if firstCode != secondCode {
+			valid, err = otpAuth.VerifyOtp(firstKey, secondCode)
+			assert.NoError(t, err)
+			assert.False(t, valid)
+		}
+	})
+
+	t.Run("EmptyVerifyKeyShouldBeInvalid", func(t *testing.T) {
+		_, code := sendEmailOtp(t, server, otpAuth, email)
+
+		valid, err := otpAuth.VerifyOtp("", code)
+		assert.NoError(t, err)
+		assert.False(t, valid)
+	})
+}
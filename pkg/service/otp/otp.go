@@ -1,6 +1,41 @@
 package otp
 
+import (
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/config"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/mail"
+)
+
+// the recipient (to) is the phone number for sms and the email address for email
+// the verify key returned on sent otp should save with the otp session and use it to verify the code of the session
 type OtpAuth interface {
-	SentOtp(phoneNumber string) (string, error)
-	VerifyOtp(phoneNumber string, code string) (valid bool, err error)
+	SentOtp(to string) (verifyKey string, err error)
+	VerifyOtp(verifyKey string, code string) (valid bool, err error)
+}
+
+type Channel string
+
+const (
+	ChannelSMS   Channel = "sms"
+	ChannelEmail Channel = "email"
+)
+
+// Channels holds the otp auth for each channel
+type Channels struct {
+	SMS   OtpAuth
+	Email OtpAuth
+}
+
+func NewOtpChannels(cfg config.Config, mailSender mail.Sender) Channels {
+	return Channels{
+		SMS:   NewTwilioOtpAuth(cfg),
+		Email: NewEmailOtpAuth(mailSender),
+	}
+}
+
+// To get the otp auth of the channel (sms is the default channel)
+func (c Channels) Of(channel Channel) OtpAuth {
+	if channel == ChannelEmail {
+		return c.Email
+	}
+	return c.SMS
 }
@@ -1,34 +1,42 @@
 package otp
 
 import (
-	"fmt"
-
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/config"
 	"github.com/twilio/twilio-go"
 	twilioApi "github.com/twilio/twilio-go/rest/verify/v2"
 )
 
+const defaultCountryCode = "+91"
+
 type twilioOtp struct {
-	serviceID string
-	client    twilio.RestClient
+	serviceID   string
+	countryCode string
+	client      twilio.RestClient
 }
 
-func NewOtpAuth(cfg config.Config) OtpAuth {
+// otp auth which send the otp as sms through twilio verify service
+func NewTwilioOtpAuth(cfg config.Config) OtpAuth {
 	client := *twilio.NewRestClientWithParams(twilio.ClientParams{
 		Username: cfg.TwilioAccountSID,
 		Password: cfg.TwilioAuthToken,
 	})
 
+	countryCode := cfg.PhoneCountryCode
+	if countryCode == "" {
+		countryCode = defaultCountryCode
+	}
+
 	return &twilioOtp{
-		serviceID: cfg.TwilioServiceID,
-		client:    client,
+		serviceID:   cfg.TwilioServiceID,
+		countryCode: countryCode,
+		client:      client,
 	}
 }
 
 func (c *twilioOtp) SentOtp(phoneNumber string) (string, error) {
 
 	params := &twilioApi.CreateVerificationParams{}
-	params.SetTo(phoneNumber)
+	params.SetTo(c.countryCode + phoneNumber)
 	params.SetChannel("sms")
 
 	resp, err := c.client.VerifyV2.CreateVerification(c.serviceID, params)
@@ -39,10 +47,15 @@ func (c *twilioOtp) SentOtp(phoneNumber string) (string, error) {
 	return *resp.Sid, nil
 }
 
-func (c *twilioOtp) VerifyOtp(phoneNumber string, code string) (valid bool, err error) {
+// verify key of the sms otp is the sid of the verification created on sent otp
+func (c *twilioOtp) VerifyOtp(verificationSid string, code string) (valid bool, err error) {
+
+	if verificationSid == "" {
+		return false, nil
+	}
 
 	params := &twilioApi.CreateVerificationCheckParams{}
-	params.SetTo(phoneNumber)
+	params.SetVerificationSid(verificationSid)
 	params.SetCode(code)
 
 	resp, err := c.client.VerifyV2.CreateVerificationCheck(c.serviceID, params)
@@ -50,8 +63,8 @@ func (c *twilioOtp) VerifyOtp(phoneNumber string, code string) (valid bool, err
 	if err != nil {
 		return false, err
 	}
-	if resp != nil && *resp.Status != "approved" {
-		return false, fmt.Errorf("invalid otp")
+	if resp == nil || resp.Status == nil || *resp.Status != "approved" {
+		return false, nil
 	}
 
 	return true, nil
@@ -0,0 +1,134 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// FakeWebhookSignature is the only signature accepted by the fake gateway webhook
+const FakeWebhookSignature = "fake-webhook-signature"
+
+// FakeGateway is an in-memory payment gateway for tests and local runs without gateway accounts
+type FakeGateway struct {
+	mu      sync.Mutex
+	lastID  uint
+	orders  map[string]*fakeOrder
+	refunds []RefundRequest
+}
+
+type fakeOrder struct {
+	amount uint
+	status PaymentStatus
+}
+
+func NewFakeGateway() *FakeGateway {
+	return &FakeGateway{
+		orders: make(map[string]*fakeOrder),
+	}
+}
+
+func (c *FakeGateway) CreateOrder(ctx context.Context, req CreateOrderRequest) (CreateOrderResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lastID++
+	gatewayOrderID := fmt.Sprintf("fake_order_%d", c.lastID)
+	c.orders[gatewayOrderID] = &fakeOrder{
+		amount: req.Amount,
+		status: StatusPending,
+	}
+
+	return CreateOrderResponse{
+		GatewayOrderID: gatewayOrderID,
+		GatewayAmount:  req.Amount,
+		ClientSecret:   gatewayOrderID + "_secret",
+		PublicKey:      "fake_public_key",
+	}, nil
+}
+
+// fake gateway payment id is same as the gateway order id
+func (c *FakeGateway) VerifyPayment(ctx context.Context, req VerifyPaymentRequest) (CapturedPayment, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	gatewayOrderID := req.GatewayOrderID
+	if gatewayOrderID == "" {
+		gatewayOrderID = req.PaymentID
+	}
+
+	order, ok := c.orders[gatewayOrderID]
+	if !ok {
+		return CapturedPayment{}, ErrPaymentNotFound
+	}
+	if order.status != StatusSucceeded {
+		return CapturedPayment{}, ErrPaymentNotApproved
+	}
+
+	return CapturedPayment{
+		GatewayOrderID: gatewayOrderID,
+		Amount:         order.amount,
+	}, nil
+}
+
+func (c *FakeGateway) FetchPaymentStatus(ctx context.Context, gatewayOrderID string) (PaymentStatus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	order, ok := c.orders[gatewayOrderID]
+	if !ok {
+		return "", ErrPaymentNotFound
+	}
+
+	return order.status, nil
+}
+
+func (c *FakeGateway) Refund(ctx context.Context, req RefundRequest) (RefundResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	order, ok := c.orders[req.GatewayOrderID]
+	if !ok || order.status != StatusSucceeded {
+		return RefundResponse{}, ErrPaymentNotFound
+	}
+
+	c.refunds = append(c.refunds, req)
+
+	return RefundResponse{
+		RefundID: fmt.Sprintf("fake_refund_%d", len(c.refunds)),
+	}, nil
+}
+
+// fake webhook payload is the json of WebhookEvent
+func (c *FakeGateway) ParseWebhookEvent(payload []byte, signature string) (WebhookEvent, error) {
+
+	if signature != FakeWebhookSignature {
+		return WebhookEvent{}, ErrInvalidSignature
+	}
+
+	var event WebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return WebhookEvent{}, fmt.Errorf("failed to parse fake webhook body: %w", err)
+	}
+
+	return event, nil
+}
+
+// SetPaymentStatus change the status of an order as the user completed or failed the payment
+func (c *FakeGateway) SetPaymentStatus(gatewayOrderID string, status PaymentStatus) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if order, ok := c.orders[gatewayOrderID]; ok {
+		order.status = status
+	}
+}
+
+// Refunds return all the refunds made on the fake gateway
+func (c *FakeGateway) Refunds() []RefundRequest {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]RefundRequest(nil), c.refunds...)
+}
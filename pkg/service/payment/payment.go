@@ -0,0 +1,86 @@
+package payment
+
+import (
+	"context"
+	"errors"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/config"
+)
+
+type PaymentGateway interface {
+	CreateOrder(ctx context.Context, req CreateOrderRequest) (CreateOrderResponse, error)
+	VerifyPayment(ctx context.Context, req VerifyPaymentRequest) (CapturedPayment, error)
+	FetchPaymentStatus(ctx context.Context, gatewayOrderID string) (PaymentStatus, error)
+	Refund(ctx context.Context, req RefundRequest) (RefundResponse, error)
+	ParseWebhookEvent(payload []byte, signature string) (WebhookEvent, error)
+}
+
+var (
+	ErrInvalidSignature   = errors.New("invalid payment signature")
+	ErrPaymentNotApproved = errors.New("payment not approved")
+	ErrPaymentNotFound    = errors.New("payment not found on gateway")
+)
+
+type PaymentStatus string
+
+const (
+	StatusPending   PaymentStatus = "pending"
+	StatusSucceeded PaymentStatus = "succeeded"
+	StatusFailed    PaymentStatus = "failed"
+)
+
+type CreateOrderRequest struct {
+	ShopOrderID uint
+	Amount      uint
+	Email       string
+}
+
+type CreateOrderResponse struct {
+	GatewayOrderID string
+	// amount on the gateway currency unit
+	GatewayAmount uint
+	ClientSecret  string
+	PublicKey     string
+}
+
+type VerifyPaymentRequest struct {
+	GatewayOrderID string
+	PaymentID      string
+	Signature      string
+}
+
+// payment captured on gateway (amount on rupees)
+type CapturedPayment struct {
+	GatewayOrderID string
+	Amount         uint
+}
+
+type RefundRequest struct {
+	GatewayOrderID string
+	Amount         uint
+}
+
+type RefundResponse struct {
+	RefundID string
+}
+
+type WebhookEvent struct {
+	EventID        string
+	EventType      string
+	GatewayOrderID string
+	// captured amount of payment events and total refunded amount of the payment on refund events (on rupees)
+	Amount uint
+}
+
+// Gateways holds the payment gateway for each online payment method
+type Gateways struct {
+	Razorpay PaymentGateway
+	Stripe   PaymentGateway
+}
+
+func NewPaymentGateways(cfg config.Config) Gateways {
+	return Gateways{
+		Razorpay: NewRazorpayGateway(cfg),
+		Stripe:   NewStripeGateway(cfg),
+	}
+}
@@ -0,0 +1,188 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/config"
+	"github.com/razorpay/razorpay-go"
+)
+
+type razorpayGateway struct {
+	key           string
+	secret        string
+	webhookSecret string
+	client        *razorpay.Client
+}
+
+func NewRazorpayGateway(cfg config.Config) PaymentGateway {
+	return &razorpayGateway{
+		key:           cfg.RazorPayKey,
+		secret:        cfg.RazorPaySecret,
+		webhookSecret: cfg.RazorPayWebhookSecret,
+		client:        razorpay.NewClient(cfg.RazorPayKey, cfg.RazorPaySecret),
+	}
+}
+
+// razorpay webhook body (only the needed fields)
+type razorpayWebhookEvent struct {
+	Event   string `json:"event"`
+	Payload struct {
+		Payment struct {
+			Entity struct {
+				ID             string `json:"id"`
+				OrderID        string `json:"order_id"`
+				Amount         uint   `json:"amount"`
+				AmountRefunded uint   `json:"amount_refunded"`
+			} `json:"entity"`
+		} `json:"payment"`
+		Refund struct {
+			Entity struct {
+				ID     string `json:"id"`
+				Amount uint   `json:"amount"`
+			} `json:"entity"`
+		} `json:"refund"`
+	} `json:"payload"`
+}
+
+// To create a razorpay order
+func (c *razorpayGateway) CreateOrder(ctx context.Context, req CreateOrderRequest) (CreateOrderResponse, error) {
+
+	//razorpay amount is calculate on pisa for india so make the actual price into paisa
+	razorPayAmount := req.Amount * 100
+
+	// razor pay data for order
+	data := map[string]interface{}{
+		"amount":   razorPayAmount,
+		"currency": "INR",
+		"receipt":  "ecommerce purchase completed",
+		"notes": map[string]interface{}{
+			"shop_order_id": req.ShopOrderID,
+		},
+	}
+
+	razorpayRes, err := c.client.Order.Create(data, nil)
+	if err != nil {
+		return CreateOrderResponse{}, err
+	}
+
+	return CreateOrderResponse{
+		GatewayOrderID: fmt.Sprint(razorpayRes["id"]),
+		GatewayAmount:  razorPayAmount,
+		PublicKey:      c.key,
+	}, nil
+}
+
+// To verify the razorpay checkout signature and the payment is captured
+func (c *razorpayGateway) VerifyPayment(ctx context.Context, req VerifyPaymentRequest) (CapturedPayment, error) {
+
+	data := req.GatewayOrderID + "|" + req.PaymentID
+	if !hmac.Equal([]byte(c.sign(c.secret, []byte(data))), []byte(req.Signature)) {
+		return CapturedPayment{}, ErrInvalidSignature
+	}
+
+	// fetch payment and verify
+	payment, err := c.client.Payment.Fetch(req.PaymentID, nil, nil)
+	if err != nil {
+		return CapturedPayment{}, err
+	}
+
+	// check payment status
+	if payment["status"] != "captured" {
+		return CapturedPayment{}, ErrPaymentNotApproved
+	}
+
+	// razorpay amount is on paisa
+	amount, _ := payment["amount"].(float64)
+
+	return CapturedPayment{
+		GatewayOrderID: fmt.Sprint(payment["order_id"]),
+		Amount:         uint(amount) / 100,
+	}, nil
+}
+
+func (c *razorpayGateway) FetchPaymentStatus(ctx context.Context, gatewayOrderID string) (PaymentStatus, error) {
+
+	order, err := c.client.Order.Fetch(gatewayOrderID, nil, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if order["status"] == "paid" {
+		return StatusSucceeded, nil
+	}
+
+	return StatusPending, nil
+}
+
+// razorpay refund is on payment so find the captured payment of the order and refund it
+func (c *razorpayGateway) Refund(ctx context.Context, req RefundRequest) (RefundResponse, error) {
+
+	payments, err := c.client.Order.Payments(req.GatewayOrderID, nil, nil)
+	if err != nil {
+		return RefundResponse{}, err
+	}
+
+	items, _ := payments["items"].([]interface{})
+	for _, item := range items {
+		payment, ok := item.(map[string]interface{})
+		if !ok || payment["status"] != "captured" {
+			continue
+		}
+
+		refund, err := c.client.Payment.Refund(fmt.Sprint(payment["id"]), int(req.Amount*100), nil, nil)
+		if err != nil {
+			return RefundResponse{}, err
+		}
+
+		return RefundResponse{
+			RefundID: fmt.Sprint(refund["id"]),
+		}, nil
+	}
+
+	return RefundResponse{}, ErrPaymentNotFound
+}
+
+// To verify the X-Razorpay-Signature of webhook and parse the event
+// razorpay send event id only on header so the event id here is made from payment id and event
+func (c *razorpayGateway) ParseWebhookEvent(payload []byte, signature string) (WebhookEvent, error) {
+
+	if !hmac.Equal([]byte(c.sign(c.webhookSecret, payload)), []byte(signature)) {
+		return WebhookEvent{}, ErrInvalidSignature
+	}
+
+	var event razorpayWebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return WebhookEvent{}, fmt.Errorf("failed to parse razorpay webhook body: %w", err)
+	}
+
+	// razorpay amount is on paisa
+	eventID := event.Payload.Payment.Entity.ID
+	amount := event.Payload.Payment.Entity.Amount
+
+	// each refund of a payment is a separate event and the refunded amount is the total refunded on payment
+	if refund := event.Payload.Refund.Entity; refund.ID != "" {
+		eventID = refund.ID
+		amount = event.Payload.Payment.Entity.AmountRefunded
+		if amount == 0 {
+			amount = refund.Amount
+		}
+	}
+
+	return WebhookEvent{
+		EventID:        eventID + ":" + event.Event,
+		EventType:      event.Event,
+		GatewayOrderID: event.Payload.Payment.Entity.OrderID,
+		Amount:         amount / 100,
+	}, nil
+}
+
+func (c *razorpayGateway) sign(secret string, data []byte) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,156 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/config"
+	"github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/paymentintent"
+	"github.com/stripe/stripe-go/v72/refund"
+	"github.com/stripe/stripe-go/v72/webhook"
+)
+
+type stripeGateway struct {
+	publishKey    string
+	webhookSecret string
+	paymentIntent paymentintent.Client
+	refund        refund.Client
+}
+
+func NewStripeGateway(cfg config.Config) PaymentGateway {
+
+	backend := stripe.GetBackend(stripe.APIBackend)
+
+	return &stripeGateway{
+		publishKey:    cfg.StripPublishKey,
+		webhookSecret: cfg.StripeWebhookSecret,
+		paymentIntent: paymentintent.Client{B: backend, Key: cfg.StripSecretKey},
+		refund:        refund.Client{B: backend, Key: cfg.StripSecretKey},
+	}
+}
+
+// To create a stripe payment intent
+func (c *stripeGateway) CreateOrder(ctx context.Context, req CreateOrderRequest) (CreateOrderResponse, error) {
+
+	// stripe amount is on the smallest currency unit so make the actual price into paisa
+	stripeAmount := req.Amount * 100
+
+	// create a payment param
+	params := &stripe.PaymentIntentParams{
+
+		Amount:       stripe.Int64(int64(stripeAmount)),
+		ReceiptEmail: stripe.String(req.Email),
+
+		Currency: stripe.String(string(stripe.CurrencyINR)),
+		AutomaticPaymentMethods: &stripe.PaymentIntentAutomaticPaymentMethodsParams{
+			Enabled: stripe.Bool(true),
+		},
+	}
+	params.AddMetadata("shop_order_id", fmt.Sprint(req.ShopOrderID))
+
+	// create new payment intent with this param
+	paymentIntent, err := c.paymentIntent.New(params)
+	if err != nil {
+		return CreateOrderResponse{}, err
+	}
+
+	return CreateOrderResponse{
+		GatewayOrderID: paymentIntent.ID,
+		GatewayAmount:  stripeAmount,
+		ClientSecret:   paymentIntent.ClientSecret,
+		PublicKey:      c.publishKey,
+	}, nil
+}
+
+// To verify the payment intent is approved
+func (c *stripeGateway) VerifyPayment(ctx context.Context, req VerifyPaymentRequest) (CapturedPayment, error) {
+
+	paymentIntent, err := c.paymentIntent.Get(req.PaymentID, nil)
+	if err != nil {
+		return CapturedPayment{}, err
+	}
+
+	if paymentIntentStatus(paymentIntent) != StatusSucceeded {
+		return CapturedPayment{}, ErrPaymentNotApproved
+	}
+
+	return CapturedPayment{
+		GatewayOrderID: paymentIntent.ID,
+		Amount:         uint(paymentIntent.AmountReceived) / 100,
+	}, nil
+}
+
+// stripe gateway order is the payment intent itself
+func (c *stripeGateway) FetchPaymentStatus(ctx context.Context, gatewayOrderID string) (PaymentStatus, error) {
+
+	paymentIntent, err := c.paymentIntent.Get(gatewayOrderID, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return paymentIntentStatus(paymentIntent), nil
+}
+
+func paymentIntentStatus(paymentIntent *stripe.PaymentIntent) PaymentStatus {
+
+	switch paymentIntent.Status {
+	case stripe.PaymentIntentStatusSucceeded, stripe.PaymentIntentStatusRequiresCapture:
+		return StatusSucceeded
+	case stripe.PaymentIntentStatusCanceled:
+		return StatusFailed
+	}
+
+	return StatusPending
+}
+
+func (c *stripeGateway) Refund(ctx context.Context, req RefundRequest) (RefundResponse, error) {
+
+	params := &stripe.RefundParams{
+		PaymentIntent: stripe.String(req.GatewayOrderID),
+		Amount:        stripe.Int64(int64(req.Amount * 100)),
+	}
+
+	stripeRefund, err := c.refund.New(params)
+	if err != nil {
+		return RefundResponse{}, err
+	}
+
+	return RefundResponse{
+		RefundID: stripeRefund.ID,
+	}, nil
+}
+
+// To verify the Stripe-Signature of webhook and parse the event
+func (c *stripeGateway) ParseWebhookEvent(payload []byte, signature string) (WebhookEvent, error) {
+
+	event, err := webhook.ConstructEvent(payload, signature, c.webhookSecret)
+	if err != nil {
+		return WebhookEvent{}, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+
+	webhookEvent := WebhookEvent{
+		EventID:   event.ID,
+		EventType: string(event.Type),
+	}
+
+	// refund events are on charge object so take the payment intent and refunded amount of charge
+	if event.Data != nil {
+		if paymentIntentID, ok := event.Data.Object["payment_intent"].(string); ok {
+			webhookEvent.GatewayOrderID = paymentIntentID
+		} else if id, ok := event.Data.Object["id"].(string); ok {
+			webhookEvent.GatewayOrderID = id
+		}
+
+		amountField := "amount_received"
+		if event.Data.Object["object"] == "charge" {
+			amountField = "amount_refunded"
+		}
+		// stripe amount is on paisa
+		if amount, ok := event.Data.Object[amountField].(float64); ok {
+			webhookEvent.Amount = uint(amount) / 100
+		}
+	}
+
+	return webhookEvent, nil
+}
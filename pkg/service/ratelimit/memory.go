@@ -0,0 +1,89 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// interval to remove the buckets which are fully refilled (not used recently)
+const memorySweepInterval = time.Minute * 5
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+	fullAt    time.Time
+}
+
+type memoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewMemoryStore() Store {
+	return &memoryStore{
+		buckets:   make(map[string]*bucket),
+		lastSweep: time.Now(),
+		now:       time.Now,
+	}
+}
+
+func (c *memoryStore) Take(ctx context.Context, key string, policy Policy) (Result, error) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	c.sweep(now)
+
+	capacity := float64(policy.Limit)
+	ratePerSecond := capacity / policy.Period.Seconds()
+
+	key = policy.Name + ":" + key
+	b, ok := c.buckets[key]
+	if !ok {
+		b = &bucket{tokens: capacity, updatedAt: now}
+		c.buckets[key] = b
+	}
+
+	// refill the tokens of the elapsed time
+	b.tokens = math.Min(capacity, b.tokens+now.Sub(b.updatedAt).Seconds()*ratePerSecond)
+	b.updatedAt = now
+
+	result := Result{Limit: policy.Limit}
+
+	if b.tokens >= 1 {
+		b.tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = secondsToDuration((1 - b.tokens) / ratePerSecond)
+	}
+
+	result.Remaining = uint(b.tokens)
+	result.ResetAfter = secondsToDuration((capacity - b.tokens) / ratePerSecond)
+	b.fullAt = now.Add(result.ResetAfter)
+
+	return result, nil
+}
+
+// remove the buckets which are full now (same as a new bucket)
+func (c *memoryStore) sweep(now time.Time) {
+
+	if now.Sub(c.lastSweep) < memorySweepInterval {
+		return
+	}
+	c.lastSweep = now
+
+	for key, b := range c.buckets {
+		if !now.Before(b.fullAt) {
+			delete(c.buckets, key)
+		}
+	}
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
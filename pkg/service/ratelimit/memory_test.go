@@ -0,0 +1,61 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryStoreTake(t *testing.T) {
+
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := &memoryStore{
+		buckets:   make(map[string]*bucket),
+		lastSweep: now,
+		now:       func() time.Time { return now },
+	}
+
+	policy := Policy{Name: "test", Limit: 3, Period: time.Minute}
+	ctx := context.Background()
+
+	// all tokens of the bucket can use at once
+	for i := 3; i > 0; i-- {
+		result, err := store.Take(ctx, "key", policy)
+		assert.NoError(t, err)
+		assert.True(t, result.Allowed)
+		assert.Equal(t, uint(i-1), result.Remaining)
+	}
+
+	// empty bucket should not allow until the next token refilled
+	result, err := store.Take(ctx, "key", policy)
+	assert.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, uint(0), result.Remaining)
+	assert.Equal(t, time.Second*20, result.RetryAfter)
+	assert.Equal(t, time.Minute, result.ResetAfter)
+
+	// other keys and policies have separate buckets
+	result, err = store.Take(ctx, "otherKey", policy)
+	assert.NoError(t, err)
+	assert.True(t, result.Allowed)
+	result, err = store.Take(ctx, "key", Policy{Name: "other", Limit: 3, Period: time.Minute})
+	assert.NoError(t, err)
+	assert.True(t, result.Allowed)
+
+	// one token refilled after 20 seconds
+	now = now.Add(time.Second * 20)
+	result, err = store.Take(ctx, "key", policy)
+	assert.NoError(t, err)
+	assert.True(t, result.Allowed)
+	result, err = store.Take(ctx, "key", policy)
+	assert.NoError(t, err)
+	assert.False(t, result.Allowed)
+
+	// full buckets are removed on sweep
+	now = now.Add(memorySweepInterval)
+	_, err = store.Take(ctx, "newKey", policy)
+	assert.NoError(t, err)
+	assert.Len(t, store.buckets, 1)
+}
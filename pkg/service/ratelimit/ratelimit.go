@@ -0,0 +1,46 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/config"
+)
+
+// token bucket policy
+// the bucket hold Limit tokens at most and it's fully refilled in the Period
+type Policy struct {
+	Name   string // each policy have separate buckets for the same key
+	Limit  uint
+	Period time.Duration
+}
+
+type Result struct {
+	Allowed    bool
+	Limit      uint
+	Remaining  uint
+	ResetAfter time.Duration // time to refill the bucket fully
+	RetryAfter time.Duration // time to get the next token (only when not allowed)
+}
+
+// Store keep the buckets of keys
+// in memory store is only for a single instance, a shared store is needed when running multiple instances
+type Store interface {
+	Take(ctx context.Context, key string, policy Policy) (Result, error)
+}
+
+const (
+	MemoryStore = "memory"
+)
+
+// To create the rate limit store selected on config (memory store is the default)
+func NewStore(cfg config.Config) (Store, error) {
+
+	switch cfg.RateLimitStore {
+	case "", MemoryStore:
+		return NewMemoryStore(), nil
+	}
+
+	return nil, fmt.Errorf("invalid rate limit store %s", cfg.RateLimitStore)
+}
@@ -0,0 +1,69 @@
+package tax
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/config"
+)
+
+// GST of an order line
+// CGST and SGST are charged for delivery inside the seller state and IGST for delivery to other states
+type LineTax struct {
+	Rate          float64
+	TaxableAmount uint
+	CGST          uint
+	SGST          uint
+	IGST          uint
+}
+
+// Engine find the tax included on the tax inclusive amount of an order line
+type Engine interface {
+	Calculate(rate float64, amount uint, deliveryState string) LineTax
+}
+
+// To create the GST engine of the seller state on config
+// seller state is required otherwise all orders are charged IGST even for orders inside the seller state
+func NewEngine(cfg config.Config) (Engine, error) {
+
+	if strings.TrimSpace(cfg.SellerState) == "" {
+		return nil, fmt.Errorf("seller state is required for GST to charge CGST and SGST on orders inside the state")
+	}
+
+	return NewGSTEngine(cfg.SellerState), nil
+}
+
+type gstEngine struct {
+	sellerState string
+}
+
+// when seller state is empty all deliveries are considered as inter state
+func NewGSTEngine(sellerState string) Engine {
+	return &gstEngine{
+		sellerState: strings.TrimSpace(sellerState),
+	}
+}
+
+func (c *gstEngine) Calculate(rate float64, amount uint, deliveryState string) LineTax {
+
+	tax := uint(math.Round(float64(amount) * rate / (100 + rate)))
+
+	lineTax := LineTax{
+		Rate:          rate,
+		TaxableAmount: amount - tax,
+	}
+
+	if c.isIntraState(deliveryState) {
+		lineTax.CGST = tax / 2
+		lineTax.SGST = tax - lineTax.CGST
+	} else {
+		lineTax.IGST = tax
+	}
+
+	return lineTax
+}
+
+func (c *gstEngine) isIntraState(deliveryState string) bool {
+	return c.sellerState != "" && strings.EqualFold(c.sellerState, strings.TrimSpace(deliveryState))
+}
@@ -0,0 +1,81 @@
+package tax
+
+import (
+	"testing"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGSTEngineCalculate(t *testing.T) {
+
+	tests := []struct {
+		testName      string
+		sellerState   string
+		rate          float64
+		amount        uint
+		deliveryState string
+		expectedTax   LineTax
+	}{
+		{
+			testName:      "SameStateShouldSplitTaxToCGSTAndSGST",
+			sellerState:   "Kerala",
+			rate:          18,
+			amount:        1180,
+			deliveryState: " kerala ",
+			expectedTax:   LineTax{Rate: 18, TaxableAmount: 1000, CGST: 90, SGST: 90},
+		},
+		{
+			testName:      "OddTaxShouldGiveRemainderToSGST",
+			sellerState:   "Kerala",
+			rate:          5,
+			amount:        105,
+			deliveryState: "Kerala",
+			expectedTax:   LineTax{Rate: 5, TaxableAmount: 100, CGST: 2, SGST: 3},
+		},
+		{
+			testName:      "OtherStateShouldChargeIGST",
+			sellerState:   "Kerala",
+			rate:          12,
+			amount:        1120,
+			deliveryState: "Tamil Nadu",
+			expectedTax:   LineTax{Rate: 12, TaxableAmount: 1000, IGST: 120},
+		},
+		{
+			testName:      "UnknownStateShouldChargeIGST",
+			sellerState:   "Kerala",
+			rate:          28,
+			amount:        1280,
+			deliveryState: "",
+			expectedTax:   LineTax{Rate: 28, TaxableAmount: 1000, IGST: 280},
+		},
+		{
+			testName:      "ZeroRateShouldHaveNoTax",
+			sellerState:   "Kerala",
+			rate:          0,
+			amount:        500,
+			deliveryState: "Kerala",
+			expectedTax:   LineTax{Rate: 0, TaxableAmount: 500},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+			t.Parallel()
+
+			engine := NewGSTEngine(test.sellerState)
+			assert.Equal(t, test.expectedTax, engine.Calculate(test.rate, test.amount, test.deliveryState))
+		})
+	}
+}
+
+func TestNewEngine(t *testing.T) {
+
+	_, err := NewEngine(config.Config{SellerState: " "})
+	assert.Error(t, err)
+
+	engine, err := NewEngine(config.Config{SellerState: "Kerala"})
+	assert.NoError(t, err)
+	assert.NotNil(t, engine)
+}
@@ -32,9 +32,11 @@ var (
 )
 
 type jwtClaims struct {
-	TokenID   string
-	UserID    uint
-	ExpiresAt time.Time
+	TokenID     string
+	UserID      uint
+	SessionID   string
+	Permissions []string
+	ExpiresAt   time.Time
 	// jwt.RegisteredClaims
 }
 
@@ -48,8 +50,10 @@ func (c *jwtAuth) GenerateToken(req GenerateTokenRequest) (GenerateTokenResponse
 
 	tokenID := utils.GenerateUniqueString()
 	claims := &jwtClaims{
-		TokenID: tokenID,
-		UserID:  req.UserID,
+		TokenID:     tokenID,
+		UserID:      req.UserID,
+		SessionID:   req.SessionID,
+		Permissions: req.Permissions,
 		// RegisteredClaims: jwt.RegisteredClaims{
 		// 	ExpiresAt: jwt.NewNumericDate(req.ExpirationDate),
 		// },
@@ -112,8 +116,10 @@ func (c *jwtAuth) VerifyToken(req VerifyTokenRequest) (VerifyTokenResponse, erro
 	}
 
 	response := VerifyTokenResponse{
-		TokenID: claims.TokenID,
-		UserID:  claims.UserID,
+		TokenID:     claims.TokenID,
+		UserID:      claims.UserID,
+		SessionID:   claims.SessionID,
+		Permissions: claims.Permissions,
 	}
 	return response, nil
 }
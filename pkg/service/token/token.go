@@ -15,9 +15,12 @@ const (
 )
 
 type GenerateTokenRequest struct {
-	UserID   uint
-	UsedFor  UserType
-	ExpireAt time.Time
+	UserID    uint
+	UsedFor   UserType
+	ExpireAt  time.Time
+	SessionID string // refresh session of the access token
+
+	Permissions []string // admin staff permissions of the access token
 }
 
 type GenerateTokenResponse struct {
@@ -31,6 +34,8 @@ type VerifyTokenRequest struct {
 }
 
 type VerifyTokenResponse struct {
-	TokenID string
-	UserID  uint
+	TokenID     string
+	UserID      uint
+	SessionID   string
+	Permissions []string
 }
@@ -4,26 +4,31 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/mail"
 	service "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
 	"golang.org/x/crypto/bcrypt"
 )
 
 type adminUseCase struct {
-	adminRepo interfaces.AdminRepository
-	userRepo  interfaces.UserRepository
+	adminRepo  interfaces.AdminRepository
+	userRepo   interfaces.UserRepository
+	authRepo   interfaces.AuthRepository
+	mailSender mail.Sender
 }
 
-func NewAdminUseCase(repo interfaces.AdminRepository, userRepo interfaces.UserRepository) service.AdminUseCase {
+func NewAdminUseCase(repo interfaces.AdminRepository, userRepo interfaces.UserRepository,
+	authRepo interfaces.AuthRepository, mailSender mail.Sender) service.AdminUseCase {
 
 	return &adminUseCase{
-		adminRepo: repo,
-		userRepo:  userRepo,
+		adminRepo:  repo,
+		userRepo:   userRepo,
+		authRepo:   authRepo,
+		mailSender: mailSender,
 	}
 }
 
@@ -80,16 +85,3 @@ func (c *adminUseCase) BlockOrUnBlockUser(ctx context.Context, blockDetails requ
 	}
 	return nil
 }
-
-func (c *adminUseCase) GetFullSalesReport(ctx context.Context, requestData request.SalesReport) (salesReport []response.SalesReport, err error) {
-	salesReport, err = c.adminRepo.CreateFullSalesReport(ctx, requestData)
-
-	if err != nil {
-		return salesReport, err
-	}
-
-	log.Printf("successfully got sales report from %v to %v of limit %v",
-		requestData.StartDate, requestData.EndDate, requestData.Pagination.Count)
-
-	return salesReport, nil
-}
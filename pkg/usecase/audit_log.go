@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+)
+
+func (c *adminUseCase) SaveAuditLog(ctx context.Context, auditLog domain.AdminAuditLog) error {
+
+	err := c.adminRepo.SaveAuditLog(ctx, auditLog)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to save audit log")
+	}
+
+	return nil
+}
+
+func (c *adminUseCase) FindAllAuditLogs(ctx context.Context,
+	filter request.AuditLogFilter) ([]response.AuditLog, error) {
+
+	if !filter.StartDate.IsZero() && !filter.EndDate.IsZero() && filter.EndDate.Before(filter.StartDate) {
+		return nil, ErrInvalidAuditLogPeriod
+	}
+
+	auditLogs, err := c.adminRepo.FindAllAuditLogs(ctx, filter)
+	if err != nil {
+		return nil, utils.PrependMessageToError(err, "failed to find all audit logs")
+	}
+
+	return auditLogs, nil
+}
@@ -4,11 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/otp"
@@ -18,7 +18,6 @@ import (
 )
 
 const (
-	countryCode       = "+91"
 	otpExpireDuration = time.Minute * 2
 )
 
@@ -28,19 +27,19 @@ type authUseCase struct {
 	userRepo     interfaces.UserRepository
 	adminRepo    interfaces.AdminRepository
 	tokenService token.TokenService
-	optAuth      otp.OtpAuth
+	otpChannels  otp.Channels
 }
 
 func NewAuthUseCase(authRepo interfaces.AuthRepository, tokenService token.TokenService,
 	userRepo interfaces.UserRepository, adminRepo interfaces.AdminRepository,
-	optAuth otp.OtpAuth) service.AuthUseCase {
+	otpChannels otp.Channels) service.AuthUseCase {
 
 	return &authUseCase{
 		userRepo:     userRepo,
 		adminRepo:    adminRepo,
 		tokenService: tokenService,
 		authRepo:     authRepo,
-		optAuth:      optAuth,
+		otpChannels:  otpChannels,
 	}
 }
 
@@ -55,6 +54,16 @@ func (c *authUseCase) UserLogin(ctx context.Context, loginDetails request.Login)
 		user domain.User
 		err  error
 	)
+	if loginDetails.Email == "" && loginDetails.UserName == "" && loginDetails.Phone == "" {
+		return 0, ErrEmptyLoginCredentials
+	}
+
+	if loginDetails.ClientIP != "" {
+		if err = c.checkLoginLocked(ctx, ipLoginAttemptKey(token.User, loginDetails.ClientIP)); err != nil {
+			return 0, err
+		}
+	}
+
 	switch {
 	case loginDetails.Email != "":
 		user, err = c.userRepo.FindUserByEmail(ctx, loginDetails.Email)
@@ -62,8 +71,6 @@ func (c *authUseCase) UserLogin(ctx context.Context, loginDetails request.Login)
 		user, err = c.userRepo.FindUserByUserName(ctx, loginDetails.UserName)
 	case loginDetails.Phone != "":
 		user, err = c.userRepo.FindUserByPhoneNumber(ctx, loginDetails.Phone)
-	default:
-		return 0, ErrEmptyLoginCredentials
 	}
 
 	if err != nil {
@@ -71,9 +78,21 @@ func (c *authUseCase) UserLogin(ctx context.Context, loginDetails request.Login)
 	}
 
 	if user.ID == 0 {
+		// guessing the login credentials also counted as failed attempt of the ip
+		if loginDetails.ClientIP != "" {
+			err = c.saveFailedLoginAttempt(ctx, ipLoginAttemptKey(token.User, loginDetails.ClientIP), maxIPLoginAttempts)
+			if err != nil {
+				return 0, err
+			}
+		}
 		return 0, ErrUserNotExist
 	}
 
+	accountKey := accountLoginAttemptKey(token.User, user.ID)
+	if err = c.checkLoginLocked(ctx, accountKey); err != nil {
+		return 0, err
+	}
+
 	if !user.Verified {
 		return 0, ErrUserNotVerified
 	}
@@ -84,13 +103,27 @@ func (c *authUseCase) UserLogin(ctx context.Context, loginDetails request.Login)
 
 	err = utils.ComparePasswordWithHashedPassword(loginDetails.Password, user.Password)
 	if err != nil {
+		if err = c.saveWrongPasswordAttempt(ctx, token.User, user.ID, loginDetails.ClientIP); err != nil {
+			return 0, err
+		}
 		return 0, ErrWrongPassword
 	}
 
+	// failed attempts of the account are cleared on successful login
+	if err = c.authRepo.DeleteLoginAttempt(ctx, accountKey); err != nil {
+		return 0, utils.PrependMessageToError(err, "failed to clear failed login attempts")
+	}
+
 	return user.ID, nil
 }
 
 func (c *authUseCase) UserLoginOtpSend(ctx context.Context, loginDetails request.OTPLogin) (string, error) {
+	return c.sendUserOtp(ctx, loginDetails, domain.OtpPurposeLogin)
+}
+
+// send otp to the user found by the login details and save the otp session for the purpose
+func (c *authUseCase) sendUserOtp(ctx context.Context, loginDetails request.OTPLogin,
+	purpose domain.OtpPurpose) (string, error) {
 
 	var (
 		user domain.User
@@ -121,40 +154,21 @@ func (c *authUseCase) UserLoginOtpSend(ctx context.Context, loginDetails request
 		return "", ErrUserBlocked
 	}
 
-	errChan := make(chan error, 2)
-	wait := sync.WaitGroup{}
-	wait.Add(2)
-
-	go func() {
-		defer wait.Done()
-		_, err := c.optAuth.SentOtp(countryCode + user.Phone)
-		if err != nil {
-			errChan <- fmt.Errorf("failed to send otp \nerrors:%v", err.Error())
-		}
-	}()
+	otpChannel := otp.Channel(loginDetails.OtpChannel)
+	recipient := otpRecipient(otpChannel, user.Phone, user.Email)
 	otpID := uuid.NewString()
 
-	go func() {
-		defer wait.Done()
-		otpSession := domain.OtpSession{
-			OtpID:    otpID,
-			UserID:   user.ID,
-			Phone:    user.Phone,
-			ExpireAt: time.Now().Add(otpExpireDuration), // 2 minutes expire for otp
-		}
-		err := c.authRepo.SaveOtpSession(ctx, otpSession)
-		if err != nil {
-			errChan <- fmt.Errorf("failed to save otp session \nerror:%v", err.Error())
-		}
-	}()
-
-	wait.Wait()
-	close(errChan)
-
-	for err := range errChan {
-		if err != nil {
-			return "", err
-		}
+	err = c.sendOtpOfSession(ctx, domain.OtpSession{
+		OtpID:     otpID,
+		UserID:    user.ID,
+		Phone:     user.Phone,
+		Channel:   string(otpChannel),
+		Recipient: recipient,
+		Purpose:   purpose,
+		ExpireAt:  time.Now().Add(otpExpireDuration), // 2 minutes expire for otp
+	})
+	if err != nil {
+		return "", err
 	}
 
 	return otpID, nil
@@ -167,16 +181,18 @@ func (c *authUseCase) LoginOtpVerify(ctx context.Context, otpVerifyDetails reque
 		return 0, utils.PrependMessageToError(err, "failed to find otp session from database")
 	}
 
+	// otp sent for another purpose can't use to login
+	if otpSession.Purpose != domain.OtpPurposeLogin {
+		return 0, ErrInvalidOtp
+	}
+
 	if time.Since(otpSession.ExpireAt) > 0 {
 		return 0, ErrOtpExpired
 	}
 
-	valid, err := c.optAuth.VerifyOtp(countryCode+otpSession.Phone, otpVerifyDetails.Otp)
+	err = c.verifyOtpWithinAttempts(ctx, otpSession, otpVerifyDetails.Otp, otpVerifyDetails.ClientIP)
 	if err != nil {
-		return 0, utils.PrependMessageToError(err, "failed to verify otp")
-	}
-	if !valid {
-		return 0, ErrInvalidOtp
+		return 0, err
 	}
 
 	return otpSession.UserID, nil
@@ -188,13 +204,21 @@ func (c *authUseCase) AdminLogin(ctx context.Context, loginDetails request.Login
 		admin domain.Admin
 		err   error
 	)
+	if loginDetails.Email == "" && loginDetails.UserName == "" {
+		return 0, ErrEmptyLoginCredentials
+	}
+
+	if loginDetails.ClientIP != "" {
+		if err = c.checkLoginLocked(ctx, ipLoginAttemptKey(token.Admin, loginDetails.ClientIP)); err != nil {
+			return 0, err
+		}
+	}
+
 	switch {
 	case loginDetails.Email != "":
 		admin, err = c.adminRepo.FindAdminByEmail(ctx, loginDetails.Email)
 	case loginDetails.UserName != "":
 		admin, err = c.adminRepo.FindAdminByUserName(ctx, loginDetails.UserName)
-	default:
-		return 0, ErrEmptyLoginCredentials
 	}
 
 	if err != nil {
@@ -202,30 +226,62 @@ func (c *authUseCase) AdminLogin(ctx context.Context, loginDetails request.Login
 	}
 
 	if admin.ID == 0 {
+		if loginDetails.ClientIP != "" {
+			err = c.saveFailedLoginAttempt(ctx, ipLoginAttemptKey(token.Admin, loginDetails.ClientIP), maxIPLoginAttempts)
+			if err != nil {
+				return 0, err
+			}
+		}
 		return 0, ErrUserNotExist
 	}
 
+	accountKey := accountLoginAttemptKey(token.Admin, admin.ID)
+	if err = c.checkLoginLocked(ctx, accountKey); err != nil {
+		return 0, err
+	}
+
+	if admin.BlockStatus {
+		return 0, ErrUserBlocked
+	}
+
 	err = utils.ComparePasswordWithHashedPassword(loginDetails.Password, admin.Password)
 	if err != nil {
+		if err = c.saveWrongPasswordAttempt(ctx, token.Admin, admin.ID, loginDetails.ClientIP); err != nil {
+			return 0, err
+		}
 		return 0, ErrWrongPassword
 	}
 
+	if err = c.authRepo.DeleteLoginAttempt(ctx, accountKey); err != nil {
+		return 0, utils.PrependMessageToError(err, "failed to clear failed login attempts")
+	}
+
 	return admin.ID, nil
 }
 
 func (c *authUseCase) GenerateAccessToken(ctx context.Context, tokenParams service.GenerateTokenParams) (string, error) {
 
 	tokenReq := token.GenerateTokenRequest{
-		UserID:   tokenParams.UserID,
-		UsedFor:  tokenParams.UserType,
-		ExpireAt: time.Now().Add(AccessTokenDuration),
+		UserID:    tokenParams.UserID,
+		UsedFor:   tokenParams.UserType,
+		ExpireAt:  time.Now().Add(AccessTokenDuration),
+		SessionID: tokenParams.SessionID,
+	}
+
+	// admin's access token carry the permissions of admin's role
+	if tokenParams.UserType == token.Admin {
+		permissions, err := c.adminRepo.FindAllPermissionsByAdminID(ctx, tokenParams.UserID)
+		if err != nil {
+			return "", utils.PrependMessageToError(err, "failed to find permissions of admin")
+		}
+		tokenReq.Permissions = permissions
 	}
 
 	tokenRes, err := c.tokenService.GenerateToken(tokenReq)
 
 	return tokenRes.TokenString, err
 }
-func (c *authUseCase) GenerateRefreshToken(ctx context.Context, tokenParams service.GenerateTokenParams) (string, error) {
+func (c *authUseCase) GenerateRefreshToken(ctx context.Context, tokenParams service.GenerateTokenParams) (domain.RefreshSession, error) {
 
 	expireAt := time.Now().Add(RefreshTokenDuration)
 	tokenReq := token.GenerateTokenRequest{
@@ -235,20 +291,32 @@ func (c *authUseCase) GenerateRefreshToken(ctx context.Context, tokenParams serv
 	}
 	tokenRes, err := c.tokenService.GenerateToken(tokenReq)
 	if err != nil {
-		return "", err
+		return domain.RefreshSession{}, err
+	}
+
+	// a new login start a new family of refresh sessions
+	familyID := tokenParams.FamilyID
+	if familyID == "" {
+		familyID = tokenRes.TokenID
 	}
 
-	err = c.authRepo.SaveRefreshSession(ctx, domain.RefreshSession{
+	refreshSession := domain.RefreshSession{
 		UserID:       tokenParams.UserID,
 		TokenID:      tokenRes.TokenID,
 		RefreshToken: tokenRes.TokenString,
 		ExpireAt:     expireAt,
-	})
+		FamilyID:     familyID,
+		UsedFor:      string(tokenParams.UserType),
+		UserAgent:    tokenParams.UserAgent,
+		ClientIP:     tokenParams.ClientIP,
+		CreatedAt:    time.Now(),
+	}
+	err = c.authRepo.SaveRefreshSession(ctx, refreshSession)
 	if err != nil {
-		return "", err
+		return domain.RefreshSession{}, err
 	}
 	log.Printf("successfully refresh token created and refresh session stored in database")
-	return tokenRes.TokenString, nil
+	return refreshSession, nil
 }
 
 func (c *authUseCase) VerifyAndGetRefreshTokenSession(ctx context.Context, refreshToken string, usedFor token.UserType) (domain.RefreshSession, error) {
@@ -279,10 +347,126 @@ func (c *authUseCase) VerifyAndGetRefreshTokenSession(ctx context.Context, refre
 		return domain.RefreshSession{}, ErrRefreshSessionBlocked
 	}
 
+	if refreshSession.IsUsed {
+		return domain.RefreshSession{}, c.revokeReusedRefreshSession(ctx, refreshSession)
+	}
+
 	return refreshSession, nil
 }
 
-func (c *authUseCase) UserSignUp(ctx context.Context, signUpDetails domain.User) (string, error) {
+// mark the refresh session as used and create a new refresh session on the same family
+func (c *authUseCase) RotateRefreshToken(ctx context.Context, refreshSession domain.RefreshSession,
+	tokenParams service.GenerateTokenParams) (domain.RefreshSession, error) {
+
+	marked, err := c.authRepo.MarkRefreshSessionUsed(ctx, refreshSession.TokenID)
+	if err != nil {
+		return domain.RefreshSession{}, utils.PrependMessageToError(err, "failed to mark refresh session as used")
+	}
+
+	// the refresh token used by another request
+	if !marked {
+		return domain.RefreshSession{}, c.revokeReusedRefreshSession(ctx, refreshSession)
+	}
+
+	// sessions created before rotation have no family
+	tokenParams.FamilyID = refreshSession.FamilyID
+	if tokenParams.FamilyID == "" {
+		tokenParams.FamilyID = refreshSession.TokenID
+	}
+
+	return c.GenerateRefreshToken(ctx, tokenParams)
+}
+
+// a used refresh token presented again means the token is stolen (either by the attacker or the user)
+// so revoke all sessions of the family and the user have to login again
+func (c *authUseCase) revokeReusedRefreshSession(ctx context.Context, refreshSession domain.RefreshSession) error {
+
+	log.Printf("refresh token reuse detected for %s %d on session %s revoking all sessions of the family %s",
+		refreshSession.UsedFor, refreshSession.UserID, refreshSession.TokenID, refreshSession.FamilyID)
+
+	revokeUntil := time.Now().Add(AccessTokenDuration)
+
+	_, err := c.authRepo.RevokeRefreshSession(ctx, refreshSession.UserID,
+		refreshSession.UsedFor, refreshSession.TokenID, revokeUntil)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to revoke reused refresh session family")
+	}
+
+	return ErrRefreshTokenReused
+}
+
+func (c *authUseCase) FindAllSessions(ctx context.Context, userID uint,
+	usedFor token.UserType) ([]response.Session, error) {
+
+	refreshSessions, err := c.authRepo.FindAllActiveRefreshSessions(ctx, userID, string(usedFor))
+	if err != nil {
+		return nil, utils.PrependMessageToError(err, "failed to find all refresh sessions")
+	}
+
+	sessions := make([]response.Session, len(refreshSessions))
+	for i, refreshSession := range refreshSessions {
+		sessions[i] = response.Session{
+			SessionID: refreshSession.TokenID,
+			UserAgent: refreshSession.UserAgent,
+			ClientIP:  refreshSession.ClientIP,
+			CreatedAt: refreshSession.CreatedAt,
+			ExpireAt:  refreshSession.ExpireAt,
+		}
+	}
+
+	return sessions, nil
+}
+
+// block the refresh session and revoke access tokens of the session
+// access tokens are valid only for AccessTokenDuration so revocation kept until that
+func (c *authUseCase) RevokeSession(ctx context.Context, userID uint,
+	usedFor token.UserType, sessionID string) error {
+
+	revokeUntil := time.Now().Add(AccessTokenDuration)
+
+	revoked, err := c.authRepo.RevokeRefreshSession(ctx, userID, string(usedFor), sessionID, revokeUntil)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to revoke refresh session")
+	}
+
+	if !revoked {
+		return ErrRefreshSessionNotExist
+	}
+
+	return nil
+}
+
+// block all refresh sessions and revoke access tokens of all sessions (logout from all devices)
+func (c *authUseCase) RevokeAllSessions(ctx context.Context, userID uint, usedFor token.UserType) error {
+
+	revokeUntil := time.Now().Add(AccessTokenDuration)
+
+	err := c.authRepo.RevokeAllRefreshSessions(ctx, userID, string(usedFor), revokeUntil)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to revoke all refresh sessions")
+	}
+
+	return nil
+}
+
+func (c *authUseCase) IsSessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+
+	// access tokens generated before sessions have no session and can't be revoked on logout
+	// so they are not accepted anymore (user should login again)
+	if sessionID == "" {
+		return true, nil
+	}
+
+	revoked, err := c.authRepo.IsTokenRevoked(ctx, sessionID)
+	if err != nil {
+		return false, utils.PrependMessageToError(err, "failed to check session revoked")
+	}
+
+	return revoked, nil
+}
+
+func (c *authUseCase) UserSignUp(ctx context.Context, signUpDetails domain.User,
+	otpChannel otp.Channel) (string, error) {
 
 	existUser, err := c.userRepo.FindUserByUserNameEmailOrPhoneNotID(ctx, signUpDetails)
 	if err != nil {
@@ -296,17 +480,10 @@ func (c *authUseCase) UserSignUp(ctx context.Context, signUpDetails domain.User)
 		return "", err
 	}
 
-	errChan := make(chan error, 2)
-	wait := sync.WaitGroup{}
-	wait.Add(2)
-
-	go func() {
-		defer wait.Done()
-		_, err := c.optAuth.SentOtp(countryCode + signUpDetails.Phone)
-		if err != nil {
-			errChan <- fmt.Errorf("failed to send otp \nerrors:%v", err.Error())
-		}
-	}()
+	// check the limits before saving the user, it's checked again with saving the otp session
+	if err = checkOtpSendLimit(ctx, c.authRepo, signUpDetails.Phone); err != nil {
+		return "", err
+	}
 
 	userID := existUser.ID
 
@@ -324,29 +501,20 @@ func (c *authUseCase) UserSignUp(ctx context.Context, signUpDetails domain.User)
 		}
 	}
 
+	recipient := otpRecipient(otpChannel, signUpDetails.Phone, signUpDetails.Email)
 	otpID := uuid.NewString()
 
-	go func() {
-		defer wait.Done()
-		otpSession := domain.OtpSession{
-			OtpID:    otpID,
-			UserID:   userID,
-			Phone:    signUpDetails.Phone,
-			ExpireAt: time.Now().Add(otpExpireDuration), // 2 minutes expire for otp
-		}
-		err := c.authRepo.SaveOtpSession(ctx, otpSession)
-		if err != nil {
-			errChan <- fmt.Errorf("failed to save otp session \nerror:%v", err.Error())
-		}
-	}()
-
-	wait.Wait()
-	close(errChan)
-
-	for err := range errChan {
-		if err != nil {
-			return "", err
-		}
+	err = c.sendOtpOfSession(ctx, domain.OtpSession{
+		OtpID:     otpID,
+		UserID:    userID,
+		Phone:     signUpDetails.Phone,
+		Channel:   string(otpChannel),
+		Recipient: recipient,
+		Purpose:   domain.OtpPurposeSignUp,
+		ExpireAt:  time.Now().Add(otpExpireDuration), // 2 minutes expire for otp
+	})
+	if err != nil {
+		return "", err
 	}
 
 	return otpID, nil
@@ -360,16 +528,18 @@ func (c *authUseCase) SingUpOtpVerify(ctx context.Context,
 		return 0, utils.PrependMessageToError(err, "failed to find otp session from database")
 	}
 
+	// otp sent for another purpose can't use to verify the sign up
+	if otpSession.Purpose != domain.OtpPurposeSignUp {
+		return 0, ErrInvalidOtp
+	}
+
 	if time.Since(otpSession.ExpireAt) > 0 {
 		return 0, ErrOtpExpired
 	}
 
-	valid, err := c.optAuth.VerifyOtp(countryCode+otpSession.Phone, otpVerifyDetails.Otp)
+	err = c.verifyOtpWithinAttempts(ctx, otpSession, otpVerifyDetails.Otp, otpVerifyDetails.ClientIP)
 	if err != nil {
-		return 0, utils.PrependMessageToError(err, "failed to verify otp")
-	}
-	if !valid {
-		return 0, ErrInvalidOtp
+		return 0, err
 	}
 
 	err = c.userRepo.UpdateVerified(ctx, otpSession.UserID)
@@ -402,3 +572,51 @@ func (c *authUseCase) GoogleLogin(ctx context.Context, user domain.User) (userID
 
 	return userID, nil
 }
+
+// the otp is sent to the email on email channel and to the phone number on sms channel
+func otpRecipient(channel otp.Channel, phone, email string) string {
+	if channel == otp.ChannelEmail {
+		return email
+	}
+	return phone
+}
+
+// save the otp session and send the otp to the recipient of the session on it's channel
+// session is saved before sending the otp so that the sent otp is counted on the send limits
+func (c *authUseCase) sendOtpOfSession(ctx context.Context, otpSession domain.OtpSession) error {
+
+	err := c.saveOtpSessionWithinLimit(ctx, otpSession)
+	if err != nil {
+		return err
+	}
+
+	verifyKey, err := c.otpChannels.Of(otp.Channel(otpSession.Channel)).SentOtp(otpSession.Recipient)
+	if err != nil {
+		return fmt.Errorf("failed to send otp \nerrors:%v", err.Error())
+	}
+
+	// each session keep the key of it's own otp so an otp sent later not affect the other sessions
+	err = c.authRepo.UpdateOtpSessionVerifyKey(ctx, otpSession.OtpID, verifyKey)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to save verify key of otp session")
+	}
+
+	return nil
+}
+
+// verify the otp with the key of the session on the same channel the otp sent
+// and use the session so the otp of the session can be verified only once
+func (c *authUseCase) verifyOtpOfSession(ctx context.Context, otpSession domain.OtpSession, code string) (bool, error) {
+
+	valid, err := c.otpChannels.Of(otp.Channel(otpSession.Channel)).VerifyOtp(otpSession.VerifyKey, code)
+	if err != nil || !valid {
+		return false, err
+	}
+
+	used, err := c.authRepo.UseOtpSession(ctx, otpSession.OtpID)
+	if err != nil {
+		return false, utils.PrependMessageToError(err, "failed to use otp session")
+	}
+
+	return used, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/mock/mockrepo"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/mock/mockservice"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/otp"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/token"
 	service "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
@@ -156,7 +157,14 @@ func TestUserLogin(t *testing.T) {
 			userMockRepo := mockrepo.NewMockUserRepository(ctl)
 			test.buildStub(userMockRepo, test.input)
 
-			authUseCase := NewAuthUseCase(nil, nil, userMockRepo, nil, nil)
+			// failed login attempts are tested on TestUserLoginAttempts
+			authMockRepo := mockrepo.NewMockAuthRepository(ctl)
+			authMockRepo.EXPECT().FindLoginAttempt(gomock.Any(), gomock.Any()).AnyTimes().Return(domain.LoginAttempt{}, nil)
+			authMockRepo.EXPECT().SaveFailedLoginAttempt(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				AnyTimes().Return(uint(1), nil)
+			authMockRepo.EXPECT().DeleteLoginAttempt(gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+
+			authUseCase := NewAuthUseCase(authMockRepo, nil, userMockRepo, nil, otp.Channels{})
 			actualOutput, actualError := authUseCase.UserLogin(context.Background(), test.input)
 
 			if test.expectedError != nil {
@@ -266,10 +274,10 @@ func TestGenerateRefreshToken(t *testing.T) {
 			test.buildStubAuthRepo(mockAuthRepo)
 			test.buildStubTokenService(mockTokenAuth)
 
-			authUseCase := NewAuthUseCase(mockAuthRepo, mockTokenAuth, nil, nil, nil)
-			tokenString, err := authUseCase.GenerateRefreshToken(context.Background(), test.inputField)
+			authUseCase := NewAuthUseCase(mockAuthRepo, mockTokenAuth, nil, nil, otp.Channels{})
+			refreshSession, err := authUseCase.GenerateRefreshToken(context.Background(), test.inputField)
 
-			test.checkOutput(t, tokenString, err)
+			test.checkOutput(t, refreshSession.RefreshToken, err)
 		})
 	}
 }
@@ -350,6 +358,24 @@ func TestVerifyAndGetRefreshTokenSession(t *testing.T) {
 			expectedOutput: domain.RefreshSession{},
 			expectedError:  ErrRefreshSessionExpired,
 		},
+		{
+			testName:     "UsedRefreshTokenShouldRevokeFamilyAndReturnError",
+			refreshToken: "usedRefreshToken",
+			buildStub: func(authMockRepo *mockrepo.MockAuthRepository, tokenMockAuth *mockservice.MockTokenService) {
+
+				tokenMockAuth.EXPECT().VerifyToken(token.VerifyTokenRequest{TokenString: "usedRefreshToken", UsedFor: tokenUser}).
+					Times(1).Return(token.VerifyTokenResponse{TokenID: "token_id", UserID: 12}, nil)
+				usedTokenSession := domain.RefreshSession{TokenID: "token_id", UserID: 12, IsUsed: true, FamilyID: "family_id",
+					UsedFor: string(tokenUser), ExpireAt: time.Now().Add(time.Hour * 2)}
+
+				authMockRepo.EXPECT().FindRefreshSessionByTokenID(gomock.Any(), "token_id").
+					Times(1).Return(usedTokenSession, nil)
+				authMockRepo.EXPECT().RevokeRefreshSession(gomock.Any(), uint(12), string(tokenUser), "token_id", gomock.Any()).
+					Times(1).Return(true, nil)
+			},
+			expectedOutput: domain.RefreshSession{},
+			expectedError:  ErrRefreshTokenReused,
+		},
 		{
 			testName:     "ValidExistingTokenIdShouldReturnRefreshSession",
 			refreshToken: "validExistingRefresh",
@@ -377,7 +403,7 @@ func TestVerifyAndGetRefreshTokenSession(t *testing.T) {
 			authMockRepo := mockrepo.NewMockAuthRepository(ctl)
 			tokenService := mockservice.NewMockTokenService(ctl)
 
-			authUseCase := NewAuthUseCase(authMockRepo, tokenService, nil, nil, nil)
+			authUseCase := NewAuthUseCase(authMockRepo, tokenService, nil, nil, otp.Channels{})
 
 			test.buildStub(authMockRepo, tokenService)
 
@@ -393,3 +419,162 @@ func TestVerifyAndGetRefreshTokenSession(t *testing.T) {
 		})
 	}
 }
+
+func TestRevokeSession(t *testing.T) {
+
+	tests := []struct {
+		testName      string
+		sessionID     string
+		buildStub     func(authMockRepo *mockrepo.MockAuthRepository)
+		expectedError error
+	}{
+		{
+			testName:  "NotExistingSessionShouldReturnError",
+			sessionID: "no_existing_token_id",
+			buildStub: func(authMockRepo *mockrepo.MockAuthRepository) {
+				authMockRepo.EXPECT().RevokeRefreshSession(gomock.Any(), uint(1), string(token.User), "no_existing_token_id", gomock.Any()).
+					Times(1).Return(false, nil)
+			},
+			expectedError: ErrRefreshSessionNotExist,
+		},
+		{
+			testName:  "ExistingSessionShouldRevokeUntilAccessTokenExpire",
+			sessionID: "token_id",
+			buildStub: func(authMockRepo *mockrepo.MockAuthRepository) {
+				authMockRepo.EXPECT().RevokeRefreshSession(gomock.Any(), uint(1), string(token.User), "token_id", gomock.Any()).
+					Times(1).DoAndReturn(func(_ context.Context, _ uint, _, _ string, revokeUntil time.Time) (bool, error) {
+					assert.WithinDuration(t, time.Now().Add(AccessTokenDuration), revokeUntil, time.Minute)
+					return true, nil
+				})
+			},
+			expectedError: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			authMockRepo := mockrepo.NewMockAuthRepository(ctl)
+			test.buildStub(authMockRepo)
+
+			authUseCase := NewAuthUseCase(authMockRepo, nil, nil, nil, otp.Channels{})
+
+			err := authUseCase.RevokeSession(context.Background(), 1, token.User, test.sessionID)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func TestIsSessionRevoked(t *testing.T) {
+
+	tests := []struct {
+		testName        string
+		sessionID       string
+		buildStub       func(authMockRepo *mockrepo.MockAuthRepository)
+		expectedRevoked bool
+	}{
+		{
+			testName:        "AccessTokenWithoutSessionShouldNotAccept",
+			sessionID:       "",
+			buildStub:       func(authMockRepo *mockrepo.MockAuthRepository) {},
+			expectedRevoked: true,
+		},
+		{
+			testName:  "RevokedSessionShouldReturnRevoked",
+			sessionID: "token_id",
+			buildStub: func(authMockRepo *mockrepo.MockAuthRepository) {
+				authMockRepo.EXPECT().IsTokenRevoked(gomock.Any(), "token_id").Times(1).Return(true, nil)
+			},
+			expectedRevoked: true,
+		},
+		{
+			testName:  "ActiveSessionShouldReturnNotRevoked",
+			sessionID: "token_id",
+			buildStub: func(authMockRepo *mockrepo.MockAuthRepository) {
+				authMockRepo.EXPECT().IsTokenRevoked(gomock.Any(), "token_id").Times(1).Return(false, nil)
+			},
+			expectedRevoked: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			authMockRepo := mockrepo.NewMockAuthRepository(ctl)
+			test.buildStub(authMockRepo)
+
+			authUseCase := NewAuthUseCase(authMockRepo, nil, nil, nil, otp.Channels{})
+
+			revoked, err := authUseCase.IsSessionRevoked(context.Background(), test.sessionID)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedRevoked, revoked)
+		})
+	}
+}
+
+func TestRotateRefreshToken(t *testing.T) {
+
+	refreshSession := domain.RefreshSession{TokenID: "token_id", UserID: 1, FamilyID: "family_id", UsedFor: string(token.User)}
+
+	tests := []struct {
+		testName              string
+		buildStubAuthRepo     func(authMockRepo *mockrepo.MockAuthRepository)
+		buildStubTokenService func(tokenService *mockservice.MockTokenService)
+		expectedFamilyID      string
+		expectedError         error
+	}{
+		{
+			testName: "AlreadyUsedRefreshSessionShouldRevokeFamilyAndReturnError",
+			buildStubAuthRepo: func(authMockRepo *mockrepo.MockAuthRepository) {
+				authMockRepo.EXPECT().MarkRefreshSessionUsed(gomock.Any(), "token_id").
+					Times(1).Return(false, nil)
+				authMockRepo.EXPECT().RevokeRefreshSession(gomock.Any(), uint(1), string(token.User), "token_id", gomock.Any()).
+					Times(1).Return(true, nil)
+			},
+			buildStubTokenService: func(tokenService *mockservice.MockTokenService) {
+				// not expecting a new token
+			},
+			expectedError: ErrRefreshTokenReused,
+		},
+		{
+			testName: "NotUsedRefreshSessionShouldCreateNewSessionOnSameFamily",
+			buildStubAuthRepo: func(authMockRepo *mockrepo.MockAuthRepository) {
+				authMockRepo.EXPECT().MarkRefreshSessionUsed(gomock.Any(), "token_id").
+					Times(1).Return(true, nil)
+				authMockRepo.EXPECT().SaveRefreshSession(gomock.Any(), gomock.Any()).
+					Times(1).Return(nil)
+			},
+			buildStubTokenService: func(tokenService *mockservice.MockTokenService) {
+				tokenService.EXPECT().GenerateToken(gomock.Any()).
+					Times(1).Return(token.GenerateTokenResponse{TokenID: "new_token_id", TokenString: "new_token"}, nil)
+			},
+			expectedFamilyID: "family_id",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			authMockRepo := mockrepo.NewMockAuthRepository(ctl)
+			tokenService := mockservice.NewMockTokenService(ctl)
+			test.buildStubAuthRepo(authMockRepo)
+			test.buildStubTokenService(tokenService)
+
+			authUseCase := NewAuthUseCase(authMockRepo, tokenService, nil, nil, otp.Channels{})
+
+			newRefreshSession, err := authUseCase.RotateRefreshToken(context.Background(), refreshSession,
+				service.GenerateTokenParams{UserID: 1, UserType: token.User})
+
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "new_token_id", newRefreshSession.TokenID)
+			assert.Equal(t, test.expectedFamilyID, newRefreshSession.FamilyID)
+		})
+	}
+}
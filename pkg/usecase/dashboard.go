@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+)
+
+// To find the kpi tiles and time series of the period for admin dashboard
+func (c *adminUseCase) FindDashboard(ctx context.Context, reqData request.SalesReport) (response.Dashboard, error) {
+
+	if reqData.Bucket == "" {
+		reqData.Bucket = request.SalesBucketDay
+	}
+	switch reqData.Bucket {
+	case request.SalesBucketDay, request.SalesBucketWeek, request.SalesBucketMonth:
+	default:
+		return response.Dashboard{}, ErrInvalidSalesReportBucket
+	}
+	if reqData.EndDate.Before(reqData.StartDate) {
+		return response.Dashboard{}, ErrInvalidDashboardPeriod
+	}
+
+	stats, err := c.adminRepo.FindDashboardStats(ctx, reqData)
+	if err != nil {
+		return response.Dashboard{}, utils.PrependMessageToError(err, "failed to find dashboard stats")
+	}
+
+	dashboard := response.Dashboard{
+		StartDate:      reqData.StartDate,
+		EndDate:        reqData.EndDate,
+		Bucket:         reqData.Bucket,
+		Revenue:        stats.Revenue,
+		OrderCount:     stats.OrderCount,
+		NewUsers:       stats.NewUsers,
+		PendingReturns: stats.PendingReturns,
+		LowStockCount:  stats.LowStockCount,
+	}
+	if stats.OrderCount != 0 {
+		dashboard.AverageOrderValue = float64(stats.Revenue) / float64(stats.OrderCount)
+	}
+	if stats.CreatedOrders != 0 {
+		dashboard.PaymentConversionRate = float64(stats.PlacedOrders) * 100 / float64(stats.CreatedOrders)
+	}
+
+	dashboard.TimeSeries, err = c.adminRepo.FindAllDashboardPoints(ctx, reqData)
+	if err != nil {
+		return response.Dashboard{}, utils.PrependMessageToError(err, "failed to find dashboard time series")
+	}
+
+	return dashboard, nil
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/mock/mockrepo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindDashboard(t *testing.T) {
+
+	startDate := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		testName       string
+		reqData        request.SalesReport
+		buildStub      func(adminRepo *mockrepo.MockAdminRepository)
+		expectedOutput response.Dashboard
+		expectedError  error
+	}{
+		{
+			testName:      "EndDateBeforeStartDateShouldReturnError",
+			reqData:       request.SalesReport{StartDate: endDate, EndDate: startDate},
+			buildStub:     func(adminRepo *mockrepo.MockAdminRepository) {},
+			expectedError: ErrInvalidDashboardPeriod,
+		},
+		{
+			testName: "ShouldFindAverageOrderValueAndConversion",
+			reqData:  request.SalesReport{StartDate: startDate, EndDate: endDate},
+			buildStub: func(adminRepo *mockrepo.MockAdminRepository) {
+				reqData := request.SalesReport{StartDate: startDate, EndDate: endDate, Bucket: request.SalesBucketDay}
+				adminRepo.EXPECT().FindDashboardStats(gomock.Any(), reqData).Times(1).
+					Return(response.DashboardStats{
+						Revenue:        3000,
+						OrderCount:     4,
+						NewUsers:       2,
+						PendingReturns: 1,
+						LowStockCount:  3,
+						CreatedOrders:  5,
+						PlacedOrders:   4,
+					}, nil)
+				adminRepo.EXPECT().FindAllDashboardPoints(gomock.Any(), reqData).Times(1).
+					Return([]response.DashboardPoint{{Period: startDate, Revenue: 3000, OrderCount: 4, NewUsers: 2}}, nil)
+			},
+			expectedOutput: response.Dashboard{
+				StartDate:             startDate,
+				EndDate:               endDate,
+				Bucket:                request.SalesBucketDay,
+				Revenue:               3000,
+				OrderCount:            4,
+				AverageOrderValue:     750,
+				NewUsers:              2,
+				PendingReturns:        1,
+				LowStockCount:         3,
+				PaymentConversionRate: 80,
+				TimeSeries:            []response.DashboardPoint{{Period: startDate, Revenue: 3000, OrderCount: 4, NewUsers: 2}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+
+			ctl := gomock.NewController(t)
+			adminRepo := mockrepo.NewMockAdminRepository(ctl)
+			test.buildStub(adminRepo)
+
+			adminUseCase := NewAdminUseCase(adminRepo, nil, nil, nil)
+
+			dashboard, err := adminUseCase.FindDashboard(context.Background(), test.reqData)
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedOutput, dashboard)
+		})
+	}
+}
@@ -9,15 +9,19 @@ var (
 	ErrUserNotVerified       = errors.New("user not verified")
 	ErrUserBlocked           = errors.New("user blocked by admin")
 	ErrWrongPassword         = errors.New("password doesn't match")
+	ErrLoginLocked           = errors.New("login temporarily locked due to too many failed attempts")
 	// otp
-	ErrOtpExpired = errors.New("otp session expired")
-	ErrInvalidOtp = errors.New("invalid otp")
+	ErrOtpExpired           = errors.New("otp session expired")
+	ErrInvalidOtp           = errors.New("invalid otp")
+	ErrOtpResendCooldown    = errors.New("otp already sent recently")
+	ErrOtpDailyLimitReached = errors.New("otp send limit reached for today")
 
 	// refresh token
 	ErrInvalidRefreshToken    = errors.New("invalid refresh token")
 	ErrRefreshSessionNotExist = errors.New("there is no refresh token session for this token")
 	ErrRefreshSessionExpired  = errors.New("refresh token expired in session")
 	ErrRefreshSessionBlocked  = errors.New("refresh token blocked in session")
+	ErrRefreshTokenReused     = errors.New("refresh token already used all sessions of the login revoked")
 
 	// signup
 	ErrUserAlreadyExit = errors.New("user already exist")
@@ -34,6 +38,17 @@ var (
 	// admin
 	ErrSameBlockStatus = errors.New("user block status already in given status")
 
+	// staff
+	ErrAdminAlreadyExist = errors.New("an admin already exist with this email or user_name")
+	ErrInvalidRoleID     = errors.New("invalid role id")
+	ErrStaffNotExist     = errors.New("staff not exist with given id")
+	ErrStaffSelfModify   = errors.New("can't update or delete own staff account")
+
+	ErrInvalidStaffPasswordToken = errors.New("invalid or expired password token")
+
+	// audit log
+	ErrInvalidAuditLogPeriod = errors.New("end date should be after start date")
+
 	//category
 	ErrCategoryAlreadyExist = errors.New("category already exist")
 
@@ -47,6 +62,21 @@ var (
 	// product item
 	ErrProductItemAlreadyExist = errors.New("product item already exist with this configuration")
 	ErrNotEnoughVariations     = errors.New("not enough variation options for this product select one variation option from each variation")
+	ErrProductItemNotExist     = errors.New("product item not exist with given id for this product")
+
+	// review
+	ErrReviewNotAllowed   = errors.New("only users with a delivered order of the product can review it")
+	ErrReviewAlreadyExist = errors.New("user already reviewed this product")
+	ErrInvalidReviewID    = errors.New("invalid review id")
+
+	// question and answer
+	ErrInvalidProductID  = errors.New("invalid product id")
+	ErrInvalidQuestionID = errors.New("invalid question id")
+	ErrInvalidAnswerID   = errors.New("invalid answer id")
+	ErrAnswerNotAllowed  = errors.New("only admins and users with a delivered order of the product can answer")
+
+	// product import
+	ErrInvalidProductImportFile = errors.New("invalid product import csv file")
 
 	// offer
 	ErrOfferNameAlreadyExist = errors.New("offer already exist this name")
@@ -57,7 +87,26 @@ var (
 	ErrProductOfferAlreadyExist  = errors.New("an offer already exist for this product")
 
 	// order
-	ErrOutOfStockOnCart = errors.New("cart is not valid for order out of stock is in cart")
+	ErrOutOfStockOnCart    = errors.New("cart is not valid for order out of stock is in cart")
+	ErrInvalidShopOrderID  = errors.New("invalid shop order id")
+	ErrOrderNotCancellable = errors.New("order can't cancel on current order status")
+
+	ErrInvalidOrderStatusTransition = errors.New("order status can't change to the given status")
+	ErrOrderStatusChanged           = errors.New("order status changed by another request")
+
+	// shipping
+	ErrInvalidAddressID         = errors.New("invalid address id")
+	ErrUnserviceablePincode     = errors.New("delivery is not available to the address pincode")
+	ErrShippingZoneAlreadyExist = errors.New("shipping zone already exist with this name")
+	ErrInvalidShippingZoneID    = errors.New("invalid shipping zone id")
+
+	// tax
+	ErrTaxClassAlreadyExist = errors.New("tax class already exist with this name")
+	ErrInvalidTaxClassID    = errors.New("invalid tax class id")
+	ErrInvalidCategoryID    = errors.New("invalid category id")
+
+	// invoice
+	ErrInvoiceNotAvailable = errors.New("invoice is only available after the order is placed")
 
 	// wish list
 	ErrExistWishListProductItem = errors.New("product item already exist on wish list")
@@ -66,6 +115,28 @@ var (
 	ErrBlockedPayment          = errors.New("selected payment is blocked by admin")
 	ErrPaymentAmountReachedMax = errors.New("order total price reached payment method maximum amount")
 	ErrPaymentNotApproved      = errors.New("payment not approved")
+	ErrOrderAlreadyCancelled   = errors.New("order already cancelled")
+	ErrOrderNotPaymentPending  = errors.New("order is not waiting for payment")
+	ErrPaymentNotForOrder      = errors.New("payment is not made for this order")
+	ErrPaymentAmountMismatch   = errors.New("paid amount is not matching with the amount to pay")
+
+	// payment webhook
+	ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+	ErrPaymentEventNotExist    = errors.New("payment event not exist")
+
+	// sales report
+	ErrInvalidSalesReportFormat = errors.New("invalid sales report format")
+	ErrInvalidSalesReportBucket = errors.New("invalid sales report bucket")
+	ErrInvalidDashboardPeriod   = errors.New("end date should be after the start date")
+
+	// stock
+	ErrInvalidSKU      = errors.New("invalid sku")
+	ErrNotEnoughStock  = errors.New("not enough stock on product item")
+	ErrZeroStockChange = errors.New("stock change quantity can't be zero")
+
+	// wallet
+	ErrInsufficientWalletBalance = errors.New("wallet balance is not enough for this payment")
+	ErrWalletCoversOrderAmount   = errors.New("wallet balance covers full order amount use wallet payment instead")
 
 	// brand
 	ErrBrandAlreadyExist = errors.New("brand name already exist")
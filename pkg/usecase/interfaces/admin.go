@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"io"
 
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
@@ -14,7 +15,22 @@ type AdminUseCase interface {
 	FindAllUser(ctx context.Context, pagination request.Pagination) (users []response.User, err error)
 	BlockOrUnBlockUser(ctx context.Context, blockDetails request.BlockUser) error
 
-	GetFullSalesReport(ctx context.Context, requestData request.SalesReport) (salesReport []response.SalesReport, err error)
+	FindSalesSummary(ctx context.Context, reqData request.SalesReport) (response.SalesSummary, error)
+	ExportSalesReport(ctx context.Context, reqData request.SalesReport, file io.Writer) error
+
+	FindDashboard(ctx context.Context, reqData request.SalesReport) (response.Dashboard, error)
+
+	// staff
+	FindAllRoles(ctx context.Context) ([]response.Role, error)
+	FindAllStaff(ctx context.Context, pagination request.Pagination) ([]response.Staff, error)
+	InviteStaff(ctx context.Context, reqData request.StaffInvite) (adminID uint, err error)
+	SetStaffPassword(ctx context.Context, reqData request.StaffSetPassword) error
+	UpdateStaff(ctx context.Context, currentAdminID, adminID uint, reqData request.StaffUpdate) error
+	DeleteStaff(ctx context.Context, currentAdminID, adminID uint) error
+
+	// audit log
+	SaveAuditLog(ctx context.Context, auditLog domain.AdminAuditLog) error
+	FindAllAuditLogs(ctx context.Context, filter request.AuditLogFilter) ([]response.AuditLog, error)
 }
 
 // GetCategory(ctx context.Context) (helper.Category, any)
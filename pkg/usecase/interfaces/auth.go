@@ -4,29 +4,49 @@ import (
 	"context"
 
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/otp"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/token"
 )
 
 //go:generate mockgen -destination=../../mock/mockusecase/auth_mock.go -package=mockusecase . AuthUseCase
 type AuthUseCase interface {
 	//user
-	UserSignUp(ctx context.Context, signUpDetails domain.User) (otpID string, err error)
+	UserSignUp(ctx context.Context, signUpDetails domain.User, otpChannel otp.Channel) (otpID string, err error)
 	SingUpOtpVerify(ctx context.Context, otpVerifyDetails request.OTPVerify) (userID uint, err error)
 	GoogleLogin(ctx context.Context, user domain.User) (userID uint, err error)
 	UserLogin(ctx context.Context, loginDetails request.Login) (userID uint, err error)
 	UserLoginOtpSend(ctx context.Context, loginDetails request.OTPLogin) (otpID string, err error)
 	LoginOtpVerify(ctx context.Context, otpVerifyDetails request.OTPVerify) (userID uint, err error)
 
+	// password
+	ForgotPasswordOtpSend(ctx context.Context, reqData request.OTPLogin) (otpID string, err error)
+	ResetPassword(ctx context.Context, reqData request.ResetPassword) error
+	ChangePassword(ctx context.Context, userID uint, reqData request.ChangePassword) error
+
 	// admin
 	AdminLogin(ctx context.Context, loginDetails request.Login) (adminID uint, err error)
 	// token
 	GenerateAccessToken(ctx context.Context, tokenParams GenerateTokenParams) (tokenString string, err error)
-	GenerateRefreshToken(ctx context.Context, tokenParams GenerateTokenParams) (tokenString string, err error)
+	GenerateRefreshToken(ctx context.Context, tokenParams GenerateTokenParams) (refreshSession domain.RefreshSession, err error)
 	VerifyAndGetRefreshTokenSession(ctx context.Context, refreshToken string, usedFor token.UserType) (domain.RefreshSession, error)
+	RotateRefreshToken(ctx context.Context, refreshSession domain.RefreshSession, tokenParams GenerateTokenParams) (domain.RefreshSession, error)
+
+	// session
+	FindAllSessions(ctx context.Context, userID uint, usedFor token.UserType) ([]response.Session, error)
+	RevokeSession(ctx context.Context, userID uint, usedFor token.UserType, sessionID string) error
+	RevokeAllSessions(ctx context.Context, userID uint, usedFor token.UserType) error
+	IsSessionRevoked(ctx context.Context, sessionID string) (revoked bool, err error)
 }
 
 type GenerateTokenParams struct {
-	UserID   uint
-	UserType token.UserType
+	UserID    uint
+	UserType  token.UserType
+	SessionID string // refresh session of the access token
+	FamilyID  string // refresh session family of the rotated refresh token
+
+	// device details for refresh session
+	UserAgent string
+	ClientIP  string
 }
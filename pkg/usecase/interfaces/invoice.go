@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+)
+
+type InvoiceUseCase interface {
+	FindUserOrderInvoice(ctx context.Context, userID, shopOrderID uint) (response.Invoice, error)
+	FindOrderInvoice(ctx context.Context, shopOrderID uint) (response.Invoice, error)
+}
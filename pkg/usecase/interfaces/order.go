@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
@@ -20,14 +21,18 @@ type OrderUseCase interface {
 
 	// cancel order and change order status
 	FindAllOrderStatuses(ctx context.Context) (orderStatuses []domain.OrderStatus, err error)
-	UpdateOrderStatus(ctx context.Context, shopOrderID, changeStatusID uint) error
-	CancelOrder(ctx context.Context, shopOrderID uint) error
+	UpdateOrderStatus(ctx context.Context, adminID uint, updateDetails request.UpdateOrder) error
+	CancelOrder(ctx context.Context, userID, shopOrderID uint) error
+	CancelOrderAdmin(ctx context.Context, adminID uint, cancelDetails request.CancelOrder) error
+	FindOrderTimeline(ctx context.Context, shopOrderID uint) ([]response.OrderStatusHistory, error)
+	FindUserOrderTimeline(ctx context.Context, userID, shopOrderID uint) ([]response.OrderStatusHistory, error)
+	ReleaseStalePaymentPendingOrders(ctx context.Context, ttl time.Duration) (releasedCount int, err error)
 
 	// return and update
-	SubmitReturnRequest(ctx context.Context, returnDetails request.Return) error
+	SubmitReturnRequest(ctx context.Context, userID uint, returnDetails request.Return) error
 	FindAllPendingOrderReturns(ctx context.Context, pagination request.Pagination) ([]response.OrderReturn, error)
 	FindAllOrderReturns(ctx context.Context, pagination request.Pagination) ([]response.OrderReturn, error)
-	UpdateReturnDetails(ctx context.Context, updateDetails request.UpdateOrderReturn) error
+	UpdateReturnDetails(ctx context.Context, adminID uint, updateDetails request.UpdateOrderReturn) error
 
 	// wallet
 	FindUserWallet(ctx context.Context, userID uint) (wallet domain.Wallet, err error)
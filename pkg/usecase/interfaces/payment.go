@@ -12,13 +12,22 @@ type PaymentUseCase interface {
 	FindAllPaymentMethods(ctx context.Context) ([]domain.PaymentMethod, error)
 	FindPaymentMethodByID(ctx context.Context, paymentMethodID uint) (domain.PaymentMethod, error)
 	UpdatePaymentMethod(ctx context.Context, paymentMethod request.PaymentMethodUpdate) error
+	FindPaymentOrderSummary(ctx context.Context, userID, shopOrderID uint) (response.PaymentOrderSummary, error)
 
 	// razorpay
-	MakeRazorpayOrder(ctx context.Context, userID, shopOrderID uint) (razorpayOrder response.RazorpayOrder, err error)
-	VerifyRazorPay(ctx context.Context, verifyReq request.RazorpayVerify) error
+	MakeRazorpayOrder(ctx context.Context, userID, shopOrderID uint, useWallet bool) (razorpayOrder response.RazorpayOrder, err error)
+	VerifyRazorPay(ctx context.Context, userID, shopOrderID uint, verifyReq request.RazorpayVerify) error
 	// stipe
-	MakeStripeOrder(ctx context.Context, userID, shopOrderID uint) (stipeOrder response.StripeOrder, err error)
-	VerifyStripOrder(ctx context.Context, stripePaymentID string) error
+	MakeStripeOrder(ctx context.Context, userID, shopOrderID uint, useWallet bool) (stipeOrder response.StripeOrder, err error)
+	VerifyStripOrder(ctx context.Context, userID, shopOrderID uint, stripePaymentID string) error
+	// wallet
+	MakeWalletPayment(ctx context.Context, userID, shopOrderID uint) error
 
 	ApproveShopOrderAndClearCart(ctx context.Context, userID uint, approveDetails request.ApproveOrder) error
+
+	// webhooks
+	HandleStripeWebhook(ctx context.Context, payload []byte, signature string) error
+	HandleRazorpayWebhook(ctx context.Context, payload []byte, signature, eventID string) error
+	FindAllPaymentEvents(ctx context.Context, pagination request.Pagination) ([]domain.PaymentEvent, error)
+	ReplayPaymentEvent(ctx context.Context, paymentEventID uint) error
 }
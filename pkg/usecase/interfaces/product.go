@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"io"
 
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
@@ -21,9 +22,15 @@ type ProductUseCase interface {
 
 	// products
 	FindAllProducts(ctx context.Context, pagination request.Pagination) (products []response.Product, err error)
+	SearchProducts(ctx context.Context, search request.ProductSearch) (response.ProductSearch, error)
 	SaveProduct(ctx context.Context, product request.Product) error
 	UpdateProduct(ctx context.Context, product domain.Product) error
 
-	SaveProductItem(ctx context.Context, productID uint, productItem request.ProductItem) error
+	SaveProductItem(ctx context.Context, adminID, productID uint, productItem request.ProductItem) error
+	UpdateProductItem(ctx context.Context, productID, productItemID uint, updateDetails request.UpdateProductItem) error
 	FindAllProductItems(ctx context.Context, productID uint) ([]response.ProductItems, error)
+
+	// import and export
+	ImportProducts(ctx context.Context, adminID uint, file io.Reader, dryRun bool) (response.ProductImport, error)
+	ExportProducts(ctx context.Context, file io.Writer) error
 }
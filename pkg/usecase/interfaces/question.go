@@ -0,0 +1,28 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+)
+
+type QuestionUseCase interface {
+	SaveProductQuestion(ctx context.Context, userID, productID uint, question request.ProductQuestion) error
+	FindAllProductQuestionsUser(ctx context.Context, productID uint,
+		pagination request.Pagination) ([]response.ProductQuestion, error)
+	FindAllProductAnswersUser(ctx context.Context, productID, questionID uint,
+		pagination request.Pagination) ([]response.ProductAnswer, error)
+	SaveProductAnswerUser(ctx context.Context, userID, productID, questionID uint, answer request.ProductAnswer) error
+
+	UpvoteProductAnswer(ctx context.Context, userID, productID, questionID, answerID uint) error
+	RemoveProductAnswerUpvote(ctx context.Context, userID, productID, questionID, answerID uint) error
+
+	// admin and moderation
+	FindAllProductQuestionsAdmin(ctx context.Context, filter request.QuestionFilter) ([]response.ProductQuestion, error)
+	FindAllProductAnswersAdmin(ctx context.Context, questionID uint,
+		pagination request.Pagination) ([]response.ProductAnswer, error)
+	SaveProductAnswerAdmin(ctx context.Context, adminID, questionID uint, answer request.ProductAnswer) error
+	UpdateProductQuestionVisibility(ctx context.Context, questionID uint, isHidden bool) error
+	UpdateProductAnswerVisibility(ctx context.Context, answerID uint, isHidden bool) error
+}
@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+)
+
+type ReviewUseCase interface {
+	SaveProductReview(ctx context.Context, userID, productID uint, review request.ProductReview) error
+	FindAllApprovedProductReviews(ctx context.Context, productID uint,
+		pagination request.Pagination) ([]response.ProductReview, error)
+
+	// moderation
+	FindAllProductReviews(ctx context.Context, filter request.ReviewFilter) ([]response.ProductReview, error)
+	ApproveProductReview(ctx context.Context, reviewID uint) error
+	HideProductReview(ctx context.Context, reviewID uint) error
+}
@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+)
+
+type ShippingUseCase interface {
+	SaveShippingZone(ctx context.Context, zone request.ShippingZone) (zoneID uint, err error)
+	FindAllShippingZones(ctx context.Context, pagination request.Pagination) ([]domain.ShippingZone, error)
+	UpdateShippingZone(ctx context.Context, zoneID uint, zone request.ShippingZone) error
+	DeleteShippingZone(ctx context.Context, zoneID uint) error
+}
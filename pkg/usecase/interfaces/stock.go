@@ -5,9 +5,14 @@ import (
 
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
 )
 
 type StockUseCase interface {
 	GetAllStockDetails(ctx context.Context, pagination request.Pagination) (stocks []response.Stock, err error)
-	UpdateStockBySKU(ctx context.Context, updateDetails request.UpdateStock) error
+	UpdateStockBySKU(ctx context.Context, adminID uint, updateDetails request.UpdateStock) error
+	FindAllStockMovementsBySKU(ctx context.Context, sku string, pagination request.Pagination) ([]domain.StockMovement, error)
+	FindAllLowStocks(ctx context.Context, pagination request.Pagination) ([]response.Stock, error)
+	UpdateReorderThresholdBySKU(ctx context.Context, sku string, reorderThreshold uint) error
+	SendLowStockDigest(ctx context.Context) (int, error)
 }
@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+)
+
+type TaxUseCase interface {
+	SaveTaxClass(ctx context.Context, taxClass request.TaxClass) (taxClassID uint, err error)
+	FindAllTaxClasses(ctx context.Context, pagination request.Pagination) ([]domain.TaxClass, error)
+	UpdateTaxClass(ctx context.Context, taxClassID uint, taxClass request.TaxClass) error
+
+	UpdateCategoryTaxClass(ctx context.Context, categoryID, taxClassID uint) error
+	UpdateProductTaxClass(ctx context.Context, productID, taxClassID uint) error
+}
@@ -0,0 +1,268 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/cloud"
+	service "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+)
+
+const (
+	invoiceDateFormat  = "02 Jan 2006"
+	invoiceContentType = "application/pdf"
+)
+
+// columns of order items on invoice
+var invoiceItemHeader = []string{
+	"Product", "Qty", "Price", "Taxable Amount", "GST Rate",
+	"CGST", "SGST", "IGST", "Amount",
+}
+
+type invoiceUseCase struct {
+	invoiceRepo  interfaces.InvoiceRepository
+	orderRepo    interfaces.OrderRepository
+	cloudService cloud.CloudService
+}
+
+func NewInvoiceUseCase(invoiceRepo interfaces.InvoiceRepository, orderRepo interfaces.OrderRepository,
+	cloudService cloud.CloudService) service.InvoiceUseCase {
+	return &invoiceUseCase{
+		invoiceRepo:  invoiceRepo,
+		orderRepo:    orderRepo,
+		cloudService: cloudService,
+	}
+}
+
+func (c *invoiceUseCase) FindUserOrderInvoice(ctx context.Context, userID, shopOrderID uint) (response.Invoice, error) {
+
+	shopOrder, err := c.orderRepo.FindShopOrderByShopOrderID(ctx, shopOrderID)
+	if err != nil {
+		return response.Invoice{}, utils.PrependMessageToError(err, "failed to find shop order")
+	}
+	if shopOrder.ID == 0 || shopOrder.UserID != userID {
+		return response.Invoice{}, ErrInvalidShopOrderID
+	}
+
+	return c.findInvoice(ctx, shopOrder.ID)
+}
+
+func (c *invoiceUseCase) FindOrderInvoice(ctx context.Context, shopOrderID uint) (response.Invoice, error) {
+
+	shopOrder, err := c.orderRepo.FindShopOrderByShopOrderID(ctx, shopOrderID)
+	if err != nil {
+		return response.Invoice{}, utils.PrependMessageToError(err, "failed to find shop order")
+	}
+	if shopOrder.ID == 0 {
+		return response.Invoice{}, ErrInvalidShopOrderID
+	}
+
+	return c.findInvoice(ctx, shopOrder.ID)
+}
+
+// To find the invoice of shop order (invoice number is given when the order is placed)
+// the pdf is stored on cloud for re-download and if it's not stored the pdf is generated again
+func (c *invoiceUseCase) findInvoice(ctx context.Context, shopOrderID uint) (response.Invoice, error) {
+
+	invoice, err := c.invoiceRepo.FindInvoiceByShopOrderID(ctx, shopOrderID)
+	if err != nil {
+		return response.Invoice{}, utils.PrependMessageToError(err, "failed to find invoice of shop order")
+	}
+
+	// orders placed before invoice numbers were given on approval get their invoice number here
+	if invoice.ID == 0 {
+		placed, err := isOrderPlaced(ctx, c.orderRepo, shopOrderID)
+		if err != nil {
+			return response.Invoice{}, err
+		}
+		if !placed {
+			return response.Invoice{}, ErrInvoiceNotAvailable
+		}
+
+		err = c.orderRepo.Transaction(func(trxRepo interfaces.OrderRepository) error {
+			invoice, err = trxRepo.SaveInvoice(ctx, shopOrderID)
+			return err
+		})
+		if err != nil {
+			return response.Invoice{}, utils.PrependMessageToError(err, "failed to save invoice of shop order")
+		}
+	}
+
+	invoiceDetails := response.Invoice{
+		InvoiceNumber: formatInvoiceNumber(invoice.InvoiceNumber),
+	}
+	invoiceDetails.FileName = invoiceDetails.InvoiceNumber + ".pdf"
+
+	if invoice.FileKey != "" {
+		url, err := c.cloudService.GetFileUrl(ctx, invoice.FileKey)
+		if err == nil {
+			invoiceDetails.FileUrl = url
+			return invoiceDetails, nil
+		}
+		log.Printf("failed to get url of invoice pdf with invoice_id %v: %v", invoice.ID, err)
+	}
+
+	invoiceOrder, err := c.invoiceRepo.FindInvoiceOrder(ctx, shopOrderID)
+	if err != nil {
+		return response.Invoice{}, utils.PrependMessageToError(err, "failed to find order details of invoice")
+	}
+
+	orderItems, err := findAllOrderItems(ctx, c.orderRepo, shopOrderID)
+	if err != nil {
+		return response.Invoice{}, err
+	}
+
+	var file bytes.Buffer
+	if err := writeInvoicePDF(invoice, invoiceOrder, orderItems, &file); err != nil {
+		return response.Invoice{}, err
+	}
+	invoiceDetails.File = file.Bytes()
+
+	// invoice is already generated so failing to store it is only logged
+	if invoice.FileKey == "" {
+		fileKey, err := c.cloudService.SaveFileContent(ctx, invoiceDetails.File, invoiceContentType)
+		if err != nil {
+			log.Printf("failed to store invoice pdf with invoice_id %v: %v", invoice.ID, err)
+			return invoiceDetails, nil
+		}
+		if err := c.invoiceRepo.UpdateInvoiceFileKey(ctx, invoice.ID, fileKey); err != nil {
+			log.Printf("failed to save file key of invoice pdf with invoice_id %v: %v", invoice.ID, err)
+		}
+	}
+
+	return invoiceDetails, nil
+}
+
+// To check the shop order is reached order placed status (order may be in any later status now)
+func isOrderPlaced(ctx context.Context, orderRepo interfaces.OrderRepository, shopOrderID uint) (bool, error) {
+
+	histories, err := orderRepo.FindAllShopOrderStatusHistories(ctx, shopOrderID)
+	if err != nil {
+		return false, utils.PrependMessageToError(err, "failed to find order status histories")
+	}
+
+	for _, history := range histories {
+		if history.OrderStatus == string(domain.StatusOrderPlaced) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func formatInvoiceNumber(invoiceNumber uint) string {
+	return fmt.Sprintf("INV-%06d", invoiceNumber)
+}
+
+func writeInvoicePDF(invoice domain.Invoice, order response.InvoiceOrder,
+	orderItems []response.OrderItem, file io.Writer) error {
+
+	uintString := func(value uint) string { return strconv.FormatUint(uint64(value), 10) }
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(10, 10, 10)
+	pdf.SetAutoPageBreak(true, 10)
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.CellFormat(0, 10, "Tax Invoice", "", 1, "C", false, 0, "")
+	pdf.Ln(4)
+
+	invoiceRows := [][]string{
+		{"Invoice Number", formatInvoiceNumber(invoice.InvoiceNumber)},
+		{"Invoice Date", invoice.CreatedAt.Format(invoiceDateFormat)},
+		{"Order ID", uintString(order.ShopOrderID)},
+		{"Order Date", order.OrderDate.Format(invoiceDateFormat)},
+		{"Payment Method", order.PaymentMethodName},
+	}
+	if order.CouponCode != "" {
+		invoiceRows = append(invoiceRows, []string{"Coupon", order.CouponCode})
+	}
+	writePDFDetails(pdf, "Invoice Details", invoiceRows)
+
+	var address []string
+	for _, value := range []string{order.House, order.Area, order.LandMark} {
+		if value != "" {
+			address = append(address, value)
+		}
+	}
+	addressRows := [][]string{
+		{"Name", order.AddressName},
+		{"Phone", order.PhoneNumber},
+		{"Email", order.Email},
+		{"Address", strings.Join(address, ", ")},
+		{"City", fmt.Sprintf("%s - %d", order.City, order.Pincode)},
+	}
+	if order.State != "" {
+		addressRows = append(addressRows, []string{"State", order.State})
+	}
+	addressRows = append(addressRows, []string{"Country", order.CountryName})
+	writePDFDetails(pdf, "Billed To", addressRows)
+
+	var (
+		subTotal, totalTax uint
+		itemRows           [][]string
+	)
+	for _, item := range orderItems {
+		subTotal += item.SubTotal
+		totalTax += item.CGST + item.SGST + item.IGST
+
+		itemRows = append(itemRows, []string{
+			item.ProductName,
+			uintString(item.Qty),
+			uintString(item.Price),
+			uintString(item.TaxableAmount),
+			strconv.FormatFloat(item.TaxRate, 'f', -1, 64) + "%",
+			uintString(item.CGST),
+			uintString(item.SGST),
+			uintString(item.IGST),
+			uintString(item.SubTotal),
+		})
+	}
+	writePDFTable(pdf, "Items", invoiceItemHeader, itemRows)
+
+	totalRows := [][]string{{"Sub Total", uintString(subTotal)}}
+	if order.Discount != 0 {
+		totalRows = append(totalRows, []string{"Discount", "-" + uintString(order.Discount)})
+	}
+	if order.DeliveryCharge != 0 {
+		totalRows = append(totalRows, []string{"Delivery Charge", uintString(order.DeliveryCharge)})
+	}
+	totalRows = append(totalRows, []string{"Order Total", uintString(order.OrderTotalPrice)})
+	if order.WalletAmount != 0 {
+		totalRows = append(totalRows, []string{"Paid From Wallet", uintString(order.WalletAmount)})
+	}
+	totalRows = append(totalRows, []string{"GST Included", uintString(totalTax)})
+	writePDFDetails(pdf, "Totals", totalRows)
+
+	pdf.SetFont("Helvetica", "", 8)
+	pdf.CellFormat(0, 6, "All prices are inclusive of GST", "", 1, "L", false, 0, "")
+
+	if err := pdf.Output(file); err != nil {
+		return utils.PrependMessageToError(err, "failed to write invoice on pdf")
+	}
+
+	return nil
+}
+
+// To write a titled table of label and value rows on pdf
+func writePDFDetails(pdf *fpdf.Fpdf, title string, rows [][]string) {
+
+	pdf.SetFont("Helvetica", "B", 12)
+	pdf.CellFormat(0, 8, title, "", 1, "L", false, 0, "")
+
+	for _, row := range rows {
+		writePDFTableRow(pdf, row, false)
+	}
+	pdf.Ln(4)
+}
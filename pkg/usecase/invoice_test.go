@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/mock/mockrepo"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/mock/mockservice"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	"github.com/stretchr/testify/assert"
+)
+
+var errInvoiceTest = errors.New("failed to save invoice")
+
+func TestFindUserOrderInvoice(t *testing.T) {
+
+	const (
+		userID      uint = 1
+		shopOrderID uint = 10
+	)
+
+	shopOrder := domain.ShopOrder{ID: shopOrderID, UserID: userID}
+	placedHistories := []response.OrderStatusHistory{
+		{OrderStatus: string(domain.StatusPaymentPending)},
+		{OrderStatus: string(domain.StatusOrderPlaced)},
+		{OrderStatus: string(domain.StatusOrderShipped)},
+	}
+
+	type mocks struct {
+		invoiceRepo  *mockrepo.MockInvoiceRepository
+		orderRepo    *mockrepo.MockOrderRepository
+		cloudService *mockservice.MockCloudService
+	}
+
+	tests := []struct {
+		testName              string
+		buildStub             func(m mocks)
+		expectedInvoiceNumber string
+		expectedFileUrl       string
+		expectPDF             bool
+		expectedError         error
+	}{
+		{
+			testName: "OrderOfOtherUserShouldReturnError",
+			buildStub: func(m mocks) {
+				m.orderRepo.EXPECT().FindShopOrderByShopOrderID(gomock.Any(), shopOrderID).Times(1).
+					Return(domain.ShopOrder{ID: shopOrderID, UserID: userID + 1}, nil)
+			},
+			expectedError: ErrInvalidShopOrderID,
+		},
+		{
+			testName: "NotPlacedOrderShouldReturnError",
+			buildStub: func(m mocks) {
+				m.orderRepo.EXPECT().FindShopOrderByShopOrderID(gomock.Any(), shopOrderID).Times(1).Return(shopOrder, nil)
+				m.invoiceRepo.EXPECT().FindInvoiceByShopOrderID(gomock.Any(), shopOrderID).Times(1).
+					Return(domain.Invoice{}, nil)
+				m.orderRepo.EXPECT().FindAllShopOrderStatusHistories(gomock.Any(), shopOrderID).Times(1).
+					Return([]response.OrderStatusHistory{{OrderStatus: string(domain.StatusPaymentPending)}}, nil)
+			},
+			expectedError: ErrInvoiceNotAvailable,
+		},
+		{
+			testName: "FailedToSaveInvoiceShouldReturnError",
+			buildStub: func(m mocks) {
+				m.orderRepo.EXPECT().FindShopOrderByShopOrderID(gomock.Any(), shopOrderID).Times(1).Return(shopOrder, nil)
+				m.invoiceRepo.EXPECT().FindInvoiceByShopOrderID(gomock.Any(), shopOrderID).Times(1).
+					Return(domain.Invoice{}, nil)
+				m.orderRepo.EXPECT().FindAllShopOrderStatusHistories(gomock.Any(), shopOrderID).Times(1).
+					Return(placedHistories, nil)
+				// the invoice number taken on failed transaction is rolled back with it
+				m.orderRepo.EXPECT().Transaction(gomock.Any()).Times(1).
+					DoAndReturn(func(callBack func(trxRepo interfaces.OrderRepository) error) error {
+						return callBack(m.orderRepo)
+					})
+				m.orderRepo.EXPECT().SaveInvoice(gomock.Any(), shopOrderID).Times(1).
+					Return(domain.Invoice{}, errInvoiceTest)
+			},
+			expectedError: errInvoiceTest,
+		},
+		{
+			testName: "StoredInvoiceShouldReturnFileUrl",
+			buildStub: func(m mocks) {
+				m.orderRepo.EXPECT().FindShopOrderByShopOrderID(gomock.Any(), shopOrderID).Times(1).Return(shopOrder, nil)
+				m.invoiceRepo.EXPECT().FindInvoiceByShopOrderID(gomock.Any(), shopOrderID).Times(1).
+					Return(domain.Invoice{ID: 1, ShopOrderID: shopOrderID, InvoiceNumber: 3, FileKey: "invoice-key"}, nil)
+				m.cloudService.EXPECT().GetFileUrl(gomock.Any(), "invoice-key").Times(1).
+					Return("https://cloud/invoice-key", nil)
+			},
+			expectedInvoiceNumber: "INV-000003",
+			expectedFileUrl:       "https://cloud/invoice-key",
+		},
+		{
+			testName: "FirstInvoiceShouldGeneratePDFAndStoreIt",
+			buildStub: func(m mocks) {
+				invoice := domain.Invoice{ID: 2, ShopOrderID: shopOrderID, InvoiceNumber: 7, CreatedAt: time.Now()}
+
+				m.orderRepo.EXPECT().FindShopOrderByShopOrderID(gomock.Any(), shopOrderID).Times(1).Return(shopOrder, nil)
+				m.invoiceRepo.EXPECT().FindInvoiceByShopOrderID(gomock.Any(), shopOrderID).Times(1).
+					Return(domain.Invoice{}, nil)
+				m.orderRepo.EXPECT().FindAllShopOrderStatusHistories(gomock.Any(), shopOrderID).Times(1).
+					Return(placedHistories, nil)
+				// invoice number is taken on a transaction with saving the invoice
+				m.orderRepo.EXPECT().Transaction(gomock.Any()).Times(1).
+					DoAndReturn(func(callBack func(trxRepo interfaces.OrderRepository) error) error {
+						return callBack(m.orderRepo)
+					})
+				m.orderRepo.EXPECT().SaveInvoice(gomock.Any(), shopOrderID).Times(1).Return(invoice, nil)
+				m.invoiceRepo.EXPECT().FindInvoiceOrder(gomock.Any(), shopOrderID).Times(1).
+					Return(response.InvoiceOrder{
+						ShopOrderID: shopOrderID, OrderDate: time.Now(), OrderTotalPrice: 950, Discount: 100,
+						DeliveryCharge: 50, PaymentMethodName: "cod", CouponCode: "SAVE10",
+						AddressName: "user", City: "Kochi", Pincode: 682001, State: "Kerala", CountryName: "India",
+					}, nil)
+				m.orderRepo.EXPECT().FindAllOrdersItemsByShopOrderID(gomock.Any(), shopOrderID, gomock.Any()).Times(1).
+					Return([]response.OrderItem{{
+						ProductName: "shirt", Price: 500, Qty: 2, SubTotal: 1000,
+						TaxRate: 12, TaxableAmount: 804, CGST: 48, SGST: 48,
+					}}, nil)
+				m.cloudService.EXPECT().SaveFileContent(gomock.Any(), gomock.Any(), "application/pdf").Times(1).
+					Return("invoice-key", nil)
+				m.invoiceRepo.EXPECT().UpdateInvoiceFileKey(gomock.Any(), invoice.ID, "invoice-key").Times(1).Return(nil)
+			},
+			expectedInvoiceNumber: "INV-000007",
+			expectPDF:             true,
+		},
+		{
+			testName: "FailedToStoreInvoiceShouldStillReturnPDF",
+			buildStub: func(m mocks) {
+				invoice := domain.Invoice{ID: 2, ShopOrderID: shopOrderID, InvoiceNumber: 7, CreatedAt: time.Now()}
+
+				m.orderRepo.EXPECT().FindShopOrderByShopOrderID(gomock.Any(), shopOrderID).Times(1).Return(shopOrder, nil)
+				m.invoiceRepo.EXPECT().FindInvoiceByShopOrderID(gomock.Any(), shopOrderID).Times(1).Return(invoice, nil)
+				m.invoiceRepo.EXPECT().FindInvoiceOrder(gomock.Any(), shopOrderID).Times(1).
+					Return(response.InvoiceOrder{ShopOrderID: shopOrderID, OrderTotalPrice: 1000}, nil)
+				m.orderRepo.EXPECT().FindAllOrdersItemsByShopOrderID(gomock.Any(), shopOrderID, gomock.Any()).Times(1).
+					Return([]response.OrderItem{{ProductName: "shirt", Price: 500, Qty: 2, SubTotal: 1000}}, nil)
+				m.cloudService.EXPECT().SaveFileContent(gomock.Any(), gomock.Any(), "application/pdf").Times(1).
+					Return("", errors.New("failed to upload file"))
+			},
+			expectedInvoiceNumber: "INV-000007",
+			expectPDF:             true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+			t.Parallel()
+
+			ctl := gomock.NewController(t)
+			m := mocks{
+				invoiceRepo:  mockrepo.NewMockInvoiceRepository(ctl),
+				orderRepo:    mockrepo.NewMockOrderRepository(ctl),
+				cloudService: mockservice.NewMockCloudService(ctl),
+			}
+			test.buildStub(m)
+
+			invoiceUseCase := NewInvoiceUseCase(m.invoiceRepo, m.orderRepo, m.cloudService)
+
+			invoice, err := invoiceUseCase.FindUserOrderInvoice(context.Background(), userID, shopOrderID)
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedInvoiceNumber, invoice.InvoiceNumber)
+			assert.Equal(t, test.expectedInvoiceNumber+".pdf", invoice.FileName)
+			assert.Equal(t, test.expectedFileUrl, invoice.FileUrl)
+			assert.Equal(t, test.expectPDF, bytes.HasPrefix(invoice.File, []byte("%PDF")))
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/token"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+)
+
+const (
+	// failed attempts allowed before lock (ip limit is higher because many users can share an ip)
+	maxAccountLoginAttempts = 5
+	maxIPLoginAttempts      = 20
+
+	// lock duration doubles on each failed attempt after the limit
+	loginLockBaseDuration = time.Minute
+	loginLockMaxDuration  = time.Hour * 24
+	// failed count restarts when there is no failed attempt on this duration
+	loginAttemptResetDuration = time.Hour * 24
+
+	// otp send limits of a phone number
+	otpResendCooldown = time.Minute
+	otpDailyLimit     = 5
+)
+
+func accountLoginAttemptKey(usedFor token.UserType, accountID uint) string {
+	return fmt.Sprintf("%s:account:%d", usedFor, accountID)
+}
+
+func ipLoginAttemptKey(usedFor token.UserType, clientIP string) string {
+	return fmt.Sprintf("%s:ip:%s", usedFor, clientIP)
+}
+
+// return ErrLoginLocked if any of the key is locked now
+func (c *authUseCase) checkLoginLocked(ctx context.Context, keys ...string) error {
+
+	for _, key := range keys {
+		loginAttempt, err := c.authRepo.FindLoginAttempt(ctx, key)
+		if err != nil {
+			return utils.PrependMessageToError(err, "failed to find login attempts")
+		}
+
+		if lockedFor := time.Until(loginAttempt.LockedUntil); lockedFor > 0 {
+			return utils.AppendMessageToError(ErrLoginLocked,
+				fmt.Sprintf("try again after %s", lockedFor.Round(time.Second)))
+		}
+	}
+
+	return nil
+}
+
+// save the failed attempt of the key and lock it when the failed count reach the max attempts
+func (c *authUseCase) saveFailedLoginAttempt(ctx context.Context, key string, maxAttempts uint) error {
+
+	now := time.Now()
+
+	failedCount, err := c.authRepo.SaveFailedLoginAttempt(ctx, key, now, now.Add(-loginAttemptResetDuration))
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to save failed login attempt")
+	}
+
+	if failedCount < maxAttempts {
+		return nil
+	}
+
+	err = c.authRepo.LockLoginAttempt(ctx, key, now.Add(loginLockDuration(failedCount-maxAttempts)))
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to lock login")
+	}
+
+	return nil
+}
+
+// lock duration of the nth failed attempt after the limit (exponential backoff)
+func loginLockDuration(exceededCount uint) time.Duration {
+
+	lockDuration := loginLockBaseDuration
+	for i := uint(0); i < exceededCount && lockDuration < loginLockMaxDuration; i++ {
+		lockDuration *= 2
+	}
+
+	if lockDuration > loginLockMaxDuration {
+		return loginLockMaxDuration
+	}
+	return lockDuration
+}
+
+// save failed attempt of the account and ip of a wrong password
+func (c *authUseCase) saveWrongPasswordAttempt(ctx context.Context, usedFor token.UserType,
+	accountID uint, clientIP string) error {
+
+	err := c.saveFailedLoginAttempt(ctx, accountLoginAttemptKey(usedFor, accountID), maxAccountLoginAttempts)
+	if err != nil {
+		return err
+	}
+
+	if clientIP == "" {
+		return nil
+	}
+	return c.saveFailedLoginAttempt(ctx, ipLoginAttemptKey(usedFor, clientIP), maxIPLoginAttempts)
+}
+
+// verify the otp of the session with the same failed attempt limits of the password login
+// so that the otp of an account can't be guessed by trying all the codes
+func (c *authUseCase) verifyOtpWithinAttempts(ctx context.Context, otpSession domain.OtpSession,
+	code, clientIP string) error {
+
+	accountKey := accountLoginAttemptKey(token.User, otpSession.UserID)
+	lockKeys := []string{accountKey}
+	if clientIP != "" {
+		lockKeys = append(lockKeys, ipLoginAttemptKey(token.User, clientIP))
+	}
+	if err := c.checkLoginLocked(ctx, lockKeys...); err != nil {
+		return err
+	}
+
+	valid, err := c.verifyOtpOfSession(ctx, otpSession, code)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to verify otp")
+	}
+	if !valid {
+		if err = c.saveWrongPasswordAttempt(ctx, token.User, otpSession.UserID, clientIP); err != nil {
+			return err
+		}
+		return ErrInvalidOtp
+	}
+
+	// failed attempts of the account are cleared on successful verification
+	if err = c.authRepo.DeleteLoginAttempt(ctx, accountKey); err != nil {
+		return utils.PrependMessageToError(err, "failed to clear failed login attempts")
+	}
+
+	return nil
+}
+
+// save the otp session when the otp send limits of the phone allows it, the limits are checked and the session
+// saved on a transaction locked for the phone so that concurrent requests can't send over the limits
+func (c *authUseCase) saveOtpSessionWithinLimit(ctx context.Context, otpSession domain.OtpSession) error {
+
+	return c.authRepo.Transaction(func(trxRepo interfaces.AuthRepository) error {
+
+		err := trxRepo.LockOtpSendsOfPhone(ctx, otpSession.Phone)
+		if err != nil {
+			return utils.PrependMessageToError(err, "failed to lock otp sends of phone")
+		}
+
+		if err = checkOtpSendLimit(ctx, trxRepo, otpSession.Phone); err != nil {
+			return err
+		}
+
+		err = trxRepo.SaveOtpSession(ctx, otpSession)
+		if err != nil {
+			return utils.PrependMessageToError(err, "failed to save otp session")
+		}
+
+		return nil
+	})
+}
+
+// check the otp resend cooldown and daily limit of the phone number
+func checkOtpSendLimit(ctx context.Context, authRepo interfaces.AuthRepository, phone string) error {
+
+	count, lastSentAt, err := authRepo.FindOtpSendStats(ctx, phone, time.Now().Add(-time.Hour*24))
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find otp send details")
+	}
+
+	if count >= otpDailyLimit {
+		return ErrOtpDailyLimitReached
+	}
+
+	if waitFor := otpResendCooldown - time.Since(lastSentAt); count > 0 && waitFor > 0 {
+		return utils.AppendMessageToError(ErrOtpResendCooldown,
+			fmt.Sprintf("try again after %s", waitFor.Round(time.Second)))
+	}
+
+	return nil
+}
@@ -0,0 +1,394 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/mock/mockrepo"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/otp"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserLoginAttempts(t *testing.T) {
+
+	var (
+		userID     uint = 1
+		clientIP        = "10.0.0.1"
+		accountKey      = "user:account:1"
+		ipKey           = "user:ip:10.0.0.1"
+	)
+
+	password := "password"
+	hashedPassword, err := utils.GetHashedPassword(password)
+	assert.NoError(t, err)
+
+	user := domain.User{ID: userID, Email: "user@gmail.com", Password: hashedPassword, Verified: true}
+
+	tests := []struct {
+		testName      string
+		input         request.Login
+		buildStub     func(userRepo *mockrepo.MockUserRepository, authRepo *mockrepo.MockAuthRepository)
+		expectedError error
+	}{
+		{
+			testName: "LockedIPShouldReturnErrorWithoutFindingUser",
+			input:    request.Login{Email: user.Email, Password: password, ClientIP: clientIP},
+			buildStub: func(userRepo *mockrepo.MockUserRepository, authRepo *mockrepo.MockAuthRepository) {
+				authRepo.EXPECT().FindLoginAttempt(gomock.Any(), ipKey).Times(1).
+					Return(domain.LoginAttempt{Key: ipKey, LockedUntil: time.Now().Add(time.Minute)}, nil)
+			},
+			expectedError: ErrLoginLocked,
+		},
+		{
+			testName: "LockedAccountShouldReturnErrorEvenWithCorrectPassword",
+			input:    request.Login{Email: user.Email, Password: password, ClientIP: clientIP},
+			buildStub: func(userRepo *mockrepo.MockUserRepository, authRepo *mockrepo.MockAuthRepository) {
+				authRepo.EXPECT().FindLoginAttempt(gomock.Any(), ipKey).Times(1).Return(domain.LoginAttempt{}, nil)
+				userRepo.EXPECT().FindUserByEmail(gomock.Any(), user.Email).Times(1).Return(user, nil)
+				authRepo.EXPECT().FindLoginAttempt(gomock.Any(), accountKey).Times(1).
+					Return(domain.LoginAttempt{Key: accountKey, LockedUntil: time.Now().Add(time.Minute)}, nil)
+			},
+			expectedError: ErrLoginLocked,
+		},
+		{
+			testName: "WrongPasswordReachingMaxAttemptsShouldLockAccount",
+			input:    request.Login{Email: user.Email, Password: "wrongPassword", ClientIP: clientIP},
+			buildStub: func(userRepo *mockrepo.MockUserRepository, authRepo *mockrepo.MockAuthRepository) {
+				authRepo.EXPECT().FindLoginAttempt(gomock.Any(), ipKey).Times(1).Return(domain.LoginAttempt{}, nil)
+				userRepo.EXPECT().FindUserByEmail(gomock.Any(), user.Email).Times(1).Return(user, nil)
+				authRepo.EXPECT().FindLoginAttempt(gomock.Any(), accountKey).Times(1).Return(domain.LoginAttempt{}, nil)
+
+				authRepo.EXPECT().SaveFailedLoginAttempt(gomock.Any(), accountKey, gomock.Any(), gomock.Any()).
+					Times(1).Return(uint(maxAccountLoginAttempts), nil)
+				authRepo.EXPECT().LockLoginAttempt(gomock.Any(), accountKey, gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, key string, lockedUntil time.Time) error {
+						assert.WithinDuration(t, time.Now().Add(loginLockBaseDuration), lockedUntil, time.Second)
+						return nil
+					})
+				authRepo.EXPECT().SaveFailedLoginAttempt(gomock.Any(), ipKey, gomock.Any(), gomock.Any()).
+					Times(1).Return(uint(1), nil)
+			},
+			expectedError: ErrWrongPassword,
+		},
+		{
+			testName: "NotExistUserShouldSaveFailedAttemptOfIP",
+			input:    request.Login{Email: "notexist@gmail.com", Password: password, ClientIP: clientIP},
+			buildStub: func(userRepo *mockrepo.MockUserRepository, authRepo *mockrepo.MockAuthRepository) {
+				authRepo.EXPECT().FindLoginAttempt(gomock.Any(), ipKey).Times(1).Return(domain.LoginAttempt{}, nil)
+				userRepo.EXPECT().FindUserByEmail(gomock.Any(), "notexist@gmail.com").Times(1).Return(domain.User{}, nil)
+				authRepo.EXPECT().SaveFailedLoginAttempt(gomock.Any(), ipKey, gomock.Any(), gomock.Any()).
+					Times(1).Return(uint(1), nil)
+			},
+			expectedError: ErrUserNotExist,
+		},
+		{
+			testName: "SuccessfulLoginShouldClearFailedAttemptsOfAccount",
+			input:    request.Login{Email: user.Email, Password: password, ClientIP: clientIP},
+			buildStub: func(userRepo *mockrepo.MockUserRepository, authRepo *mockrepo.MockAuthRepository) {
+				authRepo.EXPECT().FindLoginAttempt(gomock.Any(), ipKey).Times(1).Return(domain.LoginAttempt{}, nil)
+				userRepo.EXPECT().FindUserByEmail(gomock.Any(), user.Email).Times(1).Return(user, nil)
+				authRepo.EXPECT().FindLoginAttempt(gomock.Any(), accountKey).Times(1).
+					Return(domain.LoginAttempt{Key: accountKey, FailedCount: 3, LockedUntil: time.Now().Add(-time.Minute)}, nil)
+				authRepo.EXPECT().DeleteLoginAttempt(gomock.Any(), accountKey).Times(1).Return(nil)
+			},
+			expectedError: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+
+			ctl := gomock.NewController(t)
+			userRepo := mockrepo.NewMockUserRepository(ctl)
+			authRepo := mockrepo.NewMockAuthRepository(ctl)
+			test.buildStub(userRepo, authRepo)
+
+			authUseCase := NewAuthUseCase(authRepo, nil, userRepo, nil, otp.Channels{})
+
+			_, err := authUseCase.UserLogin(context.Background(), test.input)
+			assert.True(t, errors.Is(err, test.expectedError), "expected error %v got %v", test.expectedError, err)
+		})
+	}
+}
+
+// otp auth which accept only the given code of the given verify key
+type stubOtpAuth struct {
+	verifyKey string
+	code      string
+}
+
+func (c stubOtpAuth) SentOtp(to string) (string, error) {
+	return c.verifyKey, nil
+}
+
+func (c stubOtpAuth) VerifyOtp(verifyKey string, code string) (bool, error) {
+	return verifyKey == c.verifyKey && code == c.code, nil
+}
+
+func TestOtpVerifyAttempts(t *testing.T) {
+
+	var (
+		userID     uint = 1
+		clientIP        = "10.0.0.1"
+		accountKey      = "user:account:1"
+		ipKey           = "user:ip:10.0.0.1"
+		validOtp        = "123456"
+		verifyKey       = "verify_key"
+	)
+
+	otpChannels := otp.Channels{SMS: stubOtpAuth{verifyKey: verifyKey, code: validOtp}}
+
+	loginSession := domain.OtpSession{OtpID: "login_otp_id", UserID: userID, Channel: string(otp.ChannelSMS),
+		Purpose: domain.OtpPurposeLogin, VerifyKey: verifyKey, ExpireAt: time.Now().Add(time.Minute)}
+	resetSession := domain.OtpSession{OtpID: "reset_otp_id", UserID: userID, Channel: string(otp.ChannelSMS),
+		Purpose: domain.OtpPurposeResetPassword, VerifyKey: verifyKey, ExpireAt: time.Now().Add(time.Minute)}
+
+	// verify the otp of the session with login or reset password according to the purpose of the session
+	verifyOtp := func(authUseCase *authUseCase, otpSession domain.OtpSession, code string) error {
+		if otpSession.Purpose == domain.OtpPurposeResetPassword {
+			return authUseCase.ResetPassword(context.Background(), request.ResetPassword{OtpID: otpSession.OtpID,
+				Otp: code, Password: "newPassword", ConfirmPassword: "newPassword", ClientIP: clientIP})
+		}
+		_, err := authUseCase.LoginOtpVerify(context.Background(),
+			request.OTPVerify{OtpID: otpSession.OtpID, Otp: code, ClientIP: clientIP})
+		return err
+	}
+
+	tests := []struct {
+		testName      string
+		otpSession    domain.OtpSession
+		otp           string
+		buildStub     func(authRepo *mockrepo.MockAuthRepository)
+		expectedError error
+	}{
+		{
+			testName:   "LockedAccountShouldReturnErrorEvenWithCorrectLoginOtp",
+			otpSession: loginSession,
+			otp:        validOtp,
+			buildStub: func(authRepo *mockrepo.MockAuthRepository) {
+				authRepo.EXPECT().FindLoginAttempt(gomock.Any(), accountKey).Times(1).
+					Return(domain.LoginAttempt{Key: accountKey, LockedUntil: time.Now().Add(time.Minute)}, nil)
+			},
+			expectedError: ErrLoginLocked,
+		},
+		{
+			testName:   "LockedIPShouldReturnErrorEvenWithCorrectResetPasswordOtp",
+			otpSession: resetSession,
+			otp:        validOtp,
+			buildStub: func(authRepo *mockrepo.MockAuthRepository) {
+				authRepo.EXPECT().FindLoginAttempt(gomock.Any(), accountKey).Times(1).Return(domain.LoginAttempt{}, nil)
+				authRepo.EXPECT().FindLoginAttempt(gomock.Any(), ipKey).Times(1).
+					Return(domain.LoginAttempt{Key: ipKey, LockedUntil: time.Now().Add(time.Minute)}, nil)
+			},
+			expectedError: ErrLoginLocked,
+		},
+		{
+			testName:   "WrongLoginOtpReachingMaxAttemptsShouldLockAccount",
+			otpSession: loginSession,
+			otp:        "654321",
+			buildStub: func(authRepo *mockrepo.MockAuthRepository) {
+				authRepo.EXPECT().FindLoginAttempt(gomock.Any(), gomock.Any()).Times(2).Return(domain.LoginAttempt{}, nil)
+				authRepo.EXPECT().SaveFailedLoginAttempt(gomock.Any(), accountKey, gomock.Any(), gomock.Any()).
+					Times(1).Return(uint(maxAccountLoginAttempts), nil)
+				authRepo.EXPECT().LockLoginAttempt(gomock.Any(), accountKey, gomock.Any()).Times(1).Return(nil)
+				authRepo.EXPECT().SaveFailedLoginAttempt(gomock.Any(), ipKey, gomock.Any(), gomock.Any()).
+					Times(1).Return(uint(1), nil)
+			},
+			expectedError: ErrInvalidOtp,
+		},
+		{
+			testName:   "WrongResetPasswordOtpShouldSaveFailedAttempts",
+			otpSession: resetSession,
+			otp:        "654321",
+			buildStub: func(authRepo *mockrepo.MockAuthRepository) {
+				authRepo.EXPECT().FindLoginAttempt(gomock.Any(), gomock.Any()).Times(2).Return(domain.LoginAttempt{}, nil)
+				authRepo.EXPECT().SaveFailedLoginAttempt(gomock.Any(), accountKey, gomock.Any(), gomock.Any()).
+					Times(1).Return(uint(1), nil)
+				authRepo.EXPECT().SaveFailedLoginAttempt(gomock.Any(), ipKey, gomock.Any(), gomock.Any()).
+					Times(1).Return(uint(1), nil)
+			},
+			expectedError: ErrInvalidOtp,
+		},
+		{
+			testName:   "OtpOfUsedSessionShouldReturnError",
+			otpSession: loginSession,
+			otp:        validOtp,
+			buildStub: func(authRepo *mockrepo.MockAuthRepository) {
+				authRepo.EXPECT().FindLoginAttempt(gomock.Any(), gomock.Any()).Times(2).Return(domain.LoginAttempt{}, nil)
+				// the session is used by a concurrent request after it's found
+				authRepo.EXPECT().UseOtpSession(gomock.Any(), loginSession.OtpID).Times(1).Return(false, nil)
+				authRepo.EXPECT().SaveFailedLoginAttempt(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(2).Return(uint(1), nil)
+			},
+			expectedError: ErrInvalidOtp,
+		},
+		{
+			testName:   "CorrectLoginOtpShouldUseSessionAndClearFailedAttemptsOfAccount",
+			otpSession: loginSession,
+			otp:        validOtp,
+			buildStub: func(authRepo *mockrepo.MockAuthRepository) {
+				authRepo.EXPECT().FindLoginAttempt(gomock.Any(), gomock.Any()).Times(2).Return(domain.LoginAttempt{}, nil)
+				authRepo.EXPECT().UseOtpSession(gomock.Any(), loginSession.OtpID).Times(1).Return(true, nil)
+				authRepo.EXPECT().DeleteLoginAttempt(gomock.Any(), accountKey).Times(1).Return(nil)
+			},
+			expectedError: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+
+			ctl := gomock.NewController(t)
+			authRepo := mockrepo.NewMockAuthRepository(ctl)
+			authRepo.EXPECT().FindOtpSession(gomock.Any(), test.otpSession.OtpID).Times(1).Return(test.otpSession, nil)
+			test.buildStub(authRepo)
+
+			authUseCase := NewAuthUseCase(authRepo, nil, nil, nil, otpChannels).(*authUseCase)
+
+			err := verifyOtp(authUseCase, test.otpSession, test.otp)
+			assert.True(t, errors.Is(err, test.expectedError), "expected error %v got %v", test.expectedError, err)
+		})
+	}
+}
+
+func TestLoginLockDuration(t *testing.T) {
+
+	assert.Equal(t, loginLockBaseDuration, loginLockDuration(0))
+	assert.Equal(t, loginLockBaseDuration*4, loginLockDuration(2))
+	assert.Equal(t, loginLockMaxDuration, loginLockDuration(100))
+}
+
+func TestCheckOtpSendLimit(t *testing.T) {
+
+	phone := "9999999999"
+
+	tests := []struct {
+		testName      string
+		count         uint
+		lastSentAt    time.Time
+		expectedError error
+	}{
+		{
+			testName:      "FirstOtpShouldAllow",
+			count:         0,
+			expectedError: nil,
+		},
+		{
+			testName:      "OtpSendBeforeCooldownShouldReturnError",
+			count:         1,
+			lastSentAt:    time.Now().Add(-time.Second * 10),
+			expectedError: ErrOtpResendCooldown,
+		},
+		{
+			testName:      "OtpSendAfterCooldownShouldAllow",
+			count:         2,
+			lastSentAt:    time.Now().Add(-otpResendCooldown * 2),
+			expectedError: nil,
+		},
+		{
+			testName:      "OtpSendReachedDailyLimitShouldReturnError",
+			count:         otpDailyLimit,
+			lastSentAt:    time.Now().Add(-time.Hour),
+			expectedError: ErrOtpDailyLimitReached,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+
+			ctl := gomock.NewController(t)
+			authRepo := mockrepo.NewMockAuthRepository(ctl)
+			authRepo.EXPECT().FindOtpSendStats(gomock.Any(), phone, gomock.Any()).Times(1).
+				Return(test.count, test.lastSentAt, nil)
+
+			err := checkOtpSendLimit(context.Background(), authRepo, phone)
+			assert.ErrorIs(t, err, test.expectedError)
+		})
+	}
+}
+
+func TestSaveOtpSessionWithinLimit(t *testing.T) {
+
+	otpSession := domain.OtpSession{OtpID: "otp_id", UserID: 1, Phone: "9999999999", Purpose: domain.OtpPurposeLogin}
+
+	tests := []struct {
+		testName      string
+		buildStub     func(authRepo *mockrepo.MockAuthRepository)
+		expectedError error
+	}{
+		{
+			testName: "OtpWithinLimitShouldSaveSession",
+			buildStub: func(authRepo *mockrepo.MockAuthRepository) {
+				authRepo.EXPECT().FindOtpSendStats(gomock.Any(), otpSession.Phone, gomock.Any()).Times(1).
+					Return(uint(0), time.Time{}, nil)
+				authRepo.EXPECT().SaveOtpSession(gomock.Any(), otpSession).Times(1).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			testName: "OtpReachedDailyLimitShouldNotSaveSession",
+			buildStub: func(authRepo *mockrepo.MockAuthRepository) {
+				authRepo.EXPECT().FindOtpSendStats(gomock.Any(), otpSession.Phone, gomock.Any()).Times(1).
+					Return(uint(otpDailyLimit), time.Now().Add(-time.Hour), nil)
+			},
+			expectedError: ErrOtpDailyLimitReached,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+
+			ctl := gomock.NewController(t)
+			authRepo := mockrepo.NewMockAuthRepository(ctl)
+			authRepo.EXPECT().Transaction(gomock.Any()).Times(1).
+				DoAndReturn(func(callBack func(trxRepo interfaces.AuthRepository) error) error {
+					return callBack(authRepo)
+				})
+			authRepo.EXPECT().LockOtpSendsOfPhone(gomock.Any(), otpSession.Phone).Times(1).Return(nil)
+			test.buildStub(authRepo)
+
+			authUseCase := &authUseCase{authRepo: authRepo}
+
+			err := authUseCase.saveOtpSessionWithinLimit(context.Background(), otpSession)
+			assert.ErrorIs(t, err, test.expectedError)
+		})
+	}
+}
+
+func TestSendOtpOfSession(t *testing.T) {
+
+	otpSession := domain.OtpSession{OtpID: "otp_id", UserID: 1, Phone: "9999999999", Channel: string(otp.ChannelEmail),
+		Recipient: "user@gmail.com", Purpose: domain.OtpPurposeLogin}
+
+	ctl := gomock.NewController(t)
+	authRepo := mockrepo.NewMockAuthRepository(ctl)
+	authRepo.EXPECT().Transaction(gomock.Any()).Times(1).
+		DoAndReturn(func(callBack func(trxRepo interfaces.AuthRepository) error) error {
+			return callBack(authRepo)
+		})
+	authRepo.EXPECT().LockOtpSendsOfPhone(gomock.Any(), otpSession.Phone).Times(1).Return(nil)
+	authRepo.EXPECT().FindOtpSendStats(gomock.Any(), otpSession.Phone, gomock.Any()).Times(1).
+		Return(uint(0), time.Time{}, nil)
+	authRepo.EXPECT().SaveOtpSession(gomock.Any(), otpSession).Times(1).Return(nil)
+	// the key of the sent otp is saved only on its own session
+	authRepo.EXPECT().UpdateOtpSessionVerifyKey(gomock.Any(), otpSession.OtpID, "email_verify_key").Times(1).Return(nil)
+
+	authUseCase := &authUseCase{authRepo: authRepo, otpChannels: otp.Channels{
+		SMS:   stubOtpAuth{verifyKey: "sms_verify_key"},
+		Email: stubOtpAuth{verifyKey: "email_verify_key"},
+	}}
+
+	err := authUseCase.sendOtpOfSession(context.Background(), otpSession)
+	assert.NoError(t, err)
+}
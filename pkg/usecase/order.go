@@ -11,23 +11,38 @@ import (
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/payment"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/tax"
 	service "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
 )
 
+const orderItemsPageCount = 100
+
 type OrderUseCase struct {
-	orderRepo interfaces.OrderRepository
-	cartRepo  interfaces.CartRepository
-	userRepo  interfaces.UserRepository
+	orderRepo    interfaces.OrderRepository
+	cartRepo     interfaces.CartRepository
+	userRepo     interfaces.UserRepository
+	paymentRepo  interfaces.PaymentRepository
+	shippingRepo interfaces.ShippingRepository
+	taxRepo      interfaces.TaxRepository
+	taxEngine    tax.Engine
+	gateways     payment.Gateways
 }
 
 func NewOrderUseCase(orderRepo interfaces.OrderRepository, cartRepo interfaces.CartRepository,
-	userRepo interfaces.UserRepository,
-	paymentRepo interfaces.PaymentRepository) service.OrderUseCase {
+	userRepo interfaces.UserRepository, paymentRepo interfaces.PaymentRepository,
+	shippingRepo interfaces.ShippingRepository, taxRepo interfaces.TaxRepository,
+	taxEngine tax.Engine, gateways payment.Gateways) service.OrderUseCase {
 	return &OrderUseCase{
-		orderRepo: orderRepo,
-		cartRepo:  cartRepo,
-		userRepo:  userRepo,
+		orderRepo:    orderRepo,
+		cartRepo:     cartRepo,
+		userRepo:     userRepo,
+		paymentRepo:  paymentRepo,
+		shippingRepo: shippingRepo,
+		taxRepo:      taxRepo,
+		taxEngine:    taxEngine,
+		gateways:     gateways,
 	}
 }
 
@@ -64,19 +79,39 @@ func (c *OrderUseCase) SaveOrder(ctx context.Context, userID, addressID uint) (u
 		return 0, ErrOutOfStockOnCart
 	}
 
-	pendingOrderStatus, err := c.orderRepo.FindOrderStatusByStatus(ctx, domain.StatusPaymentPending)
+	address, err := c.userRepo.FindAddressByID(ctx, addressID)
 	if err != nil {
-		return 0, utils.PrependMessageToError(err, "failed to find pending order status")
+		return 0, utils.PrependMessageToError(err, "failed to find address")
+	}
+	if address.ID == 0 {
+		return 0, ErrInvalidAddressID
+	}
+
+	cartWeight, err := c.shippingRepo.FindCartWeight(ctx, cart.ID)
+	if err != nil {
+		return 0, utils.PrependMessageToError(err, "failed to find cart weight")
 	}
 
 	orderTotal := cart.TotalPrice - cart.DiscountAmount
 
+	deliveryCharge, err := findDeliveryCharge(ctx, c.shippingRepo, address, orderTotal, cartWeight)
+	if err != nil {
+		return 0, err
+	}
+
+	pendingOrderStatus, err := c.orderRepo.FindOrderStatusByStatus(ctx, domain.StatusPaymentPending)
+	if err != nil {
+		return 0, utils.PrependMessageToError(err, "failed to find pending order status")
+	}
+
 	shopOrder := domain.ShopOrder{
 		UserID:          userID,
 		AddressID:       addressID,
-		OrderTotalPrice: orderTotal,
+		OrderTotalPrice: orderTotal + deliveryCharge,
 		Discount:        cart.DiscountAmount,
+		DeliveryCharge:  deliveryCharge,
 		OrderStatusID:   pendingOrderStatus.ID,
+		CouponID:        cart.AppliedCouponID,
 	}
 
 	err = c.orderRepo.Transaction(func(trxRepo interfaces.OrderRepository) error {
@@ -86,35 +121,70 @@ func (c *OrderUseCase) SaveOrder(ctx context.Context, userID, addressID uint) (u
 			return utils.PrependMessageToError(err, "failed to save shop order on database")
 		}
 
+		err = trxRepo.SaveShopOrderStatusHistory(ctx, domain.ShopOrderStatusHistory{
+			ShopOrderID:   shopOrder.ID,
+			OrderStatusID: pendingOrderStatus.ID,
+			ActorID:       userID,
+			ActorType:     domain.ActorUser,
+		})
+		if err != nil {
+			return utils.PrependMessageToError(err, "failed to save order status history")
+		}
+
 		cartItems, err := c.cartRepo.FindAllCartItemsByCartID(ctx, cart.ID)
 		if err != nil {
 			return utils.PrependMessageToError(err, "failed to find all cart items")
 		}
 
-		var OrderPrice uint
-		// save all order lines
-		for _, cartItem := range cartItems {
+		orderPrices := make([]uint, len(cartItems))
+		lineAmounts := make([]uint, len(cartItems))
+		for i, cartItem := range cartItems {
 
 			if cartItem.DiscountPrice != 0 {
-				OrderPrice = cartItem.DiscountPrice
+				orderPrices[i] = cartItem.DiscountPrice
 			} else {
-				OrderPrice = cartItem.Price
+				orderPrices[i] = cartItem.Price
 			}
+			lineAmounts[i] = orderPrices[i] * cartItem.Qty
+		}
+
+		// tax is included on the price, so it's found on the line amount after the coupon discount of line
+		lineDiscounts := distributeOrderDiscount(lineAmounts, cart.DiscountAmount)
+
+		// save all order lines
+		for i, cartItem := range cartItems {
+
+			taxRate, err := c.taxRepo.FindTaxRateOfProductItem(ctx, cartItem.ProductItemId)
+			if err != nil {
+				return utils.PrependMessageToError(err, "failed to find tax rate of product item")
+			}
+			lineTax := c.taxEngine.Calculate(taxRate, lineAmounts[i]-lineDiscounts[i], address.State)
 
 			orderLine := domain.OrderLine{
 				ProductItemID: cartItem.ProductItemId,
 				ShopOrderID:   shopOrder.ID,
 				Qty:           cartItem.Qty,
-				Price:         OrderPrice,
+				Price:         orderPrices[i],
+				TaxRate:       lineTax.Rate,
+				TaxableAmount: lineTax.TaxableAmount,
+				CGST:          lineTax.CGST,
+				SGST:          lineTax.SGST,
+				IGST:          lineTax.IGST,
 			}
 			err = trxRepo.SaveOrderLine(ctx, orderLine)
 			if err != nil {
 				return utils.PrependMessageToError(err, "failed to save order line on database")
 			}
 		}
-		return nil
+
+		// reserve the stock of order until the payment completed or order cancelled
+		return changeOrderStock(ctx, trxRepo, shopOrder.ID, domain.StockReservation, domain.ActorUser, userID)
 	})
 	if err != nil {
+		// stock may taken by another order after cart validated
+		if errors.Is(err, ErrNotEnoughStock) {
+			return 0, ErrOutOfStockOnCart
+		}
 		return 0, utils.PrependMessageToError(err, "failed to complete save order")
 	}
 
@@ -173,71 +243,370 @@ func (c *OrderUseCase) FindOrderItems(ctx context.Context, shopOrderID uint,
 	return orderItems, nil
 }
 
-func (c *OrderUseCase) CancelOrder(ctx context.Context, shopOrderID uint) error {
+// To find all order items of the shop order page by page
+func findAllOrderItems(ctx context.Context, orderRepo interfaces.OrderRepository,
+	shopOrderID uint) ([]response.OrderItem, error) {
+
+	var orderItems []response.OrderItem
+	for pageNumber := uint64(1); ; pageNumber++ {
+
+		items, err := orderRepo.FindAllOrdersItemsByShopOrderID(ctx, shopOrderID, request.Pagination{
+			PageNumber: pageNumber,
+			Count:      orderItemsPageCount,
+		})
+		if err != nil {
+			return nil, utils.PrependMessageToError(err, "failed to find order items using shop order id")
+		}
+		orderItems = append(orderItems, items...)
+
+		if len(items) < orderItemsPageCount {
+			return orderItems, nil
+		}
+	}
+}
+
+// To cancel the order of user and restock all order items, refund the paid amount
+// and revert the coupon uses
+func (c *OrderUseCase) CancelOrder(ctx context.Context, userID, shopOrderID uint) error {
 
 	shopOrder, err := c.orderRepo.FindShopOrderByShopOrderID(ctx, shopOrderID)
 	if err != nil {
-		return err
+		return utils.PrependMessageToError(err, "failed to find shop order")
+	}
+	if shopOrder.ID == 0 || shopOrder.UserID != userID {
+		return ErrInvalidShopOrderID
 	}
 
-	currentOrderStatus, err := c.orderRepo.FindOrderStatusByID(ctx, shopOrder.OrderStatusID)
+	actor := domain.ShopOrderStatusHistory{
+		ActorID:   userID,
+		ActorType: domain.ActorUser,
+	}
+
+	return cancelShopOrder(ctx, c.orderRepo, c.paymentRepo, c.gateways, shopOrder, actor, false)
+}
+
+// To cancel an order by admin with same restock and refund of user cancellation
+func (c *OrderUseCase) CancelOrderAdmin(ctx context.Context, adminID uint, cancelDetails request.CancelOrder) error {
+
+	shopOrder, err := c.orderRepo.FindShopOrderByShopOrderID(ctx, cancelDetails.ShopOrderID)
 	if err != nil {
-		return err
+		return utils.PrependMessageToError(err, "failed to find shop order")
+	}
+	if shopOrder.ID == 0 {
+		return ErrInvalidShopOrderID
+	}
+
+	actor := domain.ShopOrderStatusHistory{
+		ActorID:   adminID,
+		ActorType: domain.ActorAdmin,
+		Comment:   cancelDetails.Comment,
+	}
+
+	return cancelShopOrder(ctx, c.orderRepo, c.paymentRepo, c.gateways, shopOrder, actor, false)
+}
+
+// To cancel all the orders which are on payment pending for more than the ttl
+// stock of these orders are reserved on order placement so cancelling releases them
+func (c *OrderUseCase) ReleaseStalePaymentPendingOrders(ctx context.Context, ttl time.Duration) (int, error) {
+
+	paymentPendingStatus, err := c.orderRepo.FindOrderStatusByStatus(ctx, domain.StatusPaymentPending)
+	if err != nil {
+		return 0, utils.PrependMessageToError(err, "failed to find payment pending order status")
+	}
+
+	shopOrders, err := c.orderRepo.FindAllShopOrdersByStatusBefore(ctx, paymentPendingStatus.ID, time.Now().Add(-ttl))
+	if err != nil {
+		return 0, utils.PrependMessageToError(err, "failed to find stale payment pending shop orders")
+	}
+
+	var releasedCount int
+	for _, shopOrder := range shopOrders {
+
+		// payment completed on gateway will approve the order by webhook or client verify so it should not cancel
+		paid, err := c.isGatewayPaymentCompleted(ctx, shopOrder)
+		if err != nil {
+			log.Printf("failed to check gateway payment of stale shop order of shop_order_id %v: %v", shopOrder.ID, err)
+			continue
+		}
+		if paid {
+			log.Printf("skipped stale shop order of shop_order_id %v payment completed on gateway %s and waiting for approval",
+				shopOrder.ID, shopOrder.GatewayOrderID)
+			continue
+		}
+
+		actor := domain.ShopOrderStatusHistory{
+			ActorType: domain.ActorSystem,
+			Comment:   "payment not completed within " + ttl.String(),
+		}
+		// nothing is paid for a payment pending order so no refund needed
+		err = cancelShopOrder(ctx, c.orderRepo, c.paymentRepo, c.gateways, shopOrder, actor, false)
+		if err != nil {
+			// order may approved after it fetched so skip it, other orders should release even one failed
+			if !errors.Is(err, ErrOrderNotCancellable) {
+				log.Printf("failed to release stale shop order of shop_order_id %v: %v", shopOrder.ID, err)
+			}
+			continue
+		}
+		releasedCount++
+		log.Printf("released stale payment pending shop order of shop_order_id %v ordered at %v",
+			shopOrder.ID, shopOrder.OrderDate.Format(time.RFC3339))
+	}
+
+	return releasedCount, nil
+}
+
+// To check the payment of the gateway order created for the shop order is completed on the payment gateway
+func (c *OrderUseCase) isGatewayPaymentCompleted(ctx context.Context, shopOrder domain.ShopOrder) (bool, error) {
+
+	// user not started an online payment for the order
+	if shopOrder.GatewayOrderID == "" {
+		return false, nil
+	}
+
+	paymentMethod, err := c.paymentRepo.FindPaymentMethodByID(ctx, shopOrder.PaymentMethodID)
+	if err != nil {
+		return false, utils.PrependMessageToError(err, "failed to find payment method of order")
+	}
+	if !isOnlinePayment(paymentMethod.Name) {
+		return false, nil
+	}
+
+	paymentStatus, err := findPaymentGateway(c.gateways, paymentMethod.Name).FetchPaymentStatus(ctx, shopOrder.GatewayOrderID)
+	if err != nil {
+		return false, utils.PrependMessageToError(err, "failed to fetch payment status from gateway")
+	}
+
+	return paymentStatus == payment.StatusSucceeded, nil
+}
+
+// To cancel a shop order on a single transaction by restocking all order items, reverting the coupon uses
+// and refunding the paid amount, the amount paid from wallet is credited back to wallet and the amount paid
+// online is refunded by the gateway (if gatewayRefunded is true then it's already refunded on gateway)
+func cancelShopOrder(ctx context.Context, orderRepo interfaces.OrderRepository, paymentRepo interfaces.PaymentRepository,
+	gateways payment.Gateways, shopOrder domain.ShopOrder, actor domain.ShopOrderStatusHistory, gatewayRefunded bool) error {
+
+	currentOrderStatus, err := orderRepo.FindOrderStatusByID(ctx, shopOrder.OrderStatusID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find current order status")
 	}
 
-	if currentOrderStatus.Status != domain.StatusOrderPlaced {
-		return fmt.Errorf("order is ' %s ' \ncan't cancel the order", currentOrderStatus.Status)
+	cancelOrderStatus, err := orderRepo.FindOrderStatusByStatus(ctx, domain.StatusOrderCancelled)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find cancel order status")
 	}
 
-	// if its not then find the cacel orderStatusID
-	cancelOrderStatus, err := c.orderRepo.FindOrderStatusByStatus(ctx, domain.StatusOrderCancelled)
+	err = validateOrderStatusTransition(ctx, orderRepo, currentOrderStatus, cancelOrderStatus)
 	if err != nil {
+		if errors.Is(err, ErrInvalidOrderStatusTransition) {
+			return utils.PrependMessageToError(ErrOrderNotCancellable, "order is "+string(currentOrderStatus.Status))
+		}
 		return err
 	}
 
-	err = c.orderRepo.UpdateShopOrderOrderStatus(ctx, shopOrder.ID, cancelOrderStatus.ID)
+	cancellation := domain.OrderCancellation{
+		ShopOrderID:    shopOrder.ID,
+		CancelledBy:    actor.ActorID,
+		ActorType:      actor.ActorType,
+		CancelledAt:    time.Now(),
+		PreviousStatus: string(currentOrderStatus.Status),
+	}
+
+	var paymentGateway payment.PaymentGateway
+	// after payment pending the payment is completed and coupon uses saved
+	if currentOrderStatus.Status != domain.StatusPaymentPending {
+
+		// wallet amount is the full amount for wallet payment and the wallet share for split payments
+		cancellation.RefundAmount = shopOrder.WalletAmount
+
+		paymentMethod, err := paymentRepo.FindPaymentMethodByID(ctx, shopOrder.PaymentMethodID)
+		if err != nil {
+			return utils.PrependMessageToError(err, "failed to find payment method of order")
+		}
+		if isOnlinePayment(paymentMethod.Name) && !gatewayRefunded {
+			paymentGateway = findPaymentGateway(gateways, paymentMethod.Name)
+			cancellation.GatewayRefundAmount = shopOrder.OrderTotalPrice - shopOrder.WalletAmount
+		}
+		cancellation.CouponReverted = shopOrder.CouponID != 0
+	}
+
+	actor.ShopOrderID = shopOrder.ID
+	actor.OrderStatusID = cancelOrderStatus.ID
+
+	err = orderRepo.Transaction(func(trxRepo interfaces.OrderRepository) error {
+
+		// an order cancelled or approved by another request after it's checked will not change here
+		err := changeOrderStatus(ctx, trxRepo, currentOrderStatus.ID, actor)
+		if err != nil {
+			if errors.Is(err, ErrOrderStatusChanged) {
+				return utils.PrependMessageToError(ErrOrderNotCancellable, err.Error())
+			}
+			return err
+		}
+
+		// release the reserved stock of a payment pending order otherwise restock the sold stock
+		stockReason := domain.StockRestock
+		if currentOrderStatus.Status == domain.StatusPaymentPending {
+			stockReason = domain.StockRelease
+		}
+		err = changeOrderStock(ctx, trxRepo, shopOrder.ID, stockReason, actor.ActorType, actor.ActorID)
+		if err != nil {
+			return utils.PrependMessageToError(err, "failed to restock order items")
+		}
+
+		if cancellation.RefundAmount != 0 {
+			err = creditWallet(ctx, trxRepo, shopOrder.UserID, cancellation.RefundAmount)
+			if err != nil {
+				return utils.PrependMessageToError(err, "failed to refund order amount to wallet")
+			}
+		}
+
+		if cancellation.CouponReverted {
+			err = trxRepo.DeleteCouponUses(ctx, shopOrder.UserID, shopOrder.CouponID)
+			if err != nil {
+				return utils.PrependMessageToError(err, "failed to revert coupon uses")
+			}
+		}
+
+		// refund on gateway is the last step so that any failure before it will not refund
+		if cancellation.GatewayRefundAmount != 0 {
+			refund, err := paymentGateway.Refund(ctx, payment.RefundRequest{
+				GatewayOrderID: shopOrder.GatewayOrderID,
+				Amount:         cancellation.GatewayRefundAmount,
+			})
+			if err != nil {
+				return utils.PrependMessageToError(err, "failed to refund order amount on payment gateway")
+			}
+			cancellation.GatewayRefundID = refund.RefundID
+		}
+
+		err = trxRepo.SaveOrderCancellation(ctx, cancellation)
+		if err != nil {
+			return utils.PrependMessageToError(err, "failed to save order cancellation")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to cancel order")
+	}
+
+	log.Printf("successfully cancelled order shop_order_id %v by %s %v refund amount to wallet %v on gateway %v",
+		shopOrder.ID, actor.ActorType, actor.ActorID, cancellation.RefundAmount, cancellation.GatewayRefundAmount)
+	return nil
+}
+
+// To check the order status can change from current status to the given status using transition table
+func validateOrderStatusTransition(ctx context.Context, orderRepo interfaces.OrderRepository,
+	currentStatus, changeStatus domain.OrderStatus) error {
+
+	exist, err := orderRepo.IsOrderStatusTransitionExist(ctx, currentStatus.ID, changeStatus.ID)
 	if err != nil {
-		return fmt.Errorf("failed to cancel the order %v", err.Error())
+		return utils.PrependMessageToError(err, "failed to check order status transition")
+	}
+	if !exist {
+		return utils.PrependMessageToError(ErrInvalidOrderStatusTransition,
+			fmt.Sprintf("order status %s can't change to %s", currentStatus.Status, changeStatus.Status))
+	}
+
+	return nil
+}
+
+// To update the shop order status from the current status and save it on the order status history
+// if the order status already changed by another request then the change is not applied
+func changeOrderStatus(ctx context.Context, orderRepo interfaces.OrderRepository,
+	currentStatusID uint, history domain.ShopOrderStatusHistory) error {
+
+	updated, err := orderRepo.UpdateShopOrderOrderStatus(ctx, history.ShopOrderID, currentStatusID, history.OrderStatusID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to update order status")
+	}
+	if !updated {
+		return ErrOrderStatusChanged
+	}
+
+	err = orderRepo.SaveShopOrderStatusHistory(ctx, history)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to save order status history")
 	}
 
 	return nil
 }
 
 // update order
-func (c *OrderUseCase) UpdateOrderStatus(ctx context.Context, shopOrderID, changeStatusID uint) error {
+func (c *OrderUseCase) UpdateOrderStatus(ctx context.Context, adminID uint, updateDetails request.UpdateOrder) error {
 
-	shopOrder, err := c.orderRepo.FindShopOrderByShopOrderID(ctx, shopOrderID)
+	shopOrder, err := c.orderRepo.FindShopOrderByShopOrderID(ctx, updateDetails.ShopOrderID)
 	if err != nil {
 		return utils.PrependMessageToError(err, "failed to find shop order")
 	}
+	if shopOrder.ID == 0 {
+		return ErrInvalidShopOrderID
+	}
 
 	currentOrderStatus, err := c.orderRepo.FindOrderStatusByID(ctx, shopOrder.OrderStatusID)
 	if err != nil {
 		return err
 	}
 
-	orderStatusChangeTo, err := c.orderRepo.FindOrderStatusByID(ctx, changeStatusID)
+	orderStatusChangeTo, err := c.orderRepo.FindOrderStatusByID(ctx, updateDetails.OrderStatusID)
 	if err != nil {
 		return err
 	}
 
-	switch currentOrderStatus.Status {
+	// these statuses have their own flows (payment, cancel and return)
+	switch orderStatusChangeTo.Status {
+	case domain.StatusOrderPlaced, domain.StatusOrderCancelled, domain.StatusReturnRequested,
+		domain.StatusReturnApproved, domain.StatusReturnCancelled, domain.StatusOrderReturned:
+		return utils.PrependMessageToError(ErrInvalidOrderStatusTransition,
+			fmt.Sprintf("order status can't change to %s using order update", orderStatusChangeTo.Status))
+	}
 
-	case domain.StatusOrderPlaced: // if order status is placed then change status should be order delivered
-		if orderStatusChangeTo.Status != domain.StatusOrderDelivered {
-			return fmt.Errorf("order status is 'order placed' \nchange status should be 'order delivered'")
-		}
-	default:
-		return fmt.Errorf("order status %s can't change to %s ", currentOrderStatus.Status, orderStatusChangeTo.Status)
+	err = validateOrderStatusTransition(ctx, c.orderRepo, currentOrderStatus, orderStatusChangeTo)
+	if err != nil {
+		return err
 	}
 
-	err = c.orderRepo.UpdateShopOrderOrderStatus(ctx, shopOrder.ID, changeStatusID)
+	err = c.orderRepo.Transaction(func(trxRepo interfaces.OrderRepository) error {
+		return changeOrderStatus(ctx, trxRepo, currentOrderStatus.ID, domain.ShopOrderStatusHistory{
+			ShopOrderID:   shopOrder.ID,
+			OrderStatusID: orderStatusChangeTo.ID,
+			ActorID:       adminID,
+			ActorType:     domain.ActorAdmin,
+			Comment:       updateDetails.Comment,
+		})
+	})
 	if err != nil {
-		return fmt.Errorf("failed to change order status %v", err.Error())
+		return utils.PrependMessageToError(err, "failed to change order status")
 	}
 	return nil
 }
 
+// To find all status changes of the shop order of user
+func (c *OrderUseCase) FindUserOrderTimeline(ctx context.Context,
+	userID, shopOrderID uint) ([]response.OrderStatusHistory, error) {
+
+	shopOrder, err := c.orderRepo.FindShopOrderByShopOrderID(ctx, shopOrderID)
+	if err != nil {
+		return nil, utils.PrependMessageToError(err, "failed to find shop order")
+	}
+	if shopOrder.ID == 0 || shopOrder.UserID != userID {
+		return nil, ErrInvalidShopOrderID
+	}
+
+	return c.FindOrderTimeline(ctx, shopOrderID)
+}
+
+// To find all status changes of the shop order
+func (c *OrderUseCase) FindOrderTimeline(ctx context.Context, shopOrderID uint) ([]response.OrderStatusHistory, error) {
+
+	histories, err := c.orderRepo.FindAllShopOrderStatusHistories(ctx, shopOrderID)
+	if err != nil {
+		return nil, utils.PrependMessageToError(err, "failed to find order status histories")
+	}
+
+	return histories, nil
+}
+
 // to get pending order returns
 func (c *OrderUseCase) FindAllPendingOrderReturns(ctx context.Context, pagination request.Pagination) ([]response.OrderReturn, error) {
 
@@ -258,20 +627,29 @@ func (c *OrderUseCase) FindAllOrderReturns(ctx context.Context, pagination reque
 	return orderReturns, nil
 }
 
-func (c *OrderUseCase) SubmitReturnRequest(ctx context.Context, returnDetails request.Return) error {
+func (c *OrderUseCase) SubmitReturnRequest(ctx context.Context, userID uint, returnDetails request.Return) error {
 
 	shopOrder, err := c.orderRepo.FindShopOrderByShopOrderID(ctx, returnDetails.ShopOrderID)
 	if err != nil {
 		return err
 	}
+	if shopOrder.ID == 0 || shopOrder.UserID != userID {
+		return ErrInvalidShopOrderID
+	}
 
 	currentOrderStatus, err := c.orderRepo.FindOrderStatusByID(ctx, shopOrder.OrderStatusID)
 	if err != nil {
 		return err
 	}
 
-	if currentOrderStatus.Status != domain.StatusOrderDelivered {
-		return fmt.Errorf("order is ' %s '\ncan't a make return request for this order", currentOrderStatus.Status)
+	statusToChange, err := c.orderRepo.FindOrderStatusByStatus(ctx, domain.StatusReturnRequested)
+	if err != nil {
+		return fmt.Errorf("failed to find return request status \nerror:%v", err.Error())
+	}
+
+	err = validateOrderStatusTransition(ctx, c.orderRepo, currentOrderStatus, statusToChange)
+	if err != nil {
+		return err
 	}
 
 	orderReturn := domain.OrderReturn{
@@ -288,16 +666,13 @@ func (c *OrderUseCase) SubmitReturnRequest(ctx context.Context, returnDetails re
 			return fmt.Errorf("failed to submit order return \nerror:%v", err.Error())
 		}
 
-		statusToChange, err := trxRepo.FindOrderStatusByStatus(ctx, domain.StatusReturnRequested)
-		if err != nil {
-			return fmt.Errorf("failed to find return request status \nerror:%v", err.Error())
-		}
-
-		err = trxRepo.UpdateShopOrderOrderStatus(ctx, shopOrder.ID, statusToChange.ID)
-		if err != nil {
-			return fmt.Errorf("failed to update order status \n error:%v", err.Error())
-		}
-		return nil
+		return changeOrderStatus(ctx, trxRepo, currentOrderStatus.ID, domain.ShopOrderStatusHistory{
+			ShopOrderID:   shopOrder.ID,
+			OrderStatusID: statusToChange.ID,
+			ActorID:       userID,
+			ActorType:     domain.ActorUser,
+			Comment:       returnDetails.ReturnReason,
+		})
 	})
 
 	if err != nil {
@@ -307,7 +682,7 @@ func (c *OrderUseCase) SubmitReturnRequest(ctx context.Context, returnDetails re
 	return nil
 }
 
-func (c *OrderUseCase) UpdateReturnDetails(ctx context.Context, updateDetails request.UpdateOrderReturn) error {
+func (c *OrderUseCase) UpdateReturnDetails(ctx context.Context, adminID uint, updateDetails request.UpdateOrderReturn) error {
 
 	orderReturn, err := c.orderRepo.FindOrderReturnByReturnID(ctx, updateDetails.OrderReturnID)
 	if err != nil {
@@ -329,34 +704,32 @@ func (c *OrderUseCase) UpdateReturnDetails(ctx context.Context, updateDetails re
 		return err
 	}
 
-	switch currentOrderStatus.Status {
+	err = validateOrderStatusTransition(ctx, c.orderRepo, currentOrderStatus, returnStatusChangeTo)
+	if err != nil {
+		return err
+	}
 
-	case domain.StatusReturnRequested:
-		if returnStatusChangeTo.Status == domain.StatusReturnApproved {
-			if time.Since(updateDetails.ReturnDate) > 0 {
-				return fmt.Errorf("given return date is invalid \nto update 'return approved' return date should be greater than cuurent time")
-			}
-			orderReturn.ApprovalDate = time.Now()
-			orderReturn.IsApproved = true
-			orderReturn.ReturnDate = updateDetails.ReturnDate
-		} else if returnStatusChangeTo.Status == domain.StatusReturnCancelled {
-			// nothing extra update on order return may be in future when adding new statuses
-		} else {
-			return errors.New("order staus is return requested \nchange status must be return approved or return cancelled")
-		}
+	switch returnStatusChangeTo.Status {
 
 	case domain.StatusReturnApproved:
-		if returnStatusChangeTo.Status != domain.StatusOrderReturned {
-			return errors.New(" change status must be order returned")
+		if time.Since(updateDetails.ReturnDate) > 0 {
+			return fmt.Errorf("given return date is invalid \nto update 'return approved' return date should be greater than cuurent time")
 		}
+		orderReturn.ApprovalDate = time.Now()
+		orderReturn.IsApproved = true
+		orderReturn.ReturnDate = updateDetails.ReturnDate
+
+	case domain.StatusReturnCancelled:
+		// nothing extra update on order return may be in future when adding new statuses
+
+	case domain.StatusOrderReturned:
 		if time.Since(updateDetails.ReturnDate) <= 0 {
 			return fmt.Errorf("given return date is invalid \nto update 'order returned' return should be less than current time")
-		} else {
-			orderReturn.ReturnDate = updateDetails.ReturnDate
 		}
+		orderReturn.ReturnDate = updateDetails.ReturnDate
 
 	default:
-		return fmt.Errorf("order status %s can't change to %s ", currentOrderStatus.Status, returnStatusChangeTo.Status)
+		return errors.New("change status must be a return status")
 	}
 
 	orderReturn.AdminComment = updateDetails.AdminComment
@@ -367,44 +740,29 @@ func (c *OrderUseCase) UpdateReturnDetails(ctx context.Context, updateDetails re
 			return fmt.Errorf("failed to update orders return \nerror:%v", err.Error())
 		}
 
-		err = trxRepo.UpdateShopOrderOrderStatus(ctx, shopOrder.ID, returnStatusChangeTo.ID)
+		err = changeOrderStatus(ctx, trxRepo, currentOrderStatus.ID, domain.ShopOrderStatusHistory{
+			ShopOrderID:   shopOrder.ID,
+			OrderStatusID: returnStatusChangeTo.ID,
+			ActorID:       adminID,
+			ActorType:     domain.ActorAdmin,
+			Comment:       updateDetails.AdminComment,
+		})
 		if err != nil {
-			return fmt.Errorf("failed to update order status \nerror:%v", err.Error())
+			return err
 		}
 
 		// if order changing to order return then return the order amount to use wallet
 		if returnStatusChangeTo.Status == domain.StatusOrderReturned {
-			// get user wallet
-			wallet, err := trxRepo.FindWalletByUserID(ctx, shopOrder.UserID)
-			if err != nil {
-				return fmt.Errorf("failed to get user wallet for refund amount \nerror:%v", err.Error())
-			}
-			// if user have no wallet then create a new wallet for user
-			if wallet.ID == 0 {
-				wallet.ID, err = c.orderRepo.SaveWallet(ctx, shopOrder.UserID)
-				if err != nil {
-					return fmt.Errorf("failed to create a wallet for user")
-				}
-			}
 
-			// calculate wallet amount and update
-			newWalletTotal := wallet.TotalAmount + shopOrder.OrderTotalPrice
-			err = trxRepo.UpdateWallet(ctx, wallet.ID, newWalletTotal)
+			err = changeOrderStock(ctx, trxRepo, shopOrder.ID, domain.StockReturn, domain.ActorAdmin, adminID)
 			if err != nil {
-				return fmt.Errorf("failed to update return amount to user wallet \nerror:%v", err.Error())
-			}
-
-			// wallet transaction
-			transaction := domain.Transaction{
-				WalletID:        wallet.ID,
-				TransactionDate: time.Now(),
-				TransactionType: domain.Credit,
-				Amount:          shopOrder.OrderTotalPrice,
+				return err
 			}
-			err = trxRepo.SaveWalletTransaction(ctx, transaction)
 
+			// return the order amount to user wallet
+			err = creditWallet(ctx, trxRepo, shopOrder.UserID, shopOrder.OrderTotalPrice)
 			if err != nil {
-				return fmt.Errorf("failed to save wallet transaction \nerror:%v", err.Error())
+				return err
 			}
 		}
 		return nil
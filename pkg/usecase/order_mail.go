@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/mail"
+)
+
+// To send the order confirmation mail to the user of the placed shop order
+// order is already placed so errors are only logged
+func sendOrderConfirmationMail(ctx context.Context, userRepo interfaces.UserRepository,
+	orderRepo interfaces.OrderRepository, mailSender mail.Sender, shopOrder domain.ShopOrder) {
+
+	user, err := userRepo.FindUserByUserID(ctx, shopOrder.UserID)
+	if err != nil {
+		log.Printf("failed to find user of shop order with shop_order_id %v: %v", shopOrder.ID, err)
+		return
+	}
+	if user.Email == "" {
+		return
+	}
+
+	orderItems, err := findAllOrderItems(ctx, orderRepo, shopOrder.ID)
+	if err != nil {
+		log.Printf("failed to find order items of shop order with shop_order_id %v: %v", shopOrder.ID, err)
+		return
+	}
+
+	err = mailSender.Send(ctx, mail.Mail{
+		To:      user.Email,
+		Subject: fmt.Sprintf("Order confirmed: your order #%d is placed", shopOrder.ID),
+		Body:    formatOrderConfirmation(user, shopOrder, orderItems),
+	})
+	if err != nil {
+		log.Printf("failed to send order confirmation mail of shop order with shop_order_id %v: %v", shopOrder.ID, err)
+	}
+}
+
+func formatOrderConfirmation(user domain.User, shopOrder domain.ShopOrder, orderItems []response.OrderItem) string {
+
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "Hi %s,\n\nThank you for your order. Your order #%d is placed.\n\n", user.FirstName, shopOrder.ID)
+	for _, item := range orderItems {
+		fmt.Fprintf(&builder, "%s x %d: %d\n", item.ProductName, item.Qty, item.SubTotal)
+	}
+	if shopOrder.Discount != 0 {
+		fmt.Fprintf(&builder, "\ndiscount: %d", shopOrder.Discount)
+	}
+	if shopOrder.DeliveryCharge != 0 {
+		fmt.Fprintf(&builder, "\ndelivery charge: %d", shopOrder.DeliveryCharge)
+	}
+	if shopOrder.WalletAmount != 0 {
+		fmt.Fprintf(&builder, "\npaid from wallet: %d", shopOrder.WalletAmount)
+	}
+	fmt.Fprintf(&builder, "\norder total: %d\n", shopOrder.OrderTotalPrice)
+
+	return builder.String()
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/mock/mockrepo"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/mail"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/mail/mailtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSendOrderConfirmationMail(t *testing.T) {
+
+	shopOrder := domain.ShopOrder{ID: 7, UserID: 1, OrderTotalPrice: 900, Discount: 100}
+
+	ctl := gomock.NewController(t)
+	userRepo := mockrepo.NewMockUserRepository(ctl)
+	orderRepo := mockrepo.NewMockOrderRepository(ctl)
+
+	userRepo.EXPECT().FindUserByUserID(gomock.Any(), shopOrder.UserID).Times(1).
+		Return(domain.User{ID: 1, FirstName: "user", Email: "user@example.com"}, nil)
+	orderRepo.EXPECT().FindAllOrdersItemsByShopOrderID(gomock.Any(), shopOrder.ID, gomock.Any()).Times(1).
+		Return([]response.OrderItem{{ProductName: "shirt", Qty: 2, SubTotal: 1000}}, nil)
+
+	server := mailtest.NewServer(t)
+	sender := mail.NewSmtpSender(server.Host, server.Port, "", "", "shop@example.com")
+
+	sendOrderConfirmationMail(context.Background(), userRepo, orderRepo, sender, shopOrder)
+
+	messages := server.Messages()
+	if assert.Len(t, messages, 1) {
+		assert.Equal(t, []string{"user@example.com"}, messages[0].To)
+		assert.Contains(t, messages[0].Data, "Subject: Order confirmed: your order #7 is placed")
+		assert.Contains(t, messages[0].Data, "shirt x 2: 1000")
+		assert.Contains(t, messages[0].Data, "discount: 100")
+		assert.Contains(t, messages[0].Data, "order total: 900")
+	}
+}
@@ -0,0 +1,340 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/mock/mockrepo"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/payment"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleaseStalePaymentPendingOrders(t *testing.T) {
+
+	paymentPendingStatus := domain.OrderStatus{ID: 1, Status: domain.StatusPaymentPending}
+	orderPlacedStatus := domain.OrderStatus{ID: 2, Status: domain.StatusOrderPlaced}
+	cancelledStatus := domain.OrderStatus{ID: 3, Status: domain.StatusOrderCancelled}
+	razorpayPayment := domain.PaymentMethod{ID: 2, Name: domain.RazopayPayment}
+
+	tests := []struct {
+		testName  string
+		buildStub func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository,
+			gateway *payment.FakeGateway)
+		expectedOutput int
+		expectedError  error
+	}{
+		{
+			testName: "NoStaleOrdersShouldReleaseNothing",
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository,
+				gateway *payment.FakeGateway) {
+				orderRepo.EXPECT().FindOrderStatusByStatus(gomock.Any(), domain.StatusPaymentPending).
+					Times(1).Return(paymentPendingStatus, nil)
+				orderRepo.EXPECT().FindAllShopOrdersByStatusBefore(gomock.Any(), paymentPendingStatus.ID, gomock.Any()).
+					Times(1).Return(nil, nil)
+			},
+			expectedOutput: 0,
+			expectedError:  nil,
+		},
+		{
+			testName: "StaleOrderShouldCancelAndReleaseStock",
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository,
+				gateway *payment.FakeGateway) {
+				shopOrder := domain.ShopOrder{ID: 10, UserID: 1, OrderStatusID: paymentPendingStatus.ID}
+
+				orderRepo.EXPECT().FindOrderStatusByStatus(gomock.Any(), domain.StatusPaymentPending).
+					Times(1).Return(paymentPendingStatus, nil)
+				orderRepo.EXPECT().FindAllShopOrdersByStatusBefore(gomock.Any(), paymentPendingStatus.ID, gomock.Any()).
+					Times(1).Return([]domain.ShopOrder{shopOrder}, nil)
+				orderRepo.EXPECT().FindOrderStatusByID(gomock.Any(), paymentPendingStatus.ID).
+					Times(1).Return(paymentPendingStatus, nil)
+				orderRepo.EXPECT().FindOrderStatusByStatus(gomock.Any(), domain.StatusOrderCancelled).
+					Times(1).Return(cancelledStatus, nil)
+				orderRepo.EXPECT().IsOrderStatusTransitionExist(gomock.Any(), paymentPendingStatus.ID, cancelledStatus.ID).
+					Times(1).Return(true, nil)
+				orderRepo.EXPECT().Transaction(gomock.Any()).Times(1).
+					DoAndReturn(func(callBack func(trxRepo interfaces.OrderRepository) error) error {
+						return callBack(orderRepo)
+					})
+				orderRepo.EXPECT().UpdateShopOrderOrderStatus(gomock.Any(), shopOrder.ID, paymentPendingStatus.ID,
+					cancelledStatus.ID).Times(1).Return(true, nil)
+				orderRepo.EXPECT().SaveShopOrderStatusHistory(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				orderRepo.EXPECT().FindAllOrderLinesByShopOrderID(gomock.Any(), shopOrder.ID).
+					Times(1).Return([]domain.OrderLine{{ProductItemID: 7, ShopOrderID: shopOrder.ID, Qty: 2}}, nil)
+				orderRepo.EXPECT().ApplyStockMovement(gomock.Any(), domain.StockMovement{
+					ProductItemID: 7,
+					Quantity:      2,
+					Reason:        domain.StockRelease,
+					ActorType:     domain.ActorSystem,
+					ReferenceID:   shopOrder.ID,
+				}).Times(1).Return(true, nil)
+				orderRepo.EXPECT().SaveOrderCancellation(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+			},
+			expectedOutput: 1,
+			expectedError:  nil,
+		},
+		{
+			testName: "OrderApprovedAfterFetchShouldSkip",
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository,
+				gateway *payment.FakeGateway) {
+				shopOrder := domain.ShopOrder{ID: 11, UserID: 1, OrderStatusID: orderPlacedStatus.ID}
+
+				orderRepo.EXPECT().FindOrderStatusByStatus(gomock.Any(), domain.StatusPaymentPending).
+					Times(1).Return(paymentPendingStatus, nil)
+				orderRepo.EXPECT().FindAllShopOrdersByStatusBefore(gomock.Any(), paymentPendingStatus.ID, gomock.Any()).
+					Times(1).Return([]domain.ShopOrder{shopOrder}, nil)
+				orderRepo.EXPECT().FindOrderStatusByID(gomock.Any(), orderPlacedStatus.ID).
+					Times(1).Return(orderPlacedStatus, nil)
+				orderRepo.EXPECT().FindOrderStatusByStatus(gomock.Any(), domain.StatusOrderCancelled).
+					Times(1).Return(cancelledStatus, nil)
+				orderRepo.EXPECT().IsOrderStatusTransitionExist(gomock.Any(), orderPlacedStatus.ID, cancelledStatus.ID).
+					Times(1).Return(false, nil)
+			},
+			expectedOutput: 0,
+			expectedError:  nil,
+		},
+		{
+			testName: "PaymentCompletedOnGatewayShouldSkip",
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository,
+				gateway *payment.FakeGateway) {
+				gatewayOrder, _ := gateway.CreateOrder(context.Background(), payment.CreateOrderRequest{ShopOrderID: 12, Amount: 300})
+				gateway.SetPaymentStatus(gatewayOrder.GatewayOrderID, payment.StatusSucceeded)
+				shopOrder := domain.ShopOrder{ID: 12, UserID: 1, OrderStatusID: paymentPendingStatus.ID,
+					PaymentMethodID: razorpayPayment.ID, GatewayOrderID: gatewayOrder.GatewayOrderID}
+
+				orderRepo.EXPECT().FindOrderStatusByStatus(gomock.Any(), domain.StatusPaymentPending).
+					Times(1).Return(paymentPendingStatus, nil)
+				orderRepo.EXPECT().FindAllShopOrdersByStatusBefore(gomock.Any(), paymentPendingStatus.ID, gomock.Any()).
+					Times(1).Return([]domain.ShopOrder{shopOrder}, nil)
+				paymentRepo.EXPECT().FindPaymentMethodByID(gomock.Any(), razorpayPayment.ID).
+					Times(1).Return(razorpayPayment, nil)
+			},
+			expectedOutput: 0,
+			expectedError:  nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+
+			ctl := gomock.NewController(t)
+			orderRepo := mockrepo.NewMockOrderRepository(ctl)
+			paymentRepo := mockrepo.NewMockPaymentRepository(ctl)
+			gateway := payment.NewFakeGateway()
+			test.buildStub(orderRepo, paymentRepo, gateway)
+
+			orderUseCase := NewOrderUseCase(orderRepo, nil, nil, paymentRepo, nil, nil, nil, payment.Gateways{Razorpay: gateway})
+
+			releasedCount, err := orderUseCase.ReleaseStalePaymentPendingOrders(context.Background(), 30*time.Minute)
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expectedOutput, releasedCount)
+		})
+	}
+}
+
+func TestCancelOrder(t *testing.T) {
+
+	orderPlacedStatus := domain.OrderStatus{ID: 2, Status: domain.StatusOrderPlaced}
+	cancelledStatus := domain.OrderStatus{ID: 3, Status: domain.StatusOrderCancelled}
+	razorpayPayment := domain.PaymentMethod{ID: 2, Name: domain.RazopayPayment}
+
+	// split payment of wallet and razorpay with a coupon applied
+	shopOrder := domain.ShopOrder{ID: 10, UserID: 1, OrderStatusID: orderPlacedStatus.ID, OrderTotalPrice: 300,
+		WalletAmount: 100, PaymentMethodID: razorpayPayment.ID, CouponID: 4, GatewayOrderID: "fake_order_1"}
+
+	tests := []struct {
+		testName        string
+		shopOrder       domain.ShopOrder
+		buildStub       func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository)
+		expectedRefunds []payment.RefundRequest
+		expectedError   error
+	}{
+		{
+			testName:  "OrderOfOtherUserShouldReturnInvalidShopOrder",
+			shopOrder: domain.ShopOrder{ID: 10, UserID: 2, OrderStatusID: orderPlacedStatus.ID},
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository) {
+			},
+			expectedError: ErrInvalidShopOrderID,
+		},
+		{
+			testName:  "AlreadyCancelledOrderShouldNotCancelAgain",
+			shopOrder: domain.ShopOrder{ID: 10, UserID: 1, OrderStatusID: cancelledStatus.ID},
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository) {
+				orderRepo.EXPECT().FindOrderStatusByID(gomock.Any(), cancelledStatus.ID).Times(1).Return(cancelledStatus, nil)
+				orderRepo.EXPECT().FindOrderStatusByStatus(gomock.Any(), domain.StatusOrderCancelled).
+					Times(1).Return(cancelledStatus, nil)
+				orderRepo.EXPECT().IsOrderStatusTransitionExist(gomock.Any(), cancelledStatus.ID, cancelledStatus.ID).
+					Times(1).Return(false, nil)
+			},
+			expectedError: ErrOrderNotCancellable,
+		},
+		{
+			testName:  "OrderCancelledByConcurrentRequestShouldNotRefundAgain",
+			shopOrder: shopOrder,
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository) {
+				orderRepo.EXPECT().FindOrderStatusByID(gomock.Any(), orderPlacedStatus.ID).Times(1).Return(orderPlacedStatus, nil)
+				orderRepo.EXPECT().FindOrderStatusByStatus(gomock.Any(), domain.StatusOrderCancelled).
+					Times(1).Return(cancelledStatus, nil)
+				orderRepo.EXPECT().IsOrderStatusTransitionExist(gomock.Any(), orderPlacedStatus.ID, cancelledStatus.ID).
+					Times(1).Return(true, nil)
+				paymentRepo.EXPECT().FindPaymentMethodByID(gomock.Any(), razorpayPayment.ID).Times(1).Return(razorpayPayment, nil)
+				orderRepo.EXPECT().Transaction(gomock.Any()).Times(1).
+					DoAndReturn(func(callBack func(trxRepo interfaces.OrderRepository) error) error {
+						return callBack(orderRepo)
+					})
+				// status already changed by the other request
+				orderRepo.EXPECT().UpdateShopOrderOrderStatus(gomock.Any(), shopOrder.ID, orderPlacedStatus.ID,
+					cancelledStatus.ID).Times(1).Return(false, nil)
+			},
+			expectedError: ErrOrderNotCancellable,
+		},
+		{
+			testName:  "PrepaidOrderShouldRestockAndRefundToWalletAndGateway",
+			shopOrder: shopOrder,
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository) {
+				orderRepo.EXPECT().FindOrderStatusByID(gomock.Any(), orderPlacedStatus.ID).Times(1).Return(orderPlacedStatus, nil)
+				orderRepo.EXPECT().FindOrderStatusByStatus(gomock.Any(), domain.StatusOrderCancelled).
+					Times(1).Return(cancelledStatus, nil)
+				orderRepo.EXPECT().IsOrderStatusTransitionExist(gomock.Any(), orderPlacedStatus.ID, cancelledStatus.ID).
+					Times(1).Return(true, nil)
+				paymentRepo.EXPECT().FindPaymentMethodByID(gomock.Any(), razorpayPayment.ID).Times(1).Return(razorpayPayment, nil)
+				orderRepo.EXPECT().Transaction(gomock.Any()).Times(1).
+					DoAndReturn(func(callBack func(trxRepo interfaces.OrderRepository) error) error {
+						return callBack(orderRepo)
+					})
+				orderRepo.EXPECT().UpdateShopOrderOrderStatus(gomock.Any(), shopOrder.ID, orderPlacedStatus.ID,
+					cancelledStatus.ID).Times(1).Return(true, nil)
+				orderRepo.EXPECT().SaveShopOrderStatusHistory(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				orderRepo.EXPECT().FindAllOrderLinesByShopOrderID(gomock.Any(), shopOrder.ID).
+					Times(1).Return([]domain.OrderLine{{ProductItemID: 7, ShopOrderID: shopOrder.ID, Qty: 2}}, nil)
+				orderRepo.EXPECT().ApplyStockMovement(gomock.Any(), domain.StockMovement{
+					ProductItemID: 7,
+					Quantity:      2,
+					Reason:        domain.StockRestock,
+					ActorType:     domain.ActorUser,
+					ActorID:       1,
+					ReferenceID:   shopOrder.ID,
+				}).Times(1).Return(true, nil)
+				orderRepo.EXPECT().FindWalletByUserID(gomock.Any(), uint(1)).
+					Times(1).Return(domain.Wallet{ID: 5, UserID: 1}, nil)
+				orderRepo.EXPECT().CreditWallet(gomock.Any(), uint(5), uint(100)).Times(1).Return(nil)
+				orderRepo.EXPECT().SaveWalletTransaction(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				orderRepo.EXPECT().DeleteCouponUses(gomock.Any(), uint(1), uint(4)).Times(1).Return(nil)
+				orderRepo.EXPECT().SaveOrderCancellation(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, cancellation domain.OrderCancellation) error {
+						assert.Equal(t, uint(100), cancellation.RefundAmount)
+						assert.Equal(t, uint(200), cancellation.GatewayRefundAmount)
+						assert.Equal(t, "fake_refund_1", cancellation.GatewayRefundID)
+						assert.True(t, cancellation.CouponReverted)
+						return nil
+					})
+			},
+			expectedRefunds: []payment.RefundRequest{{GatewayOrderID: "fake_order_1", Amount: 200}},
+			expectedError:   nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+
+			ctl := gomock.NewController(t)
+			orderRepo := mockrepo.NewMockOrderRepository(ctl)
+			paymentRepo := mockrepo.NewMockPaymentRepository(ctl)
+
+			orderRepo.EXPECT().FindShopOrderByShopOrderID(gomock.Any(), test.shopOrder.ID).
+				Times(1).Return(test.shopOrder, nil)
+			test.buildStub(orderRepo, paymentRepo)
+
+			// captured payment of the order on gateway
+			fakeGateway := payment.NewFakeGateway()
+			gatewayOrder, err := fakeGateway.CreateOrder(context.Background(), payment.CreateOrderRequest{Amount: 200})
+			assert.NoError(t, err)
+			fakeGateway.SetPaymentStatus(gatewayOrder.GatewayOrderID, payment.StatusSucceeded)
+
+			orderUseCase := NewOrderUseCase(orderRepo, nil, nil, paymentRepo, nil, nil, nil,
+				payment.Gateways{Razorpay: fakeGateway})
+
+			err = orderUseCase.CancelOrder(context.Background(), 1, test.shopOrder.ID)
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expectedRefunds, fakeGateway.Refunds())
+		})
+	}
+}
+
+func TestFindUserOrderTimeline(t *testing.T) {
+
+	timeline := []response.OrderStatusHistory{
+		{OrderStatusID: 1, OrderStatus: string(domain.StatusPaymentPending), ActorID: 1, ActorType: string(domain.ActorUser)},
+		{OrderStatusID: 2, OrderStatus: string(domain.StatusOrderPlaced), ActorID: 1, ActorType: string(domain.ActorUser)},
+	}
+
+	tests := []struct {
+		testName       string
+		buildStub      func(orderRepo *mockrepo.MockOrderRepository)
+		expectedOutput []response.OrderStatusHistory
+		expectedError  error
+	}{
+		{
+			testName: "NotExistingOrderShouldReturnInvalidShopOrder",
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository) {
+				orderRepo.EXPECT().FindShopOrderByShopOrderID(gomock.Any(), uint(10)).Times(1).Return(domain.ShopOrder{}, nil)
+			},
+			expectedOutput: nil,
+			expectedError:  ErrInvalidShopOrderID,
+		},
+		{
+			testName: "OrderOfOtherUserShouldReturnInvalidShopOrder",
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository) {
+				orderRepo.EXPECT().FindShopOrderByShopOrderID(gomock.Any(), uint(10)).Times(1).
+					Return(domain.ShopOrder{ID: 10, UserID: 2}, nil)
+			},
+			expectedOutput: nil,
+			expectedError:  ErrInvalidShopOrderID,
+		},
+		{
+			testName: "OrderOfUserShouldReturnTimeline",
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository) {
+				orderRepo.EXPECT().FindShopOrderByShopOrderID(gomock.Any(), uint(10)).Times(1).
+					Return(domain.ShopOrder{ID: 10, UserID: 1}, nil)
+				orderRepo.EXPECT().FindAllShopOrderStatusHistories(gomock.Any(), uint(10)).Times(1).Return(timeline, nil)
+			},
+			expectedOutput: timeline,
+			expectedError:  nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+			t.Parallel()
+
+			ctl := gomock.NewController(t)
+			orderRepo := mockrepo.NewMockOrderRepository(ctl)
+			test.buildStub(orderRepo)
+
+			orderUseCase := NewOrderUseCase(orderRepo, nil, nil, nil, nil, nil, nil, payment.Gateways{})
+
+			output, err := orderUseCase.FindUserOrderTimeline(context.Background(), 1, 10)
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expectedOutput, output)
+		})
+	}
+}
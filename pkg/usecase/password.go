@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/token"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+)
+
+// send otp to the user's registered number to reset the forgotten password
+// the otp is sent on the same way of otp login but saved only for reset password
+func (c *authUseCase) ForgotPasswordOtpSend(ctx context.Context, reqData request.OTPLogin) (string, error) {
+
+	otpID, err := c.sendUserOtp(ctx, reqData, domain.OtpPurposeResetPassword)
+	if err != nil {
+		return "", err
+	}
+
+	return otpID, nil
+}
+
+// verify the otp of forgot password and set the new password
+func (c *authUseCase) ResetPassword(ctx context.Context, reqData request.ResetPassword) error {
+
+	otpSession, err := c.authRepo.FindOtpSession(ctx, reqData.OtpID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find otp session from database")
+	}
+
+	// otp sent for login or sign up can't use to reset password
+	if otpSession.Purpose != domain.OtpPurposeResetPassword {
+		return ErrInvalidOtp
+	}
+
+	if time.Since(otpSession.ExpireAt) > 0 {
+		return ErrOtpExpired
+	}
+
+	err = c.verifyOtpWithinAttempts(ctx, otpSession, reqData.Otp, reqData.ClientIP)
+	if err != nil {
+		return err
+	}
+
+	return c.updatePasswordAndRevokeSessions(ctx, otpSession.UserID, reqData.Password)
+}
+
+// change password of logged in user using the old password
+func (c *authUseCase) ChangePassword(ctx context.Context, userID uint, reqData request.ChangePassword) error {
+
+	user, err := c.userRepo.FindUserByUserID(ctx, userID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find user")
+	}
+	if user.ID == 0 {
+		return ErrUserNotExist
+	}
+
+	// guessing the old password is limited same as login
+	accountKey := accountLoginAttemptKey(token.User, userID)
+	if err = c.checkLoginLocked(ctx, accountKey); err != nil {
+		return err
+	}
+
+	err = utils.ComparePasswordWithHashedPassword(reqData.OldPassword, user.Password)
+	if err != nil {
+		if err = c.saveWrongPasswordAttempt(ctx, token.User, userID, reqData.ClientIP); err != nil {
+			return err
+		}
+		return ErrWrongPassword
+	}
+
+	if err = c.authRepo.DeleteLoginAttempt(ctx, accountKey); err != nil {
+		return utils.PrependMessageToError(err, "failed to clear failed login attempts")
+	}
+
+	return c.updatePasswordAndRevokeSessions(ctx, userID, reqData.NewPassword)
+}
+
+// update the password and logout the user from all devices
+func (c *authUseCase) updatePasswordAndRevokeSessions(ctx context.Context, userID uint, password string) error {
+
+	hashPass, err := utils.GetHashedPassword(password)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to hash the password")
+	}
+
+	err = c.userRepo.UpdatePassword(ctx, userID, hashPass)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to update password")
+	}
+
+	err = c.RevokeAllSessions(ctx, userID, token.User)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to logout from all devices after password update")
+	}
+
+	return nil
+}
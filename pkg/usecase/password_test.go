@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/mock/mockrepo"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/otp"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/token"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangePassword(t *testing.T) {
+
+	var userID uint = 1
+
+	oldPassword := "oldPassword"
+	hashedPassword, err := utils.GetHashedPassword(oldPassword)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		testName      string
+		reqData       request.ChangePassword
+		buildStub     func(userRepo *mockrepo.MockUserRepository, authRepo *mockrepo.MockAuthRepository)
+		expectedError error
+	}{
+		{
+			testName: "NotExistUserShouldReturnError",
+			reqData:  request.ChangePassword{OldPassword: oldPassword, NewPassword: "newPassword"},
+			buildStub: func(userRepo *mockrepo.MockUserRepository, authRepo *mockrepo.MockAuthRepository) {
+				userRepo.EXPECT().FindUserByUserID(gomock.Any(), userID).Times(1).Return(domain.User{}, nil)
+			},
+			expectedError: ErrUserNotExist,
+		},
+		{
+			testName: "WrongOldPasswordShouldSaveFailedAttemptAndReturnError",
+			reqData:  request.ChangePassword{OldPassword: "wrongPassword", NewPassword: "newPassword"},
+			buildStub: func(userRepo *mockrepo.MockUserRepository, authRepo *mockrepo.MockAuthRepository) {
+				userRepo.EXPECT().FindUserByUserID(gomock.Any(), userID).Times(1).
+					Return(domain.User{ID: userID, Password: hashedPassword}, nil)
+				authRepo.EXPECT().FindLoginAttempt(gomock.Any(), "user:account:1").Times(1).
+					Return(domain.LoginAttempt{}, nil)
+				authRepo.EXPECT().SaveFailedLoginAttempt(gomock.Any(), "user:account:1", gomock.Any(), gomock.Any()).
+					Times(1).Return(uint(1), nil)
+			},
+			expectedError: ErrWrongPassword,
+		},
+		{
+			testName: "LockedAccountShouldReturnError",
+			reqData:  request.ChangePassword{OldPassword: oldPassword, NewPassword: "newPassword"},
+			buildStub: func(userRepo *mockrepo.MockUserRepository, authRepo *mockrepo.MockAuthRepository) {
+				userRepo.EXPECT().FindUserByUserID(gomock.Any(), userID).Times(1).
+					Return(domain.User{ID: userID, Password: hashedPassword}, nil)
+				authRepo.EXPECT().FindLoginAttempt(gomock.Any(), "user:account:1").Times(1).
+					Return(domain.LoginAttempt{LockedUntil: time.Now().Add(time.Minute)}, nil)
+			},
+			expectedError: ErrLoginLocked,
+		},
+		{
+			testName: "CorrectOldPasswordShouldUpdatePasswordAndRevokeAllSessions",
+			reqData:  request.ChangePassword{OldPassword: oldPassword, NewPassword: "newPassword"},
+			buildStub: func(userRepo *mockrepo.MockUserRepository, authRepo *mockrepo.MockAuthRepository) {
+				userRepo.EXPECT().FindUserByUserID(gomock.Any(), userID).Times(1).
+					Return(domain.User{ID: userID, Password: hashedPassword}, nil)
+				authRepo.EXPECT().FindLoginAttempt(gomock.Any(), "user:account:1").Times(1).
+					Return(domain.LoginAttempt{}, nil)
+				authRepo.EXPECT().DeleteLoginAttempt(gomock.Any(), "user:account:1").Times(1).Return(nil)
+				userRepo.EXPECT().UpdatePassword(gomock.Any(), userID, gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, userID uint, hashPassword string) error {
+						// new password should save as hashed
+						assert.NoError(t, utils.ComparePasswordWithHashedPassword("newPassword", hashPassword))
+						return nil
+					})
+				authRepo.EXPECT().RevokeAllRefreshSessions(gomock.Any(), userID, string(token.User), gomock.Any()).
+					Times(1).Return(nil)
+			},
+			expectedError: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+
+			ctl := gomock.NewController(t)
+			userRepo := mockrepo.NewMockUserRepository(ctl)
+			authRepo := mockrepo.NewMockAuthRepository(ctl)
+			test.buildStub(userRepo, authRepo)
+
+			authUseCase := NewAuthUseCase(authRepo, nil, userRepo, nil, otp.Channels{})
+
+			err := authUseCase.ChangePassword(context.Background(), userID, test.reqData)
+			assert.ErrorIs(t, err, test.expectedError)
+		})
+	}
+}
+
+func TestResetPassword(t *testing.T) {
+
+	tests := []struct {
+		testName      string
+		otpSession    domain.OtpSession
+		expectedError error
+	}{
+		{
+			testName: "OtpOfLoginShouldReturnInvalidOtp",
+			otpSession: domain.OtpSession{OtpID: "otp_id", UserID: 1, Purpose: domain.OtpPurposeLogin,
+				ExpireAt: time.Now().Add(time.Minute)},
+			expectedError: ErrInvalidOtp,
+		},
+		{
+			testName: "ExpiredOtpShouldReturnError",
+			otpSession: domain.OtpSession{OtpID: "otp_id", UserID: 1, Purpose: domain.OtpPurposeResetPassword,
+				ExpireAt: time.Now().Add(-time.Minute)},
+			expectedError: ErrOtpExpired,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+
+			ctl := gomock.NewController(t)
+			authRepo := mockrepo.NewMockAuthRepository(ctl)
+			authRepo.EXPECT().FindOtpSession(gomock.Any(), test.otpSession.OtpID).Times(1).Return(test.otpSession, nil)
+
+			authUseCase := NewAuthUseCase(authRepo, nil, nil, nil, otp.Channels{})
+
+			err := authUseCase.ResetPassword(context.Background(), request.ResetPassword{
+				OtpID: test.otpSession.OtpID, Otp: "123456", Password: "newPassword", ConfirmPassword: "newPassword"})
+			assert.ErrorIs(t, err, test.expectedError)
+		})
+	}
+}
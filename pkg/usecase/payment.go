@@ -2,44 +2,43 @@ package usecase
 
 import (
 	"context"
-	"crypto/hmac"
-	"crypto/sha256"
-	"crypto/subtle"
-	"encoding/hex"
 	"errors"
+	"fmt"
+	"log"
 
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
-	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/config"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/mail"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/notification"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/payment"
 	service "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
-	"github.com/razorpay/razorpay-go"
-	"github.com/stripe/stripe-go/v72"
-	"github.com/stripe/stripe-go/v72/paymentintent"
 )
 
 type paymentUseCase struct {
 	paymentRepo interfaces.PaymentRepository
 	orderRepo   interfaces.OrderRepository
 	userRepo    interfaces.UserRepository
-	cartRepo    interfaces.CartRepository
-	couponRepo  interfaces.CouponRepository
-	config      config.Config
+	stockRepo   interfaces.StockRepository
+	gateways    payment.Gateways
+	notifier    notification.Notifier
+	mailSender  mail.Sender
 }
 
 func NewPaymentUseCase(paymentRepo interfaces.PaymentRepository,
 	orderRepo interfaces.OrderRepository, userRepo interfaces.UserRepository,
-	cartRepo interfaces.CartRepository, couponRepo interfaces.CouponRepository,
-	config config.Config) service.PaymentUseCase {
+	stockRepo interfaces.StockRepository, gateways payment.Gateways,
+	notifier notification.Notifier, mailSender mail.Sender) service.PaymentUseCase {
 	return &paymentUseCase{
 		paymentRepo: paymentRepo,
 		orderRepo:   orderRepo,
 		userRepo:    userRepo,
-		cartRepo:    cartRepo,
-		couponRepo:  couponRepo,
-		config:      config,
+		stockRepo:   stockRepo,
+		gateways:    gateways,
+		notifier:    notifier,
+		mailSender:  mailSender,
 	}
 }
 
@@ -61,26 +60,55 @@ func (c *paymentUseCase) UpdatePaymentMethod(ctx context.Context, paymentMethod
 	return nil
 }
 
-// To create a razor pay order
-func (c *paymentUseCase) MakeRazorpayOrder(ctx context.Context, userID, shopOrderID uint) (response.RazorpayOrder, error) {
+// To find price details of the user order for payment
+func (c *paymentUseCase) FindPaymentOrderSummary(ctx context.Context, userID,
+	shopOrderID uint) (response.PaymentOrderSummary, error) {
 
 	shopOrder, err := c.orderRepo.FindShopOrderByShopOrderID(ctx, shopOrderID)
 	if err != nil {
-		return response.RazorpayOrder{}, utils.PrependMessageToError(err, "failed to find shop order from database")
+		return response.PaymentOrderSummary{}, utils.PrependMessageToError(err, "failed to find shop order from database")
+	}
+	if shopOrder.ID == 0 || shopOrder.UserID != userID {
+		return response.PaymentOrderSummary{}, ErrInvalidShopOrderID
+	}
+
+	return response.PaymentOrderSummary{
+		ShopOrderID:    shopOrder.ID,
+		SubTotal:       shopOrder.OrderTotalPrice + shopOrder.Discount - shopOrder.DeliveryCharge,
+		Discount:       shopOrder.Discount,
+		DeliveryCharge: shopOrder.DeliveryCharge,
+		OrderTotal:     shopOrder.OrderTotalPrice,
+	}, nil
+}
+
+// To create a razor pay order
+func (c *paymentUseCase) MakeRazorpayOrder(ctx context.Context, userID, shopOrderID uint,
+	useWallet bool) (response.RazorpayOrder, error) {
+
+	shopOrder, err := c.findPaymentPendingShopOrder(ctx, userID, shopOrderID)
+	if err != nil {
+		return response.RazorpayOrder{}, err
 	}
 
 	// find the given payment
-	payment, err := c.paymentRepo.FindPaymentMethodByType(ctx, domain.RazopayPayment)
+	paymentMethod, err := c.paymentRepo.FindPaymentMethodByType(ctx, domain.RazopayPayment)
 	if err != nil {
 		return response.RazorpayOrder{}, utils.PrependMessageToError(err, "failed to find payment method details")
 	}
 	// payment is blocked
-	if payment.BlockStatus {
+	if paymentMethod.BlockStatus {
 		return response.RazorpayOrder{}, ErrBlockedPayment
 	}
 
+	// find the amount to pay from wallet
+	walletAmount, err := c.findWalletAmountForOrder(ctx, userID, shopOrder, useWallet)
+	if err != nil {
+		return response.RazorpayOrder{}, err
+	}
+	amountToPay := shopOrder.OrderTotalPrice - walletAmount
+
 	// check order total reached the payment method max amount
-	if shopOrder.OrderTotalPrice > payment.MaximumAmount {
+	if amountToPay > paymentMethod.MaximumAmount {
 		return response.RazorpayOrder{}, ErrPaymentAmountReachedMax
 	}
 
@@ -90,33 +118,28 @@ func (c *paymentUseCase) MakeRazorpayOrder(ctx context.Context, userID, shopOrde
 		return response.RazorpayOrder{}, err
 	}
 
-	//razorpay amount is calculate on pisa for india so make the actual price into paisa
-	razorPayAmount := shopOrder.OrderTotalPrice * 100
-
-	razorpayKey := c.config.RazorPayKey
-	razorpaySecret := c.config.RazorPaySecret
-
-	client := razorpay.NewClient(razorpayKey, razorpaySecret)
-	// razor pay data for order
-	data := map[string]interface{}{
-		"amount":   razorPayAmount,
-		"currency": "INR",
-		"receipt":  "ecommerce purchase completed",
-	}
-
-	razorpayRes, err := client.Order.Create(data, nil)
+	razorpayOrder, err := c.gateways.Razorpay.CreateOrder(ctx, payment.CreateOrderRequest{
+		ShopOrderID: shopOrderID,
+		Amount:      amountToPay,
+		Email:       userDetails.Email,
+	})
 	if err != nil {
 		return response.RazorpayOrder{}, utils.PrependMessageToError(err, "failed to create razorpay order")
 	}
 
-	razorpayOrderID := razorpayRes["id"]
+	// save razorpay order id with wallet amount on shop order to verify the payment and find the order on webhook events
+	err = c.saveGatewayOrder(ctx, shopOrder, paymentMethod.ID, razorpayOrder.GatewayOrderID, walletAmount)
+	if err != nil {
+		return response.RazorpayOrder{}, err
+	}
 
 	razorPayOrder := response.RazorpayOrder{
 		ShopOrderID:     shopOrderID,
-		AmountToPay:     shopOrder.OrderTotalPrice,
-		RazorpayAmount:  razorPayAmount,
-		RazorpayKey:     razorpayKey,
-		RazorpayOrderID: razorpayOrderID,
+		AmountToPay:     amountToPay,
+		WalletAmount:    walletAmount,
+		RazorpayAmount:  razorpayOrder.GatewayAmount,
+		RazorpayKey:     razorpayOrder.PublicKey,
+		RazorpayOrderID: razorpayOrder.GatewayOrderID,
 		UserID:          userID,
 		Email:           userDetails.Email,
 		Phone:           userDetails.Phone,
@@ -125,169 +148,470 @@ func (c *paymentUseCase) MakeRazorpayOrder(ctx context.Context, userID, shopOrde
 	return razorPayOrder, nil
 }
 
-// To verify razor pay payment
-func (c *paymentUseCase) VerifyRazorPay(ctx context.Context, verifyReq request.RazorpayVerify) error {
+// To verify razor pay payment and approve the shop order
+func (c *paymentUseCase) VerifyRazorPay(ctx context.Context, userID, shopOrderID uint,
+	verifyReq request.RazorpayVerify) error {
 
-	razorpayKey := c.config.RazorPayKey
-	razorPaySecret := c.config.RazorPaySecret
+	shopOrder, err := c.findUserShopOrder(ctx, userID, shopOrderID)
+	if err != nil {
+		return err
+	}
 
-	data := verifyReq.OrderID + "|" + verifyReq.PaymentID
-	h := hmac.New(sha256.New, []byte(razorPaySecret))
-	_, err := h.Write([]byte(data))
+	capturedPayment, err := c.gateways.Razorpay.VerifyPayment(ctx, payment.VerifyPaymentRequest{
+		GatewayOrderID: verifyReq.OrderID,
+		PaymentID:      verifyReq.PaymentID,
+		Signature:      verifyReq.Signature,
+	})
 	if err != nil {
-		return utils.PrependMessageToError(err, "failed to verify signature")
+		return toPaymentVerifyError(err)
 	}
 
-	sha := hex.EncodeToString(h.Sum(nil))
-	if subtle.ConstantTimeCompare([]byte(sha), []byte(verifyReq.Signature)) != 1 {
-		return errors.New("razorpay signature not match")
+	return c.approveGatewayPayment(ctx, shopOrder, domain.RazopayPayment, capturedPayment)
+}
+
+// To mak a stripe order
+func (c *paymentUseCase) MakeStripeOrder(ctx context.Context, userID, shopOrderID uint,
+	useWallet bool) (response.StripeOrder, error) {
+
+	shopOrder, err := c.findPaymentPendingShopOrder(ctx, userID, shopOrderID)
+	if err != nil {
+		return response.StripeOrder{}, err
 	}
 
-	client := razorpay.NewClient(razorpayKey, razorPaySecret)
+	// find the given payment
+	paymentMethod, err := c.paymentRepo.FindPaymentMethodByType(ctx, domain.StripePayment)
+	if err != nil {
+		return response.StripeOrder{}, utils.PrependMessageToError(err, "failed to find payment method details")
+	}
 
-	// fetch payment and verify
-	payment, err := client.Payment.Fetch(verifyReq.PaymentID, nil, nil)
+	// payment is blocked
+	if paymentMethod.BlockStatus {
+		return response.StripeOrder{}, ErrBlockedPayment
+	}
 
+	// find the amount to pay from wallet
+	walletAmount, err := c.findWalletAmountForOrder(ctx, userID, shopOrder, useWallet)
+	if err != nil {
+		return response.StripeOrder{}, err
+	}
+	amountToPay := shopOrder.OrderTotalPrice - walletAmount
+
+	// check order total reached the payment method max amount
+	if amountToPay > paymentMethod.MaximumAmount {
+		return response.StripeOrder{}, ErrPaymentAmountReachedMax
+	}
+
+	userDetails, err := c.userRepo.FindUserByUserID(ctx, userID)
+	if err != nil {
+		return response.StripeOrder{}, err
+	}
+
+	paymentIntent, err := c.gateways.Stripe.CreateOrder(ctx, payment.CreateOrderRequest{
+		ShopOrderID: shopOrderID,
+		Amount:      amountToPay,
+		Email:       userDetails.Email,
+	})
+	if err != nil {
+		return response.StripeOrder{}, utils.PrependMessageToError(err, "failed to create new stripe payment intent")
+	}
+
+	// save payment intent id with wallet amount on shop order to verify the payment and find the order on webhook events
+	err = c.saveGatewayOrder(ctx, shopOrder, paymentMethod.ID, paymentIntent.GatewayOrderID, walletAmount)
+	if err != nil {
+		return response.StripeOrder{}, err
+	}
+
+	stripeOrder := response.StripeOrder{
+		ShopOrderID:    shopOrderID,
+		AmountToPay:    amountToPay,
+		WalletAmount:   walletAmount,
+		ClientSecret:   paymentIntent.ClientSecret,
+		PublishableKey: paymentIntent.PublicKey,
+	}
+
+	return stripeOrder, nil
+}
+
+// To verify the stripe payment intent and approve the shop order
+func (c *paymentUseCase) VerifyStripOrder(ctx context.Context, userID, shopOrderID uint, stripePaymentID string) error {
+
+	shopOrder, err := c.findUserShopOrder(ctx, userID, shopOrderID)
 	if err != nil {
 		return err
 	}
 
-	// check payment status
-	if payment["status"] != "captured" {
-		return ErrPaymentNotApproved
+	capturedPayment, err := c.gateways.Stripe.VerifyPayment(ctx, payment.VerifyPaymentRequest{
+		PaymentID: stripePaymentID,
+	})
+	if err != nil {
+		return toPaymentVerifyError(err)
 	}
 
-	return nil
+	return c.approveGatewayPayment(ctx, shopOrder, domain.StripePayment, capturedPayment)
 }
 
-// To mak a stripe order
-func (c *paymentUseCase) MakeStripeOrder(ctx context.Context, userID, shopOrderID uint) (response.StripeOrder, error) {
+// To find the shop order of the user
+func (c *paymentUseCase) findUserShopOrder(ctx context.Context, userID, shopOrderID uint) (domain.ShopOrder, error) {
 
 	shopOrder, err := c.orderRepo.FindShopOrderByShopOrderID(ctx, shopOrderID)
 	if err != nil {
-		return response.StripeOrder{}, utils.PrependMessageToError(err, "failed to find shop order from database")
+		return domain.ShopOrder{}, utils.PrependMessageToError(err, "failed to find shop order from database")
+	}
+	if shopOrder.ID == 0 || shopOrder.UserID != userID {
+		return domain.ShopOrder{}, ErrInvalidShopOrderID
 	}
 
-	// find the given payment
-	payment, err := c.paymentRepo.FindPaymentMethodByType(ctx, domain.RazopayPayment)
+	return shopOrder, nil
+}
+
+// To find the shop order of the user which is waiting for the payment
+func (c *paymentUseCase) findPaymentPendingShopOrder(ctx context.Context,
+	userID, shopOrderID uint) (domain.ShopOrder, error) {
+
+	shopOrder, err := c.findUserShopOrder(ctx, userID, shopOrderID)
 	if err != nil {
-		return response.StripeOrder{}, utils.PrependMessageToError(err, "failed to find payment method details")
+		return domain.ShopOrder{}, err
+	}
+
+	orderStatus, err := c.orderRepo.FindOrderStatusByID(ctx, shopOrder.OrderStatusID)
+	if err != nil {
+		return domain.ShopOrder{}, utils.PrependMessageToError(err, "failed to find current order status")
 	}
+	switch orderStatus.Status {
+	case domain.StatusPaymentPending:
+		return shopOrder, nil
+	case domain.StatusOrderCancelled:
+		return domain.ShopOrder{}, ErrOrderAlreadyCancelled
+	}
+
+	return domain.ShopOrder{}, ErrOrderNotPaymentPending
+}
+
+// To find the wallet amount for a split payment of wallet and gateway
+// if user not chose to use wallet then the wallet amount is zero
+func (c *paymentUseCase) findWalletAmountForOrder(ctx context.Context, userID uint,
+	shopOrder domain.ShopOrder, useWallet bool) (walletAmount uint, err error) {
+
+	if !useWallet {
+		return 0, nil
+	}
+
+	wallet, err := c.orderRepo.FindWalletByUserID(ctx, userID)
+	if err != nil {
+		return 0, utils.PrependMessageToError(err, "failed to find user wallet")
+	}
+	// if wallet can pay full amount then user should use wallet payment
+	if wallet.TotalAmount >= shopOrder.OrderTotalPrice {
+		return 0, ErrWalletCoversOrderAmount
+	}
+
+	return wallet.TotalAmount, nil
+}
 
+// To save the gateway order with the payment method and wallet amount of split payment on the shop order
+// only the latest gateway order of shop order can approve it with the amount to pay on it
+func (c *paymentUseCase) saveGatewayOrder(ctx context.Context, shopOrder domain.ShopOrder, paymentMethodID uint,
+	gatewayOrderID string, walletAmount uint) error {
+
+	updated, err := c.orderRepo.UpdateShopOrderGatewayOrder(ctx, shopOrder.ID, shopOrder.OrderStatusID,
+		paymentMethodID, gatewayOrderID, walletAmount)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to save gateway order on shop order")
+	}
+	// order status changed after it's checked
+	if !updated {
+		return ErrOrderNotPaymentPending
+	}
+
+	return nil
+}
+
+// To pay the full order amount from user wallet
+func (c *paymentUseCase) MakeWalletPayment(ctx context.Context, userID, shopOrderID uint) error {
+
+	shopOrder, err := c.findPaymentPendingShopOrder(ctx, userID, shopOrderID)
+	if err != nil {
+		return err
+	}
+
+	payment, err := c.paymentRepo.FindPaymentMethodByType(ctx, domain.WalletPayment)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find payment method details")
+	}
 	// payment is blocked
 	if payment.BlockStatus {
-		return response.StripeOrder{}, ErrBlockedPayment
+		return ErrBlockedPayment
 	}
 
 	// check order total reached the payment method max amount
 	if shopOrder.OrderTotalPrice > payment.MaximumAmount {
-		return response.StripeOrder{}, ErrPaymentAmountReachedMax
+		return ErrPaymentAmountReachedMax
 	}
 
-	userDetails, err := c.userRepo.FindUserByUserID(ctx, userID)
+	wallet, err := c.orderRepo.FindWalletByUserID(ctx, userID)
 	if err != nil {
-		return response.StripeOrder{}, err
+		return utils.PrependMessageToError(err, "failed to find user wallet")
+	}
+	if wallet.TotalAmount < shopOrder.OrderTotalPrice {
+		return ErrInsufficientWalletBalance
 	}
-	// set up the stripe secret key
-	stripe.Key = c.config.StripSecretKey
 
-	// create a payment param
-	params := &stripe.PaymentIntentParams{
+	// full order amount is debited from wallet on approve
+	return c.approveShopOrder(ctx, shopOrder, domain.WalletPayment, shopOrder.OrderTotalPrice)
+}
+
+// To convert the gateway verify error to use case error
+func toPaymentVerifyError(err error) error {
+	switch {
+	case errors.Is(err, payment.ErrPaymentNotApproved):
+		return ErrPaymentNotApproved
+	case errors.Is(err, payment.ErrInvalidSignature):
+		return utils.PrependMessageToError(ErrPaymentNotApproved, err.Error())
+	}
+	return utils.PrependMessageToError(err, "failed to verify payment on gateway")
+}
 
-		Amount:       stripe.Int64(int64(shopOrder.OrderTotalPrice)),
-		ReceiptEmail: stripe.String(userDetails.Email),
+// To check the payment is made online through a payment gateway
+func isOnlinePayment(paymentType domain.PaymentType) bool {
+	return paymentType == domain.RazopayPayment || paymentType == domain.StripePayment
+}
 
-		Currency: stripe.String(string(stripe.CurrencyINR)),
-		AutomaticPaymentMethods: &stripe.PaymentIntentAutomaticPaymentMethodsParams{
-			Enabled: stripe.Bool(true),
-		},
+// To find the payment gateway of the online payment type
+func findPaymentGateway(gateways payment.Gateways, paymentType domain.PaymentType) payment.PaymentGateway {
+	if paymentType == domain.StripePayment {
+		return gateways.Stripe
 	}
+	return gateways.Razorpay
+}
 
-	// create new payment intent with this param
-	paymentIntent, err := paymentintent.New(params)
+// To approve the shop order with the payment captured on gateway
+// the payment should be of the latest gateway order of the shop order and captured the amount to pay on it
+// if the wallet amount of split payment can't debit or the order is already cancelled then the captured payment is refunded
+func (c *paymentUseCase) approveGatewayPayment(ctx context.Context, shopOrder domain.ShopOrder,
+	paymentType domain.PaymentType, capturedPayment payment.CapturedPayment) error {
 
-	if err != nil {
-		return response.StripeOrder{}, utils.PrependMessageToError(err, "failed to create new stripe payment intent")
+	if shopOrder.GatewayOrderID == "" || capturedPayment.GatewayOrderID != shopOrder.GatewayOrderID {
+		return ErrPaymentNotForOrder
+	}
+	if capturedPayment.Amount != shopOrder.OrderTotalPrice-shopOrder.WalletAmount {
+		return utils.PrependMessageToError(ErrPaymentAmountMismatch,
+			fmt.Sprintf("paid %d of %d", capturedPayment.Amount, shopOrder.OrderTotalPrice-shopOrder.WalletAmount))
 	}
 
-	clientSecret := paymentIntent.ClientSecret
-	stripePublishKey := c.config.StripPublishKey
+	err := c.approveShopOrder(ctx, shopOrder, paymentType, shopOrder.WalletAmount)
+	switch {
+	case errors.Is(err, ErrInsufficientWalletBalance):
+		refund, refundErr := findPaymentGateway(c.gateways, paymentType).Refund(ctx, payment.RefundRequest{
+			GatewayOrderID: capturedPayment.GatewayOrderID,
+			Amount:         capturedPayment.Amount,
+		})
+		if refundErr != nil {
+			return utils.PrependMessageToError(refundErr,
+				fmt.Sprintf("failed to refund payment of shop order shop_order_id %v after wallet debit failed", shopOrder.ID))
+		}
+		log.Printf("refunded payment of shop order shop_order_id %v with refund id %s after wallet debit failed",
+			shopOrder.ID, refund.RefundID)
 
-	stripeOrder := response.StripeOrder{
-		ShopOrderID:    shopOrderID,
-		AmountToPay:    shopOrder.OrderTotalPrice,
-		ClientSecret:   clientSecret,
-		PublishableKey: stripePublishKey,
+		return utils.PrependMessageToError(err, "paid amount refunded")
+
+	case errors.Is(err, ErrOrderAlreadyCancelled):
+		refundErr := c.refundCancelledOrderPayment(ctx, shopOrder, paymentType, capturedPayment)
+		if refundErr != nil {
+			return refundErr
+		}
+		return utils.PrependMessageToError(err, "paid amount refunded")
 	}
 
-	return stripeOrder, nil
+	return err
 }
 
-func (c *paymentUseCase) VerifyStripOrder(ctx context.Context, stripePaymentID string) error {
+// To refund a payment captured after the shop order is cancelled (payment completed after the order released as stale)
+// the refund is saved on the order cancellation so the same capture from client verify and webhook refunds only once
+func (c *paymentUseCase) refundCancelledOrderPayment(ctx context.Context, shopOrder domain.ShopOrder,
+	paymentType domain.PaymentType, capturedPayment payment.CapturedPayment) error {
+
+	var refundID string
+	err := c.orderRepo.Transaction(func(trxRepo interfaces.OrderRepository) error {
+
+		// claim the refund on cancellation first, another request refunding the same order waits here
+		updated, err := trxRepo.UpdateOrderCancellationGatewayRefund(ctx, shopOrder.ID, capturedPayment.Amount, "")
+		if err != nil {
+			return utils.PrependMessageToError(err, "failed to save gateway refund on order cancellation")
+		}
+		if !updated {
+			log.Printf("payment of cancelled shop order shop_order_id %v already refunded", shopOrder.ID)
+			return nil
+		}
 
-	stripe.Key = c.config.StripSecretKey
+		refund, err := findPaymentGateway(c.gateways, paymentType).Refund(ctx, payment.RefundRequest{
+			GatewayOrderID: capturedPayment.GatewayOrderID,
+			Amount:         capturedPayment.Amount,
+		})
+		if err != nil {
+			return utils.PrependMessageToError(err, "failed to refund payment on payment gateway")
+		}
+		refundID = refund.RefundID
 
-	// get payment by payment_id
-	paymentIntent, err := paymentintent.Get(stripePaymentID, nil)
+		_, err = trxRepo.UpdateOrderCancellationGatewayRefund(ctx, shopOrder.ID, capturedPayment.Amount, refundID)
+		if err != nil {
+			return utils.PrependMessageToError(err, "failed to save gateway refund id on order cancellation")
+		}
 
+		return nil
+	})
 	if err != nil {
-		return utils.PrependMessageToError(err, "failed to get payment intent from stripe")
+		return utils.PrependMessageToError(err,
+			fmt.Sprintf("failed to refund payment of cancelled shop order shop_order_id %v", shopOrder.ID))
 	}
 
-	// verify the payment intent
-	if paymentIntent.Status != stripe.PaymentIntentStatusSucceeded && paymentIntent.Status != stripe.PaymentIntentStatusRequiresCapture {
-		return ErrPaymentNotApproved
+	if refundID != "" {
+		log.Printf("refunded payment captured after cancel of shop order shop_order_id %v with refund id %s",
+			shopOrder.ID, refundID)
 	}
-
 	return nil
 }
 
-// Approve the order and clear the cart (if coupon applied then change it used for this user)
+// Approve the order and clear the cart for payments without gateway (cod)
+// approving an already approved order will not change anything
 func (c *paymentUseCase) ApproveShopOrderAndClearCart(ctx context.Context, userID uint,
 	approveDetails request.ApproveOrder) error {
 
+	shopOrder, err := c.findUserShopOrder(ctx, userID, approveDetails.ShopOrderID)
+	if err != nil {
+		return err
+	}
+
+	return c.approveShopOrder(ctx, shopOrder, approveDetails.PaymentType, 0)
+}
+
+// To find the result of an order changed by another request while approving it
+// the order is approved by the other request or it's cancelled
+func (c *paymentUseCase) findApprovedOrderStatusError(ctx context.Context, shopOrderID uint) error {
+
+	orderStatus, err := c.orderRepo.FindOrderStatusByShopOrderID(ctx, shopOrderID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find current order status")
+	}
+	if orderStatus.Status == domain.StatusOrderCancelled {
+		return ErrOrderAlreadyCancelled
+	}
+
+	log.Printf("shop order with shop_order_id %v already approved by another request", shopOrderID)
+	return nil
+}
+
+// Approve the order with the amount paid from wallet and clear the cart (if coupon applied then change it used for this user)
+// approving an already approved order will not change anything (client verify and webhook can approve same order)
+func (c *paymentUseCase) approveShopOrder(ctx context.Context, shopOrder domain.ShopOrder,
+	paymentType domain.PaymentType, walletAmount uint) error {
+
+	userID := shopOrder.UserID
+
+	currentOrderStatus, err := c.orderRepo.FindOrderStatusByID(ctx, shopOrder.OrderStatusID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find current order status")
+	}
+	switch currentOrderStatus.Status {
+	case domain.StatusPaymentPending:
+	case domain.StatusOrderCancelled:
+		return ErrOrderAlreadyCancelled
+	default:
+		log.Printf("shop order with shop_order_id %v already approved", shopOrder.ID)
+		return nil
+	}
+
 	// find the order status of order placed
 	orderPlacedStatus, err := c.orderRepo.FindOrderStatusByStatus(ctx, domain.StatusOrderPlaced)
 	if err != nil {
 		return utils.PrependMessageToError(err, "failed to find order place status for shop order")
 	}
 	// find the payment method of given payment type
-	paymentMethod, err := c.paymentRepo.FindPaymentMethodByType(ctx, approveDetails.PaymentType)
+	paymentMethod, err := c.paymentRepo.FindPaymentMethodByType(ctx, paymentType)
 	if err != nil {
 		return utils.PrependMessageToError(err, "failed to find payment method from database")
 	}
 
 	err = c.orderRepo.Transaction(func(trxRepo interfaces.OrderRepository) error {
 
-		// change order status and save the payment method for the order
-		err = trxRepo.UpdateShopOrderStatusAndSavePaymentMethod(ctx, approveDetails.ShopOrderID,
-			orderPlacedStatus.ID, paymentMethod.ID)
+		// change order status and save the payment method and wallet amount for the order
+		// only a payment pending order is changed so a concurrent approve or cancel is not applied twice
+		updated, err := trxRepo.UpdateShopOrderStatusAndSavePaymentMethod(ctx, shopOrder.ID, currentOrderStatus.ID,
+			orderPlacedStatus.ID, paymentMethod.ID, walletAmount)
 		if err != nil {
 			return utils.PrependMessageToError(err, "failed to update shop order status and payment method")
 		}
-		// find the cart
-		cart, err := c.cartRepo.FindCartByUserID(ctx, userID)
+		if !updated {
+			return ErrOrderStatusChanged
+		}
+		err = trxRepo.SaveShopOrderStatusHistory(ctx, domain.ShopOrderStatusHistory{
+			ShopOrderID:   shopOrder.ID,
+			OrderStatusID: orderPlacedStatus.ID,
+			ActorID:       userID,
+			ActorType:     domain.ActorUser,
+			Comment:       "payment completed with " + string(paymentMethod.Name),
+		})
 		if err != nil {
-			return utils.PrependMessageToError(err, "failed to find user cart from database")
+			return utils.PrependMessageToError(err, "failed to save order status history")
 		}
 
-		// if user applied a coupon on cart then save coupon uses for user
-		if cart.AppliedCouponID != 0 {
-			err = c.couponRepo.SaveCouponUses(ctx, domain.CouponUses{
+		// payment completed so change the reserved stock to sold stock
+		err = changeOrderStock(ctx, trxRepo, shopOrder.ID, domain.StockRelease, domain.ActorUser, userID)
+		if err != nil {
+			return err
+		}
+		err = changeOrderStock(ctx, trxRepo, shopOrder.ID, domain.StockSale, domain.ActorUser, userID)
+		if err != nil {
+			return err
+		}
+
+		// if any amount paid from wallet then debit it from user wallet
+		if walletAmount != 0 {
+			err = debitWallet(ctx, trxRepo, userID, walletAmount)
+			if err != nil {
+				return err
+			}
+		}
+
+		// if a coupon applied on the order then save coupon uses for user
+		if shopOrder.CouponID != 0 {
+			err = trxRepo.SaveCouponUses(ctx, domain.CouponUses{
 				UserID:   userID,
-				CouponID: cart.AppliedCouponID,
+				CouponID: shopOrder.CouponID,
 			})
-
 			if err != nil {
 				return utils.PrependMessageToError(err, "failed to save coupon used for user")
 			}
+			err = trxRepo.RemoveCouponFromCart(ctx, userID, shopOrder.CouponID)
+			if err != nil {
+				return utils.PrependMessageToError(err, "failed to remove used coupon from cart")
+			}
 		}
-		// delete the all cart item
-		err = c.cartRepo.DeleteAllCartItemsByCartID(ctx, cart.ID)
+
+		// delete only the ordered items so the items added to cart after the order are not removed
+		err = trxRepo.DeleteOrderedCartItems(ctx, userID, shopOrder.ID)
+		if err != nil {
+			return utils.PrependMessageToError(err, "failed to clear ordered items from cart")
+		}
+
+		// invoice number is given with the order placed so it's in the order of approval
+		_, err = trxRepo.SaveInvoice(ctx, shopOrder.ID)
 		if err != nil {
-			return utils.PrependMessageToError(err, "failed to clear user cart")
+			return utils.PrependMessageToError(err, "failed to save invoice of shop order")
 		}
 		return nil
 	})
-	return err
+	if errors.Is(err, ErrOrderStatusChanged) {
+		return c.findApprovedOrderStatusError(ctx, shopOrder.ID)
+	}
+	if err != nil {
+		return err
+	}
+
+	shopOrder.WalletAmount = walletAmount
+	notifyLowStocksOfShopOrder(ctx, c.stockRepo, c.notifier, shopOrder.ID)
+	// mail is sent on background so the client verify and webhook not wait for the smtp server
+	// (request context is not used because it's done when the response is sent)
+	go sendOrderConfirmationMail(context.Background(), c.userRepo, c.orderRepo, c.mailSender, shopOrder)
+
+	return nil
 }
@@ -0,0 +1,700 @@
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/config"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/mock/mockrepo"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/payment"
+	"github.com/stretchr/testify/assert"
+	"github.com/stripe/stripe-go/v72/webhook"
+)
+
+const (
+	testStripeWebhookSecret   = "whsec_test_secret"
+	testRazorpayWebhookSecret = "razorpay_test_secret"
+)
+
+func signStripePayload(payload []byte, secret string) string {
+	now := time.Now()
+	signature := webhook.ComputeSignature(now, payload, secret)
+	return fmt.Sprintf("t=%d,v1=%s", now.Unix(), hex.EncodeToString(signature))
+}
+
+func signRazorpayPayload(payload []byte, secret string) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write(payload)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func TestHandleStripeWebhook(t *testing.T) {
+
+	failedPayload := []byte(`{"id":"evt_1","object":"event","type":"payment_intent.payment_failed",` +
+		`"data":{"object":{"id":"pi_1","object":"payment_intent"}}}`)
+
+	tests := []struct {
+		testName      string
+		payload       []byte
+		signature     string
+		buildStub     func(paymentRepo *mockrepo.MockPaymentRepository, orderRepo *mockrepo.MockOrderRepository)
+		expectedError error
+	}{
+		{
+			testName:  "InvalidSignatureShouldReturnError",
+			payload:   failedPayload,
+			signature: signStripePayload(failedPayload, "wrong_secret"),
+			buildStub: func(paymentRepo *mockrepo.MockPaymentRepository, orderRepo *mockrepo.MockOrderRepository) {
+				// not expecting any call to repos
+			},
+			expectedError: ErrInvalidWebhookSignature,
+		},
+		{
+			testName:  "AlreadyProcessedEventShouldNotApplyAgain",
+			payload:   failedPayload,
+			signature: signStripePayload(failedPayload, testStripeWebhookSecret),
+			buildStub: func(paymentRepo *mockrepo.MockPaymentRepository, orderRepo *mockrepo.MockOrderRepository) {
+				paymentRepo.EXPECT().FindPaymentEventByEventID(gomock.Any(), domain.StripePayment, "evt_1").
+					Times(1).Return(domain.PaymentEvent{ID: 1, Processed: true}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			testName:  "PaymentFailedEventShouldSaveAndMarkProcessed",
+			payload:   failedPayload,
+			signature: signStripePayload(failedPayload, testStripeWebhookSecret),
+			buildStub: func(paymentRepo *mockrepo.MockPaymentRepository, orderRepo *mockrepo.MockOrderRepository) {
+				paymentRepo.EXPECT().FindPaymentEventByEventID(gomock.Any(), domain.StripePayment, "evt_1").
+					Times(1).Return(domain.PaymentEvent{}, nil)
+				paymentRepo.EXPECT().SavePaymentEvent(gomock.Any(), gomock.Any()).Times(1).Return(uint(2), nil)
+				orderRepo.EXPECT().FindShopOrderByGatewayOrderID(gomock.Any(), "pi_1").
+					Times(1).Return(domain.ShopOrder{ID: 5, UserID: 1}, nil)
+				paymentRepo.EXPECT().UpdatePaymentEventResult(gomock.Any(), uint(2), true, "").Times(1).Return(nil)
+			},
+			expectedError: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+
+			ctl := gomock.NewController(t)
+			paymentRepo := mockrepo.NewMockPaymentRepository(ctl)
+			orderRepo := mockrepo.NewMockOrderRepository(ctl)
+			test.buildStub(paymentRepo, orderRepo)
+
+			cfg := config.Config{StripeWebhookSecret: testStripeWebhookSecret}
+			paymentUseCase := NewPaymentUseCase(paymentRepo, orderRepo, nil, nil, payment.NewPaymentGateways(cfg), nil, nil)
+
+			err := paymentUseCase.HandleStripeWebhook(context.Background(), test.payload, test.signature)
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHandleRazorpayWebhook(t *testing.T) {
+
+	capturedPayload := []byte(`{"event":"payment.captured",` +
+		`"payload":{"payment":{"entity":{"id":"pay_1","order_id":"order_1"}}}}`)
+
+	tests := []struct {
+		testName      string
+		payload       []byte
+		signature     string
+		eventID       string
+		buildStub     func(paymentRepo *mockrepo.MockPaymentRepository, orderRepo *mockrepo.MockOrderRepository)
+		expectedError error
+	}{
+		{
+			testName:  "InvalidSignatureShouldReturnError",
+			payload:   capturedPayload,
+			signature: signRazorpayPayload(capturedPayload, "wrong_secret"),
+			buildStub: func(paymentRepo *mockrepo.MockPaymentRepository, orderRepo *mockrepo.MockOrderRepository) {
+				// not expecting any call to repos
+			},
+			expectedError: ErrInvalidWebhookSignature,
+		},
+		{
+			testName:  "EmptyEventIDShouldUsePaymentIDAndEventType",
+			payload:   capturedPayload,
+			signature: signRazorpayPayload(capturedPayload, testRazorpayWebhookSecret),
+			buildStub: func(paymentRepo *mockrepo.MockPaymentRepository, orderRepo *mockrepo.MockOrderRepository) {
+				paymentRepo.EXPECT().FindPaymentEventByEventID(gomock.Any(), domain.RazopayPayment, "pay_1:payment.captured").
+					Times(1).Return(domain.PaymentEvent{ID: 1, Processed: true}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			testName:  "UnknownGatewayOrderShouldSaveErrorOnEvent",
+			payload:   capturedPayload,
+			signature: signRazorpayPayload(capturedPayload, testRazorpayWebhookSecret),
+			eventID:   "evt_razorpay_1",
+			buildStub: func(paymentRepo *mockrepo.MockPaymentRepository, orderRepo *mockrepo.MockOrderRepository) {
+				paymentRepo.EXPECT().FindPaymentEventByEventID(gomock.Any(), domain.RazopayPayment, "evt_razorpay_1").
+					Times(1).Return(domain.PaymentEvent{}, nil)
+				paymentRepo.EXPECT().SavePaymentEvent(gomock.Any(), gomock.Any()).Times(1).Return(uint(3), nil)
+				orderRepo.EXPECT().FindShopOrderByGatewayOrderID(gomock.Any(), "order_1").
+					Times(1).Return(domain.ShopOrder{}, nil)
+				paymentRepo.EXPECT().UpdatePaymentEventResult(gomock.Any(), uint(3), false, gomock.Any()).
+					Times(1).Return(nil)
+			},
+			expectedError: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+
+			ctl := gomock.NewController(t)
+			paymentRepo := mockrepo.NewMockPaymentRepository(ctl)
+			orderRepo := mockrepo.NewMockOrderRepository(ctl)
+			test.buildStub(paymentRepo, orderRepo)
+
+			cfg := config.Config{RazorPayWebhookSecret: testRazorpayWebhookSecret}
+			paymentUseCase := NewPaymentUseCase(paymentRepo, orderRepo, nil, nil, payment.NewPaymentGateways(cfg), nil, nil)
+
+			err := paymentUseCase.HandleRazorpayWebhook(context.Background(), test.payload, test.signature, test.eventID)
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifyStripOrder(t *testing.T) {
+
+	paymentPendingStatus := domain.OrderStatus{ID: 1, Status: domain.StatusPaymentPending}
+	orderPlacedStatus := domain.OrderStatus{ID: 2, Status: domain.StatusOrderPlaced}
+	cancelledStatus := domain.OrderStatus{ID: 3, Status: domain.StatusOrderCancelled}
+
+	// the first order created on fake gateway of each test
+	gatewayOrderID := "fake_order_1"
+
+	tests := []struct {
+		testName        string
+		paymentStatus   payment.PaymentStatus
+		shopOrder       domain.ShopOrder
+		buildStub       func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository)
+		expectedRefunds int
+		expectedError   error
+	}{
+		{
+			testName:      "OrderOfOtherUserShouldReturnInvalidShopOrder",
+			paymentStatus: payment.StatusSucceeded,
+			shopOrder:     domain.ShopOrder{ID: 5, UserID: 2, OrderTotalPrice: 100, GatewayOrderID: gatewayOrderID},
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository) {
+			},
+			expectedError: ErrInvalidShopOrderID,
+		},
+		{
+			testName:      "PendingPaymentShouldReturnNotApproved",
+			paymentStatus: payment.StatusPending,
+			shopOrder:     domain.ShopOrder{ID: 5, UserID: 1, OrderTotalPrice: 100, GatewayOrderID: gatewayOrderID},
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository) {
+			},
+			expectedError: ErrPaymentNotApproved,
+		},
+		{
+			testName:      "FailedPaymentShouldReturnNotApproved",
+			paymentStatus: payment.StatusFailed,
+			shopOrder:     domain.ShopOrder{ID: 5, UserID: 1, OrderTotalPrice: 100, GatewayOrderID: gatewayOrderID},
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository) {
+			},
+			expectedError: ErrPaymentNotApproved,
+		},
+		{
+			testName:      "PaymentOfOtherGatewayOrderShouldReturnNotForOrder",
+			paymentStatus: payment.StatusSucceeded,
+			shopOrder:     domain.ShopOrder{ID: 5, UserID: 1, OrderTotalPrice: 100, GatewayOrderID: "fake_order_2"},
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository) {
+			},
+			expectedError: ErrPaymentNotForOrder,
+		},
+		{
+			testName:      "PaidAmountNotMatchingShouldReturnAmountMismatch",
+			paymentStatus: payment.StatusSucceeded,
+			shopOrder:     domain.ShopOrder{ID: 5, UserID: 1, OrderTotalPrice: 150, GatewayOrderID: gatewayOrderID},
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository) {
+			},
+			expectedError: ErrPaymentAmountMismatch,
+		},
+		{
+			testName:      "AlreadyApprovedOrderShouldNotReturnError",
+			paymentStatus: payment.StatusSucceeded,
+			shopOrder: domain.ShopOrder{ID: 5, UserID: 1, OrderTotalPrice: 150, WalletAmount: 50,
+				GatewayOrderID: gatewayOrderID, OrderStatusID: orderPlacedStatus.ID},
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository) {
+				orderRepo.EXPECT().FindOrderStatusByID(gomock.Any(), orderPlacedStatus.ID).
+					Times(1).Return(orderPlacedStatus, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			testName:      "WalletDebitFailedShouldRefundPayment",
+			paymentStatus: payment.StatusSucceeded,
+			shopOrder: domain.ShopOrder{ID: 5, UserID: 1, OrderTotalPrice: 150, WalletAmount: 50,
+				GatewayOrderID: gatewayOrderID, OrderStatusID: paymentPendingStatus.ID},
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository) {
+				orderRepo.EXPECT().FindOrderStatusByID(gomock.Any(), paymentPendingStatus.ID).
+					Times(1).Return(paymentPendingStatus, nil)
+				orderRepo.EXPECT().FindOrderStatusByStatus(gomock.Any(), domain.StatusOrderPlaced).
+					Times(1).Return(orderPlacedStatus, nil)
+				paymentRepo.EXPECT().FindPaymentMethodByType(gomock.Any(), domain.StripePayment).
+					Times(1).Return(domain.PaymentMethod{ID: 3, Name: domain.StripePayment}, nil)
+				orderRepo.EXPECT().Transaction(gomock.Any()).Times(1).
+					DoAndReturn(func(callBack func(trxRepo interfaces.OrderRepository) error) error {
+						return callBack(orderRepo)
+					})
+				orderRepo.EXPECT().UpdateShopOrderStatusAndSavePaymentMethod(gomock.Any(), uint(5), paymentPendingStatus.ID,
+					orderPlacedStatus.ID, uint(3), uint(50)).Times(1).Return(true, nil)
+				orderRepo.EXPECT().SaveShopOrderStatusHistory(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				orderRepo.EXPECT().FindAllOrderLinesByShopOrderID(gomock.Any(), uint(5)).Times(2).Return(nil, nil)
+				orderRepo.EXPECT().FindWalletByUserID(gomock.Any(), uint(1)).
+					Times(1).Return(domain.Wallet{ID: 1, UserID: 1, TotalAmount: 50}, nil)
+				// wallet spent by another payment after the checkout
+				orderRepo.EXPECT().DebitWallet(gomock.Any(), uint(1), uint(50)).Times(1).Return(false, nil)
+			},
+			expectedRefunds: 1,
+			expectedError:   ErrInsufficientWalletBalance,
+		},
+		{
+			testName:      "PaymentCapturedAfterCancelShouldRefundPayment",
+			paymentStatus: payment.StatusSucceeded,
+			shopOrder: domain.ShopOrder{ID: 5, UserID: 1, OrderTotalPrice: 100,
+				GatewayOrderID: gatewayOrderID, OrderStatusID: cancelledStatus.ID},
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository) {
+				orderRepo.EXPECT().FindOrderStatusByID(gomock.Any(), cancelledStatus.ID).
+					Times(1).Return(cancelledStatus, nil)
+				orderRepo.EXPECT().Transaction(gomock.Any()).Times(1).
+					DoAndReturn(func(callBack func(trxRepo interfaces.OrderRepository) error) error {
+						return callBack(orderRepo)
+					})
+				orderRepo.EXPECT().UpdateOrderCancellationGatewayRefund(gomock.Any(), uint(5), uint(100), "").
+					Times(1).Return(true, nil)
+				orderRepo.EXPECT().UpdateOrderCancellationGatewayRefund(gomock.Any(), uint(5), uint(100), "fake_refund_1").
+					Times(1).Return(true, nil)
+			},
+			expectedRefunds: 1,
+			expectedError:   ErrOrderAlreadyCancelled,
+		},
+		{
+			testName:      "PaymentOfCancelledOrderAlreadyRefundedShouldNotRefundAgain",
+			paymentStatus: payment.StatusSucceeded,
+			shopOrder: domain.ShopOrder{ID: 5, UserID: 1, OrderTotalPrice: 100,
+				GatewayOrderID: gatewayOrderID, OrderStatusID: cancelledStatus.ID},
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository) {
+				orderRepo.EXPECT().FindOrderStatusByID(gomock.Any(), cancelledStatus.ID).
+					Times(1).Return(cancelledStatus, nil)
+				orderRepo.EXPECT().Transaction(gomock.Any()).Times(1).
+					DoAndReturn(func(callBack func(trxRepo interfaces.OrderRepository) error) error {
+						return callBack(orderRepo)
+					})
+				// refunded by the webhook of the same payment
+				orderRepo.EXPECT().UpdateOrderCancellationGatewayRefund(gomock.Any(), uint(5), uint(100), "").
+					Times(1).Return(false, nil)
+			},
+			expectedRefunds: 0,
+			expectedError:   ErrOrderAlreadyCancelled,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+
+			ctl := gomock.NewController(t)
+			orderRepo := mockrepo.NewMockOrderRepository(ctl)
+			paymentRepo := mockrepo.NewMockPaymentRepository(ctl)
+
+			orderRepo.EXPECT().FindShopOrderByShopOrderID(gomock.Any(), test.shopOrder.ID).
+				Times(1).Return(test.shopOrder, nil)
+			test.buildStub(orderRepo, paymentRepo)
+
+			fakeGateway := payment.NewFakeGateway()
+			gatewayOrder, err := fakeGateway.CreateOrder(context.Background(), payment.CreateOrderRequest{Amount: 100})
+			assert.NoError(t, err)
+			fakeGateway.SetPaymentStatus(gatewayOrder.GatewayOrderID, test.paymentStatus)
+
+			paymentUseCase := NewPaymentUseCase(paymentRepo, orderRepo, nil, nil, payment.Gateways{Stripe: fakeGateway}, nil, nil)
+
+			err = paymentUseCase.VerifyStripOrder(context.Background(), 1, test.shopOrder.ID, gatewayOrder.GatewayOrderID)
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Len(t, fakeGateway.Refunds(), test.expectedRefunds)
+		})
+	}
+}
+
+func TestMakeWalletPayment(t *testing.T) {
+
+	paymentPendingStatus := domain.OrderStatus{ID: 1, Status: domain.StatusPaymentPending}
+	orderPlacedStatus := domain.OrderStatus{ID: 2, Status: domain.StatusOrderPlaced}
+	walletPayment := domain.PaymentMethod{ID: 4, Name: domain.WalletPayment, MaximumAmount: 1000}
+
+	tests := []struct {
+		testName      string
+		shopOrder     domain.ShopOrder
+		buildStub     func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository)
+		expectedError error
+	}{
+		{
+			testName:  "OrderOfOtherUserShouldNotChangeOrder",
+			shopOrder: domain.ShopOrder{ID: 5, UserID: 2, OrderTotalPrice: 100, OrderStatusID: paymentPendingStatus.ID},
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository) {
+			},
+			expectedError: ErrInvalidShopOrderID,
+		},
+		{
+			testName:  "ApprovedOrderShouldReturnNotPaymentPending",
+			shopOrder: domain.ShopOrder{ID: 5, UserID: 1, OrderTotalPrice: 100, OrderStatusID: orderPlacedStatus.ID},
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository) {
+				orderRepo.EXPECT().FindOrderStatusByID(gomock.Any(), orderPlacedStatus.ID).
+					Times(1).Return(orderPlacedStatus, nil)
+			},
+			expectedError: ErrOrderNotPaymentPending,
+		},
+		{
+			testName:  "NotEnoughWalletBalanceShouldReturnError",
+			shopOrder: domain.ShopOrder{ID: 5, UserID: 1, OrderTotalPrice: 100, OrderStatusID: paymentPendingStatus.ID},
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository) {
+				orderRepo.EXPECT().FindOrderStatusByID(gomock.Any(), paymentPendingStatus.ID).
+					Times(1).Return(paymentPendingStatus, nil)
+				paymentRepo.EXPECT().FindPaymentMethodByType(gomock.Any(), domain.WalletPayment).
+					Times(1).Return(walletPayment, nil)
+				orderRepo.EXPECT().FindWalletByUserID(gomock.Any(), uint(1)).
+					Times(1).Return(domain.Wallet{ID: 1, UserID: 1, TotalAmount: 99}, nil)
+			},
+			expectedError: ErrInsufficientWalletBalance,
+		},
+		{
+			testName:  "ConcurrentWalletSpendShouldNotApproveOrder",
+			shopOrder: domain.ShopOrder{ID: 5, UserID: 1, OrderTotalPrice: 100, OrderStatusID: paymentPendingStatus.ID},
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, paymentRepo *mockrepo.MockPaymentRepository) {
+				orderRepo.EXPECT().FindOrderStatusByID(gomock.Any(), paymentPendingStatus.ID).
+					Times(2).Return(paymentPendingStatus, nil)
+				paymentRepo.EXPECT().FindPaymentMethodByType(gomock.Any(), domain.WalletPayment).
+					Times(2).Return(walletPayment, nil)
+				orderRepo.EXPECT().FindWalletByUserID(gomock.Any(), uint(1)).
+					Times(2).Return(domain.Wallet{ID: 1, UserID: 1, TotalAmount: 100}, nil)
+				orderRepo.EXPECT().FindOrderStatusByStatus(gomock.Any(), domain.StatusOrderPlaced).
+					Times(1).Return(orderPlacedStatus, nil)
+				orderRepo.EXPECT().Transaction(gomock.Any()).Times(1).
+					DoAndReturn(func(callBack func(trxRepo interfaces.OrderRepository) error) error {
+						return callBack(orderRepo)
+					})
+				orderRepo.EXPECT().UpdateShopOrderStatusAndSavePaymentMethod(gomock.Any(), uint(5), paymentPendingStatus.ID,
+					orderPlacedStatus.ID, walletPayment.ID, uint(100)).Times(1).Return(true, nil)
+				orderRepo.EXPECT().SaveShopOrderStatusHistory(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				orderRepo.EXPECT().FindAllOrderLinesByShopOrderID(gomock.Any(), uint(5)).Times(2).Return(nil, nil)
+				// balance spent after it's checked
+				orderRepo.EXPECT().DebitWallet(gomock.Any(), uint(1), uint(100)).Times(1).Return(false, nil)
+			},
+			expectedError: ErrInsufficientWalletBalance,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+
+			ctl := gomock.NewController(t)
+			orderRepo := mockrepo.NewMockOrderRepository(ctl)
+			paymentRepo := mockrepo.NewMockPaymentRepository(ctl)
+
+			orderRepo.EXPECT().FindShopOrderByShopOrderID(gomock.Any(), test.shopOrder.ID).
+				Times(1).Return(test.shopOrder, nil)
+			test.buildStub(orderRepo, paymentRepo)
+
+			paymentUseCase := NewPaymentUseCase(paymentRepo, orderRepo, nil, nil, payment.Gateways{}, nil, nil)
+
+			err := paymentUseCase.MakeWalletPayment(context.Background(), 1, test.shopOrder.ID)
+			assert.ErrorIs(t, err, test.expectedError)
+		})
+	}
+}
+
+func TestMakeRazorpayOrder(t *testing.T) {
+
+	paymentPendingStatus := domain.OrderStatus{ID: 1, Status: domain.StatusPaymentPending}
+	razorpayPayment := domain.PaymentMethod{ID: 2, Name: domain.RazopayPayment, MaximumAmount: 1000}
+	shopOrder := domain.ShopOrder{ID: 5, UserID: 1, OrderTotalPrice: 300, OrderStatusID: paymentPendingStatus.ID}
+
+	tests := []struct {
+		testName       string
+		useWallet      bool
+		buildStub      func(orderRepo *mockrepo.MockOrderRepository, userRepo *mockrepo.MockUserRepository)
+		expectedOutput response.RazorpayOrder
+		expectedError  error
+	}{
+		{
+			testName:  "WithoutWalletShouldPayFullAmountOnGateway",
+			useWallet: false,
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, userRepo *mockrepo.MockUserRepository) {
+				userRepo.EXPECT().FindUserByUserID(gomock.Any(), uint(1)).Times(1).Return(domain.User{ID: 1}, nil)
+				orderRepo.EXPECT().UpdateShopOrderGatewayOrder(gomock.Any(), shopOrder.ID, paymentPendingStatus.ID, razorpayPayment.ID,
+					"fake_order_1", uint(0)).Times(1).Return(true, nil)
+			},
+			expectedOutput: response.RazorpayOrder{ShopOrderID: 5, AmountToPay: 300, WalletAmount: 0, RazorpayAmount: 300,
+				RazorpayKey: "fake_public_key", RazorpayOrderID: "fake_order_1", UserID: 1},
+			expectedError: nil,
+		},
+		{
+			testName:  "WithWalletShouldSplitAmountAndSaveWalletAmount",
+			useWallet: true,
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, userRepo *mockrepo.MockUserRepository) {
+				orderRepo.EXPECT().FindWalletByUserID(gomock.Any(), uint(1)).Times(1).
+					Return(domain.Wallet{ID: 1, UserID: 1, TotalAmount: 100}, nil)
+				userRepo.EXPECT().FindUserByUserID(gomock.Any(), uint(1)).Times(1).Return(domain.User{ID: 1}, nil)
+				orderRepo.EXPECT().UpdateShopOrderGatewayOrder(gomock.Any(), shopOrder.ID, paymentPendingStatus.ID, razorpayPayment.ID,
+					"fake_order_1", uint(100)).Times(1).Return(true, nil)
+			},
+			expectedOutput: response.RazorpayOrder{ShopOrderID: 5, AmountToPay: 200, WalletAmount: 100, RazorpayAmount: 200,
+				RazorpayKey: "fake_public_key", RazorpayOrderID: "fake_order_1", UserID: 1},
+			expectedError: nil,
+		},
+		{
+			testName:  "WalletCoversOrderAmountShouldReturnError",
+			useWallet: true,
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, userRepo *mockrepo.MockUserRepository) {
+				orderRepo.EXPECT().FindWalletByUserID(gomock.Any(), uint(1)).Times(1).
+					Return(domain.Wallet{ID: 1, UserID: 1, TotalAmount: 300}, nil)
+			},
+			expectedOutput: response.RazorpayOrder{},
+			expectedError:  ErrWalletCoversOrderAmount,
+		},
+		{
+			testName:  "OrderApprovedBeforeSaveShouldReturnNotPaymentPending",
+			useWallet: false,
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository, userRepo *mockrepo.MockUserRepository) {
+				userRepo.EXPECT().FindUserByUserID(gomock.Any(), uint(1)).Times(1).Return(domain.User{ID: 1}, nil)
+				orderRepo.EXPECT().UpdateShopOrderGatewayOrder(gomock.Any(), shopOrder.ID, paymentPendingStatus.ID, razorpayPayment.ID,
+					"fake_order_1", uint(0)).Times(1).Return(false, nil)
+			},
+			expectedOutput: response.RazorpayOrder{},
+			expectedError:  ErrOrderNotPaymentPending,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+			t.Parallel()
+
+			ctl := gomock.NewController(t)
+			orderRepo := mockrepo.NewMockOrderRepository(ctl)
+			paymentRepo := mockrepo.NewMockPaymentRepository(ctl)
+			userRepo := mockrepo.NewMockUserRepository(ctl)
+
+			orderRepo.EXPECT().FindShopOrderByShopOrderID(gomock.Any(), shopOrder.ID).Times(1).Return(shopOrder, nil)
+			orderRepo.EXPECT().FindOrderStatusByID(gomock.Any(), paymentPendingStatus.ID).
+				Times(1).Return(paymentPendingStatus, nil)
+			paymentRepo.EXPECT().FindPaymentMethodByType(gomock.Any(), domain.RazopayPayment).
+				Times(1).Return(razorpayPayment, nil)
+			test.buildStub(orderRepo, userRepo)
+
+			paymentUseCase := NewPaymentUseCase(paymentRepo, orderRepo, userRepo, nil, payment.Gateways{Razorpay: payment.NewFakeGateway()}, nil, nil)
+
+			output, err := paymentUseCase.MakeRazorpayOrder(context.Background(), 1, shopOrder.ID, test.useWallet)
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expectedOutput, output)
+		})
+	}
+}
+
+func TestHandleStripeWebhookOrderEvents(t *testing.T) {
+
+	paymentPendingStatus := domain.OrderStatus{ID: 1, Status: domain.StatusPaymentPending}
+	orderPlacedStatus := domain.OrderStatus{ID: 2, Status: domain.StatusOrderPlaced}
+	cancelledStatus := domain.OrderStatus{ID: 3, Status: domain.StatusOrderCancelled}
+	stripePayment := domain.PaymentMethod{ID: 3, Name: domain.StripePayment}
+
+	// split payment of 100 from wallet and 200 on stripe with a coupon applied
+	pendingOrder := domain.ShopOrder{ID: 5, UserID: 1, OrderStatusID: paymentPendingStatus.ID, OrderTotalPrice: 300,
+		WalletAmount: 100, GatewayOrderID: "pi_1", CouponID: 4}
+	placedOrder := pendingOrder
+	placedOrder.OrderStatusID = orderPlacedStatus.ID
+	placedOrder.PaymentMethodID = stripePayment.ID
+
+	succeededPayload := []byte(`{"id":"evt_1","object":"event","type":"payment_intent.succeeded",` +
+		`"data":{"object":{"id":"pi_1","object":"payment_intent","amount_received":20000}}}`)
+	partialRefundPayload := []byte(`{"id":"evt_1","object":"event","type":"charge.refunded",` +
+		`"data":{"object":{"id":"ch_1","object":"charge","payment_intent":"pi_1","amount_refunded":5000}}}`)
+	fullRefundPayload := []byte(`{"id":"evt_1","object":"event","type":"charge.refunded",` +
+		`"data":{"object":{"id":"ch_1","object":"charge","payment_intent":"pi_1","amount_refunded":20000}}}`)
+
+	// order confirmation mail is sent on background after approve
+	mailStarted := make(chan struct{})
+
+	tests := []struct {
+		testName  string
+		payload   []byte
+		shopOrder domain.ShopOrder
+		waitMail  bool
+		buildStub func(paymentRepo *mockrepo.MockPaymentRepository, orderRepo *mockrepo.MockOrderRepository,
+			userRepo *mockrepo.MockUserRepository, stockRepo *mockrepo.MockStockRepository)
+	}{
+		{
+			testName:  "SucceededEventShouldApproveOrderAndClearOrderedCartItems",
+			payload:   succeededPayload,
+			shopOrder: pendingOrder,
+			buildStub: func(paymentRepo *mockrepo.MockPaymentRepository, orderRepo *mockrepo.MockOrderRepository,
+				userRepo *mockrepo.MockUserRepository, stockRepo *mockrepo.MockStockRepository) {
+				orderRepo.EXPECT().FindOrderStatusByID(gomock.Any(), paymentPendingStatus.ID).
+					Times(1).Return(paymentPendingStatus, nil)
+				orderRepo.EXPECT().FindOrderStatusByStatus(gomock.Any(), domain.StatusOrderPlaced).
+					Times(1).Return(orderPlacedStatus, nil)
+				paymentRepo.EXPECT().FindPaymentMethodByType(gomock.Any(), domain.StripePayment).
+					Times(1).Return(stripePayment, nil)
+				orderRepo.EXPECT().Transaction(gomock.Any()).Times(1).
+					DoAndReturn(func(callBack func(trxRepo interfaces.OrderRepository) error) error {
+						return callBack(orderRepo)
+					})
+				orderRepo.EXPECT().UpdateShopOrderStatusAndSavePaymentMethod(gomock.Any(), uint(5), paymentPendingStatus.ID,
+					orderPlacedStatus.ID, stripePayment.ID, uint(100)).Times(1).Return(true, nil)
+				orderRepo.EXPECT().SaveShopOrderStatusHistory(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				orderRepo.EXPECT().FindAllOrderLinesByShopOrderID(gomock.Any(), uint(5)).Times(2).Return(nil, nil)
+				orderRepo.EXPECT().FindWalletByUserID(gomock.Any(), uint(1)).
+					Times(1).Return(domain.Wallet{ID: 7, UserID: 1, TotalAmount: 100}, nil)
+				orderRepo.EXPECT().DebitWallet(gomock.Any(), uint(1), uint(100)).Times(1).Return(true, nil)
+				orderRepo.EXPECT().SaveWalletTransaction(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				// coupon of the order (not the current cart coupon) is used
+				orderRepo.EXPECT().SaveCouponUses(gomock.Any(), domain.CouponUses{UserID: 1, CouponID: 4}).
+					Times(1).Return(nil)
+				orderRepo.EXPECT().RemoveCouponFromCart(gomock.Any(), uint(1), uint(4)).Times(1).Return(nil)
+				orderRepo.EXPECT().DeleteOrderedCartItems(gomock.Any(), uint(1), uint(5)).Times(1).Return(nil)
+				orderRepo.EXPECT().SaveInvoice(gomock.Any(), uint(5)).Times(1).
+					Return(domain.Invoice{ID: 1, ShopOrderID: 5, InvoiceNumber: 1}, nil)
+
+				stockRepo.EXPECT().FindAllLowStocksByShopOrderID(gomock.Any(), uint(5)).Times(1).Return(nil, nil)
+				userRepo.EXPECT().FindUserByUserID(gomock.Any(), uint(1)).Times(1).
+					DoAndReturn(func(ctx context.Context, userID uint) (domain.User, error) {
+						close(mailStarted)
+						return domain.User{ID: 1}, nil
+					})
+			},
+			waitMail: true,
+		},
+		{
+			testName:  "SucceededEventOfOrderApprovedByAnotherRequestShouldNotApplyAgain",
+			payload:   succeededPayload,
+			shopOrder: pendingOrder,
+			buildStub: func(paymentRepo *mockrepo.MockPaymentRepository, orderRepo *mockrepo.MockOrderRepository,
+				userRepo *mockrepo.MockUserRepository, stockRepo *mockrepo.MockStockRepository) {
+				orderRepo.EXPECT().FindOrderStatusByID(gomock.Any(), paymentPendingStatus.ID).
+					Times(1).Return(paymentPendingStatus, nil)
+				orderRepo.EXPECT().FindOrderStatusByStatus(gomock.Any(), domain.StatusOrderPlaced).
+					Times(1).Return(orderPlacedStatus, nil)
+				paymentRepo.EXPECT().FindPaymentMethodByType(gomock.Any(), domain.StripePayment).
+					Times(1).Return(stripePayment, nil)
+				orderRepo.EXPECT().Transaction(gomock.Any()).Times(1).
+					DoAndReturn(func(callBack func(trxRepo interfaces.OrderRepository) error) error {
+						return callBack(orderRepo)
+					})
+				orderRepo.EXPECT().UpdateShopOrderStatusAndSavePaymentMethod(gomock.Any(), uint(5), paymentPendingStatus.ID,
+					orderPlacedStatus.ID, stripePayment.ID, uint(100)).Times(1).Return(false, nil)
+				orderRepo.EXPECT().FindOrderStatusByShopOrderID(gomock.Any(), uint(5)).Times(1).Return(orderPlacedStatus, nil)
+			},
+		},
+		{
+			testName:  "PartialRefundEventShouldNotCancelOrder",
+			payload:   partialRefundPayload,
+			shopOrder: placedOrder,
+			buildStub: func(paymentRepo *mockrepo.MockPaymentRepository, orderRepo *mockrepo.MockOrderRepository,
+				userRepo *mockrepo.MockUserRepository, stockRepo *mockrepo.MockStockRepository) {
+			},
+		},
+		{
+			testName:  "FullRefundEventShouldCancelOrderAndRefundWalletShare",
+			payload:   fullRefundPayload,
+			shopOrder: placedOrder,
+			buildStub: func(paymentRepo *mockrepo.MockPaymentRepository, orderRepo *mockrepo.MockOrderRepository,
+				userRepo *mockrepo.MockUserRepository, stockRepo *mockrepo.MockStockRepository) {
+				orderRepo.EXPECT().FindOrderStatusByID(gomock.Any(), orderPlacedStatus.ID).
+					Times(1).Return(orderPlacedStatus, nil)
+				orderRepo.EXPECT().FindOrderStatusByStatus(gomock.Any(), domain.StatusOrderCancelled).
+					Times(1).Return(cancelledStatus, nil)
+				orderRepo.EXPECT().IsOrderStatusTransitionExist(gomock.Any(), orderPlacedStatus.ID, cancelledStatus.ID).
+					Times(1).Return(true, nil)
+				paymentRepo.EXPECT().FindPaymentMethodByID(gomock.Any(), stripePayment.ID).Times(1).Return(stripePayment, nil)
+				orderRepo.EXPECT().Transaction(gomock.Any()).Times(1).
+					DoAndReturn(func(callBack func(trxRepo interfaces.OrderRepository) error) error {
+						return callBack(orderRepo)
+					})
+				orderRepo.EXPECT().UpdateShopOrderOrderStatus(gomock.Any(), uint(5), orderPlacedStatus.ID,
+					cancelledStatus.ID).Times(1).Return(true, nil)
+				orderRepo.EXPECT().SaveShopOrderStatusHistory(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				orderRepo.EXPECT().FindAllOrderLinesByShopOrderID(gomock.Any(), uint(5)).Times(1).Return(nil, nil)
+				orderRepo.EXPECT().FindWalletByUserID(gomock.Any(), uint(1)).
+					Times(1).Return(domain.Wallet{ID: 7, UserID: 1}, nil)
+				orderRepo.EXPECT().CreditWallet(gomock.Any(), uint(7), uint(100)).Times(1).Return(nil)
+				orderRepo.EXPECT().SaveWalletTransaction(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				orderRepo.EXPECT().DeleteCouponUses(gomock.Any(), uint(1), uint(4)).Times(1).Return(nil)
+				orderRepo.EXPECT().SaveOrderCancellation(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, cancellation domain.OrderCancellation) error {
+						assert.Equal(t, uint(100), cancellation.RefundAmount)
+						assert.Equal(t, uint(0), cancellation.GatewayRefundAmount)
+						return nil
+					})
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+
+			ctl := gomock.NewController(t)
+			paymentRepo := mockrepo.NewMockPaymentRepository(ctl)
+			orderRepo := mockrepo.NewMockOrderRepository(ctl)
+			userRepo := mockrepo.NewMockUserRepository(ctl)
+			stockRepo := mockrepo.NewMockStockRepository(ctl)
+
+			paymentRepo.EXPECT().FindPaymentEventByEventID(gomock.Any(), domain.StripePayment, "evt_1").
+				Times(1).Return(domain.PaymentEvent{}, nil)
+			paymentRepo.EXPECT().SavePaymentEvent(gomock.Any(), gomock.Any()).Times(1).Return(uint(2), nil)
+			orderRepo.EXPECT().FindShopOrderByGatewayOrderID(gomock.Any(), "pi_1").Times(1).Return(test.shopOrder, nil)
+			test.buildStub(paymentRepo, orderRepo, userRepo, stockRepo)
+			paymentRepo.EXPECT().UpdatePaymentEventResult(gomock.Any(), uint(2), true, "").Times(1).Return(nil)
+
+			cfg := config.Config{StripeWebhookSecret: testStripeWebhookSecret}
+			paymentUseCase := NewPaymentUseCase(paymentRepo, orderRepo, userRepo, stockRepo,
+				payment.NewPaymentGateways(cfg), nil, nil)
+
+			err := paymentUseCase.HandleStripeWebhook(context.Background(), test.payload,
+				signStripePayload(test.payload, testStripeWebhookSecret))
+			assert.NoError(t, err)
+
+			if test.waitMail {
+				select {
+				case <-mailStarted:
+				case <-time.After(time.Second):
+					t.Fatal("order confirmation mail not sent after approve")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/payment"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+)
+
+type paymentEventAction uint
+
+const (
+	paymentEventSucceeded paymentEventAction = iota + 1
+	paymentEventFailed
+	paymentEventRefunded
+)
+
+// webhook event types of each gateway which are handled
+var paymentEventActions = map[domain.PaymentType]map[string]paymentEventAction{
+	domain.StripePayment: {
+		"payment_intent.succeeded":      paymentEventSucceeded,
+		"payment_intent.payment_failed": paymentEventFailed,
+		"charge.refunded":               paymentEventRefunded,
+	},
+	domain.RazopayPayment: {
+		"payment.captured": paymentEventSucceeded,
+		"order.paid":       paymentEventSucceeded,
+		"payment.failed":   paymentEventFailed,
+		"refund.processed": paymentEventRefunded,
+	},
+}
+
+// To verify and process the stripe webhook event
+func (c *paymentUseCase) HandleStripeWebhook(ctx context.Context, payload []byte, signature string) error {
+
+	event, err := c.gateways.Stripe.ParseWebhookEvent(payload, signature)
+	if err != nil {
+		return toWebhookError(err)
+	}
+
+	return c.processPaymentEvent(ctx, domain.PaymentEvent{
+		Gateway:        domain.StripePayment,
+		EventID:        event.EventID,
+		EventType:      event.EventType,
+		GatewayOrderID: event.GatewayOrderID,
+		Amount:         event.Amount,
+		Payload:        string(payload),
+	})
+}
+
+// To verify and process the razorpay webhook event
+func (c *paymentUseCase) HandleRazorpayWebhook(ctx context.Context, payload []byte, signature, eventID string) error {
+
+	event, err := c.gateways.Razorpay.ParseWebhookEvent(payload, signature)
+	if err != nil {
+		return toWebhookError(err)
+	}
+
+	// razorpay send the actual event id on header
+	if eventID == "" {
+		eventID = event.EventID
+	}
+
+	return c.processPaymentEvent(ctx, domain.PaymentEvent{
+		Gateway:        domain.RazopayPayment,
+		EventID:        eventID,
+		EventType:      event.EventType,
+		GatewayOrderID: event.GatewayOrderID,
+		Amount:         event.Amount,
+		Payload:        string(payload),
+	})
+}
+
+func toWebhookError(err error) error {
+	if errors.Is(err, payment.ErrInvalidSignature) {
+		return utils.PrependMessageToError(ErrInvalidWebhookSignature, err.Error())
+	}
+	return utils.PrependMessageToError(err, "failed to parse webhook event")
+}
+
+// To find all saved payment events
+func (c *paymentUseCase) FindAllPaymentEvents(ctx context.Context,
+	pagination request.Pagination) ([]domain.PaymentEvent, error) {
+
+	events, err := c.paymentRepo.FindAllPaymentEvents(ctx, pagination)
+	if err != nil {
+		return nil, utils.PrependMessageToError(err, "failed to find all payment events")
+	}
+
+	return events, nil
+}
+
+// To process a saved payment event again
+func (c *paymentUseCase) ReplayPaymentEvent(ctx context.Context, paymentEventID uint) error {
+
+	event, err := c.paymentRepo.FindPaymentEventByID(ctx, paymentEventID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find payment event")
+	}
+	if event.ID == 0 {
+		return ErrPaymentEventNotExist
+	}
+
+	return c.applyPaymentEvent(ctx, event)
+}
+
+// To save the payment event (if not already exist) and apply it on its shop order
+// an already processed event will skip so that the gateway retries are not applied twice
+func (c *paymentUseCase) processPaymentEvent(ctx context.Context, event domain.PaymentEvent) error {
+
+	savedEvent, err := c.paymentRepo.FindPaymentEventByEventID(ctx, event.Gateway, event.EventID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to check payment event already exist")
+	}
+
+	if savedEvent.ID != 0 {
+		if savedEvent.Processed {
+			log.Printf("payment event %s of %s already processed", event.EventID, event.Gateway)
+			return nil
+		}
+		event.ID = savedEvent.ID
+	} else {
+		event.ID, err = c.paymentRepo.SavePaymentEvent(ctx, event)
+		if err != nil {
+			return utils.PrependMessageToError(err, "failed to save payment event")
+		}
+	}
+
+	return c.applyPaymentEvent(ctx, event)
+}
+
+// To apply the payment event and save the result of it on the event
+func (c *paymentUseCase) applyPaymentEvent(ctx context.Context, event domain.PaymentEvent) error {
+
+	err := c.handlePaymentEventAction(ctx, event)
+
+	var errMessage string
+	if err != nil {
+		errMessage = err.Error()
+	}
+
+	if updateErr := c.paymentRepo.UpdatePaymentEventResult(ctx, event.ID, err == nil, errMessage); updateErr != nil {
+		return utils.PrependMessageToError(updateErr, "failed to update payment event result")
+	}
+
+	// these errors can't be solved by gateway retries so the event is only saved with error for admin
+	if errors.Is(err, ErrInvalidShopOrderID) || errors.Is(err, ErrOrderAlreadyCancelled) ||
+		errors.Is(err, ErrPaymentNotForOrder) || errors.Is(err, ErrPaymentAmountMismatch) ||
+		errors.Is(err, ErrInsufficientWalletBalance) {
+		log.Printf("payment event %s of %s not applied: %v", event.EventID, event.Gateway, err)
+		return nil
+	}
+
+	return err
+}
+
+func (c *paymentUseCase) handlePaymentEventAction(ctx context.Context, event domain.PaymentEvent) error {
+
+	action, ok := paymentEventActions[event.Gateway][event.EventType]
+	if !ok {
+		log.Printf("ignored payment event type %s of %s", event.EventType, event.Gateway)
+		return nil
+	}
+
+	shopOrder, err := c.orderRepo.FindShopOrderByGatewayOrderID(ctx, event.GatewayOrderID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find shop order of payment event")
+	}
+	if shopOrder.ID == 0 {
+		return utils.PrependMessageToError(ErrInvalidShopOrderID,
+			fmt.Sprintf("no shop order for gateway order id %s", event.GatewayOrderID))
+	}
+
+	switch action {
+	case paymentEventSucceeded:
+		return c.approveGatewayPayment(ctx, shopOrder, event.Gateway, payment.CapturedPayment{
+			GatewayOrderID: event.GatewayOrderID,
+			Amount:         event.Amount,
+		})
+
+	case paymentEventFailed:
+		// order stay on payment pending so user can retry the payment
+		log.Printf("payment failed on %s for shop order shop_order_id %v", event.Gateway, shopOrder.ID)
+		return nil
+
+	case paymentEventRefunded:
+		// a partial refund (made on gateway dashboard) is not cancelling the order
+		paidAmount := shopOrder.OrderTotalPrice - shopOrder.WalletAmount
+		if event.Amount < paidAmount {
+			log.Printf("partial refund of %v from paid %v received on %s for shop order shop_order_id %v",
+				event.Amount, paidAmount, event.Gateway, shopOrder.ID)
+			return nil
+		}
+
+		// amount paid online already refunded by gateway so only the wallet share is refunded
+		actor := domain.ShopOrderStatusHistory{
+			ActorType: domain.ActorSystem,
+			Comment:   "payment refunded on " + string(event.Gateway),
+		}
+		err = cancelShopOrder(ctx, c.orderRepo, c.paymentRepo, c.gateways, shopOrder, actor, true)
+		if errors.Is(err, ErrOrderNotCancellable) {
+			log.Printf("refund received for shop order shop_order_id %v which can't cancel: %v", shopOrder.ID, err)
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
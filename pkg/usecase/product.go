@@ -172,6 +172,34 @@ func (c *productUseCase) FindAllProducts(ctx context.Context, pagination request
 	return products, nil
 }
 
+// to search products with filters and find the facet counts for the search
+func (c *productUseCase) SearchProducts(ctx context.Context, search request.ProductSearch) (response.ProductSearch, error) {
+
+	products, err := c.productRepo.SearchProducts(ctx, search)
+	if err != nil {
+		return response.ProductSearch{}, utils.PrependMessageToError(err, "failed to search products on database")
+	}
+
+	for i := range products {
+
+		url, err := c.cloudService.GetFileUrl(ctx, products[i].Image)
+		if err != nil {
+			continue
+		}
+		products[i].Image = url
+	}
+
+	facet, err := c.productRepo.FindProductSearchFacets(ctx, search)
+	if err != nil {
+		return response.ProductSearch{}, utils.PrependMessageToError(err, "failed to find facets of product search")
+	}
+
+	return response.ProductSearch{
+		Products: products,
+		Facets:   facet,
+	}, nil
+}
+
 // to add new product
 func (c *productUseCase) SaveProduct(ctx context.Context, product request.Product) error {
 
@@ -203,7 +231,8 @@ func (c *productUseCase) SaveProduct(ctx context.Context, product request.Produc
 }
 
 // for add new productItem for a specific product
-func (c *productUseCase) SaveProductItem(ctx context.Context, productID uint, productItem request.ProductItem) error {
+func (c *productUseCase) SaveProductItem(ctx context.Context, adminID, productID uint,
+	productItem request.ProductItem) error {
 
 	variationCount, err := c.productRepo.FindVariationCountForProduct(ctx, productID)
 	if err != nil {
@@ -231,6 +260,7 @@ func (c *productUseCase) SaveProductItem(ctx context.Context, productID uint, pr
 			QtyInStock: productItem.QtyInStock,
 			Price:      productItem.Price,
 			SKU:        sku,
+			Weight:     productItem.Weight,
 		}
 
 		productItemID, err := trxRepo.SaveProductItem(ctx, newProductItem)
@@ -238,6 +268,19 @@ func (c *productUseCase) SaveProductItem(ctx context.Context, productID uint, pr
 			return utils.PrependMessageToError(err, "failed to save product item")
 		}
 
+		// save the initial stock on stock movements
+		err = trxRepo.SaveStockMovement(ctx, domain.StockMovement{
+			ProductItemID: productItemID,
+			Quantity:      int(productItem.QtyInStock),
+			Reason:        domain.StockRestock,
+			ActorType:     domain.ActorAdmin,
+			ActorID:       adminID,
+			Note:          "initial stock",
+		})
+		if err != nil {
+			return utils.PrependMessageToError(err, "failed to save initial stock movement")
+		}
+
 		errChan := make(chan error, 2)
 		newCtx, cancel := context.WithCancel(ctx) // for any of one of goroutine get error then cancel the working of other also
 		defer cancel()
@@ -345,6 +388,21 @@ func (c *productUseCase) isProductVariationCombinationExist(productID uint, vari
 	return false, nil
 }
 
+// To update the weight of a product item (used for weight based delivery charge)
+func (c *productUseCase) UpdateProductItem(ctx context.Context, productID, productItemID uint,
+	updateDetails request.UpdateProductItem) error {
+
+	updated, err := c.productRepo.UpdateProductItemWeight(ctx, productID, productItemID, *updateDetails.Weight)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to update weight of product item")
+	}
+	if !updated {
+		return ErrProductItemNotExist
+	}
+
+	return nil
+}
+
 // for get all productItem for a specific product
 func (c *productUseCase) FindAllProductItems(ctx context.Context, productID uint) ([]response.ProductItems, error) {
 
@@ -0,0 +1,558 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+)
+
+// columns of product import and export csv
+var productCSVHeader = []string{
+	"product_name", "description", "category_name", "brand_name", "product_price",
+	"sku", "price", "qty_in_stock", "weight", "variations",
+}
+
+const (
+	productExportPageCount = 100
+
+	// variations column is saved as "Color:Red|Size:M"
+	variationSeparator      = "|"
+	variationValueSeparator = ":"
+)
+
+// validator for import rows with the same binding tags used by gin on request bodies
+var importRowValidator = newImportRowValidator()
+
+func newImportRowValidator() *validator.Validate {
+
+	v := validator.New()
+	v.SetTagName("binding")
+	// use json names of fields on error messages
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		return strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+	})
+
+	return v
+}
+
+// a validated import row which is ready to save
+type productImportItem struct {
+	row                request.ProductImportRow
+	productID          uint // zero when the product is created by this import
+	categoryID         uint
+	brandID            uint
+	variationOptionIDs []uint
+}
+
+// To import products and product items from csv
+// every row is validated and the valid rows are saved in a single transaction (nothing is saved on dry run)
+func (c *productUseCase) ImportProducts(ctx context.Context, adminID uint, file io.Reader,
+	dryRun bool) (response.ProductImport, error) {
+
+	rows, rowErrors, err := parseProductImportCSV(file)
+	if err != nil {
+		return response.ProductImport{}, err
+	}
+
+	result := response.ProductImport{
+		DryRun:    dryRun,
+		TotalRows: len(rows) + len(rowErrors),
+		Errors:    rowErrors,
+	}
+
+	items, validationErrors, err := c.validateProductImportRows(ctx, rows)
+	if err != nil {
+		return response.ProductImport{}, err
+	}
+	result.Errors = append(result.Errors, validationErrors...)
+	sort.Slice(result.Errors, func(i, j int) bool {
+		return result.Errors[i].Row < result.Errors[j].Row
+	})
+
+	result.ValidRows = len(items)
+	result.InvalidRows = len(result.Errors)
+
+	if dryRun || len(items) == 0 {
+		return result, nil
+	}
+
+	err = c.productRepo.Transactions(ctx, func(trxRepo interfaces.ProductRepository) error {
+
+		// product ids of products created by this import
+		createdProductIDs := make(map[string]uint)
+
+		for _, item := range items {
+
+			productID := item.productID
+			if productID == 0 {
+				productID = createdProductIDs[item.row.ProductName]
+			}
+
+			if productID == 0 {
+				err := trxRepo.SaveProduct(ctx, domain.Product{
+					Name:        item.row.ProductName,
+					Description: item.row.Description,
+					CategoryID:  item.categoryID,
+					BrandID:     item.brandID,
+					Price:       item.row.ProductPrice,
+				})
+				if err != nil {
+					return utils.PrependMessageToError(err, "failed to save product of row "+strconv.Itoa(item.row.Row))
+				}
+				product, err := trxRepo.FindProductByName(ctx, item.row.ProductName)
+				if err != nil {
+					return utils.PrependMessageToError(err, "failed to find saved product")
+				}
+				productID = product.ID
+				createdProductIDs[item.row.ProductName] = productID
+				result.ProductsCreated++
+			}
+
+			sku := item.row.SKU
+			if sku == "" {
+				sku = utils.GenerateSKU()
+			}
+
+			productItemID, err := trxRepo.SaveProductItem(ctx, domain.ProductItem{
+				ProductID:  productID,
+				QtyInStock: item.row.QtyInStock,
+				Price:      item.row.Price,
+				SKU:        sku,
+				Weight:     item.row.Weight,
+			})
+			if err != nil {
+				return utils.PrependMessageToError(err, "failed to save product item of row "+strconv.Itoa(item.row.Row))
+			}
+
+			err = trxRepo.SaveStockMovement(ctx, domain.StockMovement{
+				ProductItemID: productItemID,
+				Quantity:      int(item.row.QtyInStock),
+				Reason:        domain.StockRestock,
+				ActorType:     domain.ActorAdmin,
+				ActorID:       adminID,
+				Note:          "initial stock from import",
+			})
+			if err != nil {
+				return utils.PrependMessageToError(err, "failed to save initial stock movement")
+			}
+
+			for _, variationOptionID := range item.variationOptionIDs {
+				err = trxRepo.SaveProductConfiguration(ctx, productItemID, variationOptionID)
+				if err != nil {
+					return utils.PrependMessageToError(err, "failed to save product_item configuration")
+				}
+			}
+			result.ItemsCreated++
+		}
+		return nil
+	})
+	if err != nil {
+		return response.ProductImport{}, err
+	}
+
+	log.Printf("successfully imported %d products and %d product items", result.ProductsCreated, result.ItemsCreated)
+	return result, nil
+}
+
+// To validate all rows with the rules of saving product and product item
+// the rows are also checked against the previous rows of the same file
+func (c *productUseCase) validateProductImportRows(ctx context.Context,
+	rows []request.ProductImportRow) ([]productImportItem, []response.ProductImportRowError, error) {
+
+	var (
+		items       []productImportItem
+		rowErrors   []response.ProductImportRowError
+		lookup      = newProductImportLookup(c.productRepo)
+		fileSKUs    = make(map[string]bool)
+		fileConfigs = make(map[string]bool) // product name with its variation option ids
+		fileProduct = make(map[string]uint) // category of products created by the file
+	)
+
+	for _, row := range rows {
+
+		var errs []string
+
+		if err := importRowValidator.Struct(row); err != nil {
+			var validationErrs validator.ValidationErrors
+			if !errors.As(err, &validationErrs) {
+				return nil, nil, utils.PrependMessageToError(err, "failed to validate import row")
+			}
+			for _, fieldErr := range validationErrs {
+				errs = append(errs, fmt.Sprintf("%s failed on %s %s", fieldErr.Field(), fieldErr.Tag(), fieldErr.Param()))
+			}
+			rowErrors = append(rowErrors, response.ProductImportRowError{Row: row.Row, Errors: errs})
+			continue
+		}
+
+		item := productImportItem{row: row}
+
+		category, err := lookup.subCategory(ctx, row.CategoryName)
+		if err != nil {
+			return nil, nil, err
+		}
+		if category.ID == 0 {
+			errs = append(errs, "category "+row.CategoryName+" not found")
+		}
+		item.categoryID = category.ID
+
+		brand, err := lookup.brand(ctx, row.BrandName)
+		if err != nil {
+			return nil, nil, err
+		}
+		if brand.ID == 0 {
+			errs = append(errs, "brand "+row.BrandName+" not found")
+		}
+		item.brandID = brand.ID
+
+		product, err := lookup.product(ctx, row.ProductName)
+		if err != nil {
+			return nil, nil, err
+		}
+		item.productID = product.ID
+		// product already exist then the item is added on it
+		if product.ID != 0 && product.CategoryID != category.ID {
+			errs = append(errs, "product "+row.ProductName+" already exist on another category")
+		}
+		if categoryID, ok := fileProduct[row.ProductName]; ok && categoryID != category.ID {
+			errs = append(errs, "product "+row.ProductName+" used with another category on previous rows")
+		}
+
+		if row.SKU != "" {
+			skuExist, err := c.productRepo.IsSKUExist(ctx, row.SKU)
+			if err != nil {
+				return nil, nil, utils.PrependMessageToError(err, "failed to check sku already exist")
+			}
+			if skuExist || fileSKUs[row.SKU] {
+				errs = append(errs, "sku "+row.SKU+" already exist")
+			}
+		}
+
+		if category.ID != 0 {
+			variationOptionIDs, variationErrs, err := lookup.variationOptionIDs(ctx, category.ID, row.Variations)
+			if err != nil {
+				return nil, nil, err
+			}
+			errs = append(errs, variationErrs...)
+			item.variationOptionIDs = variationOptionIDs
+
+			if len(variationErrs) == 0 {
+				configExist := fileConfigs[productConfigurationKey(row.ProductName, variationOptionIDs)]
+				if !configExist && product.ID != 0 {
+					configExist, err = c.isProductVariationCombinationExist(product.ID, variationOptionIDs)
+					if err != nil {
+						return nil, nil, err
+					}
+				}
+				if configExist {
+					errs = append(errs, ErrProductItemAlreadyExist.Error())
+				}
+			}
+		}
+
+		if len(errs) != 0 {
+			rowErrors = append(rowErrors, response.ProductImportRowError{Row: row.Row, Errors: errs})
+			continue
+		}
+
+		if row.SKU != "" {
+			fileSKUs[row.SKU] = true
+		}
+		fileConfigs[productConfigurationKey(row.ProductName, item.variationOptionIDs)] = true
+		if product.ID == 0 {
+			fileProduct[row.ProductName] = category.ID
+		}
+		items = append(items, item)
+	}
+
+	return items, rowErrors, nil
+}
+
+func productConfigurationKey(productName string, variationOptionIDs []uint) string {
+
+	ids := make([]uint, len(variationOptionIDs))
+	copy(ids, variationOptionIDs)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	return fmt.Sprint(productName, ids)
+}
+
+// To cache the category, brand, product and variations which are used on multiple rows of import
+type productImportLookup struct {
+	productRepo   interfaces.ProductRepository
+	subCategories map[string]domain.Category
+	brands        map[string]domain.Brand
+	products      map[string]domain.Product
+	variations    map[uint]map[string]map[string]uint // category id to variation name to value to variation option id
+}
+
+func newProductImportLookup(productRepo interfaces.ProductRepository) *productImportLookup {
+	return &productImportLookup{
+		productRepo:   productRepo,
+		subCategories: make(map[string]domain.Category),
+		brands:        make(map[string]domain.Brand),
+		products:      make(map[string]domain.Product),
+		variations:    make(map[uint]map[string]map[string]uint),
+	}
+}
+
+func (c *productImportLookup) subCategory(ctx context.Context, name string) (domain.Category, error) {
+
+	if category, ok := c.subCategories[name]; ok {
+		return category, nil
+	}
+	category, err := c.productRepo.FindSubCategoryByName(ctx, name)
+	if err != nil {
+		return domain.Category{}, utils.PrependMessageToError(err, "failed to find category by name")
+	}
+	c.subCategories[name] = category
+
+	return category, nil
+}
+
+func (c *productImportLookup) brand(ctx context.Context, name string) (domain.Brand, error) {
+
+	if brand, ok := c.brands[name]; ok {
+		return brand, nil
+	}
+	brand, err := c.productRepo.FindBrandByName(ctx, name)
+	if err != nil {
+		return domain.Brand{}, utils.PrependMessageToError(err, "failed to find brand by name")
+	}
+	c.brands[name] = brand
+
+	return brand, nil
+}
+
+func (c *productImportLookup) product(ctx context.Context, name string) (domain.Product, error) {
+
+	if product, ok := c.products[name]; ok {
+		return product, nil
+	}
+	product, err := c.productRepo.FindProductByName(ctx, name)
+	if err != nil {
+		return domain.Product{}, utils.PrependMessageToError(err, "failed to find product by name")
+	}
+	c.products[name] = product
+
+	return product, nil
+}
+
+// To find the variation option ids of given variation values
+// one value should be given for each variation of the category
+func (c *productImportLookup) variationOptionIDs(ctx context.Context, categoryID uint,
+	values map[string]string) (variationOptionIDs []uint, errs []string, err error) {
+
+	variations, ok := c.variations[categoryID]
+	if !ok {
+		categoryVariations, err := c.productRepo.FindAllVariationsByCategoryID(ctx, categoryID)
+		if err != nil {
+			return nil, nil, utils.PrependMessageToError(err, "failed to find all variations of category")
+		}
+
+		variations = make(map[string]map[string]uint, len(categoryVariations))
+		for _, variation := range categoryVariations {
+
+			options, err := c.productRepo.FindAllVariationOptionsByVariationID(ctx, variation.ID)
+			if err != nil {
+				return nil, nil, utils.PrependMessageToError(err, "failed to get variation option")
+			}
+			variations[variation.Name] = make(map[string]uint, len(options))
+			for _, option := range options {
+				variations[variation.Name][option.Value] = option.ID
+			}
+		}
+		c.variations[categoryID] = variations
+	}
+
+	if len(values) != len(variations) {
+		errs = append(errs, ErrNotEnoughVariations.Error())
+	}
+
+	for name, value := range values {
+		options, ok := variations[name]
+		if !ok {
+			errs = append(errs, "variation "+name+" not found for category")
+			continue
+		}
+		variationOptionID, ok := options[value]
+		if !ok {
+			errs = append(errs, "variation value "+value+" not found for variation "+name)
+			continue
+		}
+		variationOptionIDs = append(variationOptionIDs, variationOptionID)
+	}
+	sort.Strings(errs)
+
+	return variationOptionIDs, errs, nil
+}
+
+// To read rows of product import csv
+// rows with invalid values are returned as row errors and invalid header or csv format return error
+func parseProductImportCSV(file io.Reader) ([]request.ProductImportRow, []response.ProductImportRowError, error) {
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = len(productCSVHeader)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, utils.PrependMessageToError(ErrInvalidProductImportFile, "failed to read header "+err.Error())
+	}
+	for i, column := range productCSVHeader {
+		if strings.TrimSpace(header[i]) != column {
+			return nil, nil, utils.PrependMessageToError(ErrInvalidProductImportFile,
+				fmt.Sprintf("expected column %s found %s", column, header[i]))
+		}
+	}
+
+	var (
+		rows      []request.ProductImportRow
+		rowErrors []response.ProductImportRowError
+	)
+
+	for {
+
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		rowNumber, _ := reader.FieldPos(0)
+
+		if errors.Is(err, csv.ErrFieldCount) {
+			rowErrors = append(rowErrors, response.ProductImportRowError{
+				Row:    rowNumber,
+				Errors: []string{fmt.Sprintf("expected %d columns found %d", len(productCSVHeader), len(record))},
+			})
+			continue
+		}
+		if err != nil {
+			return nil, nil, utils.PrependMessageToError(ErrInvalidProductImportFile, err.Error())
+		}
+
+		row, errs := parseProductImportRecord(record)
+		if len(errs) != 0 {
+			rowErrors = append(rowErrors, response.ProductImportRowError{Row: rowNumber, Errors: errs})
+			continue
+		}
+		row.Row = rowNumber
+		rows = append(rows, row)
+	}
+
+	return rows, rowErrors, nil
+}
+
+func parseProductImportRecord(record []string) (row request.ProductImportRow, errs []string) {
+
+	for i := range record {
+		record[i] = strings.TrimSpace(record[i])
+	}
+
+	parseUint := func(column int) uint {
+		value, err := strconv.ParseUint(record[column], 10, 32)
+		if err != nil {
+			errs = append(errs, productCSVHeader[column]+" should be a positive number")
+		}
+		return uint(value)
+	}
+
+	row = request.ProductImportRow{
+		ProductName:  record[0],
+		Description:  record[1],
+		CategoryName: record[2],
+		BrandName:    record[3],
+		ProductPrice: parseUint(4),
+		SKU:          record[5],
+		Price:        parseUint(6),
+		QtyInStock:   parseUint(7),
+		Variations:   make(map[string]string),
+	}
+	// weight is optional (only needed for weight based delivery charge)
+	if record[8] != "" {
+		row.Weight = parseUint(8)
+	}
+
+	if record[9] == "" {
+		return row, errs
+	}
+	for _, variation := range strings.Split(record[9], variationSeparator) {
+
+		name, value, found := strings.Cut(variation, variationValueSeparator)
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if !found || name == "" || value == "" {
+			errs = append(errs, "variation "+variation+" should be on name:value format")
+			continue
+		}
+		if _, ok := row.Variations[name]; ok {
+			errs = append(errs, "variation "+name+" given more than once")
+			continue
+		}
+		row.Variations[name] = value
+	}
+
+	return row, errs
+}
+
+// To write all product items on csv with the same format of import
+func (c *productUseCase) ExportProducts(ctx context.Context, file io.Writer) error {
+
+	writer := csv.NewWriter(file)
+
+	err := writer.Write(productCSVHeader)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to write csv header")
+	}
+
+	for pageNumber := uint64(1); ; pageNumber++ {
+
+		productItems, err := c.productRepo.FindAllProductItemsForExport(ctx, request.Pagination{
+			PageNumber: pageNumber,
+			Count:      productExportPageCount,
+		})
+		if err != nil {
+			return utils.PrependMessageToError(err, "failed to find product items for export")
+		}
+
+		for _, productItem := range productItems {
+			err = writer.Write([]string{
+				productItem.ProductName,
+				productItem.Description,
+				productItem.CategoryName,
+				productItem.BrandName,
+				strconv.FormatUint(uint64(productItem.ProductPrice), 10),
+				productItem.SKU,
+				strconv.FormatUint(uint64(productItem.Price), 10),
+				strconv.FormatUint(uint64(productItem.QtyInStock), 10),
+				strconv.FormatUint(uint64(productItem.Weight), 10),
+				productItem.Variations,
+			})
+			if err != nil {
+				return utils.PrependMessageToError(err, "failed to write csv row")
+			}
+		}
+		writer.Flush()
+
+		if len(productItems) < productExportPageCount {
+			break
+		}
+	}
+
+	if err := writer.Error(); err != nil {
+		return utils.PrependMessageToError(err, "failed to write csv")
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/mock/mockrepo"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportProducts(t *testing.T) {
+
+	const (
+		header   = "product_name,description,category_name,brand_name,product_price,sku,price,qty_in_stock,weight,variations\n"
+		validRow = "Polo Shirt,Cotton polo shirt for men,Shirts,Nike,999,SKU1,899,10,250,Size:M\n"
+	)
+	adminID := uint(4)
+
+	// stubs for validating the valid row
+	buildValidRowStub := func(productRepo *mockrepo.MockProductRepository) {
+		productRepo.EXPECT().FindSubCategoryByName(gomock.Any(), "Shirts").
+			Times(1).Return(domain.Category{ID: 2, CategoryID: 1, Name: "Shirts"}, nil)
+		productRepo.EXPECT().FindBrandByName(gomock.Any(), "Nike").
+			Times(1).Return(domain.Brand{ID: 3, Name: "Nike"}, nil)
+		productRepo.EXPECT().FindProductByName(gomock.Any(), "Polo Shirt").
+			Times(1).Return(domain.Product{}, nil)
+		productRepo.EXPECT().IsSKUExist(gomock.Any(), "SKU1").Times(1).Return(false, nil)
+		productRepo.EXPECT().FindAllVariationsByCategoryID(gomock.Any(), uint(2)).
+			Times(1).Return([]response.Variation{{ID: 5, Name: "Size"}}, nil)
+		productRepo.EXPECT().FindAllVariationOptionsByVariationID(gomock.Any(), uint(5)).
+			Times(1).Return([]response.VariationOption{{ID: 7, Value: "M"}}, nil)
+	}
+
+	tests := []struct {
+		testName       string
+		file           string
+		dryRun         bool
+		buildStub      func(productRepo *mockrepo.MockProductRepository)
+		expectedOutput response.ProductImport
+		expectedError  error
+	}{
+		{
+			testName:      "InvalidHeaderShouldReturnError",
+			file:          "name,price\n",
+			buildStub:     func(productRepo *mockrepo.MockProductRepository) {},
+			expectedError: ErrInvalidProductImportFile,
+		},
+		{
+			testName: "DryRunShouldReturnRowErrorsWithoutSaving",
+			file:     header + validRow + "Polo Shirt,Cotton polo shirt for men,Shirts,Nike,999,SKU1,899,ten,heavy,Size:L\n",
+			dryRun:   true,
+			buildStub: func(productRepo *mockrepo.MockProductRepository) {
+				buildValidRowStub(productRepo)
+			},
+			expectedOutput: response.ProductImport{
+				DryRun:      true,
+				TotalRows:   2,
+				ValidRows:   1,
+				InvalidRows: 1,
+				Errors: []response.ProductImportRowError{
+					{Row: 3, Errors: []string{"qty_in_stock should be a positive number", "weight should be a positive number"}},
+				},
+			},
+		},
+		{
+			testName: "ValidRowsShouldSaveOnTransaction",
+			file:     header + validRow + "Polo Shirt,Cotton polo shirt for men,Shirts,Nike,999,SKU1,899,5,,Size:M\n",
+			buildStub: func(productRepo *mockrepo.MockProductRepository) {
+				buildValidRowStub(productRepo)
+				productRepo.EXPECT().IsSKUExist(gomock.Any(), "SKU1").Times(1).Return(false, nil)
+
+				productRepo.EXPECT().Transactions(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, trxFn func(repo interfaces.ProductRepository) error) error {
+						return trxFn(productRepo)
+					})
+				productRepo.EXPECT().SaveProduct(gomock.Any(), domain.Product{
+					Name:        "Polo Shirt",
+					Description: "Cotton polo shirt for men",
+					CategoryID:  2,
+					BrandID:     3,
+					Price:       999,
+				}).Times(1).Return(nil)
+				productRepo.EXPECT().FindProductByName(gomock.Any(), "Polo Shirt").
+					Times(1).Return(domain.Product{ID: 11, Name: "Polo Shirt"}, nil)
+				productRepo.EXPECT().SaveProductItem(gomock.Any(), domain.ProductItem{
+					ProductID:  11,
+					QtyInStock: 10,
+					Price:      899,
+					SKU:        "SKU1",
+					Weight:     250,
+				}).Times(1).Return(uint(21), nil)
+				// initial stock should be saved with the admin who imported it
+				productRepo.EXPECT().SaveStockMovement(gomock.Any(), domain.StockMovement{
+					ProductItemID: 21,
+					Quantity:      10,
+					Reason:        domain.StockRestock,
+					ActorType:     domain.ActorAdmin,
+					ActorID:       adminID,
+					Note:          "initial stock from import",
+				}).Times(1).Return(nil)
+				productRepo.EXPECT().SaveProductConfiguration(gomock.Any(), uint(21), uint(7)).Times(1).Return(nil)
+			},
+			expectedOutput: response.ProductImport{
+				TotalRows:       2,
+				ValidRows:       1,
+				InvalidRows:     1,
+				ProductsCreated: 1,
+				ItemsCreated:    1,
+				Errors: []response.ProductImportRowError{
+					{Row: 3, Errors: []string{"sku SKU1 already exist", ErrProductItemAlreadyExist.Error()}},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+
+			ctl := gomock.NewController(t)
+			productRepo := mockrepo.NewMockProductRepository(ctl)
+			test.buildStub(productRepo)
+
+			productUseCase := NewProductUseCase(productRepo, nil)
+
+			result, err := productUseCase.ImportProducts(context.Background(), adminID, strings.NewReader(test.file), test.dryRun)
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedOutput, result)
+		})
+	}
+}
+
+func TestExportProducts(t *testing.T) {
+
+	ctl := gomock.NewController(t)
+	productRepo := mockrepo.NewMockProductRepository(ctl)
+	productRepo.EXPECT().FindAllProductItemsForExport(gomock.Any(), gomock.Any()).Times(1).
+		Return([]response.ProductItemExport{{
+			ProductName:  "Polo Shirt",
+			Description:  "Cotton polo shirt for men",
+			CategoryName: "Shirts",
+			BrandName:    "Nike",
+			ProductPrice: 999,
+			SKU:          "SKU1",
+			Price:        899,
+			QtyInStock:   10,
+			Weight:       250,
+			Variations:   "Size:M",
+		}}, nil)
+
+	productUseCase := NewProductUseCase(productRepo, nil)
+
+	var file bytes.Buffer
+	err := productUseCase.ExportProducts(context.Background(), &file)
+	assert.NoError(t, err)
+
+	// exported file should be on the same format of import
+	assert.Equal(t, "product_name,description,category_name,brand_name,product_price,sku,price,qty_in_stock,weight,variations\n"+
+		"Polo Shirt,Cotton polo shirt for men,Shirts,Nike,999,SKU1,899,10,250,Size:M\n", file.String())
+}
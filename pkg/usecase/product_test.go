@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/mock/mockrepo"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/mock/mockservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchProducts(t *testing.T) {
+
+	search := request.ProductSearch{
+		Keyword:    "shirt",
+		BrandID:    2,
+		MinPrice:   100,
+		MaxPrice:   500,
+		SortBy:     request.SortByPriceAsc,
+		Pagination: request.Pagination{PageNumber: 1, Count: 10},
+	}
+	facets := response.ProductFacet{
+		Brands: []response.FacetCount{{ID: 2, Name: "brand", Count: 2}},
+	}
+	dbErr := errors.New("db error")
+
+	tests := []struct {
+		testName       string
+		buildStub      func(productRepo *mockrepo.MockProductRepository, cloudService *mockservice.MockCloudService)
+		expectedOutput response.ProductSearch
+		expectedError  error
+	}{
+		{
+			testName: "SearchShouldReturnProductsWithImageUrlAndFacets",
+			buildStub: func(productRepo *mockrepo.MockProductRepository, cloudService *mockservice.MockCloudService) {
+				productRepo.EXPECT().SearchProducts(gomock.Any(), search).Times(1).
+					Return([]response.Product{{ID: 1, Image: "image_1"}, {ID: 2, Image: "image_2"}}, nil)
+				cloudService.EXPECT().GetFileUrl(gomock.Any(), "image_1").Times(1).Return("url_1", nil)
+				// failed image url should keep the saved image
+				cloudService.EXPECT().GetFileUrl(gomock.Any(), "image_2").Times(1).Return("", errors.New("cloud error"))
+				productRepo.EXPECT().FindProductSearchFacets(gomock.Any(), search).Times(1).Return(facets, nil)
+			},
+			expectedOutput: response.ProductSearch{
+				Products: []response.Product{{ID: 1, Image: "url_1"}, {ID: 2, Image: "image_2"}},
+				Facets:   facets,
+			},
+			expectedError: nil,
+		},
+		{
+			testName: "FailedSearchShouldReturnError",
+			buildStub: func(productRepo *mockrepo.MockProductRepository, cloudService *mockservice.MockCloudService) {
+				productRepo.EXPECT().SearchProducts(gomock.Any(), search).Times(1).Return(nil, dbErr)
+			},
+			expectedOutput: response.ProductSearch{},
+			expectedError:  dbErr,
+		},
+		{
+			testName: "FailedFacetsShouldReturnError",
+			buildStub: func(productRepo *mockrepo.MockProductRepository, cloudService *mockservice.MockCloudService) {
+				productRepo.EXPECT().SearchProducts(gomock.Any(), search).Times(1).Return(nil, nil)
+				productRepo.EXPECT().FindProductSearchFacets(gomock.Any(), search).Times(1).
+					Return(response.ProductFacet{}, dbErr)
+			},
+			expectedOutput: response.ProductSearch{},
+			expectedError:  dbErr,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+			t.Parallel()
+
+			ctl := gomock.NewController(t)
+			productRepo := mockrepo.NewMockProductRepository(ctl)
+			cloudService := mockservice.NewMockCloudService(ctl)
+			test.buildStub(productRepo, cloudService)
+
+			productUseCase := NewProductUseCase(productRepo, cloudService)
+
+			output, err := productUseCase.SearchProducts(context.Background(), search)
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expectedOutput, output)
+		})
+	}
+}
+
+func TestUpdateProductItem(t *testing.T) {
+
+	var (
+		productID     uint = 1
+		productItemID uint = 2
+		weight        uint = 0 // zero weight should also update
+	)
+
+	tests := []struct {
+		testName      string
+		buildStub     func(productRepo *mockrepo.MockProductRepository)
+		expectedError error
+	}{
+		{
+			testName: "ProductItemNotOfProductShouldReturnError",
+			buildStub: func(productRepo *mockrepo.MockProductRepository) {
+				productRepo.EXPECT().UpdateProductItemWeight(gomock.Any(), productID, productItemID, weight).
+					Times(1).Return(false, nil)
+			},
+			expectedError: ErrProductItemNotExist,
+		},
+		{
+			testName: "DbErrorShouldReturnError",
+			buildStub: func(productRepo *mockrepo.MockProductRepository) {
+				productRepo.EXPECT().UpdateProductItemWeight(gomock.Any(), productID, productItemID, weight).
+					Times(1).Return(false, errors.New("db error"))
+			},
+			expectedError: errors.New("db error"),
+		},
+		{
+			testName: "ExistingProductItemShouldUpdateWeight",
+			buildStub: func(productRepo *mockrepo.MockProductRepository) {
+				productRepo.EXPECT().UpdateProductItemWeight(gomock.Any(), productID, productItemID, weight).
+					Times(1).Return(true, nil)
+			},
+			expectedError: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+			t.Parallel()
+
+			ctl := gomock.NewController(t)
+			productRepo := mockrepo.NewMockProductRepository(ctl)
+			test.buildStub(productRepo)
+
+			productUseCase := NewProductUseCase(productRepo, nil)
+
+			err := productUseCase.UpdateProductItem(context.Background(), productID, productItemID,
+				request.UpdateProductItem{Weight: &weight})
+			switch {
+			case test.expectedError == nil:
+				assert.NoError(t, err)
+			case errors.Is(test.expectedError, ErrProductItemNotExist):
+				assert.ErrorIs(t, err, test.expectedError)
+			default:
+				assert.ErrorContains(t, err, test.expectedError.Error())
+			}
+		})
+	}
+}
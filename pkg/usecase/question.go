@@ -0,0 +1,293 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/mail"
+	service "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+)
+
+type questionUseCase struct {
+	questionRepo interfaces.QuestionRepository
+	productRepo  interfaces.ProductRepository
+	reviewRepo   interfaces.ReviewRepository
+	userRepo     interfaces.UserRepository
+	mailSender   mail.Sender
+}
+
+func NewQuestionUseCase(questionRepo interfaces.QuestionRepository, productRepo interfaces.ProductRepository,
+	reviewRepo interfaces.ReviewRepository, userRepo interfaces.UserRepository,
+	mailSender mail.Sender) service.QuestionUseCase {
+	return &questionUseCase{
+		questionRepo: questionRepo,
+		productRepo:  productRepo,
+		reviewRepo:   reviewRepo,
+		userRepo:     userRepo,
+		mailSender:   mailSender,
+	}
+}
+
+func (c *questionUseCase) SaveProductQuestion(ctx context.Context, userID, productID uint,
+	questionDetails request.ProductQuestion) error {
+
+	product, err := c.productRepo.FindProductByID(ctx, productID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find product")
+	}
+	if product.ID == 0 {
+		return ErrInvalidProductID
+	}
+
+	_, err = c.questionRepo.SaveProductQuestion(ctx, domain.ProductQuestion{
+		ProductID:      productID,
+		UserID:         userID,
+		Question:       questionDetails.Question,
+		NotifyOnAnswer: questionDetails.NotifyOnAnswer,
+	})
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to save product question")
+	}
+
+	return nil
+}
+
+// To find visible questions of the product for users
+func (c *questionUseCase) FindAllProductQuestionsUser(ctx context.Context, productID uint,
+	pagination request.Pagination) ([]response.ProductQuestion, error) {
+
+	questions, err := c.questionRepo.FindAllProductQuestions(ctx, request.QuestionFilter{
+		ProductID:   productID,
+		VisibleOnly: true,
+		Pagination:  pagination,
+	})
+	if err != nil {
+		return nil, utils.PrependMessageToError(err, "failed to find product questions")
+	}
+
+	return questions, nil
+}
+
+// To find visible answers of a visible question of the product for users
+func (c *questionUseCase) FindAllProductAnswersUser(ctx context.Context, productID, questionID uint,
+	pagination request.Pagination) ([]response.ProductAnswer, error) {
+
+	if _, err := c.findVisibleQuestionOfProduct(ctx, productID, questionID); err != nil {
+		return nil, err
+	}
+
+	answers, err := c.questionRepo.FindAllProductAnswers(ctx, questionID, true, pagination)
+	if err != nil {
+		return nil, utils.PrependMessageToError(err, "failed to find answers of question")
+	}
+
+	return answers, nil
+}
+
+// To save answer of a user, only users with a delivered order of the product can answer
+func (c *questionUseCase) SaveProductAnswerUser(ctx context.Context, userID, productID, questionID uint,
+	answerDetails request.ProductAnswer) error {
+
+	question, err := c.findVisibleQuestionOfProduct(ctx, productID, questionID)
+	if err != nil {
+		return err
+	}
+
+	delivered, err := c.reviewRepo.IsDeliveredProductOfUser(ctx, userID, productID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to check user have delivered order of product")
+	}
+	if !delivered {
+		return ErrAnswerNotAllowed
+	}
+
+	return c.saveProductAnswer(ctx, question, domain.ProductAnswer{
+		ProductQuestionID: questionID,
+		ActorID:           userID,
+		ActorType:         domain.ActorUser,
+		Answer:            answerDetails.Answer,
+	})
+}
+
+func (c *questionUseCase) UpvoteProductAnswer(ctx context.Context, userID, productID, questionID, answerID uint) error {
+
+	if err := c.findVisibleAnswerOfQuestion(ctx, productID, questionID, answerID); err != nil {
+		return err
+	}
+
+	err := c.questionRepo.SaveProductAnswerUpvote(ctx, answerID, userID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to save upvote of answer")
+	}
+
+	return nil
+}
+
+func (c *questionUseCase) RemoveProductAnswerUpvote(ctx context.Context, userID, productID, questionID, answerID uint) error {
+
+	if err := c.findVisibleAnswerOfQuestion(ctx, productID, questionID, answerID); err != nil {
+		return err
+	}
+
+	err := c.questionRepo.DeleteProductAnswerUpvote(ctx, answerID, userID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to remove upvote of answer")
+	}
+
+	return nil
+}
+
+// To find questions of all products including hidden questions for admin
+func (c *questionUseCase) FindAllProductQuestionsAdmin(ctx context.Context,
+	filter request.QuestionFilter) ([]response.ProductQuestion, error) {
+
+	questions, err := c.questionRepo.FindAllProductQuestions(ctx, filter)
+	if err != nil {
+		return nil, utils.PrependMessageToError(err, "failed to find product questions")
+	}
+
+	return questions, nil
+}
+
+func (c *questionUseCase) FindAllProductAnswersAdmin(ctx context.Context, questionID uint,
+	pagination request.Pagination) ([]response.ProductAnswer, error) {
+
+	answers, err := c.questionRepo.FindAllProductAnswers(ctx, questionID, false, pagination)
+	if err != nil {
+		return nil, utils.PrependMessageToError(err, "failed to find answers of question")
+	}
+
+	return answers, nil
+}
+
+func (c *questionUseCase) SaveProductAnswerAdmin(ctx context.Context, adminID, questionID uint,
+	answerDetails request.ProductAnswer) error {
+
+	question, err := c.questionRepo.FindProductQuestionByID(ctx, questionID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find question")
+	}
+	if question.ID == 0 {
+		return ErrInvalidQuestionID
+	}
+
+	return c.saveProductAnswer(ctx, question, domain.ProductAnswer{
+		ProductQuestionID: questionID,
+		ActorID:           adminID,
+		ActorType:         domain.ActorAdmin,
+		Answer:            answerDetails.Answer,
+	})
+}
+
+func (c *questionUseCase) UpdateProductQuestionVisibility(ctx context.Context, questionID uint, isHidden bool) error {
+
+	question, err := c.questionRepo.FindProductQuestionByID(ctx, questionID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find question")
+	}
+	if question.ID == 0 {
+		return ErrInvalidQuestionID
+	}
+
+	err = c.questionRepo.UpdateProductQuestionHidden(ctx, questionID, isHidden)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to update visibility of question")
+	}
+
+	return nil
+}
+
+func (c *questionUseCase) UpdateProductAnswerVisibility(ctx context.Context, answerID uint, isHidden bool) error {
+
+	answer, err := c.questionRepo.FindProductAnswerByID(ctx, answerID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find answer")
+	}
+	if answer.ID == 0 {
+		return ErrInvalidAnswerID
+	}
+
+	err = c.questionRepo.UpdateProductAnswerHidden(ctx, answerID, isHidden)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to update visibility of answer")
+	}
+
+	return nil
+}
+
+func (c *questionUseCase) findVisibleQuestionOfProduct(ctx context.Context,
+	productID, questionID uint) (domain.ProductQuestion, error) {
+
+	question, err := c.questionRepo.FindProductQuestionByID(ctx, questionID)
+	if err != nil {
+		return question, utils.PrependMessageToError(err, "failed to find question")
+	}
+	if question.ID == 0 || question.ProductID != productID || question.IsHidden {
+		return question, ErrInvalidQuestionID
+	}
+
+	return question, nil
+}
+
+func (c *questionUseCase) findVisibleAnswerOfQuestion(ctx context.Context, productID, questionID, answerID uint) error {
+
+	if _, err := c.findVisibleQuestionOfProduct(ctx, productID, questionID); err != nil {
+		return err
+	}
+
+	answer, err := c.questionRepo.FindProductAnswerByID(ctx, answerID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find answer")
+	}
+	if answer.ID == 0 || answer.ProductQuestionID != questionID || answer.IsHidden {
+		return ErrInvalidAnswerID
+	}
+
+	return nil
+}
+
+func (c *questionUseCase) saveProductAnswer(ctx context.Context, question domain.ProductQuestion,
+	answer domain.ProductAnswer) error {
+
+	_, err := c.questionRepo.SaveProductAnswer(ctx, answer)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to save answer of question")
+	}
+
+	// user not need to be notified for own answer
+	if question.NotifyOnAnswer && !(answer.ActorType == domain.ActorUser && answer.ActorID == question.UserID) {
+		c.notifyQuestionAnswered(ctx, question, answer)
+	}
+
+	return nil
+}
+
+// answer is already saved so errors are only logged
+func (c *questionUseCase) notifyQuestionAnswered(ctx context.Context, question domain.ProductQuestion,
+	answer domain.ProductAnswer) {
+
+	user, err := c.userRepo.FindUserByUserID(ctx, question.UserID)
+	if err != nil {
+		log.Printf("failed to find user of question with question_id %v: %v", question.ID, err)
+		return
+	}
+	if user.Email == "" {
+		return
+	}
+
+	err = c.mailSender.Send(ctx, mail.Mail{
+		To:      user.Email,
+		Subject: "Your question is answered",
+		Body: fmt.Sprintf("Hi %s,\n\nYour question: %s\n\nAnswer: %s\n",
+			user.FirstName, question.Question, answer.Answer),
+	})
+	if err != nil {
+		log.Printf("failed to send answer mail of question with question_id %v: %v", question.ID, err)
+	}
+}
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/mock/mockrepo"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/mail"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/mail/mailtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaveProductAnswerUser(t *testing.T) {
+
+	const (
+		askedUserID uint = 1
+		userID      uint = 2
+		productID   uint = 3
+		questionID  uint = 4
+	)
+	answerDetails := request.ProductAnswer{Answer: "true to size"}
+	question := domain.ProductQuestion{
+		ID:             questionID,
+		ProductID:      productID,
+		UserID:         askedUserID,
+		Question:       "is it true to size?",
+		NotifyOnAnswer: true,
+	}
+
+	tests := []struct {
+		testName      string
+		buildStub     func(questionRepo *mockrepo.MockQuestionRepository, reviewRepo *mockrepo.MockReviewRepository, userRepo *mockrepo.MockUserRepository)
+		expectedError error
+		expectedMails int
+	}{
+		{
+			testName: "HiddenQuestionShouldReturnError",
+			buildStub: func(questionRepo *mockrepo.MockQuestionRepository, reviewRepo *mockrepo.MockReviewRepository, userRepo *mockrepo.MockUserRepository) {
+				hiddenQuestion := question
+				hiddenQuestion.IsHidden = true
+				questionRepo.EXPECT().FindProductQuestionByID(gomock.Any(), questionID).Times(1).Return(hiddenQuestion, nil)
+			},
+			expectedError: ErrInvalidQuestionID,
+		},
+		{
+			testName: "QuestionOfOtherProductShouldReturnError",
+			buildStub: func(questionRepo *mockrepo.MockQuestionRepository, reviewRepo *mockrepo.MockReviewRepository, userRepo *mockrepo.MockUserRepository) {
+				otherQuestion := question
+				otherQuestion.ProductID = productID + 1
+				questionRepo.EXPECT().FindProductQuestionByID(gomock.Any(), questionID).Times(1).Return(otherQuestion, nil)
+			},
+			expectedError: ErrInvalidQuestionID,
+		},
+		{
+			testName: "UserWithoutDeliveredOrderShouldReturnError",
+			buildStub: func(questionRepo *mockrepo.MockQuestionRepository, reviewRepo *mockrepo.MockReviewRepository, userRepo *mockrepo.MockUserRepository) {
+				questionRepo.EXPECT().FindProductQuestionByID(gomock.Any(), questionID).Times(1).Return(question, nil)
+				reviewRepo.EXPECT().IsDeliveredProductOfUser(gomock.Any(), userID, productID).Times(1).Return(false, nil)
+			},
+			expectedError: ErrAnswerNotAllowed,
+		},
+		{
+			testName: "VerifiedBuyerShouldSaveAnswerAndNotifyAskedUser",
+			buildStub: func(questionRepo *mockrepo.MockQuestionRepository, reviewRepo *mockrepo.MockReviewRepository, userRepo *mockrepo.MockUserRepository) {
+				questionRepo.EXPECT().FindProductQuestionByID(gomock.Any(), questionID).Times(1).Return(question, nil)
+				reviewRepo.EXPECT().IsDeliveredProductOfUser(gomock.Any(), userID, productID).Times(1).Return(true, nil)
+				questionRepo.EXPECT().SaveProductAnswer(gomock.Any(), domain.ProductAnswer{
+					ProductQuestionID: questionID,
+					ActorID:           userID,
+					ActorType:         domain.ActorUser,
+					Answer:            answerDetails.Answer,
+				}).Times(1).Return(uint(1), nil)
+				userRepo.EXPECT().FindUserByUserID(gomock.Any(), askedUserID).Times(1).
+					Return(domain.User{ID: askedUserID, FirstName: "user", Email: "user@example.com"}, nil)
+			},
+			expectedError: nil,
+			expectedMails: 1,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+			t.Parallel()
+
+			ctl := gomock.NewController(t)
+			questionRepo := mockrepo.NewMockQuestionRepository(ctl)
+			reviewRepo := mockrepo.NewMockReviewRepository(ctl)
+			userRepo := mockrepo.NewMockUserRepository(ctl)
+			test.buildStub(questionRepo, reviewRepo, userRepo)
+
+			server := mailtest.NewServer(t)
+			sender := mail.NewSmtpSender(server.Host, server.Port, "", "", "shop@example.com")
+
+			questionUseCase := NewQuestionUseCase(questionRepo, nil, reviewRepo, userRepo, sender)
+
+			err := questionUseCase.SaveProductAnswerUser(context.Background(), userID, productID, questionID, answerDetails)
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			messages := server.Messages()
+			if assert.Len(t, messages, test.expectedMails) && test.expectedMails > 0 {
+				assert.Equal(t, []string{"user@example.com"}, messages[0].To)
+				assert.Contains(t, messages[0].Data, "Answer: true to size")
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"mime/multipart"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/cloud"
+	service "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+)
+
+type reviewUseCase struct {
+	reviewRepo   interfaces.ReviewRepository
+	cloudService cloud.CloudService
+}
+
+func NewReviewUseCase(reviewRepo interfaces.ReviewRepository, cloudService cloud.CloudService) service.ReviewUseCase {
+	return &reviewUseCase{
+		reviewRepo:   reviewRepo,
+		cloudService: cloudService,
+	}
+}
+
+// To save review of a product by a verified buyer, the review is shown after admin approve it
+func (c *reviewUseCase) SaveProductReview(ctx context.Context, userID, productID uint,
+	reviewDetails request.ProductReview) error {
+
+	delivered, err := c.reviewRepo.IsDeliveredProductOfUser(ctx, userID, productID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to check user have delivered order of product")
+	}
+	if !delivered {
+		return ErrReviewNotAllowed
+	}
+
+	existReview, err := c.reviewRepo.FindProductReviewByUserID(ctx, productID, userID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to check user already reviewed the product")
+	}
+	if existReview.ID != 0 {
+		return ErrReviewAlreadyExist
+	}
+
+	// images are uploaded before the transaction so that it's not kept open while uploading
+	// and the uploaded images are deleted when the review is not saved
+	uploadIDs, err := c.uploadReviewImages(ctx, reviewDetails.ImageFileHeaders)
+	if err != nil {
+		return err
+	}
+
+	err = c.reviewRepo.Transactions(ctx, func(trxRepo interfaces.ReviewRepository) error {
+
+		reviewID, err := trxRepo.SaveProductReview(ctx, domain.ProductReview{
+			ProductID: productID,
+			UserID:    userID,
+			Rating:    reviewDetails.Rating,
+			Title:     reviewDetails.Title,
+			Body:      reviewDetails.Body,
+			Status:    domain.ReviewPending,
+		})
+		if err != nil {
+			return utils.PrependMessageToError(err, "failed to save product review")
+		}
+
+		for _, uploadID := range uploadIDs {
+			err = trxRepo.SaveProductReviewImage(ctx, reviewID, uploadID)
+			if err != nil {
+				return utils.PrependMessageToError(err, "failed to save image of review")
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		c.deleteUploadedFiles(ctx, uploadIDs)
+		return err
+	}
+
+	return nil
+}
+
+// To upload all images of the review, already uploaded images are deleted when any of the upload failed
+func (c *reviewUseCase) uploadReviewImages(ctx context.Context, imageFiles []*multipart.FileHeader) ([]string, error) {
+
+	uploadIDs := make([]string, 0, len(imageFiles))
+	for _, imageFile := range imageFiles {
+
+		uploadID, err := c.cloudService.SaveFile(ctx, imageFile)
+		if err != nil {
+			c.deleteUploadedFiles(ctx, uploadIDs)
+			return nil, utils.PrependMessageToError(err, "failed to upload review image to cloud")
+		}
+		uploadIDs = append(uploadIDs, uploadID)
+	}
+
+	return uploadIDs, nil
+}
+
+// files not deleted are only logged because the actual error is already returned
+func (c *reviewUseCase) deleteUploadedFiles(ctx context.Context, uploadIDs []string) {
+	for _, uploadID := range uploadIDs {
+		if err := c.cloudService.DeleteFile(ctx, uploadID); err != nil {
+			log.Printf("failed to delete uploaded file of upload_id %s: %v", uploadID, err)
+		}
+	}
+}
+
+// To find approved reviews of the product for users
+func (c *reviewUseCase) FindAllApprovedProductReviews(ctx context.Context, productID uint,
+	pagination request.Pagination) ([]response.ProductReview, error) {
+
+	return c.findAllProductReviews(ctx, request.ReviewFilter{
+		ProductID:  productID,
+		Status:     string(domain.ReviewApproved),
+		Pagination: pagination,
+	})
+}
+
+// To find reviews of all statuses for admin moderation
+func (c *reviewUseCase) FindAllProductReviews(ctx context.Context,
+	filter request.ReviewFilter) ([]response.ProductReview, error) {
+
+	return c.findAllProductReviews(ctx, filter)
+}
+
+func (c *reviewUseCase) findAllProductReviews(ctx context.Context,
+	filter request.ReviewFilter) ([]response.ProductReview, error) {
+
+	reviews, err := c.reviewRepo.FindAllProductReviews(ctx, filter)
+	if err != nil {
+		return nil, utils.PrependMessageToError(err, "failed to find product reviews")
+	}
+
+	for i := range reviews {
+
+		images, err := c.reviewRepo.FindAllProductReviewImages(ctx, reviews[i].ID)
+		if err != nil {
+			return nil, utils.PrependMessageToError(err, "failed to find images of review")
+		}
+
+		reviews[i].Images = make([]string, 0, len(images))
+		for _, image := range images {
+			url, err := c.cloudService.GetFileUrl(ctx, image)
+			if err != nil {
+				continue
+			}
+			reviews[i].Images = append(reviews[i].Images, url)
+		}
+	}
+
+	return reviews, nil
+}
+
+func (c *reviewUseCase) ApproveProductReview(ctx context.Context, reviewID uint) error {
+	return c.changeProductReviewStatus(ctx, reviewID, domain.ReviewApproved)
+}
+
+func (c *reviewUseCase) HideProductReview(ctx context.Context, reviewID uint) error {
+	return c.changeProductReviewStatus(ctx, reviewID, domain.ReviewHidden)
+}
+
+func (c *reviewUseCase) changeProductReviewStatus(ctx context.Context, reviewID uint,
+	status domain.ReviewStatus) error {
+
+	review, err := c.reviewRepo.FindProductReviewByID(ctx, reviewID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find review")
+	}
+	if review.ID == 0 {
+		return ErrInvalidReviewID
+	}
+
+	err = c.reviewRepo.UpdateProductReviewStatus(ctx, reviewID, status)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to update review status")
+	}
+
+	return nil
+}
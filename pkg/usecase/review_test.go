@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"mime/multipart"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/mock/mockrepo"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/mock/mockservice"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaveProductReview(t *testing.T) {
+
+	const (
+		userID    uint = 1
+		productID uint = 2
+	)
+	reviewDetails := request.ProductReview{Rating: 4, Title: "good shirt", Body: "good quality shirt"}
+
+	imageFile := &multipart.FileHeader{Filename: "shirt.png"}
+	saveFailed := errors.New("failed to save")
+
+	tests := []struct {
+		testName      string
+		images        []*multipart.FileHeader
+		buildStub     func(reviewRepo *mockrepo.MockReviewRepository, cloudService *mockservice.MockCloudService)
+		expectedError error
+	}{
+		{
+			testName: "UserWithoutDeliveredOrderShouldReturnError",
+			buildStub: func(reviewRepo *mockrepo.MockReviewRepository, cloudService *mockservice.MockCloudService) {
+				reviewRepo.EXPECT().IsDeliveredProductOfUser(gomock.Any(), userID, productID).Times(1).Return(false, nil)
+			},
+			expectedError: ErrReviewNotAllowed,
+		},
+		{
+			testName: "AlreadyReviewedUserShouldReturnError",
+			buildStub: func(reviewRepo *mockrepo.MockReviewRepository, cloudService *mockservice.MockCloudService) {
+				reviewRepo.EXPECT().IsDeliveredProductOfUser(gomock.Any(), userID, productID).Times(1).Return(true, nil)
+				reviewRepo.EXPECT().FindProductReviewByUserID(gomock.Any(), productID, userID).Times(1).
+					Return(domain.ProductReview{ID: 1}, nil)
+			},
+			expectedError: ErrReviewAlreadyExist,
+		},
+		{
+			testName: "VerifiedBuyerShouldSaveReviewAsPending",
+			buildStub: func(reviewRepo *mockrepo.MockReviewRepository, cloudService *mockservice.MockCloudService) {
+				reviewRepo.EXPECT().IsDeliveredProductOfUser(gomock.Any(), userID, productID).Times(1).Return(true, nil)
+				reviewRepo.EXPECT().FindProductReviewByUserID(gomock.Any(), productID, userID).Times(1).
+					Return(domain.ProductReview{}, nil)
+				reviewRepo.EXPECT().Transactions(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, trxFn func(repo interfaces.ReviewRepository) error) error {
+						return trxFn(reviewRepo)
+					})
+				reviewRepo.EXPECT().SaveProductReview(gomock.Any(), domain.ProductReview{
+					ProductID: productID,
+					UserID:    userID,
+					Rating:    reviewDetails.Rating,
+					Title:     reviewDetails.Title,
+					Body:      reviewDetails.Body,
+					Status:    domain.ReviewPending,
+				}).Times(1).Return(uint(1), nil)
+			},
+		},
+		{
+			testName: "ImagesShouldUploadBeforeSaveReview",
+			images:   []*multipart.FileHeader{imageFile},
+			buildStub: func(reviewRepo *mockrepo.MockReviewRepository, cloudService *mockservice.MockCloudService) {
+				reviewRepo.EXPECT().IsDeliveredProductOfUser(gomock.Any(), userID, productID).Times(1).Return(true, nil)
+				reviewRepo.EXPECT().FindProductReviewByUserID(gomock.Any(), productID, userID).Times(1).
+					Return(domain.ProductReview{}, nil)
+				cloudService.EXPECT().SaveFile(gomock.Any(), imageFile).Times(1).Return("upload_1", nil)
+				reviewRepo.EXPECT().Transactions(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, trxFn func(repo interfaces.ReviewRepository) error) error {
+						return trxFn(reviewRepo)
+					})
+				reviewRepo.EXPECT().SaveProductReview(gomock.Any(), gomock.Any()).Times(1).Return(uint(1), nil)
+				reviewRepo.EXPECT().SaveProductReviewImage(gomock.Any(), uint(1), "upload_1").Times(1).Return(nil)
+			},
+		},
+		{
+			testName: "ReviewNotSavedShouldDeleteUploadedImages",
+			images:   []*multipart.FileHeader{imageFile},
+			buildStub: func(reviewRepo *mockrepo.MockReviewRepository, cloudService *mockservice.MockCloudService) {
+				reviewRepo.EXPECT().IsDeliveredProductOfUser(gomock.Any(), userID, productID).Times(1).Return(true, nil)
+				reviewRepo.EXPECT().FindProductReviewByUserID(gomock.Any(), productID, userID).Times(1).
+					Return(domain.ProductReview{}, nil)
+				cloudService.EXPECT().SaveFile(gomock.Any(), imageFile).Times(1).Return("upload_1", nil)
+				reviewRepo.EXPECT().Transactions(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, trxFn func(repo interfaces.ReviewRepository) error) error {
+						return trxFn(reviewRepo)
+					})
+				reviewRepo.EXPECT().SaveProductReview(gomock.Any(), gomock.Any()).Times(1).Return(uint(0), saveFailed)
+				cloudService.EXPECT().DeleteFile(gomock.Any(), "upload_1").Times(1).Return(nil)
+			},
+			expectedError: saveFailed,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+
+			ctl := gomock.NewController(t)
+			reviewRepo := mockrepo.NewMockReviewRepository(ctl)
+			cloudService := mockservice.NewMockCloudService(ctl)
+			test.buildStub(reviewRepo, cloudService)
+
+			reviewUseCase := NewReviewUseCase(reviewRepo, cloudService)
+
+			details := reviewDetails
+			details.ImageFileHeaders = test.images
+			err := reviewUseCase.SaveProductReview(context.Background(), userID, productID, details)
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
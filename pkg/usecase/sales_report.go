@@ -0,0 +1,346 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+)
+
+const (
+	salesReportTopCount  = 10
+	salesReportPageCount = 500
+
+	salesReportDateFormat = "2006-01-02 15:04:05"
+	salesBucketDateFormat = "2006-01-02"
+)
+
+// columns of orders on sales report
+var salesReportOrderHeader = []string{
+	"UserID", "FirstName", "Email",
+	"ShopOrderID", "OrderDate", "OrderTotalPrice",
+	"Discount", "OrderStatus", "PaymentType",
+	"CGST", "SGST", "IGST",
+}
+
+// To find the aggregates of sales on the period with sales of each bucket
+func (c *adminUseCase) FindSalesSummary(ctx context.Context, reqData request.SalesReport) (response.SalesSummary, error) {
+
+	if reqData.Bucket == "" {
+		reqData.Bucket = request.SalesBucketDay
+	}
+	switch reqData.Bucket {
+	case request.SalesBucketDay, request.SalesBucketWeek, request.SalesBucketMonth:
+	default:
+		return response.SalesSummary{}, ErrInvalidSalesReportBucket
+	}
+
+	total, err := c.adminRepo.FindSalesTotal(ctx, reqData)
+	if err != nil {
+		return response.SalesSummary{}, utils.PrependMessageToError(err, "failed to find sales total")
+	}
+
+	summary := response.SalesSummary{
+		StartDate:    reqData.StartDate,
+		EndDate:      reqData.EndDate,
+		Bucket:       reqData.Bucket,
+		TotalOrders:  total.Orders,
+		GrossRevenue: total.GrossRevenue,
+		Discounts:    total.Discounts,
+		Refunds:      total.Refunds,
+		NetRevenue:   netRevenue(total),
+		CGST:         total.CGST,
+		SGST:         total.SGST,
+		IGST:         total.IGST,
+	}
+
+	summary.OrdersByStatus, err = c.adminRepo.FindAllOrderCountsByStatus(ctx, reqData)
+	if err != nil {
+		return response.SalesSummary{}, utils.PrependMessageToError(err, "failed to find order counts by status")
+	}
+	summary.OrdersByPaymentMethod, err = c.adminRepo.FindAllOrderCountsByPaymentMethod(ctx, reqData)
+	if err != nil {
+		return response.SalesSummary{}, utils.PrependMessageToError(err, "failed to find order counts by payment method")
+	}
+	summary.TopProducts, err = c.adminRepo.FindTopSellingProducts(ctx, reqData, salesReportTopCount)
+	if err != nil {
+		return response.SalesSummary{}, utils.PrependMessageToError(err, "failed to find top selling products")
+	}
+	summary.TopCategories, err = c.adminRepo.FindTopSellingCategories(ctx, reqData, salesReportTopCount)
+	if err != nil {
+		return response.SalesSummary{}, utils.PrependMessageToError(err, "failed to find top selling categories")
+	}
+
+	summary.Buckets, err = c.adminRepo.FindAllSalesBuckets(ctx, reqData)
+	if err != nil {
+		return response.SalesSummary{}, utils.PrependMessageToError(err, "failed to find sales of each "+reqData.Bucket)
+	}
+	for i := range summary.Buckets {
+		summary.Buckets[i].NetRevenue = netRevenue(summary.Buckets[i])
+	}
+
+	return summary, nil
+}
+
+func netRevenue(sales response.SalesBucket) int {
+	return int(sales.GrossRevenue) - int(sales.Discounts) - int(sales.Refunds)
+}
+
+// To write the full sales report of the period with its summary as csv or pdf
+// orders are read page by page so the full period is written without loading all orders at once
+func (c *adminUseCase) ExportSalesReport(ctx context.Context, reqData request.SalesReport, file io.Writer) error {
+
+	if reqData.Format == "" {
+		reqData.Format = request.SalesReportCSV
+	}
+	if reqData.Format != request.SalesReportCSV && reqData.Format != request.SalesReportPDF {
+		return ErrInvalidSalesReportFormat
+	}
+
+	summary, err := c.FindSalesSummary(ctx, reqData)
+	if err != nil {
+		return err
+	}
+	reqData.Bucket = summary.Bucket
+
+	if reqData.Format == request.SalesReportPDF {
+		err = c.writeSalesReportPDF(ctx, reqData, summary, file)
+	} else {
+		err = c.writeSalesReportCSV(ctx, reqData, summary, file)
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Printf("successfully exported %s sales report from %v to %v", reqData.Format, reqData.StartDate, reqData.EndDate)
+	return nil
+}
+
+// To call the fn with each page of orders on the sales report period
+func (c *adminUseCase) forEachSalesReportPage(ctx context.Context, reqData request.SalesReport,
+	fn func(salesReport []response.SalesReport) error) error {
+
+	for pageNumber := uint64(1); ; pageNumber++ {
+
+		reqData.Pagination = request.Pagination{
+			PageNumber: pageNumber,
+			Count:      salesReportPageCount,
+		}
+		salesReport, err := c.adminRepo.CreateFullSalesReport(ctx, reqData)
+		if err != nil {
+			return utils.PrependMessageToError(err, "failed to find sales report")
+		}
+
+		if err := fn(salesReport); err != nil {
+			return err
+		}
+
+		if len(salesReport) < salesReportPageCount {
+			return nil
+		}
+	}
+}
+
+func salesReportOrderRow(sales response.SalesReport) []string {
+	return []string{
+		fmt.Sprintf("%v", sales.UserID),
+		sales.FirstName,
+		sales.Email,
+		fmt.Sprintf("%v", sales.ShopOrderID),
+		sales.OrderDate.Format(salesReportDateFormat),
+		fmt.Sprintf("%v", sales.OrderTotalPrice),
+		fmt.Sprintf("%v", sales.Discount),
+		sales.OrderStatus,
+		sales.PaymentType,
+		fmt.Sprintf("%v", sales.CGST),
+		fmt.Sprintf("%v", sales.SGST),
+		fmt.Sprintf("%v", sales.IGST),
+	}
+}
+
+// rows of each summary section of the report (section title, table header and table rows)
+type salesReportSection struct {
+	title  string
+	header []string
+	rows   [][]string
+}
+
+func salesSummarySections(summary response.SalesSummary) []salesReportSection {
+
+	uintString := func(value uint) string { return strconv.FormatUint(uint64(value), 10) }
+
+	sections := []salesReportSection{
+		{
+			title:  "Summary",
+			header: []string{"Metric", "Value"},
+			rows: [][]string{
+				{"Start Date", summary.StartDate.Format(salesReportDateFormat)},
+				{"End Date", summary.EndDate.Format(salesReportDateFormat)},
+				{"Total Orders", uintString(summary.TotalOrders)},
+				{"Gross Revenue", uintString(summary.GrossRevenue)},
+				{"Discounts", uintString(summary.Discounts)},
+				{"Refunds", uintString(summary.Refunds)},
+				{"Net Revenue", strconv.Itoa(summary.NetRevenue)},
+				{"CGST", uintString(summary.CGST)},
+				{"SGST", uintString(summary.SGST)},
+				{"IGST", uintString(summary.IGST)},
+			},
+		},
+	}
+
+	countSection := func(title string, counts []response.SalesCount) salesReportSection {
+		section := salesReportSection{title: title, header: []string{"Name", "Orders", "Amount"}}
+		for _, count := range counts {
+			section.rows = append(section.rows, []string{count.Name, uintString(count.Count), uintString(count.Amount)})
+		}
+		return section
+	}
+	topSection := func(title string, items []response.TopSellingItem) salesReportSection {
+		section := salesReportSection{title: title, header: []string{"Name", "Quantity", "Revenue"}}
+		for _, item := range items {
+			section.rows = append(section.rows, []string{item.Name, uintString(item.Quantity), uintString(item.Revenue)})
+		}
+		return section
+	}
+
+	sections = append(sections,
+		countSection("Orders By Status", summary.OrdersByStatus),
+		countSection("Orders By Payment Method", summary.OrdersByPaymentMethod),
+		topSection("Top Products", summary.TopProducts),
+		topSection("Top Categories", summary.TopCategories),
+	)
+
+	bucketSection := salesReportSection{
+		title:  "Sales By " + summary.Bucket,
+		header: []string{"Period", "Orders", "Gross Revenue", "Discounts", "Refunds", "Net Revenue", "GST"},
+	}
+	for _, bucket := range summary.Buckets {
+		bucketSection.rows = append(bucketSection.rows, []string{
+			bucket.Period.Format(salesBucketDateFormat),
+			uintString(bucket.Orders),
+			uintString(bucket.GrossRevenue),
+			uintString(bucket.Discounts),
+			uintString(bucket.Refunds),
+			strconv.Itoa(bucket.NetRevenue),
+			uintString(bucket.CGST + bucket.SGST + bucket.IGST),
+		})
+	}
+
+	return append(sections, bucketSection)
+}
+
+func (c *adminUseCase) writeSalesReportCSV(ctx context.Context, reqData request.SalesReport,
+	summary response.SalesSummary, file io.Writer) error {
+
+	csvWriter := csv.NewWriter(file)
+
+	for _, section := range salesSummarySections(summary) {
+
+		records := append([][]string{{section.title}, section.header}, section.rows...)
+		records = append(records, []string{})
+
+		if err := csvWriter.WriteAll(records); err != nil {
+			return utils.PrependMessageToError(err, "failed to write sales summary on csv")
+		}
+	}
+
+	err := csvWriter.WriteAll([][]string{{"Orders"}, salesReportOrderHeader})
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to write sales report on csv")
+	}
+
+	return c.forEachSalesReportPage(ctx, reqData, func(salesReport []response.SalesReport) error {
+
+		for _, sales := range salesReport {
+			if err := csvWriter.Write(salesReportOrderRow(sales)); err != nil {
+				return utils.PrependMessageToError(err, "failed to write sales report on csv")
+			}
+		}
+		csvWriter.Flush()
+
+		return csvWriter.Error()
+	})
+}
+
+func (c *adminUseCase) writeSalesReportPDF(ctx context.Context, reqData request.SalesReport,
+	summary response.SalesSummary, file io.Writer) error {
+
+	pdf := fpdf.New("L", "mm", "A4", "")
+	pdf.SetMargins(10, 10, 10)
+	pdf.SetAutoPageBreak(true, 10)
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.CellFormat(0, 10, "Sales Report", "", 1, "C", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(0, 6, fmt.Sprintf("%s to %s", summary.StartDate.Format(salesReportDateFormat),
+		summary.EndDate.Format(salesReportDateFormat)), "", 1, "C", false, 0, "")
+	pdf.Ln(4)
+
+	for _, section := range salesSummarySections(summary) {
+		writePDFTable(pdf, section.title, section.header, section.rows)
+	}
+
+	pdf.AddPage()
+	pdf.SetFont("Helvetica", "B", 12)
+	pdf.CellFormat(0, 8, "Orders", "", 1, "L", false, 0, "")
+	writePDFTableRow(pdf, salesReportOrderHeader, true)
+
+	err := c.forEachSalesReportPage(ctx, reqData, func(salesReport []response.SalesReport) error {
+		for _, sales := range salesReport {
+			writePDFTableRow(pdf, salesReportOrderRow(sales), false)
+		}
+		return pdf.Error()
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := pdf.Output(file); err != nil {
+		return utils.PrependMessageToError(err, "failed to write sales report on pdf")
+	}
+
+	return nil
+}
+
+// To write a titled table on pdf with equal width columns
+func writePDFTable(pdf *fpdf.Fpdf, title string, header []string, rows [][]string) {
+
+	pdf.SetFont("Helvetica", "B", 12)
+	pdf.CellFormat(0, 8, title, "", 1, "L", false, 0, "")
+
+	writePDFTableRow(pdf, header, true)
+	for _, row := range rows {
+		writePDFTableRow(pdf, row, false)
+	}
+	pdf.Ln(4)
+}
+
+func writePDFTableRow(pdf *fpdf.Fpdf, columns []string, header bool) {
+
+	pageWidth, _ := pdf.GetPageSize()
+	left, _, right, _ := pdf.GetMargins()
+	columnWidth := (pageWidth - left - right) / float64(len(columns))
+
+	if header {
+		pdf.SetFont("Helvetica", "B", 8)
+	} else {
+		pdf.SetFont("Helvetica", "", 8)
+	}
+
+	for _, column := range columns {
+		// cut the text which not fit on the column
+		for len(column) > 0 && pdf.GetStringWidth(column) > columnWidth-2 {
+			column = column[:len(column)-1]
+		}
+		pdf.CellFormat(columnWidth, 6, column, "1", 0, "L", header, 0, "")
+	}
+	pdf.Ln(-1)
+}
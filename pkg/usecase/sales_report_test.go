@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/mock/mockrepo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportSalesReport(t *testing.T) {
+
+	startDate := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	buildSummaryStub := func(adminRepo *mockrepo.MockAdminRepository) {
+		adminRepo.EXPECT().FindSalesTotal(gomock.Any(), gomock.Any()).Times(1).
+			Return(response.SalesBucket{Orders: 2, GrossRevenue: 2000, Discounts: 200, Refunds: 500}, nil)
+		adminRepo.EXPECT().FindAllOrderCountsByStatus(gomock.Any(), gomock.Any()).Times(1).
+			Return([]response.SalesCount{{Name: "order delivered", Count: 2, Amount: 1800}}, nil)
+		adminRepo.EXPECT().FindAllOrderCountsByPaymentMethod(gomock.Any(), gomock.Any()).Times(1).
+			Return([]response.SalesCount{{Name: "cod", Count: 2, Amount: 1800}}, nil)
+		adminRepo.EXPECT().FindTopSellingProducts(gomock.Any(), gomock.Any(), uint(salesReportTopCount)).Times(1).
+			Return([]response.TopSellingItem{{ID: 1, Name: "Polo Shirt", Quantity: 2, Revenue: 1800}}, nil)
+		adminRepo.EXPECT().FindTopSellingCategories(gomock.Any(), gomock.Any(), uint(salesReportTopCount)).Times(1).
+			Return([]response.TopSellingItem{{ID: 2, Name: "Shirts", Quantity: 2, Revenue: 1800}}, nil)
+		adminRepo.EXPECT().FindAllSalesBuckets(gomock.Any(), gomock.Any()).Times(1).
+			Return([]response.SalesBucket{{Period: startDate, Orders: 2, GrossRevenue: 2000, Discounts: 200, Refunds: 500}}, nil)
+	}
+
+	tests := []struct {
+		testName         string
+		reqData          request.SalesReport
+		buildStub        func(adminRepo *mockrepo.MockAdminRepository)
+		expectedContains []string
+		expectedError    error
+	}{
+		{
+			testName:      "InvalidFormatShouldReturnError",
+			reqData:       request.SalesReport{StartDate: startDate, EndDate: endDate, Format: "xls"},
+			buildStub:     func(adminRepo *mockrepo.MockAdminRepository) {},
+			expectedError: ErrInvalidSalesReportFormat,
+		},
+		{
+			testName:      "InvalidBucketShouldReturnError",
+			reqData:       request.SalesReport{StartDate: startDate, EndDate: endDate, Bucket: "year"},
+			buildStub:     func(adminRepo *mockrepo.MockAdminRepository) {},
+			expectedError: ErrInvalidSalesReportBucket,
+		},
+		{
+			testName: "CSVShouldHaveSummaryAndAllOrders",
+			reqData: request.SalesReport{StartDate: startDate, EndDate: endDate,
+				Format: request.SalesReportCSV, Bucket: request.SalesBucketWeek},
+			buildStub: func(adminRepo *mockrepo.MockAdminRepository) {
+				buildSummaryStub(adminRepo)
+
+				fullPage := make([]response.SalesReport, salesReportPageCount)
+				for i := range fullPage {
+					fullPage[i] = response.SalesReport{ShopOrderID: uint(i + 1), OrderStatus: "order delivered"}
+				}
+				adminRepo.EXPECT().CreateFullSalesReport(gomock.Any(), request.SalesReport{
+					StartDate:  startDate,
+					EndDate:    endDate,
+					Format:     request.SalesReportCSV,
+					Bucket:     request.SalesBucketWeek,
+					Pagination: request.Pagination{PageNumber: 1, Count: salesReportPageCount},
+				}).Times(1).Return(fullPage, nil)
+				adminRepo.EXPECT().CreateFullSalesReport(gomock.Any(), gomock.Any()).Times(1).
+					Return([]response.SalesReport{{ShopOrderID: 501, Email: "last@gmail.com"}}, nil)
+			},
+			expectedContains: []string{
+				"Net Revenue,1300",
+				"order delivered,2,1800",
+				"Top Products",
+				"Sales By week",
+				"2023-01-01,2,2000,200,500,1300",
+				"last@gmail.com",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+
+			ctl := gomock.NewController(t)
+			adminRepo := mockrepo.NewMockAdminRepository(ctl)
+			test.buildStub(adminRepo)
+
+			adminUseCase := NewAdminUseCase(adminRepo, nil, nil, nil)
+
+			var file bytes.Buffer
+			err := adminUseCase.ExportSalesReport(context.Background(), test.reqData, &file)
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+				assert.Zero(t, file.Len())
+				return
+			}
+			assert.NoError(t, err)
+			for _, expected := range test.expectedContains {
+				assert.Contains(t, file.String(), expected)
+			}
+			// header row and all orders of both pages
+			_, orders, _ := strings.Cut(file.String(), "\nOrders\n")
+			assert.Equal(t, salesReportPageCount+2, strings.Count(orders, "\n"))
+		})
+	}
+}
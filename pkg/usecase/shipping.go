@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	service "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+)
+
+type shippingUseCase struct {
+	shippingRepo interfaces.ShippingRepository
+}
+
+func NewShippingUseCase(shippingRepo interfaces.ShippingRepository) service.ShippingUseCase {
+	return &shippingUseCase{
+		shippingRepo: shippingRepo,
+	}
+}
+
+func (c *shippingUseCase) SaveShippingZone(ctx context.Context, zoneDetails request.ShippingZone) (uint, error) {
+
+	exist, err := c.shippingRepo.IsShippingZoneNameExist(ctx, zoneDetails.Name, 0)
+	if err != nil {
+		return 0, utils.PrependMessageToError(err, "failed to check shipping zone name already exist")
+	}
+	if exist {
+		return 0, ErrShippingZoneAlreadyExist
+	}
+
+	zoneID, err := c.shippingRepo.SaveShippingZone(ctx, shippingZoneFromRequest(zoneDetails))
+	if err != nil {
+		return 0, utils.PrependMessageToError(err, "failed to save shipping zone")
+	}
+
+	return zoneID, nil
+}
+
+func (c *shippingUseCase) FindAllShippingZones(ctx context.Context,
+	pagination request.Pagination) ([]domain.ShippingZone, error) {
+
+	zones, err := c.shippingRepo.FindAllShippingZones(ctx, pagination)
+	if err != nil {
+		return nil, utils.PrependMessageToError(err, "failed to find all shipping zones")
+	}
+
+	return zones, nil
+}
+
+func (c *shippingUseCase) UpdateShippingZone(ctx context.Context, zoneID uint, zoneDetails request.ShippingZone) error {
+
+	zone, err := c.shippingRepo.FindShippingZoneByID(ctx, zoneID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find shipping zone")
+	}
+	if zone.ID == 0 {
+		return ErrInvalidShippingZoneID
+	}
+
+	exist, err := c.shippingRepo.IsShippingZoneNameExist(ctx, zoneDetails.Name, zoneID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to check shipping zone name already exist")
+	}
+	if exist {
+		return ErrShippingZoneAlreadyExist
+	}
+
+	zone = shippingZoneFromRequest(zoneDetails)
+	zone.ID = zoneID
+
+	err = c.shippingRepo.UpdateShippingZone(ctx, zone)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to update shipping zone")
+	}
+
+	return nil
+}
+
+func (c *shippingUseCase) DeleteShippingZone(ctx context.Context, zoneID uint) error {
+
+	zone, err := c.shippingRepo.FindShippingZoneByID(ctx, zoneID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find shipping zone")
+	}
+	if zone.ID == 0 {
+		return ErrInvalidShippingZoneID
+	}
+
+	err = c.shippingRepo.DeleteShippingZone(ctx, zoneID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to delete shipping zone")
+	}
+
+	return nil
+}
+
+func shippingZoneFromRequest(zoneDetails request.ShippingZone) domain.ShippingZone {
+	return domain.ShippingZone{
+		Name:           zoneDetails.Name,
+		CountryID:      zoneDetails.CountryID,
+		PincodeFrom:    zoneDetails.PincodeFrom,
+		PincodeTo:      zoneDetails.PincodeTo,
+		RateType:       domain.ShippingRateType(zoneDetails.RateType),
+		BaseCharge:     zoneDetails.BaseCharge,
+		ChargePerKg:    zoneDetails.ChargePerKg,
+		FreeOverAmount: zoneDetails.FreeOverAmount,
+	}
+}
+
+// To find delivery charge of an order to the address (error if no shipping zone cover the address pincode)
+// delivery is free for all addresses until the shop configure any shipping zone
+func findDeliveryCharge(ctx context.Context, shippingRepo interfaces.ShippingRepository,
+	address response.Address, orderAmount, weight uint) (uint, error) {
+
+	zone, err := shippingRepo.FindShippingZoneOfPincode(ctx, address.CountryID, address.Pincode)
+	if err != nil {
+		return 0, utils.PrependMessageToError(err, "failed to find shipping zone of address")
+	}
+	if zone.ID == 0 {
+		zoneExist, err := shippingRepo.IsAnyShippingZoneExist(ctx)
+		if err != nil {
+			return 0, utils.PrependMessageToError(err, "failed to check any shipping zone exist")
+		}
+		if !zoneExist {
+			return 0, nil
+		}
+		return 0, ErrUnserviceablePincode
+	}
+
+	return calculateDeliveryCharge(zone, orderAmount, weight), nil
+}
+
+// weight is in grams and every started kg is charged on weight based rate
+func calculateDeliveryCharge(zone domain.ShippingZone, orderAmount, weight uint) uint {
+
+	switch zone.RateType {
+	case domain.ShippingRateWeight:
+		kgs := (weight + 999) / 1000
+		return zone.BaseCharge + kgs*zone.ChargePerKg
+	case domain.ShippingRateFreeOver:
+		if orderAmount >= zone.FreeOverAmount {
+			return 0
+		}
+		return zone.BaseCharge
+	default:
+		return zone.BaseCharge
+	}
+}
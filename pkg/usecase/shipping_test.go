@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/mock/mockrepo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculateDeliveryCharge(t *testing.T) {
+
+	tests := []struct {
+		testName       string
+		zone           domain.ShippingZone
+		orderAmount    uint
+		weight         uint
+		expectedCharge uint
+	}{
+		{
+			testName:       "FlatRateShouldReturnBaseCharge",
+			zone:           domain.ShippingZone{RateType: domain.ShippingRateFlat, BaseCharge: 40},
+			orderAmount:    1000,
+			weight:         2500,
+			expectedCharge: 40,
+		},
+		{
+			testName:       "WeightRateShouldChargeEveryStartedKg",
+			zone:           domain.ShippingZone{RateType: domain.ShippingRateWeight, BaseCharge: 20, ChargePerKg: 15},
+			orderAmount:    1000,
+			weight:         2001,
+			expectedCharge: 65,
+		},
+		{
+			testName:       "WeightRateWithoutWeightShouldReturnBaseCharge",
+			zone:           domain.ShippingZone{RateType: domain.ShippingRateWeight, BaseCharge: 20, ChargePerKg: 15},
+			orderAmount:    1000,
+			weight:         0,
+			expectedCharge: 20,
+		},
+		{
+			testName:       "FreeOverRateBelowThresholdShouldReturnBaseCharge",
+			zone:           domain.ShippingZone{RateType: domain.ShippingRateFreeOver, BaseCharge: 50, FreeOverAmount: 500},
+			orderAmount:    499,
+			expectedCharge: 50,
+		},
+		{
+			testName:       "FreeOverRateOnThresholdShouldBeFree",
+			zone:           domain.ShippingZone{RateType: domain.ShippingRateFreeOver, BaseCharge: 50, FreeOverAmount: 500},
+			orderAmount:    500,
+			expectedCharge: 0,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+			t.Parallel()
+
+			charge := calculateDeliveryCharge(test.zone, test.orderAmount, test.weight)
+			assert.Equal(t, test.expectedCharge, charge)
+		})
+	}
+}
+
+func TestFindDeliveryChargeUnserviceablePincode(t *testing.T) {
+
+	address := response.Address{ID: 1, CountryID: 1, Pincode: 682001}
+
+	ctl := gomock.NewController(t)
+	shippingRepo := mockrepo.NewMockShippingRepository(ctl)
+	shippingRepo.EXPECT().FindShippingZoneOfPincode(gomock.Any(), address.CountryID, address.Pincode).
+		Times(1).Return(domain.ShippingZone{}, nil)
+	shippingRepo.EXPECT().IsAnyShippingZoneExist(gomock.Any()).Times(1).Return(true, nil)
+
+	_, err := findDeliveryCharge(context.Background(), shippingRepo, address, 1000, 0)
+	assert.ErrorIs(t, err, ErrUnserviceablePincode)
+}
+
+func TestFindDeliveryChargeWithoutShippingZones(t *testing.T) {
+
+	address := response.Address{ID: 1, CountryID: 1, Pincode: 682001}
+
+	ctl := gomock.NewController(t)
+	shippingRepo := mockrepo.NewMockShippingRepository(ctl)
+	shippingRepo.EXPECT().FindShippingZoneOfPincode(gomock.Any(), address.CountryID, address.Pincode).
+		Times(1).Return(domain.ShippingZone{}, nil)
+	shippingRepo.EXPECT().IsAnyShippingZoneExist(gomock.Any()).Times(1).Return(false, nil)
+
+	// checkout should work with free delivery before the shop configure any zone
+	charge, err := findDeliveryCharge(context.Background(), shippingRepo, address, 1000, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(0), charge)
+}
@@ -0,0 +1,243 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/mail"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/token"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+)
+
+const (
+	// length of random bytes of password token for invited staff
+	staffPasswordTokenLength   = 32
+	staffPasswordTokenDuration = time.Hour * 48
+)
+
+func (c *adminUseCase) FindAllRoles(ctx context.Context) ([]response.Role, error) {
+
+	roles, err := c.adminRepo.FindAllRoles(ctx)
+	if err != nil {
+		return nil, utils.PrependMessageToError(err, "failed to find all roles")
+	}
+
+	for i := range roles {
+		roles[i].Permissions = []string{}
+		if roles[i].PermissionNames != "" {
+			roles[i].Permissions = strings.Split(roles[i].PermissionNames, ",")
+		}
+	}
+
+	return roles, nil
+}
+
+func (c *adminUseCase) FindAllStaff(ctx context.Context, pagination request.Pagination) ([]response.Staff, error) {
+
+	staff, err := c.adminRepo.FindAllStaff(ctx, pagination)
+	if err != nil {
+		return nil, utils.PrependMessageToError(err, "failed to find all staff")
+	}
+
+	return staff, nil
+}
+
+// create a staff account without password and send the invitation mail with a token to set the password
+// the staff is saved only if the invitation is sent (staff can't login until the password is set)
+func (c *adminUseCase) InviteStaff(ctx context.Context, reqData request.StaffInvite) (uint, error) {
+
+	existAdmin, err := c.adminRepo.FindAdminByEmail(ctx, reqData.Email)
+	if err != nil {
+		return 0, utils.PrependMessageToError(err, "failed to check admin already exist with email")
+	}
+	if existAdmin.ID != 0 {
+		return 0, ErrAdminAlreadyExist
+	}
+
+	existAdmin, err = c.adminRepo.FindAdminByUserName(ctx, reqData.UserName)
+	if err != nil {
+		return 0, utils.PrependMessageToError(err, "failed to check admin already exist with user_name")
+	}
+	if existAdmin.ID != 0 {
+		return 0, ErrAdminAlreadyExist
+	}
+
+	role, err := c.adminRepo.FindRoleByID(ctx, reqData.RoleID)
+	if err != nil {
+		return 0, utils.PrependMessageToError(err, "failed to find role")
+	}
+	if role.ID == 0 {
+		return 0, ErrInvalidRoleID
+	}
+
+	passwordToken, err := generateStaffPasswordToken()
+	if err != nil {
+		return 0, utils.PrependMessageToError(err, "failed to generate password token")
+	}
+
+	var adminID uint
+	err = c.adminRepo.Transaction(func(trxRepo interfaces.AdminRepository) error {
+
+		adminID, err = trxRepo.SaveStaff(ctx, domain.Admin{
+			UserName: reqData.UserName,
+			Email:    reqData.Email,
+			RoleID:   role.ID,
+		})
+		if err != nil {
+			return utils.PrependMessageToError(err, "failed to save staff")
+		}
+
+		err = trxRepo.SaveStaffPasswordToken(ctx, domain.StaffPasswordToken{
+			AdminID:   adminID,
+			TokenHash: hashStaffPasswordToken(passwordToken),
+			ExpireAt:  time.Now().Add(staffPasswordTokenDuration),
+		})
+		if err != nil {
+			return utils.PrependMessageToError(err, "failed to save password token")
+		}
+
+		err = c.mailSender.Send(ctx, mail.Mail{
+			To:      reqData.Email,
+			Subject: "Staff invitation",
+			Body: fmt.Sprintf("You are invited as %s with user_name %s.\n"+
+				"Set your password with this token within %v hours: %s",
+				role.Name, reqData.UserName, staffPasswordTokenDuration.Hours(), passwordToken),
+		})
+		if err != nil {
+			return utils.PrependMessageToError(err, "failed to send staff invitation")
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return adminID, nil
+}
+
+// To set the password of invited staff with the password token (a token can be used only once)
+func (c *adminUseCase) SetStaffPassword(ctx context.Context, reqData request.StaffSetPassword) error {
+
+	passwordToken, err := c.adminRepo.FindStaffPasswordToken(ctx, hashStaffPasswordToken(reqData.Token))
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find password token")
+	}
+	if passwordToken.ID == 0 || passwordToken.Used || time.Now().After(passwordToken.ExpireAt) {
+		return ErrInvalidStaffPasswordToken
+	}
+
+	hashPass, err := utils.GetHashedPassword(reqData.Password)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to hash the password")
+	}
+
+	return c.adminRepo.Transaction(func(trxRepo interfaces.AdminRepository) error {
+
+		// a concurrent request may have used the token already
+		used, err := trxRepo.UseStaffPasswordToken(ctx, passwordToken.ID)
+		if err != nil {
+			return utils.PrependMessageToError(err, "failed to mark password token used")
+		}
+		if !used {
+			return ErrInvalidStaffPasswordToken
+		}
+
+		err = trxRepo.UpdateAdminPassword(ctx, passwordToken.AdminID, hashPass)
+		if err != nil {
+			return utils.PrependMessageToError(err, "failed to update password of staff")
+		}
+		return nil
+	})
+}
+
+// generate a random token to send for staff (only the hash of it is saved)
+func generateStaffPasswordToken() (string, error) {
+
+	token := make([]byte, staffPasswordTokenLength)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(token), nil
+}
+
+func hashStaffPasswordToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// update role or block status of the staff
+// all sessions of the staff are revoked so the new permissions apply from the next login
+func (c *adminUseCase) UpdateStaff(ctx context.Context, currentAdminID, adminID uint,
+	reqData request.StaffUpdate) error {
+
+	if currentAdminID == adminID {
+		return ErrStaffSelfModify
+	}
+
+	admin, err := c.adminRepo.FindAdminByID(ctx, adminID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find staff")
+	}
+	if admin.ID == 0 {
+		return ErrStaffNotExist
+	}
+
+	role, err := c.adminRepo.FindRoleByID(ctx, reqData.RoleID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find role")
+	}
+	if role.ID == 0 {
+		return ErrInvalidRoleID
+	}
+
+	err = c.adminRepo.UpdateStaff(ctx, adminID, role.ID, reqData.BlockStatus)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to update staff")
+	}
+
+	return c.revokeAllStaffSessions(ctx, adminID)
+}
+
+func (c *adminUseCase) DeleteStaff(ctx context.Context, currentAdminID, adminID uint) error {
+
+	if currentAdminID == adminID {
+		return ErrStaffSelfModify
+	}
+
+	admin, err := c.adminRepo.FindAdminByID(ctx, adminID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find staff")
+	}
+	if admin.ID == 0 {
+		return ErrStaffNotExist
+	}
+
+	err = c.adminRepo.DeleteAdmin(ctx, adminID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to delete staff")
+	}
+
+	return c.revokeAllStaffSessions(ctx, adminID)
+}
+
+func (c *adminUseCase) revokeAllStaffSessions(ctx context.Context, adminID uint) error {
+
+	revokeUntil := time.Now().Add(AccessTokenDuration)
+
+	err := c.authRepo.RevokeAllRefreshSessions(ctx, adminID, string(token.Admin), revokeUntil)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to revoke all sessions of staff")
+	}
+
+	return nil
+}
@@ -0,0 +1,284 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/mock/mockrepo"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/mail"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/mail/mailtest"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/token"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateStaff(t *testing.T) {
+
+	var (
+		currentAdminID uint = 1
+		staffID        uint = 2
+		roleID         uint = 3
+	)
+
+	tests := []struct {
+		testName       string
+		currentAdminID uint
+		adminID        uint
+		reqData        request.StaffUpdate
+		buildStub      func(adminRepo *mockrepo.MockAdminRepository, authRepo *mockrepo.MockAuthRepository)
+		expectedError  error
+	}{
+		{
+			testName:       "UpdatingOwnAccountShouldReturnError",
+			currentAdminID: currentAdminID,
+			adminID:        currentAdminID,
+			reqData:        request.StaffUpdate{RoleID: roleID},
+			buildStub: func(adminRepo *mockrepo.MockAdminRepository, authRepo *mockrepo.MockAuthRepository) {
+			},
+			expectedError: ErrStaffSelfModify,
+		},
+		{
+			testName:       "NotExistStaffShouldReturnError",
+			currentAdminID: currentAdminID,
+			adminID:        staffID,
+			reqData:        request.StaffUpdate{RoleID: roleID},
+			buildStub: func(adminRepo *mockrepo.MockAdminRepository, authRepo *mockrepo.MockAuthRepository) {
+				adminRepo.EXPECT().FindAdminByID(gomock.Any(), staffID).Times(1).Return(domain.Admin{}, nil)
+			},
+			expectedError: ErrStaffNotExist,
+		},
+		{
+			testName:       "InvalidRoleShouldReturnError",
+			currentAdminID: currentAdminID,
+			adminID:        staffID,
+			reqData:        request.StaffUpdate{RoleID: roleID},
+			buildStub: func(adminRepo *mockrepo.MockAdminRepository, authRepo *mockrepo.MockAuthRepository) {
+				adminRepo.EXPECT().FindAdminByID(gomock.Any(), staffID).Times(1).Return(domain.Admin{ID: staffID}, nil)
+				adminRepo.EXPECT().FindRoleByID(gomock.Any(), roleID).Times(1).Return(domain.Role{}, nil)
+			},
+			expectedError: ErrInvalidRoleID,
+		},
+		{
+			testName:       "FailedToRevokeSessionsShouldReturnError",
+			currentAdminID: currentAdminID,
+			adminID:        staffID,
+			reqData:        request.StaffUpdate{RoleID: roleID, BlockStatus: true},
+			buildStub: func(adminRepo *mockrepo.MockAdminRepository, authRepo *mockrepo.MockAuthRepository) {
+				adminRepo.EXPECT().FindAdminByID(gomock.Any(), staffID).Times(1).Return(domain.Admin{ID: staffID}, nil)
+				adminRepo.EXPECT().FindRoleByID(gomock.Any(), roleID).Times(1).Return(domain.Role{ID: roleID}, nil)
+				adminRepo.EXPECT().UpdateStaff(gomock.Any(), staffID, roleID, true).Times(1).Return(nil)
+				authRepo.EXPECT().RevokeAllRefreshSessions(gomock.Any(), staffID, string(token.Admin), gomock.Any()).
+					Times(1).Return(errors.New("db error"))
+			},
+			expectedError: errors.New("failed to revoke all sessions of staff"),
+		},
+		{
+			testName:       "SuccessfulUpdateShouldRevokeAllSessionsOfStaff",
+			currentAdminID: currentAdminID,
+			adminID:        staffID,
+			reqData:        request.StaffUpdate{RoleID: roleID},
+			buildStub: func(adminRepo *mockrepo.MockAdminRepository, authRepo *mockrepo.MockAuthRepository) {
+				adminRepo.EXPECT().FindAdminByID(gomock.Any(), staffID).Times(1).Return(domain.Admin{ID: staffID}, nil)
+				adminRepo.EXPECT().FindRoleByID(gomock.Any(), roleID).Times(1).Return(domain.Role{ID: roleID}, nil)
+				adminRepo.EXPECT().UpdateStaff(gomock.Any(), staffID, roleID, false).Times(1).Return(nil)
+				authRepo.EXPECT().RevokeAllRefreshSessions(gomock.Any(), staffID, string(token.Admin), gomock.Any()).
+					Times(1).Return(nil)
+			},
+			expectedError: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+
+			ctl := gomock.NewController(t)
+			adminRepo := mockrepo.NewMockAdminRepository(ctl)
+			authRepo := mockrepo.NewMockAuthRepository(ctl)
+			test.buildStub(adminRepo, authRepo)
+
+			adminUseCase := NewAdminUseCase(adminRepo, nil, authRepo, nil)
+
+			err := adminUseCase.UpdateStaff(context.Background(), test.currentAdminID, test.adminID, test.reqData)
+			if test.expectedError == nil {
+				assert.NoError(t, err)
+				return
+			}
+			// sentinel errors are checked directly, others by the prepended message
+			if !errors.Is(err, test.expectedError) {
+				assert.ErrorContains(t, err, test.expectedError.Error())
+			}
+		})
+	}
+}
+
+func TestInviteStaff(t *testing.T) {
+
+	reqData := request.StaffInvite{UserName: "staff", Email: "staff@example.com", RoleID: 3}
+	role := domain.Role{ID: 3, Name: domain.RoleSupport}
+
+	// stubs till the staff is saved on transaction
+	buildSaveStub := func(adminRepo *mockrepo.MockAdminRepository, savedTokenHash *string) {
+		adminRepo.EXPECT().FindAdminByEmail(gomock.Any(), reqData.Email).Times(1).Return(domain.Admin{}, nil)
+		adminRepo.EXPECT().FindAdminByUserName(gomock.Any(), reqData.UserName).Times(1).Return(domain.Admin{}, nil)
+		adminRepo.EXPECT().FindRoleByID(gomock.Any(), reqData.RoleID).Times(1).Return(role, nil)
+		adminRepo.EXPECT().Transaction(gomock.Any()).Times(1).
+			DoAndReturn(func(trxFn func(repo interfaces.AdminRepository) error) error {
+				return trxFn(adminRepo)
+			})
+		// staff is saved without any password
+		adminRepo.EXPECT().SaveStaff(gomock.Any(), domain.Admin{
+			UserName: reqData.UserName,
+			Email:    reqData.Email,
+			RoleID:   role.ID,
+		}).Times(1).Return(uint(5), nil)
+		adminRepo.EXPECT().SaveStaffPasswordToken(gomock.Any(), gomock.Any()).Times(1).
+			DoAndReturn(func(_ context.Context, passwordToken domain.StaffPasswordToken) error {
+				assert.Equal(t, uint(5), passwordToken.AdminID)
+				assert.WithinDuration(t, time.Now().Add(staffPasswordTokenDuration), passwordToken.ExpireAt, time.Minute)
+				*savedTokenHash = passwordToken.TokenHash
+				return nil
+			})
+	}
+
+	t.Run("InvitationShouldMailTokenAndSaveOnlyItsHash", func(t *testing.T) {
+
+		ctl := gomock.NewController(t)
+		adminRepo := mockrepo.NewMockAdminRepository(ctl)
+		var savedTokenHash string
+		buildSaveStub(adminRepo, &savedTokenHash)
+
+		server := mailtest.NewServer(t)
+		sender := mail.NewSmtpSender(server.Host, server.Port, "", "", "shop@example.com")
+
+		adminUseCase := NewAdminUseCase(adminRepo, nil, nil, sender)
+
+		adminID, err := adminUseCase.InviteStaff(context.Background(), reqData)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(5), adminID)
+
+		messages := server.Messages()
+		if assert.Len(t, messages, 1) {
+			assert.Equal(t, []string{reqData.Email}, messages[0].To)
+
+			passwordToken := regexp.MustCompile(`[0-9a-f]{64}`).FindString(messages[0].Data)
+			assert.NotEmpty(t, passwordToken)
+			assert.NotEqual(t, passwordToken, savedTokenHash)
+			assert.Equal(t, hashStaffPasswordToken(passwordToken), savedTokenHash)
+		}
+	})
+
+	t.Run("FailedInvitationMailShouldNotSaveStaff", func(t *testing.T) {
+
+		ctl := gomock.NewController(t)
+		adminRepo := mockrepo.NewMockAdminRepository(ctl)
+		var savedTokenHash string
+		buildSaveStub(adminRepo, &savedTokenHash)
+
+		// closed server fail to send the mail
+		server := mailtest.NewServer(t)
+		server.Close()
+		sender := mail.NewSmtpSender(server.Host, server.Port, "", "", "shop@example.com")
+
+		adminUseCase := NewAdminUseCase(adminRepo, nil, nil, sender)
+
+		_, err := adminUseCase.InviteStaff(context.Background(), reqData)
+		// error returned from transaction callback roll back the saved staff
+		assert.ErrorContains(t, err, "failed to send staff invitation")
+	})
+}
+
+func TestSetStaffPassword(t *testing.T) {
+
+	reqData := request.StaffSetPassword{Token: "token", Password: "new_password", ConfirmPassword: "new_password"}
+	tokenHash := hashStaffPasswordToken(reqData.Token)
+	validToken := domain.StaffPasswordToken{ID: 1, AdminID: 5, TokenHash: tokenHash, ExpireAt: time.Now().Add(time.Hour)}
+
+	tests := []struct {
+		testName      string
+		buildStub     func(adminRepo *mockrepo.MockAdminRepository)
+		expectedError error
+	}{
+		{
+			testName: "NotExistingTokenShouldReturnError",
+			buildStub: func(adminRepo *mockrepo.MockAdminRepository) {
+				adminRepo.EXPECT().FindStaffPasswordToken(gomock.Any(), tokenHash).Times(1).
+					Return(domain.StaffPasswordToken{}, nil)
+			},
+			expectedError: ErrInvalidStaffPasswordToken,
+		},
+		{
+			testName: "ExpiredTokenShouldReturnError",
+			buildStub: func(adminRepo *mockrepo.MockAdminRepository) {
+				expiredToken := validToken
+				expiredToken.ExpireAt = time.Now().Add(-time.Minute)
+				adminRepo.EXPECT().FindStaffPasswordToken(gomock.Any(), tokenHash).Times(1).Return(expiredToken, nil)
+			},
+			expectedError: ErrInvalidStaffPasswordToken,
+		},
+		{
+			testName: "UsedTokenShouldReturnError",
+			buildStub: func(adminRepo *mockrepo.MockAdminRepository) {
+				usedToken := validToken
+				usedToken.Used = true
+				adminRepo.EXPECT().FindStaffPasswordToken(gomock.Any(), tokenHash).Times(1).Return(usedToken, nil)
+			},
+			expectedError: ErrInvalidStaffPasswordToken,
+		},
+		{
+			testName: "TokenUsedByConcurrentRequestShouldReturnError",
+			buildStub: func(adminRepo *mockrepo.MockAdminRepository) {
+				adminRepo.EXPECT().FindStaffPasswordToken(gomock.Any(), tokenHash).Times(1).Return(validToken, nil)
+				adminRepo.EXPECT().Transaction(gomock.Any()).Times(1).
+					DoAndReturn(func(trxFn func(repo interfaces.AdminRepository) error) error {
+						return trxFn(adminRepo)
+					})
+				adminRepo.EXPECT().UseStaffPasswordToken(gomock.Any(), validToken.ID).Times(1).Return(false, nil)
+			},
+			expectedError: ErrInvalidStaffPasswordToken,
+		},
+		{
+			testName: "ValidTokenShouldSetPasswordOfStaff",
+			buildStub: func(adminRepo *mockrepo.MockAdminRepository) {
+				adminRepo.EXPECT().FindStaffPasswordToken(gomock.Any(), tokenHash).Times(1).Return(validToken, nil)
+				adminRepo.EXPECT().Transaction(gomock.Any()).Times(1).
+					DoAndReturn(func(trxFn func(repo interfaces.AdminRepository) error) error {
+						return trxFn(adminRepo)
+					})
+				adminRepo.EXPECT().UseStaffPasswordToken(gomock.Any(), validToken.ID).Times(1).Return(true, nil)
+				adminRepo.EXPECT().UpdateAdminPassword(gomock.Any(), validToken.AdminID, gomock.Any()).Times(1).
+					DoAndReturn(func(_ context.Context, _ uint, hashPassword string) error {
+						assert.True(t, utils.VerifyHashAndPassword(hashPassword, reqData.Password))
+						return nil
+					})
+			},
+			expectedError: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+
+			ctl := gomock.NewController(t)
+			adminRepo := mockrepo.NewMockAdminRepository(ctl)
+			test.buildStub(adminRepo)
+
+			adminUseCase := NewAdminUseCase(adminRepo, nil, nil, nil)
+
+			err := adminUseCase.SetStaffPassword(context.Background(), reqData)
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
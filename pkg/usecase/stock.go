@@ -2,22 +2,29 @@ package usecase
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/notification"
 	service "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
 )
 
 type stockUseCase struct {
 	stockRepo interfaces.StockRepository
+	notifier  notification.Notifier
 }
 
-func NewStockUseCase(stockRepo interfaces.StockRepository) service.StockUseCase {
+func NewStockUseCase(stockRepo interfaces.StockRepository, notifier notification.Notifier) service.StockUseCase {
 
 	return &stockUseCase{
 		stockRepo: stockRepo,
+		notifier:  notifier,
 	}
 }
 
@@ -32,14 +39,201 @@ func (c *stockUseCase) GetAllStockDetails(ctx context.Context, pagination reques
 	return stocks, nil
 }
 
-func (c *stockUseCase) UpdateStockBySKU(ctx context.Context, updateDetails request.UpdateStock) error {
+// To add or remove stock of product item by admin and save it on stock movements
+func (c *stockUseCase) UpdateStockBySKU(ctx context.Context, adminID uint, updateDetails request.UpdateStock) error {
 
-	err := c.stockRepo.Update(ctx, updateDetails)
+	if updateDetails.QtyToAdd == 0 {
+		return ErrZeroStockChange
+	}
+
+	productItem, err := c.stockRepo.FindProductItemBySKU(ctx, updateDetails.SKU)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find product item by sku")
+	}
+	if productItem.ID == 0 {
+		return ErrInvalidSKU
+	}
 
+	reason := domain.StockRestock
+	if updateDetails.QtyToAdd < 0 {
+		reason = domain.StockManualAdjustment
+	}
+
+	applied, err := c.stockRepo.ApplyStockMovement(ctx, domain.StockMovement{
+		ProductItemID: productItem.ID,
+		Quantity:      updateDetails.QtyToAdd,
+		Reason:        reason,
+		ActorType:     domain.ActorAdmin,
+		ActorID:       adminID,
+		Note:          updateDetails.Note,
+	})
 	if err != nil {
-		return err
+		return utils.PrependMessageToError(err, "failed to update stock")
+	}
+	if !applied {
+		return ErrNotEnoughStock
 	}
 
 	log.Printf("successfully updated of stock details of stock with sku %v", updateDetails.SKU)
 	return nil
 }
+
+// To find all stock movements of a product item
+func (c *stockUseCase) FindAllStockMovementsBySKU(ctx context.Context, sku string,
+	pagination request.Pagination) ([]domain.StockMovement, error) {
+
+	productItem, err := c.stockRepo.FindProductItemBySKU(ctx, sku)
+	if err != nil {
+		return nil, utils.PrependMessageToError(err, "failed to find product item by sku")
+	}
+	if productItem.ID == 0 {
+		return nil, ErrInvalidSKU
+	}
+
+	movements, err := c.stockRepo.FindAllStockMovements(ctx, productItem.ID, pagination)
+	if err != nil {
+		return nil, utils.PrependMessageToError(err, "failed to find stock movements")
+	}
+
+	return movements, nil
+}
+
+// To find all stocks which are reached their reorder threshold
+func (c *stockUseCase) FindAllLowStocks(ctx context.Context, pagination request.Pagination) ([]response.Stock, error) {
+
+	stocks, err := c.stockRepo.FindAllLowStocks(ctx, pagination)
+	if err != nil {
+		return nil, utils.PrependMessageToError(err, "failed to find low stocks")
+	}
+
+	return stocks, nil
+}
+
+// To update the reorder threshold of product item (zero threshold disable the low stock alert)
+func (c *stockUseCase) UpdateReorderThresholdBySKU(ctx context.Context, sku string, reorderThreshold uint) error {
+
+	productItem, err := c.stockRepo.FindProductItemBySKU(ctx, sku)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find product item by sku")
+	}
+	if productItem.ID == 0 {
+		return ErrInvalidSKU
+	}
+
+	err = c.stockRepo.UpdateReorderThreshold(ctx, productItem.ID, reorderThreshold)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to update reorder threshold")
+	}
+
+	log.Printf("successfully updated reorder threshold of stock with sku %v to %v", sku, reorderThreshold)
+	return nil
+}
+
+// To send a digest of all stocks which are reached their reorder threshold
+// return the count of low stocks (digest will not send when there is no low stocks)
+func (c *stockUseCase) SendLowStockDigest(ctx context.Context) (int, error) {
+
+	const digestPageCount = 100
+
+	var lowStocks []response.Stock
+	for pageNumber := uint64(1); ; pageNumber++ {
+
+		stocks, err := c.stockRepo.FindAllLowStocks(ctx, request.Pagination{
+			PageNumber: pageNumber,
+			Count:      digestPageCount,
+		})
+		if err != nil {
+			return 0, utils.PrependMessageToError(err, "failed to find low stocks")
+		}
+		lowStocks = append(lowStocks, stocks...)
+
+		if len(stocks) < digestPageCount {
+			break
+		}
+	}
+
+	if len(lowStocks) == 0 {
+		return 0, nil
+	}
+
+	err := c.notifier.Notify(ctx, notification.Notification{
+		Subject: fmt.Sprintf("Low stock digest: %d items reached reorder threshold", len(lowStocks)),
+		Message: formatLowStocks(lowStocks),
+	})
+	if err != nil {
+		return 0, utils.PrependMessageToError(err, "failed to send low stock digest")
+	}
+
+	return len(lowStocks), nil
+}
+
+// To notify the stocks of shop order which are reached reorder threshold by this order
+// the order is already completed so failures are only logged
+func notifyLowStocksOfShopOrder(ctx context.Context, stockRepo interfaces.StockRepository,
+	notifier notification.Notifier, shopOrderID uint) {
+
+	lowStocks, err := stockRepo.FindAllLowStocksByShopOrderID(ctx, shopOrderID)
+	if err != nil {
+		log.Printf("failed to find low stocks of shop order with shop_order_id %v: %v", shopOrderID, err)
+		return
+	}
+	if len(lowStocks) == 0 {
+		return
+	}
+
+	err = notifier.Notify(ctx, notification.Notification{
+		Subject: fmt.Sprintf("Low stock alert: %d items reached reorder threshold", len(lowStocks)),
+		Message: formatLowStocks(lowStocks),
+	})
+	if err != nil {
+		log.Printf("failed to notify low stocks of shop order with shop_order_id %v: %v", shopOrderID, err)
+	}
+}
+
+func formatLowStocks(stocks []response.Stock) string {
+
+	var builder strings.Builder
+	for _, stock := range stocks {
+		fmt.Fprintf(&builder, "sku: %s, product: %s, qty_in_stock: %d, reorder_threshold: %d\n",
+			stock.SKU, stock.ProductName, stock.QtyInStock, stock.ReorderThreshold)
+	}
+
+	return builder.String()
+}
+
+// To change the stock of all order lines of the shop order and save them on stock movements
+// reservation and sale take out the stock and all other reasons add back the stock
+func changeOrderStock(ctx context.Context, orderRepo interfaces.OrderRepository, shopOrderID uint,
+	reason domain.StockMovementReason, actorType domain.ActorType, actorID uint) error {
+
+	orderLines, err := orderRepo.FindAllOrderLinesByShopOrderID(ctx, shopOrderID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find order lines of shop order")
+	}
+
+	for _, orderLine := range orderLines {
+
+		quantity := int(orderLine.Qty)
+		if reason == domain.StockReservation || reason == domain.StockSale {
+			quantity = -quantity
+		}
+
+		applied, err := orderRepo.ApplyStockMovement(ctx, domain.StockMovement{
+			ProductItemID: orderLine.ProductItemID,
+			Quantity:      quantity,
+			Reason:        reason,
+			ActorType:     actorType,
+			ActorID:       actorID,
+			ReferenceID:   shopOrderID,
+		})
+		if err != nil {
+			return utils.PrependMessageToError(err, "failed to save stock movement of order line")
+		}
+		if !applied {
+			return utils.PrependMessageToError(ErrNotEnoughStock,
+				fmt.Sprintf("product item of product_item_id %v", orderLine.ProductItemID))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,456 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/response"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/mock/mockrepo"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/mock/mockservice"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/service/notification"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateStockBySKU(t *testing.T) {
+
+	adminID := uint(3)
+	productItem := domain.ProductItem{ID: 9, SKU: "SKU1"}
+	dbErr := errors.New("db error")
+
+	tests := []struct {
+		testName      string
+		input         request.UpdateStock
+		buildStub     func(stockRepo *mockrepo.MockStockRepository)
+		expectedError error
+	}{
+		{
+			testName:      "ZeroStockChangeShouldReturnError",
+			input:         request.UpdateStock{SKU: "SKU1"},
+			buildStub:     func(stockRepo *mockrepo.MockStockRepository) {},
+			expectedError: ErrZeroStockChange,
+		},
+		{
+			testName: "InvalidSKUShouldReturnError",
+			input:    request.UpdateStock{SKU: "SKU2", QtyToAdd: 5},
+			buildStub: func(stockRepo *mockrepo.MockStockRepository) {
+				stockRepo.EXPECT().FindProductItemBySKU(gomock.Any(), "SKU2").Times(1).
+					Return(domain.ProductItem{}, nil)
+			},
+			expectedError: ErrInvalidSKU,
+		},
+		{
+			testName: "AddedStockShouldSaveRestockWithAdmin",
+			input:    request.UpdateStock{SKU: "SKU1", QtyToAdd: 5, Note: "new arrival"},
+			buildStub: func(stockRepo *mockrepo.MockStockRepository) {
+				stockRepo.EXPECT().FindProductItemBySKU(gomock.Any(), "SKU1").Times(1).Return(productItem, nil)
+				stockRepo.EXPECT().ApplyStockMovement(gomock.Any(), domain.StockMovement{
+					ProductItemID: productItem.ID,
+					Quantity:      5,
+					Reason:        domain.StockRestock,
+					ActorType:     domain.ActorAdmin,
+					ActorID:       adminID,
+					Note:          "new arrival",
+				}).Times(1).Return(true, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			testName: "RemovedStockShouldSaveManualAdjustment",
+			input:    request.UpdateStock{SKU: "SKU1", QtyToAdd: -2, Note: "damaged"},
+			buildStub: func(stockRepo *mockrepo.MockStockRepository) {
+				stockRepo.EXPECT().FindProductItemBySKU(gomock.Any(), "SKU1").Times(1).Return(productItem, nil)
+				stockRepo.EXPECT().ApplyStockMovement(gomock.Any(), domain.StockMovement{
+					ProductItemID: productItem.ID,
+					Quantity:      -2,
+					Reason:        domain.StockManualAdjustment,
+					ActorType:     domain.ActorAdmin,
+					ActorID:       adminID,
+					Note:          "damaged",
+				}).Times(1).Return(true, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			testName: "RemovingMoreThanStockShouldReturnError",
+			input:    request.UpdateStock{SKU: "SKU1", QtyToAdd: -20},
+			buildStub: func(stockRepo *mockrepo.MockStockRepository) {
+				stockRepo.EXPECT().FindProductItemBySKU(gomock.Any(), "SKU1").Times(1).Return(productItem, nil)
+				stockRepo.EXPECT().ApplyStockMovement(gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
+			},
+			expectedError: ErrNotEnoughStock,
+		},
+		{
+			testName: "FailedStockMovementShouldReturnError",
+			input:    request.UpdateStock{SKU: "SKU1", QtyToAdd: 5},
+			buildStub: func(stockRepo *mockrepo.MockStockRepository) {
+				stockRepo.EXPECT().FindProductItemBySKU(gomock.Any(), "SKU1").Times(1).Return(productItem, nil)
+				stockRepo.EXPECT().ApplyStockMovement(gomock.Any(), gomock.Any()).Times(1).Return(false, dbErr)
+			},
+			expectedError: dbErr,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+			t.Parallel()
+
+			ctl := gomock.NewController(t)
+			stockRepo := mockrepo.NewMockStockRepository(ctl)
+			test.buildStub(stockRepo)
+
+			stockUseCase := NewStockUseCase(stockRepo, nil)
+
+			err := stockUseCase.UpdateStockBySKU(context.Background(), adminID, test.input)
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFindAllStockMovementsBySKU(t *testing.T) {
+
+	pagination := request.Pagination{PageNumber: 1, Count: 10}
+	movements := []domain.StockMovement{
+		{ID: 2, ProductItemID: 9, Quantity: -1, Reason: domain.StockReservation, ReferenceID: 4},
+		{ID: 1, ProductItemID: 9, Quantity: 10, Reason: domain.StockRestock},
+	}
+
+	tests := []struct {
+		testName       string
+		sku            string
+		buildStub      func(stockRepo *mockrepo.MockStockRepository)
+		expectedOutput []domain.StockMovement
+		expectedError  error
+	}{
+		{
+			testName: "InvalidSKUShouldReturnError",
+			sku:      "SKU2",
+			buildStub: func(stockRepo *mockrepo.MockStockRepository) {
+				stockRepo.EXPECT().FindProductItemBySKU(gomock.Any(), "SKU2").Times(1).
+					Return(domain.ProductItem{}, nil)
+			},
+			expectedOutput: nil,
+			expectedError:  ErrInvalidSKU,
+		},
+		{
+			testName: "ValidSKUShouldReturnMovementsOfProductItem",
+			sku:      "SKU1",
+			buildStub: func(stockRepo *mockrepo.MockStockRepository) {
+				stockRepo.EXPECT().FindProductItemBySKU(gomock.Any(), "SKU1").Times(1).
+					Return(domain.ProductItem{ID: 9, SKU: "SKU1"}, nil)
+				stockRepo.EXPECT().FindAllStockMovements(gomock.Any(), uint(9), pagination).Times(1).
+					Return(movements, nil)
+			},
+			expectedOutput: movements,
+			expectedError:  nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+			t.Parallel()
+
+			ctl := gomock.NewController(t)
+			stockRepo := mockrepo.NewMockStockRepository(ctl)
+			test.buildStub(stockRepo)
+
+			stockUseCase := NewStockUseCase(stockRepo, nil)
+
+			output, err := stockUseCase.FindAllStockMovementsBySKU(context.Background(), test.sku, pagination)
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expectedOutput, output)
+		})
+	}
+}
+
+func TestChangeOrderStock(t *testing.T) {
+
+	shopOrderID := uint(4)
+	orderLines := []domain.OrderLine{
+		{ID: 1, ShopOrderID: shopOrderID, ProductItemID: 7, Qty: 2},
+		{ID: 2, ShopOrderID: shopOrderID, ProductItemID: 8, Qty: 1},
+	}
+
+	// expect the stock movement of each order line with the given quantity sign
+	expectMovements := func(orderRepo *mockrepo.MockOrderRepository, reason domain.StockMovementReason, sign int) {
+		for _, orderLine := range orderLines {
+			orderRepo.EXPECT().ApplyStockMovement(gomock.Any(), domain.StockMovement{
+				ProductItemID: orderLine.ProductItemID,
+				Quantity:      sign * int(orderLine.Qty),
+				Reason:        reason,
+				ActorType:     domain.ActorUser,
+				ActorID:       1,
+				ReferenceID:   shopOrderID,
+			}).Times(1).Return(true, nil)
+		}
+	}
+
+	tests := []struct {
+		testName      string
+		reason        domain.StockMovementReason
+		buildStub     func(orderRepo *mockrepo.MockOrderRepository)
+		expectedError error
+	}{
+		{
+			testName: "ReservationShouldTakeOutStock",
+			reason:   domain.StockReservation,
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository) {
+				orderRepo.EXPECT().FindAllOrderLinesByShopOrderID(gomock.Any(), shopOrderID).Times(1).
+					Return(orderLines, nil)
+				expectMovements(orderRepo, domain.StockReservation, -1)
+			},
+			expectedError: nil,
+		},
+		{
+			testName: "ReleaseShouldAddBackStock",
+			reason:   domain.StockRelease,
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository) {
+				orderRepo.EXPECT().FindAllOrderLinesByShopOrderID(gomock.Any(), shopOrderID).Times(1).
+					Return(orderLines, nil)
+				expectMovements(orderRepo, domain.StockRelease, 1)
+			},
+			expectedError: nil,
+		},
+		{
+			testName: "SaleShouldTakeOutStock",
+			reason:   domain.StockSale,
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository) {
+				orderRepo.EXPECT().FindAllOrderLinesByShopOrderID(gomock.Any(), shopOrderID).Times(1).
+					Return(orderLines, nil)
+				expectMovements(orderRepo, domain.StockSale, -1)
+			},
+			expectedError: nil,
+		},
+		{
+			testName: "NotEnoughStockShouldStopWithError",
+			reason:   domain.StockReservation,
+			buildStub: func(orderRepo *mockrepo.MockOrderRepository) {
+				orderRepo.EXPECT().FindAllOrderLinesByShopOrderID(gomock.Any(), shopOrderID).Times(1).
+					Return(orderLines, nil)
+				// stock of first item would go negative so the next item is not changed
+				orderRepo.EXPECT().ApplyStockMovement(gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
+			},
+			expectedError: ErrNotEnoughStock,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+			t.Parallel()
+
+			ctl := gomock.NewController(t)
+			orderRepo := mockrepo.NewMockOrderRepository(ctl)
+			test.buildStub(orderRepo)
+
+			err := changeOrderStock(context.Background(), orderRepo, shopOrderID, test.reason, domain.ActorUser, 1)
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUpdateReorderThresholdBySKU(t *testing.T) {
+
+	tests := []struct {
+		testName      string
+		sku           string
+		threshold     uint
+		buildStub     func(stockRepo *mockrepo.MockStockRepository)
+		expectedError error
+	}{
+		{
+			testName:  "InvalidSKUShouldReturnError",
+			sku:       "SKU2",
+			threshold: 5,
+			buildStub: func(stockRepo *mockrepo.MockStockRepository) {
+				stockRepo.EXPECT().FindProductItemBySKU(gomock.Any(), "SKU2").Times(1).
+					Return(domain.ProductItem{}, nil)
+			},
+			expectedError: ErrInvalidSKU,
+		},
+		{
+			testName:  "ValidSKUShouldUpdateThreshold",
+			sku:       "SKU1",
+			threshold: 5,
+			buildStub: func(stockRepo *mockrepo.MockStockRepository) {
+				stockRepo.EXPECT().FindProductItemBySKU(gomock.Any(), "SKU1").Times(1).
+					Return(domain.ProductItem{ID: 9, SKU: "SKU1"}, nil)
+				stockRepo.EXPECT().UpdateReorderThreshold(gomock.Any(), uint(9), uint(5)).Times(1).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			testName:  "ZeroThresholdShouldUpdateToDisableAlert",
+			sku:       "SKU1",
+			threshold: 0,
+			buildStub: func(stockRepo *mockrepo.MockStockRepository) {
+				stockRepo.EXPECT().FindProductItemBySKU(gomock.Any(), "SKU1").Times(1).
+					Return(domain.ProductItem{ID: 9, SKU: "SKU1"}, nil)
+				stockRepo.EXPECT().UpdateReorderThreshold(gomock.Any(), uint(9), uint(0)).Times(1).Return(nil)
+			},
+			expectedError: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+			t.Parallel()
+
+			ctl := gomock.NewController(t)
+			stockRepo := mockrepo.NewMockStockRepository(ctl)
+			test.buildStub(stockRepo)
+
+			stockUseCase := NewStockUseCase(stockRepo, nil)
+
+			err := stockUseCase.UpdateReorderThresholdBySKU(context.Background(), test.sku, test.threshold)
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSendLowStockDigest(t *testing.T) {
+
+	lowStock := response.Stock{SKU: "SKU1", ProductName: "shirt", QtyInStock: 2, ReorderThreshold: 5}
+	notifyErr := errors.New("notify error")
+
+	tests := []struct {
+		testName      string
+		buildStub     func(stockRepo *mockrepo.MockStockRepository, notifier *mockservice.MockNotifier)
+		expectedCount int
+		expectedError error
+	}{
+		{
+			testName: "NoLowStocksShouldNotSendDigest",
+			buildStub: func(stockRepo *mockrepo.MockStockRepository, notifier *mockservice.MockNotifier) {
+				stockRepo.EXPECT().FindAllLowStocks(gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+			},
+			expectedCount: 0,
+			expectedError: nil,
+		},
+		{
+			testName: "LowStocksOfAllPagesShouldSendOnOneDigest",
+			buildStub: func(stockRepo *mockrepo.MockStockRepository, notifier *mockservice.MockNotifier) {
+				fullPage := make([]response.Stock, 100)
+				for i := range fullPage {
+					fullPage[i] = lowStock
+				}
+				stockRepo.EXPECT().FindAllLowStocks(gomock.Any(), request.Pagination{PageNumber: 1, Count: 100}).
+					Times(1).Return(fullPage, nil)
+				stockRepo.EXPECT().FindAllLowStocks(gomock.Any(), request.Pagination{PageNumber: 2, Count: 100}).
+					Times(1).Return([]response.Stock{lowStock}, nil)
+				notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, n notification.Notification) error {
+						assert.Equal(t, "Low stock digest: 101 items reached reorder threshold", n.Subject)
+						return nil
+					})
+			},
+			expectedCount: 101,
+			expectedError: nil,
+		},
+		{
+			testName: "FailedNotifyShouldReturnError",
+			buildStub: func(stockRepo *mockrepo.MockStockRepository, notifier *mockservice.MockNotifier) {
+				stockRepo.EXPECT().FindAllLowStocks(gomock.Any(), gomock.Any()).Times(1).
+					Return([]response.Stock{lowStock}, nil)
+				notifier.EXPECT().Notify(gomock.Any(), notification.Notification{
+					Subject: "Low stock digest: 1 items reached reorder threshold",
+					Message: "sku: SKU1, product: shirt, qty_in_stock: 2, reorder_threshold: 5\n",
+				}).Times(1).Return(notifyErr)
+			},
+			expectedCount: 0,
+			expectedError: notifyErr,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+
+			ctl := gomock.NewController(t)
+			stockRepo := mockrepo.NewMockStockRepository(ctl)
+			notifier := mockservice.NewMockNotifier(ctl)
+			test.buildStub(stockRepo, notifier)
+
+			stockUseCase := NewStockUseCase(stockRepo, notifier)
+
+			count, err := stockUseCase.SendLowStockDigest(context.Background())
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expectedCount, count)
+		})
+	}
+}
+
+func TestNotifyLowStocksOfShopOrder(t *testing.T) {
+
+	shopOrderID := uint(4)
+
+	tests := []struct {
+		testName  string
+		buildStub func(stockRepo *mockrepo.MockStockRepository, notifier *mockservice.MockNotifier)
+	}{
+		{
+			testName: "NoLowStocksShouldNotNotify",
+			buildStub: func(stockRepo *mockrepo.MockStockRepository, notifier *mockservice.MockNotifier) {
+				stockRepo.EXPECT().FindAllLowStocksByShopOrderID(gomock.Any(), shopOrderID).Times(1).Return(nil, nil)
+			},
+		},
+		{
+			testName: "LowStocksOfOrderShouldNotify",
+			buildStub: func(stockRepo *mockrepo.MockStockRepository, notifier *mockservice.MockNotifier) {
+				stockRepo.EXPECT().FindAllLowStocksByShopOrderID(gomock.Any(), shopOrderID).Times(1).
+					Return([]response.Stock{{SKU: "SKU1", ProductName: "shirt", QtyInStock: 0, ReorderThreshold: 3}}, nil)
+				notifier.EXPECT().Notify(gomock.Any(), notification.Notification{
+					Subject: "Low stock alert: 1 items reached reorder threshold",
+					Message: "sku: SKU1, product: shirt, qty_in_stock: 0, reorder_threshold: 3\n",
+				}).Times(1).Return(nil)
+			},
+		},
+		{
+			testName: "FailedLowStocksShouldNotNotify",
+			buildStub: func(stockRepo *mockrepo.MockStockRepository, notifier *mockservice.MockNotifier) {
+				stockRepo.EXPECT().FindAllLowStocksByShopOrderID(gomock.Any(), shopOrderID).Times(1).
+					Return(nil, errors.New("db error"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+			t.Parallel()
+
+			ctl := gomock.NewController(t)
+			stockRepo := mockrepo.NewMockStockRepository(ctl)
+			notifier := mockservice.NewMockNotifier(ctl)
+			test.buildStub(stockRepo, notifier)
+
+			notifyLowStocksOfShopOrder(context.Background(), stockRepo, notifier, shopOrderID)
+		})
+	}
+}
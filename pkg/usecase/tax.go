@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	service "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
+)
+
+type taxUseCase struct {
+	taxRepo interfaces.TaxRepository
+}
+
+func NewTaxUseCase(taxRepo interfaces.TaxRepository) service.TaxUseCase {
+	return &taxUseCase{
+		taxRepo: taxRepo,
+	}
+}
+
+func (c *taxUseCase) SaveTaxClass(ctx context.Context, taxClassDetails request.TaxClass) (uint, error) {
+
+	exist, err := c.taxRepo.IsTaxClassNameExist(ctx, taxClassDetails.Name, 0)
+	if err != nil {
+		return 0, utils.PrependMessageToError(err, "failed to check tax class name already exist")
+	}
+	if exist {
+		return 0, ErrTaxClassAlreadyExist
+	}
+
+	taxClassID, err := c.taxRepo.SaveTaxClass(ctx, domain.TaxClass{
+		Name: taxClassDetails.Name,
+		Rate: taxClassDetails.Rate,
+	})
+	if err != nil {
+		return 0, utils.PrependMessageToError(err, "failed to save tax class")
+	}
+
+	return taxClassID, nil
+}
+
+func (c *taxUseCase) FindAllTaxClasses(ctx context.Context, pagination request.Pagination) ([]domain.TaxClass, error) {
+
+	taxClasses, err := c.taxRepo.FindAllTaxClasses(ctx, pagination)
+	if err != nil {
+		return nil, utils.PrependMessageToError(err, "failed to find all tax classes")
+	}
+
+	return taxClasses, nil
+}
+
+// rate change is only applied on new orders (tax of placed orders are saved on order lines)
+func (c *taxUseCase) UpdateTaxClass(ctx context.Context, taxClassID uint, taxClassDetails request.TaxClass) error {
+
+	if err := c.validateTaxClassID(ctx, taxClassID); err != nil {
+		return err
+	}
+
+	exist, err := c.taxRepo.IsTaxClassNameExist(ctx, taxClassDetails.Name, taxClassID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to check tax class name already exist")
+	}
+	if exist {
+		return ErrTaxClassAlreadyExist
+	}
+
+	err = c.taxRepo.UpdateTaxClass(ctx, domain.TaxClass{
+		ID:   taxClassID,
+		Name: taxClassDetails.Name,
+		Rate: taxClassDetails.Rate,
+	})
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to update tax class")
+	}
+
+	return nil
+}
+
+func (c *taxUseCase) UpdateCategoryTaxClass(ctx context.Context, categoryID, taxClassID uint) error {
+
+	exist, err := c.taxRepo.IsCategoryIDExist(ctx, categoryID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to check category exist")
+	}
+	if !exist {
+		return ErrInvalidCategoryID
+	}
+
+	// zero tax class id remove the tax class of category
+	if taxClassID != 0 {
+		if err := c.validateTaxClassID(ctx, taxClassID); err != nil {
+			return err
+		}
+	}
+
+	err = c.taxRepo.UpdateCategoryTaxClass(ctx, categoryID, taxClassID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to update tax class of category")
+	}
+
+	return nil
+}
+
+func (c *taxUseCase) UpdateProductTaxClass(ctx context.Context, productID, taxClassID uint) error {
+
+	exist, err := c.taxRepo.IsProductIDExist(ctx, productID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to check product exist")
+	}
+	if !exist {
+		return ErrInvalidProductID
+	}
+
+	// zero tax class id remove the tax class of product
+	if taxClassID != 0 {
+		if err := c.validateTaxClassID(ctx, taxClassID); err != nil {
+			return err
+		}
+	}
+
+	err = c.taxRepo.UpdateProductTaxClass(ctx, productID, taxClassID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to update tax class of product")
+	}
+
+	return nil
+}
+
+func (c *taxUseCase) validateTaxClassID(ctx context.Context, taxClassID uint) error {
+
+	taxClass, err := c.taxRepo.FindTaxClassByID(ctx, taxClassID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find tax class")
+	}
+	if taxClass.ID == 0 {
+		return ErrInvalidTaxClassID
+	}
+
+	return nil
+}
+
+// To split the order discount on the line amounts in proportion to the amounts
+// (remaining of the discount from rounding is given to the last line)
+func distributeOrderDiscount(lineAmounts []uint, discount uint) []uint {
+
+	var total uint
+	for _, amount := range lineAmounts {
+		total += amount
+	}
+
+	lineDiscounts := make([]uint, len(lineAmounts))
+	if total == 0 || discount == 0 {
+		return lineDiscounts
+	}
+	if discount > total {
+		discount = total
+	}
+
+	var distributed uint
+	for i, amount := range lineAmounts {
+		if i == len(lineAmounts)-1 {
+			lineDiscounts[i] = discount - distributed
+			break
+		}
+		lineDiscounts[i] = uint(uint64(discount) * uint64(amount) / uint64(total))
+		distributed += lineDiscounts[i]
+	}
+
+	return lineDiscounts
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/mock/mockrepo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistributeOrderDiscount(t *testing.T) {
+
+	tests := []struct {
+		testName          string
+		lineAmounts       []uint
+		discount          uint
+		expectedDiscounts []uint
+	}{
+		{
+			testName:          "NoDiscountShouldReturnZeroDiscounts",
+			lineAmounts:       []uint{100, 200},
+			discount:          0,
+			expectedDiscounts: []uint{0, 0},
+		},
+		{
+			testName:          "DiscountShouldSplitInProportion",
+			lineAmounts:       []uint{100, 300},
+			discount:          40,
+			expectedDiscounts: []uint{10, 30},
+		},
+		{
+			testName:          "RoundingRemainingShouldGoToLastLine",
+			lineAmounts:       []uint{100, 100, 100},
+			discount:          100,
+			expectedDiscounts: []uint{33, 33, 34},
+		},
+		{
+			testName:          "DiscountMoreThanTotalShouldLimitToTotal",
+			lineAmounts:       []uint{50, 50},
+			discount:          150,
+			expectedDiscounts: []uint{50, 50},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expectedDiscounts, distributeOrderDiscount(test.lineAmounts, test.discount))
+		})
+	}
+}
+
+func TestUpdateCategoryTaxClass(t *testing.T) {
+
+	const (
+		categoryID uint = 1
+		taxClassID uint = 2
+	)
+
+	tests := []struct {
+		testName      string
+		taxClassID    uint
+		buildStub     func(taxRepo *mockrepo.MockTaxRepository)
+		expectedError error
+	}{
+		{
+			testName:   "InvalidCategoryShouldReturnError",
+			taxClassID: taxClassID,
+			buildStub: func(taxRepo *mockrepo.MockTaxRepository) {
+				taxRepo.EXPECT().IsCategoryIDExist(gomock.Any(), categoryID).Times(1).Return(false, nil)
+			},
+			expectedError: ErrInvalidCategoryID,
+		},
+		{
+			testName:   "InvalidTaxClassShouldReturnError",
+			taxClassID: taxClassID,
+			buildStub: func(taxRepo *mockrepo.MockTaxRepository) {
+				taxRepo.EXPECT().IsCategoryIDExist(gomock.Any(), categoryID).Times(1).Return(true, nil)
+				taxRepo.EXPECT().FindTaxClassByID(gomock.Any(), taxClassID).Times(1).Return(domain.TaxClass{}, nil)
+			},
+			expectedError: ErrInvalidTaxClassID,
+		},
+		{
+			testName:   "ZeroTaxClassShouldRemoveTaxClassOfCategory",
+			taxClassID: 0,
+			buildStub: func(taxRepo *mockrepo.MockTaxRepository) {
+				taxRepo.EXPECT().IsCategoryIDExist(gomock.Any(), categoryID).Times(1).Return(true, nil)
+				taxRepo.EXPECT().UpdateCategoryTaxClass(gomock.Any(), categoryID, uint(0)).Times(1).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			testName:   "ValidTaxClassShouldUpdateTaxClassOfCategory",
+			taxClassID: taxClassID,
+			buildStub: func(taxRepo *mockrepo.MockTaxRepository) {
+				taxRepo.EXPECT().IsCategoryIDExist(gomock.Any(), categoryID).Times(1).Return(true, nil)
+				taxRepo.EXPECT().FindTaxClassByID(gomock.Any(), taxClassID).Times(1).
+					Return(domain.TaxClass{ID: taxClassID, Name: "GST 18", Rate: 18}, nil)
+				taxRepo.EXPECT().UpdateCategoryTaxClass(gomock.Any(), categoryID, taxClassID).Times(1).Return(nil)
+			},
+			expectedError: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.testName, func(t *testing.T) {
+			t.Parallel()
+
+			ctl := gomock.NewController(t)
+			taxRepo := mockrepo.NewMockTaxRepository(ctl)
+			test.buildStub(taxRepo)
+
+			taxUseCase := NewTaxUseCase(taxRepo)
+
+			err := taxUseCase.UpdateCategoryTaxClass(context.Background(), categoryID, test.taxClassID)
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
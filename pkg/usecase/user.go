@@ -13,7 +13,6 @@ import (
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
 	service "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
-	"golang.org/x/crypto/bcrypt"
 )
 
 type userUserCase struct {
@@ -54,15 +53,6 @@ func (c *userUserCase) UpdateProfile(ctx context.Context, user domain.User) erro
 		return err
 	}
 
-	// if user password given then hash the password
-	if user.Password != "" {
-		hash, err := bcrypt.GenerateFromPassword([]byte(user.Password), 10)
-		if err != nil {
-			return fmt.Errorf("failed to generate hash password for user")
-		}
-		user.Password = string(hash)
-	}
-
 	err = c.userRepo.UpdateUser(ctx, user)
 
 	if err != nil {
@@ -7,6 +7,8 @@ import (
 
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/api/handler/request"
 	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/domain"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/repository/interfaces"
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/utils"
 )
 
 func (c *OrderUseCase) FindUserWallet(ctx context.Context, userID uint) (wallet domain.Wallet, err error) {
@@ -48,3 +50,67 @@ func (c *OrderUseCase) FindUserWalletTransactions(ctx context.Context,
 
 	return transactions, nil
 }
+
+// To debit the amount from user wallet and save the debit transaction
+func debitWallet(ctx context.Context, orderRepo interfaces.OrderRepository, userID, amount uint) error {
+
+	wallet, err := orderRepo.FindWalletByUserID(ctx, userID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find user wallet")
+	}
+	if wallet.ID == 0 {
+		return ErrInsufficientWalletBalance
+	}
+
+	// balance is checked again on the debit so a concurrent payment can't spend it
+	debited, err := orderRepo.DebitWallet(ctx, userID, amount)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to debit wallet amount")
+	}
+	if !debited {
+		return ErrInsufficientWalletBalance
+	}
+
+	err = orderRepo.SaveWalletTransaction(ctx, domain.Transaction{
+		WalletID:        wallet.ID,
+		Amount:          amount,
+		TransactionType: domain.Debit,
+	})
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to save wallet debit transaction")
+	}
+
+	return nil
+}
+
+// To credit the amount to user wallet (create wallet if not exist) and save the credit transaction
+func creditWallet(ctx context.Context, orderRepo interfaces.OrderRepository, userID, amount uint) error {
+
+	wallet, err := orderRepo.FindWalletByUserID(ctx, userID)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to find user wallet")
+	}
+	// if user have no wallet then create a new wallet for user
+	if wallet.ID == 0 {
+		wallet.ID, err = orderRepo.SaveWallet(ctx, userID)
+		if err != nil {
+			return utils.PrependMessageToError(err, "failed to create wallet for user")
+		}
+	}
+
+	err = orderRepo.CreditWallet(ctx, wallet.ID, amount)
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to credit wallet amount")
+	}
+
+	err = orderRepo.SaveWalletTransaction(ctx, domain.Transaction{
+		WalletID:        wallet.ID,
+		Amount:          amount,
+		TransactionType: domain.Credit,
+	})
+	if err != nil {
+		return utils.PrependMessageToError(err, "failed to save wallet credit transaction")
+	}
+
+	return nil
+}
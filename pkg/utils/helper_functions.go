@@ -18,6 +18,12 @@ func GetUserIdFromContext(ctx *gin.Context) uint {
 	return userID
 }
 
+// take sessionId (refresh session of the access token) from context
+func GetSessionIdFromContext(ctx *gin.Context) string {
+	sessionID := ctx.GetString("sessionId")
+	return sessionID
+}
+
 func StringToUint(str string) (uint, error) {
 	val, err := strconv.Atoi(str)
 	return uint(val), err
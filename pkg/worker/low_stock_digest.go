@@ -0,0 +1,64 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/config"
+	service "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
+)
+
+const defaultLowStockDigestInterval = 24 * time.Hour
+
+// LowStockDigest periodically sends the digest of all stocks which are reached their reorder threshold
+type LowStockDigest struct {
+	stockUseCase service.StockUseCase
+	interval     time.Duration
+}
+
+func NewLowStockDigest(stockUseCase service.StockUseCase, cfg config.Config) *LowStockDigest {
+
+	interval := cfg.LowStockDigestInterval
+	if interval <= 0 {
+		interval = defaultLowStockDigestInterval
+	}
+
+	return &LowStockDigest{
+		stockUseCase: stockUseCase,
+		interval:     interval,
+	}
+}
+
+// Start run the digest on a new goroutine until the context is done
+// first digest is sent after the interval (not on every restart of application)
+func (c *LowStockDigest) Start(ctx context.Context) {
+
+	log.Printf("low stock digest started with interval %v", c.interval)
+
+	go func() {
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Println("low stock digest stopped")
+				return
+			case <-ticker.C:
+				c.run(ctx)
+			}
+		}
+	}()
+}
+
+func (c *LowStockDigest) run(ctx context.Context) {
+
+	lowStockCount, err := c.stockUseCase.SendLowStockDigest(ctx)
+	if err != nil {
+		log.Printf("low stock digest failed: %v", err)
+		return
+	}
+
+	log.Printf("low stock digest sent with %d low stocks", lowStockCount)
+}
@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/config"
+	service "github.com/nikhilnarayanan623/ecommerce-gin-clean-arch/pkg/usecase/interfaces"
+)
+
+const (
+	defaultPaymentPendingOrderTTL = 30 * time.Minute
+	defaultOrderReaperInterval    = time.Minute
+)
+
+// OrderReaper periodically cancels the orders which are on payment pending for more than the ttl
+// so the stock reduced for abandoned checkouts is released
+type OrderReaper struct {
+	orderUseCase service.OrderUseCase
+	ttl          time.Duration
+	interval     time.Duration
+}
+
+func NewOrderReaper(orderUseCase service.OrderUseCase, cfg config.Config) *OrderReaper {
+
+	ttl := cfg.PaymentPendingOrderTTL
+	if ttl <= 0 {
+		ttl = defaultPaymentPendingOrderTTL
+	}
+	interval := cfg.OrderReaperInterval
+	if interval <= 0 {
+		interval = defaultOrderReaperInterval
+	}
+
+	return &OrderReaper{
+		orderUseCase: orderUseCase,
+		ttl:          ttl,
+		interval:     interval,
+	}
+}
+
+// Start run the reaper on a new goroutine until the context is done
+func (c *OrderReaper) Start(ctx context.Context) {
+
+	log.Printf("order reaper started with ttl %v and interval %v", c.ttl, c.interval)
+
+	go func() {
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+
+		for {
+			c.run(ctx)
+
+			select {
+			case <-ctx.Done():
+				log.Println("order reaper stopped")
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (c *OrderReaper) run(ctx context.Context) {
+
+	releasedCount, err := c.orderUseCase.ReleaseStalePaymentPendingOrders(ctx, c.ttl)
+	if err != nil {
+		log.Printf("order reaper failed to release stale orders: %v", err)
+		return
+	}
+
+	if releasedCount > 0 {
+		log.Printf("order reaper released %d stale payment pending orders", releasedCount)
+	}
+}